@@ -33,12 +33,13 @@ import (
 	"github.com/mysteriumnetwork/node/core/ip"
 	"github.com/mysteriumnetwork/node/metadata"
 	"github.com/mysteriumnetwork/node/requests"
+	"github.com/mysteriumnetwork/node/tequilapi/openapi"
 )
 
 // Check performs commons checks.
 func Check() {
 	mg.Deps(CheckGenerate)
-	mg.Deps(CheckSwagger)
+	mg.Deps(CheckOpenAPI)
 	mg.Deps(CheckGoImports, CheckDNSMaps, CheckGoLint, CheckGoVet, CheckCopyright)
 }
 
@@ -62,10 +63,17 @@ func CheckGoImports() error {
 	return commands.GoImportsD(".", "pb", "tequilapi/endpoints/assets")
 }
 
-// CheckSwagger checks whether swagger spec at "tequilapi/docs/swagger.json" is valid against swagger specification 2.0.
-func CheckSwagger() error {
-	if err := sh.RunV("swagger", "validate", "tequilapi/docs/swagger.json"); err != nil {
-		return fmt.Errorf("could not validate swagger spec: %w", err)
+// CheckOpenAPI checks whether every route of Tequilapi is documented and examples of the spec conform to their schemas.
+func CheckOpenAPI() error {
+	doc, err := openapi.Generate(".")
+	if err != nil {
+		return fmt.Errorf("could not generate OpenAPI spec: %w", err)
+	}
+	if errs := doc.ValidateExamples(); len(errs) > 0 {
+		for _, err := range errs {
+			fmt.Println(err)
+		}
+		return errors.New("OpenAPI spec has invalid examples")
 	}
 	return nil
 }
@@ -19,19 +19,21 @@ package packages
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/magefile/mage/mg"
 	"github.com/magefile/mage/sh"
 	"github.com/mysteriumnetwork/go-ci/util"
+	"github.com/mysteriumnetwork/node/tequilapi/openapi"
 	"github.com/shurcooL/vfsgen"
 )
 
 // Generate recreates dynamic project parts which changes time to time.
 func Generate() {
-	mg.Deps(GenerateProtobuf, GenerateSwagger)
+	mg.Deps(GenerateProtobuf, GenerateOpenAPI)
 
-	// Doc generation should occur after swagger generation
+	// Doc generation should occur after OpenAPI spec generation
 	mg.Deps(GenerateDocs)
 }
 
@@ -48,33 +50,50 @@ func GenerateProtobuf() error {
 	if err := sh.Run("protoc", "-I=.", "--go_out=./pb", "./pb/session.proto"); err != nil {
 		return err
 	}
+	if err := sh.Run("protoc", "-I=.", "--go_out=./pb", "./pb/speedtest.proto"); err != nil {
+		return err
+	}
+	if err := sh.Run("protoc", "-I=.", "--go_out=./pb/control", "--go-grpc_out=./pb/control", "./pb/control/control.proto"); err != nil {
+		return err
+	}
 	return sh.Run("protoc", "-I=.", "--go_out=./pb", "./pb/payment.proto")
 }
 
-// GetProtobuf installs protobuf golang compiler.
+// GetProtobuf installs protobuf golang compiler and gRPC plugin.
 func GetProtobuf() error {
-	path, _ := util.GetGoBinaryPath("protoc-gen-go")
-	if path != "" {
-		fmt.Println("Tool 'protoc-gen-go' already installed")
-		return nil
+	tools := map[string]string{
+		"protoc-gen-go":      "google.golang.org/protobuf/cmd/protoc-gen-go@v1.25.0",
+		"protoc-gen-go-grpc": "google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.0.1",
 	}
-	err := sh.RunV("go", "get", "-u", "google.golang.org/protobuf/cmd/protoc-gen-go@v1.25.0")
-	if err != nil {
-		fmt.Println("could not go get 'protoc-gen-go'")
-		return err
+	for name, pkg := range tools {
+		path, _ := util.GetGoBinaryPath(name)
+		if path != "" {
+			fmt.Printf("Tool '%s' already installed\n", name)
+			continue
+		}
+		if err := sh.RunV("go", "get", "-u", pkg); err != nil {
+			fmt.Printf("could not go get '%s'\n", name)
+			return err
+		}
 	}
 	return nil
 }
 
-// GenerateSwagger Tequilapi Swagger specification.
-func GenerateSwagger() error {
-	mg.Deps(GetSwagger)
-
-	return sh.RunV("swagger", "generate", "spec", "-o", "tequilapi/docs/swagger.json", "--scan-models")
+// GenerateOpenAPI generates Tequilapi OpenAPI 3 specification from route registrations and contract types.
+func GenerateOpenAPI() error {
+	doc, err := openapi.Generate(".")
+	if err != nil {
+		return fmt.Errorf("could not generate OpenAPI spec: %w", err)
+	}
+	data, err := doc.MarshalIndented()
+	if err != nil {
+		return fmt.Errorf("could not encode OpenAPI spec: %w", err)
+	}
+	return ioutil.WriteFile("tequilapi/docs/openapi.json", data, 0644)
 }
 
 // GenerateDocs generates Tequilapi documentation pages.
-// Based on Redoc template for swagger - https://github.com/Redocly/redoc.
+// Based on Redoc template for OpenAPI - https://github.com/Redocly/redoc.
 func GenerateDocs() error {
 	err := vfsgen.Generate(
 		http.Dir("./tequilapi/docs"),
@@ -89,18 +108,3 @@ func GenerateDocs() error {
 	}
 	return nil
 }
-
-// GetSwagger installs swagger tool.
-func GetSwagger() error {
-	path, _ := util.GetGoBinaryPath("swagger")
-	if path != "" {
-		fmt.Println("Tool 'swagger' already installed")
-		return nil
-	}
-	err := sh.RunV("go", "get", "-u", "github.com/go-swagger/go-swagger/cmd/swagger")
-	if err != nil {
-		fmt.Println("could not go get swagger")
-		return err
-	}
-	return nil
-}
@@ -209,7 +209,7 @@ func PackageDockerAlpine() error {
 	return env.IfRelease(storage.UploadDockerImages)
 }
 
-// PackageDockerSwaggerRedoc builds and stores docker swagger redoc image
+// PackageDockerSwaggerRedoc builds and stores docker redoc image of OpenAPI spec
 func PackageDockerSwaggerRedoc() error {
 	logconfig.Bootstrap()
 	if err := env.EnsureEnvVars(env.BuildVersion); err != nil {
@@ -223,7 +223,7 @@ func PackageDockerSwaggerRedoc() error {
 		return err
 	}
 	return env.IfRelease(func() error {
-		if err := storage.UploadSingleArtifact("tequilapi/docs/swagger.json"); err != nil {
+		if err := storage.UploadSingleArtifact("tequilapi/docs/openapi.json"); err != nil {
 			return err
 		}
 		if err := storage.UploadDockerImages(); err != nil {
@@ -22,12 +22,20 @@ package cmd
 import (
 	"net"
 
+	"github.com/pkg/errors"
+
 	"github.com/mysteriumnetwork/node/ui"
 	uinoop "github.com/mysteriumnetwork/node/ui/noop"
 
 	"github.com/mysteriumnetwork/node/config"
+	"github.com/mysteriumnetwork/node/core/audit"
 	"github.com/mysteriumnetwork/node/core/node"
+	"github.com/mysteriumnetwork/node/core/webhook"
+	"github.com/mysteriumnetwork/node/grpcapi"
+	grpcnoop "github.com/mysteriumnetwork/node/grpcapi/noop"
+	"github.com/mysteriumnetwork/node/logconfig"
 	"github.com/mysteriumnetwork/node/services"
+	"github.com/mysteriumnetwork/node/session/pingpong"
 	"github.com/mysteriumnetwork/node/tequilapi"
 	tequilapi_endpoints "github.com/mysteriumnetwork/node/tequilapi/endpoints"
 	"github.com/mysteriumnetwork/node/utils"
@@ -42,16 +50,24 @@ func (di *Dependencies) bootstrapTequilapi(nodeOptions node.Options, listener ne
 	tequilapi_endpoints.AddRoutesForDocs(router)
 	tequilapi_endpoints.AddRouteForStop(router, utils.SoftKiller(di.Shutdown))
 	tequilapi_endpoints.AddRoutesForAuthentication(router, di.Authenticator, di.JWTAuthenticator)
-	tequilapi_endpoints.AddRoutesForIdentities(router, di.IdentityManager, di.IdentitySelector, di.IdentityRegistry, di.ConsumerBalanceTracker, di.AddressProvider, di.HermesChannelRepository, di.BCHelper, di.Transactor, di.BeneficiaryProvider, di.IdentityMover)
-	tequilapi_endpoints.AddRoutesForConnection(router, di.ConnectionManager, di.StateKeeper, di.ProposalRepository, di.IdentityRegistry, di.EventBus, di.AddressProvider)
+	tequilapi_endpoints.AddRoutesForAPITokens(router, di.APITokenManager)
+	tequilapi_endpoints.AddRoutesForIdentities(router, di.IdentityManager, di.IdentitySelector, di.IdentityRegistry, di.ConsumerBalanceTracker, di.AddressProvider, di.HermesChannelRepository, di.BCHelper, di.Transactor, di.BeneficiaryProvider, di.IdentityBackup)
+	tequilapi_endpoints.AddRoutesForIdentityHermes(router, di.HermesChannelRepository, di.HermesStatusChecker, di.AddressProvider, di.ConsumerTotalsStorage, di.acceptedHermes(nodeOptions))
+	tequilapi_endpoints.AddRoutesForConnection(router, di.ConnectionManager, di.StateKeeper, di.ProposalRepository, di.IdentityRegistry, di.EventBus, di.HermesSelector)
 	tequilapi_endpoints.AddRoutesForSessions(router, di.SessionStorage)
 	tequilapi_endpoints.AddRoutesForConnectionLocation(router, di.IPResolver, di.LocationResolver, di.LocationResolver)
-	tequilapi_endpoints.AddRoutesForProposals(router, di.ProposalRepository, di.QualityClient)
-	tequilapi_endpoints.AddRoutesForService(router, di.ServicesManager, services.JSONParsersByType)
+	tequilapi_endpoints.AddRoutesForProposals(router, di.ProposalRepository, di.QualityClient, di.SpeedTestStorage)
+	tequilapi_endpoints.AddRoutesForSpeedTest(router, di.ConnectionManager, di.SpeedTestStorage)
+	tequilapi_endpoints.AddRoutesForService(router, di.ServicesManager, services.JSONParsersByType, di.ServicePricer)
 	tequilapi_endpoints.AddRoutesForPayout(router, di.IdentityManager, di.SignerFactory, di.MysteriumAPI)
 	tequilapi_endpoints.AddRoutesForAccessPolicies(di.HTTPClient, router, config.GetString(config.FlagAccessPolicyAddress))
 	tequilapi_endpoints.AddRoutesForNAT(router, di.StateKeeper)
 	tequilapi_endpoints.AddRoutesForTransactor(router, di.IdentityRegistry, di.Transactor, di.HermesPromiseSettler, di.SettlementHistoryStorage, di.AddressProvider, di.BeneficiarySaver)
+	tequilapi_endpoints.AddRoutesForSettlementPolicy(router, di.SettlementPolicyStorage)
+	tequilapi_endpoints.AddRoutesForForecast(router, di.Forecaster)
+	tequilapi_endpoints.AddRoutesForSpendingLimits(router, di.SpendingLimitStorage)
+	tequilapi_endpoints.AddRoutesForPaymentLedger(router, di.ConsumerLedgerStorage, pingpong.NewLedgerVerifier(di.ConsumerTotalsStorage))
+	tequilapi_endpoints.AddRoutesForPaymentIncidents(router, di.PaymentIncidentStorage)
 	tequilapi_endpoints.AddRoutesForConfig(router)
 	tequilapi_endpoints.AddRoutesForMMN(router, di.MMN)
 	tequilapi_endpoints.AddRoutesForFeedback(router, di.Reporter)
@@ -59,16 +75,71 @@ func (di *Dependencies) bootstrapTequilapi(nodeOptions node.Options, listener ne
 	tequilapi_endpoints.AddRoutesForCurrencyExchange(router, di.PilvytisAPI)
 	tequilapi_endpoints.AddRoutesForPilvytis(router, di.PilvytisAPI)
 	tequilapi_endpoints.AddRoutesForTerms(router)
-	if err := tequilapi_endpoints.AddRoutesForSSE(router, di.StateKeeper, di.EventBus); err != nil {
-		return nil, err
-	}
+	tequilapi_endpoints.AddRoutesForSSE(router, di.EventStream)
+	tequilapi_endpoints.AddRoutesForLogs(router, logconfig.LogControl{})
+	tequilapi_endpoints.AddRoutesForWebhooks(router, di.Webhooks)
 
 	if config.GetBool(config.FlagPProfEnable) {
 		tequilapi_endpoints.AddRoutesForPProf(router)
 	}
 
+	handler := tequilapi.AuthorizeRequests(router, di.RequestAuthorizer)
+	if auditLogPath := nodeOptions.Tequilapi.AuditLog; auditLogPath != "" {
+		auditLog, err := audit.NewLog(auditLogPath, di.Storage)
+		if err != nil {
+			return nil, err
+		}
+		di.AuditLog = auditLog
+		tequilapi_endpoints.AddRoutesForAudit(router, di.AuditLog)
+		handler = tequilapi.AuditRequests(handler, di.AuditLog, di.RequestAuthorizer)
+	}
+
 	corsPolicy := tequilapi.NewMysteriumCorsPolicy()
-	return tequilapi.NewServer(listener, router, corsPolicy), nil
+	return tequilapi.NewServer(listener, handler, corsPolicy), nil
+}
+
+func (di *Dependencies) bootstrapEventStream() error {
+	di.EventStream = tequilapi_endpoints.NewSSEHandler(di.StateKeeper)
+	return di.EventStream.Subscribe(di.EventBus)
+}
+
+func (di *Dependencies) bootstrapWebhooks() error {
+	di.Webhooks = webhook.NewDispatcher(di.Storage, webhook.DefaultConfig())
+	return di.Webhooks.Subscribe(di.EventBus)
+}
+
+func (di *Dependencies) bootstrapControlServer(options node.Options) error {
+	if !options.GRPC.GRPCEnabled {
+		di.ControlServer = grpcnoop.NewServer()
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", options.GRPC.GRPCAddress)
+	if err != nil {
+		return errors.Wrap(err, "could not listen for gRPC control API on "+options.GRPC.GRPCAddress)
+	}
+
+	control := grpcapi.NewControlService(grpcapi.Deps{
+		ChainID:            config.GetInt64(config.FlagChainID),
+		IdentityManager:    di.IdentityManager,
+		IdentityRegistry:   di.IdentityRegistry,
+		ConnectionManager:  di.ConnectionManager,
+		ProposalRepository: di.ProposalRepository,
+		HermesSelector:     di.HermesSelector,
+		ServiceManager:     di.ServicesManager,
+		ServiceOptions:     services.JSONParsersByType,
+		ServicePricer:      di.ServicePricer,
+		SessionStorage:     di.SessionStorage,
+		PromiseSettler:     di.HermesPromiseSettler,
+		StateProvider:      di.StateKeeper,
+		EventStream:        di.EventStream,
+	})
+	var auditLog grpcapi.AuditLog
+	if di.AuditLog != nil {
+		auditLog = di.AuditLog
+	}
+	di.ControlServer = grpcapi.NewServer(listener, di.TequilapiTLS, di.RequestAuthorizer, auditLog, control)
+	return nil
 }
 
 func (di *Dependencies) bootstrapUIServer(options node.Options) (err error) {
@@ -85,6 +156,6 @@ func (di *Dependencies) bootstrapUIServer(options node.Options) (err error) {
 		}
 		bindAddress = bindAddress + ",127.0.0.1"
 	}
-	di.UIServer = ui.NewServer(bindAddress, options.UI.UIPort, options.TequilapiAddress, options.TequilapiPort, di.JWTAuthenticator, di.HTTPClient)
+	di.UIServer = ui.NewServer(bindAddress, options.UI.UIPort, options.TequilapiAddress, options.TequilapiPort, di.TequilapiClientTLS, di.RequestAuthorizer, di.HTTPClient)
 	return nil
 }
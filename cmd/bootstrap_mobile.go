@@ -23,6 +23,7 @@ import (
 	"net"
 
 	"github.com/mysteriumnetwork/node/core/node"
+	grpcnoop "github.com/mysteriumnetwork/node/grpcapi/noop"
 	"github.com/mysteriumnetwork/node/tequilapi"
 	uinoop "github.com/mysteriumnetwork/node/ui/noop"
 )
@@ -35,3 +36,16 @@ func (di *Dependencies) bootstrapUIServer(_ node.Options) (err error) {
 	di.UIServer = uinoop.NewServer()
 	return nil
 }
+
+func (di *Dependencies) bootstrapEventStream() error {
+	return nil
+}
+
+func (di *Dependencies) bootstrapWebhooks() error {
+	return nil
+}
+
+func (di *Dependencies) bootstrapControlServer(_ node.Options) error {
+	di.ControlServer = grpcnoop.NewServer()
+	return nil
+}
@@ -68,7 +68,7 @@ func NewCommand() *cli.Command {
 		Name:        CommandName,
 		Usage:       "Manage your account",
 		Description: "Using account subcommands you can manage your account details and get information about it",
-		Flags:       []cli.Flag{&config.FlagTequilapiAddress, &config.FlagTequilapiPort},
+		Flags:       clio.TequilapiFlags(),
 		Before: func(ctx *cli.Context) error {
 			tc, err := clio.NewTequilApiClient(ctx)
 			if err != nil {
@@ -26,15 +26,43 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-// NewTequilApiClient - initializes and returns a pointer to tequilapi client - also fetches config using it
+// TequilapiFlags - flags used to reach tequilapi of the running node
+func TequilapiFlags() []cli.Flag {
+	return []cli.Flag{
+		&config.FlagTequilapiAddress,
+		&config.FlagTequilapiPort,
+		&config.FlagTequilapiSocket,
+		&config.FlagTequilapiTLSFingerprint,
+		&config.FlagTequilapiToken,
+	}
+}
+
+// NewTequilApiClient - initializes and returns a pointer to tequilapi client - also fetches config using it.
+// Unix socket is preferred if given, otherwise HTTPS is used when certificate fingerprint is given.
 func NewTequilApiClient(ctx *cli.Context) (*tequilapi_client.Client, error) {
 	address := TequilAPIAddress(ctx)
 	port := TequilAPIPort(ctx)
-	client := tequilapi_client.NewClient(address, port)
+	socket := ctx.String(config.FlagTequilapiSocket.Name)
+	fingerprint := ctx.String(config.FlagTequilapiTLSFingerprint.Name)
+
+	var client *tequilapi_client.Client
+	target := fmt.Sprintf("url: %s:%d", address, port)
+	switch {
+	case socket != "":
+		client = tequilapi_client.NewUnixSocketClient(socket)
+		target = "socket: " + socket
+	case fingerprint != "":
+		client = tequilapi_client.NewTLSClient(address, port, tequilapi_client.PinnedTLSConfig(fingerprint))
+	default:
+		client = tequilapi_client.NewClient(address, port)
+	}
+	if token := ctx.String(config.FlagTequilapiToken.Name); token != "" {
+		client.SetToken(token)
+	}
 
 	_, err := client.Healthcheck()
 	if err != nil {
-		Error(fmt.Sprintf("failed to connect to node via url: %s:%d", address, port))
+		Error(fmt.Sprintf("failed to connect to node via %s", target))
 		return nil, err
 	}
 	return client, nil
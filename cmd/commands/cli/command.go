@@ -62,7 +62,7 @@ func NewCommand() *cli.Command {
 	return &cli.Command{
 		Name:  CommandName,
 		Usage: "Starts a CLI client with a Tequilapi",
-		Flags: []cli.Flag{&config.FlagAgreedTermsConditions, &config.FlagTequilapiAddress, &config.FlagTequilapiPort},
+		Flags: append([]cli.Flag{&config.FlagAgreedTermsConditions}, clio.TequilapiFlags()...),
 		Action: func(ctx *cli.Context) error {
 			client, err := clio.NewTequilApiClient(ctx)
 			if err != nil {
@@ -239,7 +239,9 @@ func (c *cliApp) handleActions(line string) {
 		{"proposals", c.proposals},
 		{"service", c.service},
 		{"stake", c.stake},
+		{"tokens", c.tokens},
 		{"mmn", c.mmnApiKey},
+		{"speedtest", c.speedTest},
 	}
 
 	for _, cmd := range staticCmds {
@@ -485,6 +487,38 @@ func (c *cliApp) disconnect() {
 	clio.Success("Disconnected.")
 }
 
+func (c *cliApp) speedTest(argsString string) {
+	args := strings.Fields(argsString)
+	if len(args) > 0 && args[0] == "list" {
+		providerID := ""
+		if len(args) > 1 {
+			providerID = args[1]
+		}
+		results, err := c.tequilapi.SpeedTests(providerID)
+		if err != nil {
+			clio.Warn(err)
+			return
+		}
+		if len(results.Results) == 0 {
+			clio.Info("No speed test results found")
+			return
+		}
+		for _, r := range results.Results {
+			clio.Info(fmt.Sprintf("%s %s (%s): download %s, upload %s, latency %dms", r.TestedAt, r.ProviderID, r.ServiceType,
+				datasize.BitSpeed(r.Download), datasize.BitSpeed(r.Upload), r.Latency))
+		}
+		return
+	}
+
+	clio.Status("SPEED TEST", "Measuring throughput to the provider, it may take a while...")
+	r, err := c.tequilapi.ConnectionSpeedTest()
+	if err != nil {
+		clio.Warn(err)
+		return
+	}
+	clio.Success(fmt.Sprintf("Download %s, upload %s, latency %dms", datasize.BitSpeed(r.Download), datasize.BitSpeed(r.Upload), r.Latency))
+}
+
 func (c *cliApp) status() {
 	status, err := c.tequilapi.ConnectionStatus()
 	if err != nil {
@@ -718,6 +752,12 @@ func newAutocompleter(tequilapi *tequilapi_client.Client, proposals []contract.P
 			readline.PcItem("increase"),
 			readline.PcItem("decrease"),
 		),
+		readline.PcItem(
+			"tokens",
+			readline.PcItem("list"),
+			readline.PcItem("create"),
+			readline.PcItem("revoke"),
+		),
 		readline.PcItem("orders",
 			readline.PcItem("create"),
 			readline.PcItem("get"),
@@ -729,6 +769,10 @@ func newAutocompleter(tequilapi *tequilapi_client.Client, proposals []contract.P
 		readline.PcItem("proposals"),
 		readline.PcItem("location"),
 		readline.PcItem("disconnect"),
+		readline.PcItem(
+			"speedtest",
+			readline.PcItem("list"),
+		),
 		readline.PcItem("mmn"),
 		readline.PcItem("help"),
 		readline.PcItem("quit"),
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/big"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -42,6 +43,7 @@ func (c *cliApp) identities(argsString string) {
 		"  " + usageGetIdentity,
 		"  " + usageNewIdentity,
 		"  " + usageUnlockIdentity,
+		"  " + usageLockIdentity,
 		"  " + usageRegisterIdentity,
 		"  " + usageSettle,
 		"  " + usageGetReferralCode,
@@ -67,6 +69,8 @@ func (c *cliApp) identities(argsString string) {
 		c.newIdentity(actionArgs)
 	case "unlock":
 		c.unlockIdentity(actionArgs)
+	case "lock":
+		c.lockIdentity(actionArgs)
 	case "register":
 		c.registerIdentity(actionArgs)
 	case "beneficiary":
@@ -144,7 +148,7 @@ func (c *cliApp) newIdentity(args []string) {
 	clio.Success("New identity created:", id.Address)
 }
 
-const usageUnlockIdentity = "unlock <identity> [passphrase]"
+const usageUnlockIdentity = "unlock <identity> [passphrase] [timeout]"
 
 func (c *cliApp) unlockIdentity(actionArgs []string) {
 	if len(actionArgs) < 1 {
@@ -157,17 +161,47 @@ func (c *cliApp) unlockIdentity(actionArgs []string) {
 	if len(actionArgs) >= 2 {
 		passphrase = actionArgs[1]
 	}
+	var timeout time.Duration
+	if len(actionArgs) >= 3 {
+		var err error
+		timeout, err = time.ParseDuration(actionArgs[2])
+		if err != nil {
+			clio.Warn("could not parse timeout: ", err)
+			return
+		}
+	}
 
 	clio.Info("Unlocking", address)
-	err := c.tequilapi.Unlock(address, passphrase)
+	err := c.tequilapi.UnlockTimed(address, passphrase, timeout)
 	if err != nil {
 		clio.Warn(err)
 		return
 	}
 
+	if timeout > 0 {
+		clio.Success(fmt.Sprintf("Identity %s unlocked for %s.", address, timeout))
+		return
+	}
 	clio.Success(fmt.Sprintf("Identity %s unlocked.", address))
 }
 
+const usageLockIdentity = "lock <identity>"
+
+func (c *cliApp) lockIdentity(actionArgs []string) {
+	if len(actionArgs) != 1 {
+		clio.Info("Usage: " + usageLockIdentity)
+		return
+	}
+
+	address := actionArgs[0]
+	if err := c.tequilapi.Lock(address); err != nil {
+		clio.Warn(err)
+		return
+	}
+
+	clio.Success(fmt.Sprintf("Identity %s locked.", address))
+}
+
 const usageRegisterIdentity = "register <identity> [stake] [beneficiary] [referralcode]"
 
 func (c *cliApp) registerIdentity(actionArgs []string) {
@@ -321,28 +355,34 @@ func (c *cliApp) setBeneficiary(actionArgs []string) {
 	}
 }
 
-const usageExportIdentity = "export <identity> <new_passphrase> [file]"
+const usageExportIdentity = "export [--bundle] <identity> <new_passphrase> [file]"
 
 func (c *cliApp) exportIdentity(actionsArgs []string) {
-	dataDir := c.config.GetStringByFlag(config.FlagDataDir)
-	if dataDir == "" {
-		clio.Error("Could not get data directory")
-		return
+	bundle := false
+	args := make([]string, 0, len(actionsArgs))
+	for _, arg := range actionsArgs {
+		if arg == "--bundle" {
+			bundle = true
+			continue
+		}
+		args = append(args, arg)
 	}
+	actionsArgs = args
 
 	if len(actionsArgs) < 2 || len(actionsArgs) > 3 {
 		clio.Info("Usage: " + usageExportIdentity)
+		clio.Info("--bundle exports identity registration, beneficiary, Hermes promises and settlement history together with the key")
 		return
 	}
 	id := actionsArgs[0]
 	passphrase := actionsArgs[1]
 
-	ksdir := node.GetOptionsDirectoryKeystore(dataDir)
-	ks := keystore.NewKeyStore(ksdir, keystore.LightScryptN, keystore.LightScryptP)
-
-	ex := identity.NewExporter(identity.NewKeystoreFilesystem(ksdir, ks))
-
-	blob, err := ex.Export(id, "", passphrase)
+	blob, err := c.tequilapi.ExportIdentity(id, "", passphrase, bundle)
+	// Keys can be exported without a running node, bundles need node state.
+	if _, unreachable := err.(*url.Error); unreachable && !bundle {
+		clio.Warn("Node is not reachable, exporting key from local keystore")
+		blob, err = c.exportLocalIdentity(id, passphrase)
+	}
 	if err != nil {
 		clio.Error("Failed to export identity: ", err)
 		return
@@ -375,6 +415,19 @@ func (c *cliApp) exportIdentity(actionsArgs []string) {
 	fmt.Println(string(blob))
 }
 
+// exportLocalIdentity exports the key straight from the keystore files, so it works while the node is not running.
+func (c *cliApp) exportLocalIdentity(id, passphrase string) ([]byte, error) {
+	dataDir := c.config.GetStringByFlag(config.FlagDataDir)
+	if dataDir == "" {
+		return nil, errors.New("could not get data directory")
+	}
+
+	ksdir := node.GetOptionsDirectoryKeystore(dataDir)
+	ks := keystore.NewKeyStore(ksdir, keystore.LightScryptN, keystore.LightScryptP)
+
+	return identity.NewExporter(identity.NewKeystoreFilesystem(ksdir, ks)).Export(id, "", passphrase)
+}
+
 const usageImportIdentity = "import <passphrase> <key-string/key-file>"
 
 func (c *cliApp) importIdentity(actionsArgs []string) {
@@ -0,0 +1,135 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mysteriumnetwork/node/cmd/commands/cli/clio"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+)
+
+func (c *cliApp) tokens(argsString string) {
+	var usage = strings.Join([]string{
+		"Usage: tokens <action> [args]",
+		"Available actions:",
+		"  " + usageTokensList,
+		"  " + usageTokensCreate,
+		"  " + usageTokensRevoke,
+		"Scopes: admin, read, connection, service, payments",
+	}, "\n")
+
+	if len(argsString) == 0 {
+		clio.Info(usage)
+		return
+	}
+
+	args := strings.Fields(argsString)
+	action := args[0]
+	actionArgs := args[1:]
+
+	switch action {
+	case "list":
+		c.tokensList(actionArgs)
+	case "create":
+		c.tokensCreate(actionArgs)
+	case "revoke":
+		c.tokensRevoke(actionArgs)
+	default:
+		clio.Warnf("Unknown sub-command '%s'\n", argsString)
+		fmt.Println(usage)
+	}
+}
+
+const usageTokensList = "list"
+
+func (c *cliApp) tokensList(args []string) {
+	if len(args) > 0 {
+		clio.Info("Usage: " + usageTokensList)
+		return
+	}
+
+	res, err := c.tequilapi.APITokens()
+	if err != nil {
+		clio.Warn(errors.Wrap(err, "could not list API tokens"))
+		return
+	}
+	if len(res.Tokens) == 0 {
+		clio.Info("No API tokens")
+		return
+	}
+	for _, token := range res.Tokens {
+		status := "active"
+		if token.RevokedAt != "" {
+			status = "revoked at " + token.RevokedAt
+		} else if token.ExpiresAt != "" {
+			status = "expires at " + token.ExpiresAt
+		}
+		clio.Info(fmt.Sprintf("%s %s [%s] %s", token.ID, token.Name, strings.Join(token.Scopes, ","), status))
+	}
+}
+
+const usageTokensCreate = "create <name> <scope[,scope...]> [valid for, e.g. 720h]"
+
+func (c *cliApp) tokensCreate(args []string) {
+	if len(args) < 2 || len(args) > 3 {
+		clio.Info("Usage: " + usageTokensCreate)
+		return
+	}
+
+	req := contract.CreateAPITokenRequest{
+		Name:   args[0],
+		Scopes: strings.Split(args[1], ","),
+	}
+	if len(args) == 3 {
+		validFor, err := time.ParseDuration(args[2])
+		if err != nil {
+			clio.Warn("could not parse token validity: ", err)
+			return
+		}
+		expiresAt := time.Now().Add(validFor).UTC()
+		req.ExpiresAt = &expiresAt
+	}
+
+	res, err := c.tequilapi.CreateAPIToken(req)
+	if err != nil {
+		clio.Warn(errors.Wrap(err, "could not create API token"))
+		return
+	}
+	clio.Success(fmt.Sprintf("API token %s created, store it now as it will not be shown again:", res.ID))
+	fmt.Println(res.Token)
+}
+
+const usageTokensRevoke = "revoke <id>"
+
+func (c *cliApp) tokensRevoke(args []string) {
+	if len(args) != 1 {
+		clio.Info("Usage: " + usageTokensRevoke)
+		return
+	}
+
+	if err := c.tequilapi.RevokeAPIToken(args[0]); err != nil {
+		clio.Warn(errors.Wrap(err, "could not revoke API token"))
+		return
+	}
+	clio.Success("API token revoked")
+}
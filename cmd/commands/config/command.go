@@ -23,7 +23,6 @@ import (
 	"strings"
 
 	"github.com/mysteriumnetwork/node/cmd/commands/cli/clio"
-	"github.com/mysteriumnetwork/node/config"
 	"github.com/mysteriumnetwork/node/tequilapi/client"
 
 	"github.com/urfave/cli/v2"
@@ -39,7 +38,7 @@ func NewCommand() *cli.Command {
 		Name:        CommandName,
 		Usage:       "Manage your node config",
 		Description: "Using config subcommands you can view and manage your current node config",
-		Flags:       []cli.Flag{&config.FlagTequilapiAddress, &config.FlagTequilapiPort},
+		Flags:       clio.TequilapiFlags(),
 		Before: func(ctx *cli.Context) error {
 			var err error
 			cmd.tc, err = clio.NewTequilApiClient(ctx)
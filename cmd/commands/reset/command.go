@@ -47,7 +47,7 @@ func NewCommand() *cli.Command {
 		Name:      CommandName,
 		Usage:     "Resets Mysterium Node to defaults",
 		ArgsUsage: " ",
-		Flags:     []cli.Flag{&flagResetTequilapiAuth},
+		Flags:     append([]cli.Flag{&flagResetTequilapiAuth}, clio.TequilapiFlags()...),
 		Action: func(ctx *cli.Context) error {
 			cmd, err := newAction(ctx)
 			if err != nil {
@@ -67,8 +67,12 @@ func NewCommand(licenseCommandName string) *cli.Command {
 
 			cmd.RegisterSignalCallback(func() { quit <- nil })
 
+			tequilapiClient, err := di.TequilapiClient(*nodeOptions)
+			if err != nil {
+				return err
+			}
 			cmdService := &serviceCommand{
-				tequilapi:    client.NewClient(nodeOptions.TequilapiAddress, nodeOptions.TequilapiPort),
+				tequilapi:    tequilapiClient,
 				errorChannel: quit,
 			}
 			go func() {
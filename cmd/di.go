@@ -18,6 +18,7 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -26,6 +27,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -36,28 +38,37 @@ import (
 	appconfig "github.com/mysteriumnetwork/node/config"
 	"github.com/mysteriumnetwork/node/consumer/bandwidth"
 	consumer_session "github.com/mysteriumnetwork/node/consumer/session"
+	"github.com/mysteriumnetwork/node/core/audit"
 	"github.com/mysteriumnetwork/node/core/auth"
 	"github.com/mysteriumnetwork/node/core/beneficiary"
 	"github.com/mysteriumnetwork/node/core/connection"
 	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
 	"github.com/mysteriumnetwork/node/core/discovery"
 	"github.com/mysteriumnetwork/node/core/discovery/proposal"
+	"github.com/mysteriumnetwork/node/core/forecast"
 	"github.com/mysteriumnetwork/node/core/ip"
 	"github.com/mysteriumnetwork/node/core/location"
+	"github.com/mysteriumnetwork/node/core/netmon"
 	"github.com/mysteriumnetwork/node/core/node"
 	nodevent "github.com/mysteriumnetwork/node/core/node/event"
 	"github.com/mysteriumnetwork/node/core/policy"
 	"github.com/mysteriumnetwork/node/core/port"
+	"github.com/mysteriumnetwork/node/core/pricing"
 	"github.com/mysteriumnetwork/node/core/quality"
 	"github.com/mysteriumnetwork/node/core/service"
+	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/core/speedtest"
 	"github.com/mysteriumnetwork/node/core/state"
 	"github.com/mysteriumnetwork/node/core/storage/boltdb"
 	"github.com/mysteriumnetwork/node/core/storage/boltdb/migrations/history"
 	"github.com/mysteriumnetwork/node/core/storage/boltdb/migrator"
+	"github.com/mysteriumnetwork/node/core/webhook"
 	"github.com/mysteriumnetwork/node/eventbus"
 	"github.com/mysteriumnetwork/node/feedback"
 	"github.com/mysteriumnetwork/node/firewall"
 	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/identity/backup"
+	"github.com/mysteriumnetwork/node/identity/external"
 	"github.com/mysteriumnetwork/node/identity/registry"
 	identity_registry "github.com/mysteriumnetwork/node/identity/registry"
 	identity_selector "github.com/mysteriumnetwork/node/identity/selector"
@@ -79,6 +90,8 @@ import (
 	"github.com/mysteriumnetwork/node/session/pingpong"
 	"github.com/mysteriumnetwork/node/sleep"
 	"github.com/mysteriumnetwork/node/tequilapi"
+	tequilapi_client "github.com/mysteriumnetwork/node/tequilapi/client"
+	tequilapi_endpoints "github.com/mysteriumnetwork/node/tequilapi/endpoints"
 	"github.com/mysteriumnetwork/node/utils/netutil"
 	"github.com/mysteriumnetwork/payments/client"
 	paymentClient "github.com/mysteriumnetwork/payments/client"
@@ -90,6 +103,26 @@ type UIServer interface {
 	Stop()
 }
 
+// ControlServer represents gRPC control API server
+type ControlServer interface {
+	Serve()
+	Stop()
+}
+
+// Keystore keeps identity keys, either in local files or in an external signer.
+type Keystore interface {
+	Accounts() []accounts.Account
+	NewAccount(passphrase string) (accounts.Account, error)
+	Find(a accounts.Account) (accounts.Account, error)
+	Unlock(a accounts.Account, passphrase string) error
+	Lock(addr common.Address) error
+	SignHash(a accounts.Account, hash []byte) ([]byte, error)
+	Encrypt(addr common.Address, plaintext []byte) ([]byte, error)
+	Decrypt(addr common.Address, encrypted []byte) ([]byte, error)
+	Export(a accounts.Account, passphrase, newPassphrase string) ([]byte, error)
+	Import(keyJSON []byte, passphrase, newPassphrase string) (accounts.Account, error)
+}
+
 // Dependencies is DI container for top level components which is reused in several places
 type Dependencies struct {
 	Node *Node
@@ -106,12 +139,15 @@ type Dependencies struct {
 
 	NATService       nat.NATService
 	Storage          *boltdb.Bolt
-	Keystore         *identity.Keystore
+	Keystore         Keystore
 	IdentityManager  identity.Manager
 	SignerFactory    identity.SignerFactory
 	IdentityRegistry identity_registry.IdentityRegistry
 	IdentitySelector identity_selector.Handler
 	IdentityMover    *identity.Mover
+	IdentityBackup   *backup.Mover
+
+	RegistrationStatusStorage *registry.RegistrationStatusStorage
 
 	DiscoveryFactory   service.DiscoveryFactory
 	ProposalRepository proposal.Repository
@@ -127,15 +163,19 @@ type Dependencies struct {
 	SessionStorage                   *consumer_session.Storage
 	SessionConnectivityStatusStorage connectivity.StatusStorage
 
+	Forecaster *forecast.Forecaster
+
 	EventBus eventbus.EventBus
 
 	ConnectionManager  connection.Manager
+	NetworkMonitor     *netmon.Monitor
 	ConnectionRegistry *connection.Registry
 
 	ServicesManager *service.Manager
 	ServiceRegistry *service.Registry
 	ServiceSessions *service.SessionPool
 	ServiceFirewall firewall.IncomingTrafficFirewall
+	ServicePricer   *pricing.Repricer
 
 	NATPinger  traversal.NATPinger
 	NATTracker *event.Tracker
@@ -149,7 +189,13 @@ type Dependencies struct {
 
 	Authenticator     *auth.Authenticator
 	JWTAuthenticator  *auth.JWTAuthenticator
+	APITokenManager   *auth.TokenManager
+	RequestAuthorizer *auth.RequestAuthorizer
 	UIServer          UIServer
+	ControlServer     ControlServer
+	EventStream       *tequilapi_endpoints.Handler
+	AuditLog          *audit.Log
+	Webhooks          *webhook.Dispatcher
 	Transactor        *registry.Transactor
 	BCHelper          *paymentClient.MultichainBlockchainClient
 	ProviderRegistrar *registry.ProviderRegistrar
@@ -170,13 +216,25 @@ type Dependencies struct {
 	HermesCaller             *pingpong.HermesCaller
 	HermesPromiseHandler     *pingpong.HermesPromiseHandler
 	SettlementHistoryStorage *pingpong.SettlementHistoryStorage
+	SettlementPolicyStorage  *pingpong.SettlementPolicyStorage
+	SpendingLimitStorage     *pingpong.SpendingLimitStorage
+	ConsumerLedgerStorage    *pingpong.ConsumerLedgerStorage
+	PaymentIncidentStorage   *pingpong.PaymentIncidentStorage
+	PaymentIncidentRecorder  *pingpong.PaymentIncidentRecorder
+	SpeedTestStorage         *speedtest.Storage
 	AddressProvider          *pingpong.AddressProvider
 	HermesStatusChecker      *pingpong.HermesStatusChecker
+	HermesSelector           *pingpong.HermesSelector
 
 	MMN             *mmn.MMN
 	PilvytisAPI     *pilvytis.API
 	Pilvytis        *pilvytis.Service
 	ResidentCountry *identity.ResidentCountry
+
+	// TequilapiTLS is served by tequilapi and gRPC control API, nil when TLS is off.
+	TequilapiTLS *tls.Config
+	// TequilapiClientTLS is used by local components talking to TLS enabled tequilapi, nil when TLS is off.
+	TequilapiClientTLS *tls.Config
 }
 
 // Bootstrap initiates all container dependencies
@@ -210,6 +268,7 @@ func (di *Dependencies) Bootstrap(nodeOptions node.Options) error {
 	if err := di.bootstrapStorage(nodeOptions.Directories.Storage); err != nil {
 		return err
 	}
+	di.PaymentIncidentRecorder = pingpong.NewPaymentIncidentRecorder(di.PaymentIncidentStorage, nodeOptions.Payments.IncidentBlockThreshold, nodeOptions.Payments.IncidentBlockWindow)
 
 	netutil.ClearStaleRoutes()
 
@@ -301,6 +360,32 @@ func (di *Dependencies) bootstrapAddressProvider(nodeOptions node.Options) {
 	di.AddressProvider = pingpong.NewAddressProvider(keeper, common.HexToAddress(nodeOptions.Transactor.Identity))
 }
 
+// acceptedHermes returns Hermes addresses provider accepts payments through, active Hermes first.
+func (di *Dependencies) acceptedHermes(nodeOptions node.Options) []string {
+	accepted := make([]string, 0, len(nodeOptions.Payments.AcceptedHermes)+1)
+	if active, err := di.AddressProvider.GetActiveHermes(nodeOptions.ChainID); err == nil {
+		accepted = append(accepted, active.Hex())
+	} else {
+		log.Warn().Err(err).Msg("Could not get active hermes")
+	}
+
+	for _, hex := range nodeOptions.Payments.AcceptedHermes {
+		if !common.IsHexAddress(hex) {
+			log.Warn().Msgf("Ignoring invalid accepted hermes address %q", hex)
+			continue
+		}
+		hermes := common.HexToAddress(hex).Hex()
+		known := false
+		for _, a := range accepted {
+			known = known || a == hermes
+		}
+		if !known {
+			accepted = append(accepted, hermes)
+		}
+	}
+	return accepted
+}
+
 func (di *Dependencies) bootstrapP2P(p2pPorts *port.Range) {
 	portPool := di.PortPool
 	natPinger := di.NATPinger
@@ -324,7 +409,60 @@ func (di *Dependencies) createTequilaListener(nodeOptions node.Options) (net.Lis
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("the port %v seems to be taken. Either you're already running a node or it is already used by another application", nodeOptions.TequilapiPort))
 	}
-	return tequilaListener, nil
+
+	opts := nodeOptions.Tequilapi
+	if opts.TLSEnabled() {
+		tlsConfig, fingerprint, err := tequilapi.NewTLSConfig(tequilapi.TLSOptions{
+			CertFile:     opts.TLSCert,
+			KeyFile:      opts.TLSKey,
+			SelfSigned:   opts.TLSSelfSigned,
+			Dir:          nodeOptions.Directories.Data,
+			Hosts:        []string{nodeOptions.TequilapiAddress},
+			ClientCAFile: opts.TLSClientCA,
+		})
+		if err != nil {
+			tequilaListener.Close()
+			return nil, errors.Wrap(err, "could not configure tequilapi TLS")
+		}
+		log.Info().Msgf("Tequilapi TLS certificate SHA-256 fingerprint: %s", fingerprint)
+		if opts.TLSClientCA != "" {
+			log.Warn().Msg("Tequilapi requires client certificates, web UI will not be able to reach it")
+		}
+		di.TequilapiTLS = tlsConfig
+		di.TequilapiClientTLS = tequilapi_client.PinnedTLSConfig(fingerprint)
+		tequilaListener = tls.NewListener(tequilaListener, tlsConfig)
+	}
+
+	if opts.Socket == "" {
+		return tequilaListener, nil
+	}
+
+	socketListener, err := tequilapi.NewUnixListener(opts.Socket, opts.SocketMode)
+	if err != nil {
+		tequilaListener.Close()
+		return nil, errors.Wrap(err, "could not listen on tequilapi socket "+opts.Socket)
+	}
+	log.Info().Msgf("API socket listening on: %s", opts.Socket)
+	return tequilapi.NewMultiListener(tequilaListener, socketListener), nil
+}
+
+// TequilapiClient returns client of the node's own tequilapi, reaching it over Unix socket or pinned TLS when those are enabled.
+// Requests over TLS are authorized with a login token issued by the node itself.
+func (di *Dependencies) TequilapiClient(nodeOptions node.Options) (*tequilapi_client.Client, error) {
+	switch {
+	case nodeOptions.Tequilapi.Socket != "":
+		return tequilapi_client.NewUnixSocketClient(nodeOptions.Tequilapi.Socket), nil
+	case di.TequilapiClientTLS != nil:
+		token, err := di.JWTAuthenticator.CreateToken(config.GetString(config.FlagTequilapiUsername))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not issue tequilapi token")
+		}
+		client := tequilapi_client.NewTLSClient(nodeOptions.TequilapiAddress, nodeOptions.TequilapiPort, di.TequilapiClientTLS)
+		client.SetToken(token.Token)
+		return client, nil
+	default:
+		return tequilapi_client.NewClient(nodeOptions.TequilapiAddress, nodeOptions.TequilapiPort), nil
+	}
 }
 
 func (di *Dependencies) bootstrapStateKeeper(options node.Options) error {
@@ -389,6 +527,10 @@ func (di *Dependencies) Shutdown() (err error) {
 		}
 	}
 
+	if di.NetworkMonitor != nil {
+		di.NetworkMonitor.Stop()
+	}
+
 	if di.ServicesManager != nil {
 		if err := di.ServicesManager.Kill(); err != nil {
 			errs = append(errs, err)
@@ -410,6 +552,9 @@ func (di *Dependencies) Shutdown() (err error) {
 	if di.Pilvytis != nil {
 		di.Pilvytis.Stop()
 	}
+	if di.ServicePricer != nil {
+		di.ServicePricer.Stop()
+	}
 	if di.BrokerConnection != nil {
 		di.BrokerConnection.Close()
 	}
@@ -418,6 +563,10 @@ func (di *Dependencies) Shutdown() (err error) {
 		di.QualityClient.Stop()
 	}
 
+	if di.Webhooks != nil {
+		di.Webhooks.Stop()
+	}
+
 	if di.ServiceFirewall != nil {
 		di.ServiceFirewall.Teardown()
 	}
@@ -456,6 +605,14 @@ func (di *Dependencies) bootstrapStorage(path string) error {
 	di.HermesPromiseStorage = pingpong.NewHermesPromiseStorage(di.Storage)
 	di.SessionStorage = consumer_session.NewSessionStorage(di.Storage)
 	di.SettlementHistoryStorage = pingpong.NewSettlementHistoryStorage(di.Storage)
+	di.SettlementPolicyStorage = pingpong.NewSettlementPolicyStorage(di.Storage)
+	di.SpendingLimitStorage = pingpong.NewSpendingLimitStorage(di.Storage)
+	di.ConsumerLedgerStorage = pingpong.NewConsumerLedgerStorage(di.Storage)
+	di.PaymentIncidentStorage = pingpong.NewPaymentIncidentStorage(di.Storage)
+	di.SpeedTestStorage = speedtest.NewStorage(di.Storage)
+	if err := di.SpeedTestStorage.Subscribe(di.EventBus); err != nil {
+		return err
+	}
 	return di.SessionStorage.Subscribe(di.EventBus)
 }
 
@@ -491,6 +648,16 @@ func (di *Dependencies) bootstrapNodeComponents(nodeOptions node.Options, tequil
 
 	di.bootstrapBeneficiarySaver(nodeOptions)
 
+	di.IdentityBackup = backup.NewMover(
+		di.IdentityMover,
+		nodeOptions.ChainID,
+		di.RegistrationStatusStorage,
+		di.BeneficiaryProvider,
+		beneficiary.NewRestorer(nodeOptions.ChainID, di.Storage),
+		di.HermesPromiseStorage,
+		di.SettlementHistoryStorage,
+	)
+
 	if err := di.bootstrapProviderRegistrar(nodeOptions); err != nil {
 		return err
 	}
@@ -509,6 +676,17 @@ func (di *Dependencies) bootstrapNodeComponents(nodeOptions node.Options, tequil
 	if err != nil {
 		return errors.Wrap(err, "could not subscribe consumer balance tracker to relevant events")
 	}
+	di.HermesSelector = pingpong.NewHermesSelector(di.HermesStatusChecker, di.AddressProvider, di.ConsumerBalanceTracker)
+
+	di.Forecaster = forecast.NewForecaster(
+		nodeOptions.ChainID,
+		forecast.DefaultLookback,
+		nodeOptions.Payments.HermesPromiseSettlingThreshold,
+		di.SessionStorage,
+		di.ConsumerBalanceTracker,
+		di.HermesChannelRepository,
+		di.SettlementPolicyStorage,
+	)
 
 	di.HermesPromiseHandler = pingpong.NewHermesPromiseHandler(pingpong.HermesPromiseHandlerDeps{
 		HermesPromiseStorage: di.HermesPromiseStorage,
@@ -525,8 +703,26 @@ func (di *Dependencies) bootstrapNodeComponents(nodeOptions node.Options, tequil
 		return err
 	}
 
+	connectionConfig := connection.DefaultConfig()
+	connectionConfig.Probe = connection.ProbeConfig{
+		Interval:        nodeOptions.Connection.ProbeInterval,
+		Targets:         nodeOptions.Connection.ProbeTargets,
+		Count:           nodeOptions.Connection.ProbeCount,
+		Timeout:         nodeOptions.Connection.ProbeTimeout,
+		ThroughputURL:   nodeOptions.Connection.ProbeThroughputURL,
+		ThroughputBytes: nodeOptions.Connection.ProbeThroughputBytes,
+		ReconnectLoss:   nodeOptions.Connection.ProbeReconnectLoss,
+	}
+	connectionConfig.Reconnect = connection.ReconnectConfig{
+		MaxAttempts:         nodeOptions.Connection.ReconnectMaxAttempts,
+		Backoff:             nodeOptions.Connection.ReconnectBackoff,
+		MaxBackoff:          nodeOptions.Connection.ReconnectMaxBackoff,
+		SwitchProviderAfter: nodeOptions.Connection.ReconnectSwitchProviderAfter,
+		TunnelTimeout:       nodeOptions.Connection.ReconnectTunnelTimeout,
+	}
+
 	di.ConnectionRegistry = connection.NewRegistry()
-	di.ConnectionManager = connection.NewManager(
+	connectionManager := connection.NewManager(
 		pingpong.ExchangeFactoryFunc(
 			di.Keystore,
 			di.SignerFactory,
@@ -534,19 +730,25 @@ func (di *Dependencies) bootstrapNodeComponents(nodeOptions node.Options, tequil
 			di.AddressProvider,
 			di.EventBus,
 			nodeOptions.Payments.ConsumerDataLeewayMegabytes,
+			pingpong.NewSpendingGuard(di.SpendingLimitStorage, di.EventBus),
+			di.ConsumerLedgerStorage,
+			di.PaymentIncidentRecorder,
 		),
 		di.ConnectionRegistry.CreateConnection,
 		di.EventBus,
 		di.IPResolver,
 		di.LocationResolver,
-		connection.DefaultConfig(),
+		connectionConfig,
 		connection.DefaultStatsReportInterval,
 		connection.NewValidator(
 			di.ConsumerBalanceTracker,
 			di.IdentityManager,
+			di.PaymentIncidentRecorder,
 		),
 		di.P2PDialer,
+		proposal.NewAlternativeFinder(di.ProposalRepository).Alternatives,
 	)
+	di.ConnectionManager = connectionManager
 
 	di.LogCollector = logconfig.NewCollector(&logconfig.CurrentLogOptions)
 	reporter, err := feedback.NewReporter(di.LogCollector, di.IdentityManager, nodeOptions.FeedbackURL)
@@ -560,16 +762,38 @@ func (di *Dependencies) bootstrapNodeComponents(nodeOptions node.Options, tequil
 	}
 
 	di.bootstrapPilvytis(nodeOptions)
+	di.bootstrapServicePricer(nodeOptions)
+
+	if err := di.bootstrapEventStream(); err != nil {
+		return err
+	}
+
+	if err := di.bootstrapWebhooks(); err != nil {
+		return err
+	}
 
 	tequilapiHTTPServer, err := di.bootstrapTequilapi(nodeOptions, tequilaListener)
 	if err != nil {
 		return err
 	}
 
+	if err := di.bootstrapControlServer(nodeOptions); err != nil {
+		return err
+	}
+
 	sleepNotifier := sleep.NewNotifier(di.ConnectionManager, di.EventBus)
 	sleepNotifier.Subscribe()
 
-	di.Node = NewNode(di.ConnectionManager, tequilapiHTTPServer, di.EventBus, di.NATPinger, di.UIServer, sleepNotifier)
+	di.NetworkMonitor = netmon.NewMonitor(di.EventBus, netmon.DefaultSettleDuration)
+	if err := di.EventBus.SubscribeAsync(netmon.AppTopicNetworkChange, connectionManager.HandleNetworkChange); err != nil {
+		return err
+	}
+	if err := di.EventBus.SubscribeAsync(identity.AppTopicIdentityLock, connectionManager.HandleIdentityLock); err != nil {
+		return err
+	}
+	go di.NetworkMonitor.Start()
+
+	di.Node = NewNode(di.ConnectionManager, tequilapiHTTPServer, di.EventBus, di.NATPinger, di.UIServer, di.ControlServer, sleepNotifier)
 	return nil
 }
 
@@ -644,8 +868,9 @@ func (di *Dependencies) bootstrapNetworkComponents(options node.Options) (err er
 	di.BCHelper = paymentClient.NewMultichainBlockchainClient(clients)
 
 	di.HermesURLGetter = pingpong.NewHermesURLGetter(di.BCHelper, di.AddressProvider)
+	di.HermesStatusChecker = pingpong.NewHermesStatusChecker(di.BCHelper, options.Payments.HermesStatusRecheckInterval)
 
-	registryStorage := registry.NewRegistrationStatusStorage(di.Storage)
+	di.RegistrationStatusStorage = registry.NewRegistrationStatusStorage(di.Storage)
 
 	hermesURL, err := di.getHermesURL(options)
 	if err != nil {
@@ -654,7 +879,7 @@ func (di *Dependencies) bootstrapNetworkComponents(options node.Options) (err er
 
 	di.HermesCaller = pingpong.NewHermesCaller(di.HTTPClient, hermesURL)
 
-	if di.IdentityRegistry, err = identity_registry.NewIdentityRegistryContract(di.EtherClient, di.AddressProvider, registryStorage, di.EventBus, di.HermesCaller); err != nil {
+	if di.IdentityRegistry, err = identity_registry.NewIdentityRegistryContract(di.EtherClient, di.AddressProvider, di.RegistrationStatusStorage, di.EventBus, di.HermesCaller); err != nil {
 		return err
 	}
 
@@ -683,21 +908,31 @@ func (di *Dependencies) bootstrapEventBus() {
 }
 
 func (di *Dependencies) bootstrapIdentityComponents(options node.Options) error {
-	var ks *keystore.KeyStore
-	if options.Keystore.UseLightweight {
-		log.Debug().Msg("Using lightweight keystore")
-		ks = keystore.NewKeyStore(options.Directories.Keystore, keystore.LightScryptN, keystore.LightScryptP)
-	} else {
-		log.Debug().Msg("Using heavyweight keystore")
-		ks = keystore.NewKeyStore(options.Directories.Keystore, keystore.StandardScryptN, keystore.StandardScryptP)
-	}
-
-	di.Keystore = identity.NewKeystoreFilesystem(options.Directories.Keystore, ks)
 	if di.ResidentCountry == nil {
 		return errMissingDependency("di.residentCountry")
 	}
+	if options.Keystore.ExternalSigner != "" {
+		log.Info().Msgf("Using external signer at %s", options.Keystore.ExternalSigner)
+		signerKeystore, err := external.NewKeystore(options.Keystore.ExternalSigner, options.Keystore.ExternalSignerTimeout)
+		if err != nil {
+			return err
+		}
+		if err := signerKeystore.CheckSupport(); err != nil {
+			return err
+		}
+		di.Keystore = signerKeystore
+	} else {
+		var ks *keystore.KeyStore
+		if options.Keystore.UseLightweight {
+			log.Debug().Msg("Using lightweight keystore")
+			ks = keystore.NewKeyStore(options.Directories.Keystore, keystore.LightScryptN, keystore.LightScryptP)
+		} else {
+			log.Debug().Msg("Using heavyweight keystore")
+			ks = keystore.NewKeyStore(options.Directories.Keystore, keystore.StandardScryptN, keystore.StandardScryptP)
+		}
+		di.Keystore = identity.NewKeystoreFilesystem(options.Directories.Keystore, ks)
+	}
 	di.IdentityManager = identity.NewIdentityManager(di.Keystore, di.EventBus, di.ResidentCountry)
-
 	di.SignerFactory = func(id identity.Identity) identity.Signer {
 		return identity.NewSigner(di.Keystore, id)
 	}
@@ -816,6 +1051,8 @@ func (di *Dependencies) bootstrapAuthenticator() error {
 	}
 	di.Authenticator = auth.NewAuthenticator()
 	di.JWTAuthenticator = auth.NewJWTAuthenticator(key)
+	di.APITokenManager = auth.NewTokenManager(di.Storage)
+	di.RequestAuthorizer = auth.NewRequestAuthorizer(di.JWTAuthenticator, di.APITokenManager)
 
 	return nil
 }
@@ -843,6 +1080,24 @@ func (di *Dependencies) migrateCrendentials() {
 	}
 }
 
+func (di *Dependencies) bootstrapServicePricer(options node.Options) {
+	opts := options.Payments.ProviderPricing
+	di.ServicePricer = pricing.NewRepricer(
+		pricing.Config{
+			Currency:       opts.Currency,
+			UpdateInterval: opts.UpdateInterval,
+			Smoothing:      opts.Smoothing,
+			MaxChange:      opts.MaxChange,
+		},
+		di.Pilvytis,
+		di.ServicesManager,
+	)
+	if err := di.EventBus.SubscribeAsync(servicestate.AppTopicServiceStatus, di.ServicePricer.HandleServiceStatus); err != nil {
+		log.Error().Err(err).Msg("Failed to subscribe service pricer to service status")
+	}
+	go di.ServicePricer.Start()
+}
+
 func (di *Dependencies) bootstrapPilvytis(options node.Options) {
 	di.PilvytisAPI = pilvytis.NewAPI(di.HTTPClient, options.PilvytisAddress, di.SignerFactory, di.LocationResolver, di.AddressProvider)
 	statusTracker := pilvytis.NewStatusTracker(di.PilvytisAPI, di.IdentityManager, di.EventBus, 30*time.Second)
@@ -22,11 +22,14 @@ import (
 
 	"github.com/mysteriumnetwork/node/config"
 	"github.com/mysteriumnetwork/node/core/connection"
+	"github.com/mysteriumnetwork/node/core/ip"
+	"github.com/mysteriumnetwork/node/core/netmon"
 	"github.com/mysteriumnetwork/node/core/node"
 	"github.com/mysteriumnetwork/node/core/policy"
 	"github.com/mysteriumnetwork/node/core/port"
 	"github.com/mysteriumnetwork/node/core/service"
 	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/identity/registry"
 	"github.com/mysteriumnetwork/node/market"
 	"github.com/mysteriumnetwork/node/mmn"
@@ -201,9 +204,12 @@ func (di *Dependencies) bootstrapHermesPromiseSettler(nodeOptions node.Options)
 		di.IdentityRegistry,
 		di.Keystore,
 		di.SettlementHistoryStorage,
+		di.SettlementPolicyStorage,
+		di.EventBus,
 		pingpong.HermesPromiseSettlerConfig{
 			Threshold:            nodeOptions.Payments.HermesPromiseSettlingThreshold,
 			MaxWaitForSettlement: nodeOptions.Payments.SettlementTimeout,
+			PolicyCheckInterval:  nodeOptions.Payments.SettlementPolicyCheckInterval,
 		},
 	)
 	if err := settler.Subscribe(di.EventBus); err != nil {
@@ -231,8 +237,6 @@ func (di *Dependencies) bootstrapServiceComponents(nodeOptions node.Options) err
 	)
 	go di.PolicyOracle.Start()
 
-	di.HermesStatusChecker = pingpong.NewHermesStatusChecker(di.BCHelper, nodeOptions.Payments.HermesStatusRecheckInterval)
-
 	newP2PSessionHandler := func(serviceInstance *service.Instance, channel p2p.Channel) *service.SessionManager {
 		paymentEngineFactory := pingpong.InvoiceFactoryCreator(
 			channel, nodeOptions.Payments.ProviderInvoiceFrequency,
@@ -242,9 +246,10 @@ func (di *Dependencies) bootstrapServiceComponents(nodeOptions node.Options) err
 			nodeOptions.Payments.MaxUnpaidInvoiceValue,
 			di.HermesStatusChecker,
 			di.EventBus,
-			serviceInstance.Proposal,
+			serviceInstance.CopyProposal(),
 			di.HermesPromiseHandler,
 			di.AddressProvider,
+			di.PaymentIncidentRecorder,
 		)
 		return service.NewSessionManager(
 			serviceInstance,
@@ -262,16 +267,33 @@ func (di *Dependencies) bootstrapServiceComponents(nodeOptions node.Options) err
 		di.DiscoveryFactory,
 		di.EventBus,
 		di.PolicyOracle,
+		di.PaymentIncidentRecorder,
+		di.acceptedHermes(nodeOptions),
+		di.IdentityManager,
 		di.P2PListener,
 		newP2PSessionHandler,
 		di.SessionConnectivityStatusStorage,
 	)
 
+	if err := di.EventBus.SubscribeAsync(identity.AppTopicIdentityLock, di.ServicesManager.HandleIdentityLock); err != nil {
+		log.Error().Err(err).Msg("Failed to subscribe service manager to identity locks")
+	}
+
 	serviceCleaner := service.Cleaner{SessionStorage: di.ServiceSessions}
 	if err := di.EventBus.Subscribe(servicestate.AppTopicServiceStatus, serviceCleaner.HandleServiceStatus); err != nil {
 		log.Error().Err(err).Msg("Failed to subscribe service cleaner")
 	}
 
+	if ipResolver, ok := di.IPResolver.(*ip.CachedResolver); ok {
+		networkChangeHandler := service.NewNetworkChangeHandler(di.ServicesManager, ipResolver, di.LocationResolver)
+		if err := di.EventBus.SubscribeAsync(netmon.AppTopicNetworkChange, networkChangeHandler.Handle); err != nil {
+			log.Error().Err(err).Msg("Failed to subscribe service network change handler")
+		}
+		if err := di.EventBus.SubscribeAsync(servicestate.AppTopicServiceStatus, networkChangeHandler.HandleServiceStatus); err != nil {
+			log.Error().Err(err).Msg("Failed to subscribe service network change handler to service status")
+		}
+	}
+
 	return nil
 }
 
@@ -42,13 +42,14 @@ type SleepNotifier interface {
 }
 
 // NewNode function creates new Mysterium node by given options
-func NewNode(connectionManager connection.Manager, tequilapiServer tequilapi.APIServer, publisher Publisher, natPinger NATPinger, uiServer UIServer, notifier SleepNotifier) *Node {
+func NewNode(connectionManager connection.Manager, tequilapiServer tequilapi.APIServer, publisher Publisher, natPinger NATPinger, uiServer UIServer, controlServer ControlServer, notifier SleepNotifier) *Node {
 	return &Node{
 		connectionManager: connectionManager,
 		httpAPIServer:     tequilapiServer,
 		publisher:         publisher,
 		natPinger:         natPinger,
 		uiServer:          uiServer,
+		controlServer:     controlServer,
 		sleepNotifier:     notifier,
 	}
 }
@@ -60,6 +61,7 @@ type Node struct {
 	publisher         Publisher
 	natPinger         NATPinger
 	uiServer          UIServer
+	controlServer     ControlServer
 	sleepNotifier     SleepNotifier
 }
 
@@ -69,6 +71,7 @@ func (node *Node) Start() error {
 	node.httpAPIServer.StartServing()
 
 	node.uiServer.Serve()
+	node.controlServer.Serve()
 	node.publisher.Publish(event.AppTopicNode, event.Payload{Status: event.StatusStarted})
 
 	return nil
@@ -100,6 +103,9 @@ func (node *Node) Kill() error {
 	node.uiServer.Stop()
 	log.Info().Msg("Web UI server stopped")
 
+	node.controlServer.Stop()
+	log.Info().Msg("gRPC control API stopped")
+
 	node.natPinger.Stop()
 	log.Info().Msg("NAT pinger stopped")
 
@@ -0,0 +1,134 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package config
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// FlagConnectionProbeInterval sets how often connection quality is probed through the tunnel.
+	FlagConnectionProbeInterval = cli.DurationFlag{
+		Name:  "connection.probe.interval",
+		Usage: "How often to probe connection quality through the tunnel, probing is disabled unless interval and targets or throughput URL are set",
+		Value: 0,
+	}
+	// FlagConnectionProbeTargets sets the targets which are probed for latency, jitter and loss.
+	FlagConnectionProbeTargets = cli.StringSliceFlag{
+		Name:  "connection.probe.targets",
+		Usage: "TCP targets (host:port) to probe for latency, jitter and loss, separated by comma",
+		Value: cli.NewStringSlice(),
+	}
+	// FlagConnectionProbeCount sets the number of probes sent to every target during single probing round.
+	FlagConnectionProbeCount = cli.IntFlag{
+		Name:  "connection.probe.count",
+		Usage: "Number of probes sent to every target during single probing round",
+		Value: 5,
+	}
+	// FlagConnectionProbeTimeout sets the timeout of a single probe.
+	FlagConnectionProbeTimeout = cli.DurationFlag{
+		Name:  "connection.probe.timeout",
+		Usage: "Timeout of a single probe, probes which time out are counted as lost",
+		Value: 3 * time.Second,
+	}
+	// FlagConnectionProbeThroughputURL sets the URL which is downloaded to measure throughput.
+	FlagConnectionProbeThroughputURL = cli.StringFlag{
+		Name:  "connection.probe.throughput-url",
+		Usage: "URL downloaded through the tunnel to measure throughput. Downloaded data is paid for, empty value disables throughput probing",
+		Value: "",
+	}
+	// FlagConnectionProbeThroughputBytes limits the amount of data downloaded during throughput probe.
+	FlagConnectionProbeThroughputBytes = cli.Int64Flag{
+		Name:  "connection.probe.throughput-bytes",
+		Usage: "Max amount of bytes downloaded during single throughput probe",
+		Value: 1024 * 1024,
+	}
+	// FlagConnectionProbeReconnectLoss sets the loss ratio at which connection is reconnected.
+	FlagConnectionProbeReconnectLoss = cli.Float64Flag{
+		Name:  "connection.probe.reconnect-loss",
+		Usage: "Reconnect when probe loss to every target reaches this ratio (0-1) and tunnel receives no traffic, value of 0 disables reconnects",
+		Value: 0,
+	}
+	// FlagConnectionReconnectMaxAttempts sets the max number of automatic reconnect attempts.
+	FlagConnectionReconnectMaxAttempts = cli.IntFlag{
+		Name:  "connection.reconnect.max-attempts",
+		Usage: "Max number of automatic reconnect attempts after connection failure, value of 0 disables reconnect supervisor and reconnects once",
+		Value: 0,
+	}
+	// FlagConnectionReconnectBackoff sets the delay before second reconnect attempt.
+	FlagConnectionReconnectBackoff = cli.DurationFlag{
+		Name:  "connection.reconnect.backoff",
+		Usage: "Delay before second reconnect attempt, the delay is doubled after every failed attempt",
+		Value: 2 * time.Second,
+	}
+	// FlagConnectionReconnectMaxBackoff limits the delay between reconnect attempts.
+	FlagConnectionReconnectMaxBackoff = cli.DurationFlag{
+		Name:  "connection.reconnect.max-backoff",
+		Usage: "Max delay between reconnect attempts",
+		Value: time.Minute,
+	}
+	// FlagConnectionReconnectSwitchProviderAfter sets the number of failed attempts after which alternative proposal is used.
+	FlagConnectionReconnectSwitchProviderAfter = cli.IntFlag{
+		Name:  "connection.reconnect.switch-provider-after",
+		Usage: "Number of failed reconnect attempts after which alternative matching proposal is used, value of 0 disables provider switching",
+		Value: 2,
+	}
+	// FlagConnectionReconnectTunnelTimeout sets how long tunnel may stay reconnecting before reconnect supervisor takes over.
+	FlagConnectionReconnectTunnelTimeout = cli.DurationFlag{
+		Name:  "connection.reconnect.tunnel-timeout",
+		Usage: "How long tunnel may stay in reconnecting state before session is reconnected, value of 0 disables",
+		Value: 30 * time.Second,
+	}
+)
+
+// RegisterFlagsConnection function registers connection flags to flag list.
+func RegisterFlagsConnection(flags *[]cli.Flag) {
+	*flags = append(
+		*flags,
+		&FlagConnectionProbeInterval,
+		&FlagConnectionProbeTargets,
+		&FlagConnectionProbeCount,
+		&FlagConnectionProbeTimeout,
+		&FlagConnectionProbeThroughputURL,
+		&FlagConnectionProbeThroughputBytes,
+		&FlagConnectionProbeReconnectLoss,
+		&FlagConnectionReconnectMaxAttempts,
+		&FlagConnectionReconnectBackoff,
+		&FlagConnectionReconnectMaxBackoff,
+		&FlagConnectionReconnectSwitchProviderAfter,
+		&FlagConnectionReconnectTunnelTimeout,
+	)
+}
+
+// ParseFlagsConnection function fills in connection options from CLI context.
+func ParseFlagsConnection(ctx *cli.Context) {
+	Current.ParseDurationFlag(ctx, FlagConnectionProbeInterval)
+	Current.ParseStringSliceFlag(ctx, FlagConnectionProbeTargets)
+	Current.ParseIntFlag(ctx, FlagConnectionProbeCount)
+	Current.ParseDurationFlag(ctx, FlagConnectionProbeTimeout)
+	Current.ParseStringFlag(ctx, FlagConnectionProbeThroughputURL)
+	Current.ParseInt64Flag(ctx, FlagConnectionProbeThroughputBytes)
+	Current.ParseFloat64Flag(ctx, FlagConnectionProbeReconnectLoss)
+	Current.ParseIntFlag(ctx, FlagConnectionReconnectMaxAttempts)
+	Current.ParseDurationFlag(ctx, FlagConnectionReconnectBackoff)
+	Current.ParseDurationFlag(ctx, FlagConnectionReconnectMaxBackoff)
+	Current.ParseIntFlag(ctx, FlagConnectionReconnectSwitchProviderAfter)
+	Current.ParseDurationFlag(ctx, FlagConnectionReconnectTunnelTimeout)
+}
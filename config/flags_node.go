@@ -110,6 +110,18 @@ var (
 		Usage: "Determines the scrypt memory complexity. If set to true, will use 4MB blocks instead of the standard 256MB ones",
 		Value: true,
 	}
+	// FlagKeystoreExternalSigner address of an external signer keeping identity keys.
+	FlagKeystoreExternalSigner = cli.StringFlag{
+		Name:  "keystore.external-signer",
+		Usage: "JSON-RPC address (HTTP, websocket or IPC path) of an external signer keeping identity keys. Local keystore is used if empty",
+		Value: "",
+	}
+	// FlagKeystoreExternalSignerTimeout time to wait for an external signer to respond, including manual approval.
+	FlagKeystoreExternalSignerTimeout = cli.DurationFlag{
+		Name:  "keystore.external-signer.timeout",
+		Usage: "Time to wait for an external signer to respond, including manual approval",
+		Value: time.Minute,
+	}
 	// FlagLogHTTP enables HTTP payload logging.
 	FlagLogHTTP = cli.BoolFlag{
 		Name:  "log.http",
@@ -183,6 +195,64 @@ var (
 		Usage: "Default password for API authentication",
 		Value: "mystberry",
 	}
+	// FlagTequilapiTLSCert path to the TLS certificate of the API.
+	FlagTequilapiTLSCert = cli.StringFlag{
+		Name:  "tequilapi.tls.cert",
+		Usage: "Path to the PEM encoded TLS certificate to serve API over HTTPS",
+		Value: "",
+	}
+	// FlagTequilapiTLSKey path to the TLS private key of the API.
+	FlagTequilapiTLSKey = cli.StringFlag{
+		Name:  "tequilapi.tls.key",
+		Usage: "Path to the PEM encoded private key of the API TLS certificate",
+		Value: "",
+	}
+	// FlagTequilapiTLSSelfSigned enables auto generated self-signed API certificate.
+	FlagTequilapiTLSSelfSigned = cli.BoolFlag{
+		Name:  "tequilapi.tls.self-signed",
+		Usage: "Serve API over HTTPS using a self-signed certificate generated in the config directory",
+	}
+	// FlagTequilapiTLSClientCA path to the CA used to verify API client certificates.
+	FlagTequilapiTLSClientCA = cli.StringFlag{
+		Name:  "tequilapi.tls.client-ca",
+		Usage: "Path to the PEM encoded CA bundle. If set, API clients are required to present a certificate signed by it",
+		Value: "",
+	}
+	// FlagTequilapiTLSFingerprint SHA-256 fingerprint of the API certificate pinned by API clients.
+	FlagTequilapiTLSFingerprint = cli.StringFlag{
+		Name:  "tequilapi.tls.fingerprint",
+		Usage: "SHA-256 fingerprint of the API certificate, logged by the node on start. If set, API is reached over HTTPS trusting only this certificate",
+		Value: "",
+	}
+	// FlagTequilapiToken API token or login JWT used by API clients.
+	FlagTequilapiToken = cli.StringFlag{
+		Name:  "tequilapi.token",
+		Usage: "API token or login JWT to authorize API requests with. Required when API is reached over HTTPS",
+		Value: "",
+	}
+	// FlagTequilapiSocket path of the Unix domain socket to serve API on.
+	FlagTequilapiSocket = cli.StringFlag{
+		Name:  "tequilapi.socket",
+		Usage: "Path of the Unix domain socket to additionally serve API on",
+		Value: "",
+	}
+	// FlagTequilapiSocketMode file mode of the API Unix domain socket.
+	FlagTequilapiSocketMode = cli.StringFlag{
+		Name:  "tequilapi.socket.mode",
+		Usage: "Octal file mode of the API Unix domain socket, restricting which local users can access it",
+		Value: "0600",
+	}
+	// FlagGRPCEnable enables gRPC control API.
+	FlagGRPCEnable = cli.BoolFlag{
+		Name:  "grpc.enable",
+		Usage: "Enables gRPC control API, it shares authentication with the API",
+	}
+	// FlagGRPCAddress address to listen for incoming gRPC control API connections.
+	FlagGRPCAddress = cli.StringFlag{
+		Name:  "grpc.address",
+		Usage: "Address to bind gRPC control API to",
+		Value: "127.0.0.1:4060",
+	}
 	// FlagPProfEnable enables pprof via TequilAPI.
 	FlagPProfEnable = cli.BoolFlag{
 		Name:  "pprof.enable",
@@ -272,6 +342,7 @@ func RegisterFlagsNode(flags *[]cli.Flag) error {
 
 	RegisterFlagsLocation(flags)
 	RegisterFlagsNetwork(flags)
+	RegisterFlagsConnection(flags)
 	RegisterFlagsTransactor(flags)
 	RegisterFlagsPayments(flags)
 	RegisterFlagsPolicy(flags)
@@ -293,6 +364,8 @@ func RegisterFlagsNode(flags *[]cli.Flag) error {
 		&FlagFirewallProtectedNetworks,
 		&FlagShaperEnabled,
 		&FlagKeystoreLightweight,
+		&FlagKeystoreExternalSigner,
+		&FlagKeystoreExternalSignerTimeout,
 		&FlagLogHTTP,
 		&FlagLogLevel,
 		&FlagVerbose,
@@ -303,6 +376,14 @@ func RegisterFlagsNode(flags *[]cli.Flag) error {
 		&FlagTequilapiPort,
 		&FlagTequilapiUsername,
 		&FlagTequilapiPassword,
+		&FlagTequilapiTLSCert,
+		&FlagTequilapiTLSKey,
+		&FlagTequilapiTLSSelfSigned,
+		&FlagTequilapiTLSClientCA,
+		&FlagTequilapiSocket,
+		&FlagTequilapiSocketMode,
+		&FlagGRPCEnable,
+		&FlagGRPCAddress,
 		&FlagPProfEnable,
 		&FlagUIEnable,
 		&FlagUIAddress,
@@ -326,6 +407,7 @@ func ParseFlagsNode(ctx *cli.Context) {
 
 	ParseFlagsLocation(ctx)
 	ParseFlagsNetwork(ctx)
+	ParseFlagsConnection(ctx)
 	ParseFlagsTransactor(ctx)
 	ParseFlagsPayments(ctx)
 	ParseFlagsPolicy(ctx)
@@ -346,6 +428,8 @@ func ParseFlagsNode(ctx *cli.Context) {
 	Current.ParseStringFlag(ctx, FlagFirewallProtectedNetworks)
 	Current.ParseBoolFlag(ctx, FlagShaperEnabled)
 	Current.ParseBoolFlag(ctx, FlagKeystoreLightweight)
+	Current.ParseStringFlag(ctx, FlagKeystoreExternalSigner)
+	Current.ParseDurationFlag(ctx, FlagKeystoreExternalSignerTimeout)
 	Current.ParseBoolFlag(ctx, FlagLogHTTP)
 	Current.ParseBoolFlag(ctx, FlagVerbose)
 	Current.ParseStringFlag(ctx, FlagLogLevel)
@@ -356,6 +440,12 @@ func ParseFlagsNode(ctx *cli.Context) {
 	Current.ParseIntFlag(ctx, FlagTequilapiPort)
 	Current.ParseStringFlag(ctx, FlagTequilapiUsername)
 	Current.ParseStringFlag(ctx, FlagTequilapiPassword)
+	Current.ParseStringFlag(ctx, FlagTequilapiTLSCert)
+	Current.ParseStringFlag(ctx, FlagTequilapiTLSKey)
+	Current.ParseBoolFlag(ctx, FlagTequilapiTLSSelfSigned)
+	Current.ParseStringFlag(ctx, FlagTequilapiTLSClientCA)
+	Current.ParseStringFlag(ctx, FlagTequilapiSocket)
+	Current.ParseStringFlag(ctx, FlagTequilapiSocketMode)
 	Current.ParseBoolFlag(ctx, FlagPProfEnable)
 	Current.ParseBoolFlag(ctx, FlagUIEnable)
 	Current.ParseStringFlag(ctx, FlagUIAddress)
@@ -50,6 +50,30 @@ var (
 		Value: time.Hour * 2,
 		Usage: "The duration we'll wait before timing out our wait for promise settle.",
 	}
+	// FlagPaymentsSettlementPolicyCheckInterval determines how often scheduled settlement policies are evaluated.
+	FlagPaymentsSettlementPolicyCheckInterval = cli.DurationFlag{
+		Name:  "payments.provider.settlement-policy-check-interval",
+		Value: time.Minute * 10,
+		Usage: "Determines how often scheduled settlement policies are checked.",
+	}
+	// FlagPaymentsProviderAcceptedHermes lists additional Hermes provider accepts payments through.
+	FlagPaymentsProviderAcceptedHermes = cli.StringSliceFlag{
+		Name:  "payments.provider.accepted-hermes",
+		Usage: "Hermes addresses, besides the active one, provider accepts payments through, separated by comma",
+		Value: cli.NewStringSlice(),
+	}
+	// FlagPaymentsIncidentBlockThreshold determines after how many payment incidents the peer is blocked.
+	FlagPaymentsIncidentBlockThreshold = cli.IntFlag{
+		Name:  "payments.incident-block-threshold",
+		Value: 0,
+		Usage: "Blocks consumers with this many payment incidents from using provided services and providers with this many incidents from being connected to. 0 disables blocking.",
+	}
+	// FlagPaymentsIncidentBlockWindow determines how long payment incidents count towards blocking the peer.
+	FlagPaymentsIncidentBlockWindow = cli.DurationFlag{
+		Name:  "payments.incident-block-window",
+		Value: 24 * time.Hour,
+		Usage: "Only payment incidents within this window count towards blocking, older ones decay and expire. Exchange message timeouts count as a quarter.",
+	}
 	// FlagPaymentsProviderInvoiceFrequency determines how often the provider sends invoices.
 	FlagPaymentsProviderInvoiceFrequency = cli.DurationFlag{
 		Name:  "payments.provider.invoice-frequency",
@@ -99,6 +123,30 @@ var (
 		Usage:  "sets the hermes status recheck interval. Setting this to a lower value will decrease potential loss in case of Hermes getting locked.",
 		Value:  time.Hour * 2,
 	}
+	// FlagPaymentsProviderPriceCurrency sets the currency provider service prices are set in.
+	FlagPaymentsProviderPriceCurrency = cli.StringFlag{
+		Name:  "payments.provider.price-currency",
+		Usage: "currency service prices are set in, e.g. USD or EUR. When set, prices are converted to MYST using exchange rate and updated periodically",
+		Value: "",
+	}
+	// FlagPaymentsProviderPriceUpdateInterval sets how often fiat denominated prices are converted to MYST again.
+	FlagPaymentsProviderPriceUpdateInterval = cli.DurationFlag{
+		Name:  "payments.provider.price-update-interval",
+		Usage: "how often service prices set in fiat currency are re-calculated from exchange rate",
+		Value: time.Hour,
+	}
+	// FlagPaymentsProviderPriceSmoothing sets the weight of the latest exchange rate when re-calculating prices.
+	FlagPaymentsProviderPriceSmoothing = cli.Float64Flag{
+		Name:  "payments.provider.price-smoothing",
+		Usage: "weight of the latest exchange rate from 0 to 1, lower values smooth out rate fluctuations",
+		Value: 0.5,
+	}
+	// FlagPaymentsProviderPriceMaxChange sets the maximum relative change of MYST price in a single update.
+	FlagPaymentsProviderPriceMaxChange = cli.Float64Flag{
+		Name:  "payments.provider.price-max-change",
+		Usage: "maximum relative change of MYST service price in a single update, e.g. 0.1 is 10%",
+		Value: 0.1,
+	}
 )
 
 // RegisterFlagsPayments function register payments flags to flag list.
@@ -109,6 +157,10 @@ func RegisterFlagsPayments(flags *[]cli.Flag) {
 		&FlagPaymentsBCTimeout,
 		&FlagPaymentsHermesPromiseSettleThreshold,
 		&FlagPaymentsHermesPromiseSettleTimeout,
+		&FlagPaymentsSettlementPolicyCheckInterval,
+		&FlagPaymentsProviderAcceptedHermes,
+		&FlagPaymentsIncidentBlockThreshold,
+		&FlagPaymentsIncidentBlockWindow,
 		&FlagPaymentsProviderInvoiceFrequency,
 		&FlagPaymentsConsumerPricePerMinuteUpperBound,
 		&FlagPaymentsConsumerPricePerMinuteLowerBound,
@@ -117,6 +169,10 @@ func RegisterFlagsPayments(flags *[]cli.Flag) {
 		&FlagPaymentsConsumerDataLeewayMegabytes,
 		&FlagPaymentsMaxUnpaidInvoiceValue,
 		&FlagPaymentsHermesStatusRecheckInterval,
+		&FlagPaymentsProviderPriceCurrency,
+		&FlagPaymentsProviderPriceUpdateInterval,
+		&FlagPaymentsProviderPriceSmoothing,
+		&FlagPaymentsProviderPriceMaxChange,
 	)
 }
 
@@ -126,6 +182,10 @@ func ParseFlagsPayments(ctx *cli.Context) {
 	Current.ParseDurationFlag(ctx, FlagPaymentsBCTimeout)
 	Current.ParseFloat64Flag(ctx, FlagPaymentsHermesPromiseSettleThreshold)
 	Current.ParseDurationFlag(ctx, FlagPaymentsHermesPromiseSettleTimeout)
+	Current.ParseDurationFlag(ctx, FlagPaymentsSettlementPolicyCheckInterval)
+	Current.ParseStringSliceFlag(ctx, FlagPaymentsProviderAcceptedHermes)
+	Current.ParseIntFlag(ctx, FlagPaymentsIncidentBlockThreshold)
+	Current.ParseDurationFlag(ctx, FlagPaymentsIncidentBlockWindow)
 	Current.ParseDurationFlag(ctx, FlagPaymentsProviderInvoiceFrequency)
 	Current.ParseStringFlag(ctx, FlagPaymentsConsumerPricePerMinuteUpperBound)
 	Current.ParseStringFlag(ctx, FlagPaymentsConsumerPricePerMinuteLowerBound)
@@ -134,4 +194,8 @@ func ParseFlagsPayments(ctx *cli.Context) {
 	Current.ParseUInt64Flag(ctx, FlagPaymentsConsumerDataLeewayMegabytes)
 	Current.ParseStringFlag(ctx, FlagPaymentsMaxUnpaidInvoiceValue)
 	Current.ParseDurationFlag(ctx, FlagPaymentsHermesStatusRecheckInterval)
+	Current.ParseStringFlag(ctx, FlagPaymentsProviderPriceCurrency)
+	Current.ParseDurationFlag(ctx, FlagPaymentsProviderPriceUpdateInterval)
+	Current.ParseFloat64Flag(ctx, FlagPaymentsProviderPriceSmoothing)
+	Current.ParseFloat64Flag(ctx, FlagPaymentsProviderPriceMaxChange)
 }
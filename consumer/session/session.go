@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/p2p"
 	node_session "github.com/mysteriumnetwork/node/session"
 )
 
@@ -52,6 +53,7 @@ type History struct {
 	DataSent        uint64
 	DataReceived    uint64
 	Tokens          *big.Int
+	ChannelStats    *p2p.ChannelStats
 
 	NodeType string
 
@@ -0,0 +1,383 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/logconfig/rollingwriter"
+)
+
+const (
+	storageBucket  = "audit-log"
+	storageKeyMAC  = "hmac-key"
+	storageKeyHead = "head"
+
+	macKeyLength = 32
+)
+
+// Storage keeps the MAC key and the chain head outside of the log files, so they can not be rewritten together with entries.
+type Storage interface {
+	GetValue(bucket string, key interface{}, to interface{}) error
+	SetValue(bucket string, key interface{}, to interface{}) error
+}
+
+// head is the last recorded entry, used to detect entries removed from the end of the log.
+type head struct {
+	Seq  uint64
+	Hash string
+}
+
+// Entry is a single audited operation.
+type Entry struct {
+	Seq          uint64              `json:"seq"`
+	Time         time.Time           `json:"time"`
+	Actor        string              `json:"actor"`
+	RemoteAddr   string              `json:"remote_addr"`
+	ForwardedFor string              `json:"forwarded_for,omitempty"`
+	Method       string              `json:"method"`
+	Path         string              `json:"path"`
+	Query        map[string][]string `json:"query,omitempty"`
+	Body         json.RawMessage     `json:"body,omitempty"`
+	Status       int                 `json:"status"`
+	// PrevHash links the entry to the previous one, so removed or modified entries break the chain.
+	PrevHash string `json:"prev_hash"`
+	// Hash is HMAC-SHA256 of the entry, it can not be recomputed without the key kept outside of the log.
+	Hash string `json:"hash"`
+}
+
+func (e Entry) computeHash(key []byte) (string, error) {
+	e.Hash = ""
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(e.PrevHash + "\n"))
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Filter narrows down queried entries, zero values match everything.
+type Filter struct {
+	From       *time.Time
+	To         *time.Time
+	Actor      string
+	PathPrefix string
+	Limit      int
+}
+
+func (f Filter) matches(e Entry) bool {
+	if f.From != nil && e.Time.Before(*f.From) {
+		return false
+	}
+	if f.To != nil && e.Time.After(*f.To) {
+		return false
+	}
+	if f.Actor != "" && e.Actor != f.Actor {
+		return false
+	}
+	return strings.HasPrefix(e.Path, f.PathPrefix)
+}
+
+// Log is an append-only audit log.
+// Entries are HMAC chained JSON lines written to rolling files, so tampering is detected by Verify.
+// The key and the last entry are kept in storage, so entries can neither be forged nor removed from the end unnoticed.
+type Log struct {
+	lock     sync.Mutex
+	dir      string
+	filename string
+	writer   io.Writer
+	storage  Storage
+	key      []byte
+	lastSeq  uint64
+	lastHash string
+	now      func() time.Time
+}
+
+// NewLog opens audit log at the given path, continuing the chain of recorded entries.
+func NewLog(filepath string, storage Storage) (*Log, error) {
+	key, err := macKey(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := rollingwriter.NewRollingWriter(filepath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open audit log")
+	}
+	if err := writer.CleanObsoleteLogs(); err != nil {
+		log.Warn().Err(err).Msg("Failed to cleanup obsolete audit logs")
+	}
+
+	l := &Log{
+		dir:      path.Dir(filepath),
+		filename: path.Base(filepath) + ".log",
+		writer:   writer,
+		storage:  storage,
+		key:      key,
+		now:      time.Now,
+	}
+
+	last, err := l.lastEntry()
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		l.lastSeq = last.Seq
+		l.lastHash = last.Hash
+	}
+
+	// Chain continues from the stored head even if files were truncated, so the gap stays detectable.
+	var h head
+	if err := storage.GetValue(storageBucket, storageKeyHead, &h); err == nil {
+		if h.Seq != l.lastSeq || h.Hash != l.lastHash {
+			log.Error().Msgf("Audit log was truncated, last recorded entry %d is missing", h.Seq)
+		}
+		l.lastSeq, l.lastHash = h.Seq, h.Hash
+	}
+	return l, nil
+}
+
+func macKey(storage Storage) ([]byte, error) {
+	var key []byte
+	if err := storage.GetValue(storageBucket, storageKeyMAC, &key); err == nil && len(key) > 0 {
+		return key, nil
+	}
+
+	key = make([]byte, macKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "could not generate audit log key")
+	}
+	if err := storage.SetValue(storageBucket, storageKeyMAC, key); err != nil {
+		return nil, errors.Wrap(err, "could not store audit log key")
+	}
+	return key, nil
+}
+
+// Record appends the entry to the log, filling in its sequence number, time and hashes.
+func (l *Log) Record(e Entry) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	e.Seq = l.lastSeq + 1
+	e.Time = l.now().UTC()
+	e.PrevHash = l.lastHash
+	hash, err := e.computeHash(l.key)
+	if err != nil {
+		return errors.Wrap(err, "could not hash audit entry")
+	}
+	e.Hash = hash
+
+	line, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal audit entry")
+	}
+	if _, err := l.writer.Write(append(line, '\n')); err != nil {
+		return errors.Wrap(err, "could not write audit entry")
+	}
+
+	l.lastSeq = e.Seq
+	l.lastHash = e.Hash
+	if err := l.storage.SetValue(storageBucket, storageKeyHead, head{Seq: e.Seq, Hash: e.Hash}); err != nil {
+		return errors.Wrap(err, "could not store audit log head")
+	}
+	return nil
+}
+
+// Query returns the newest entries matching the filter.
+func (l *Log) Query(filter Filter) ([]Entry, error) {
+	entries, err := l.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Entry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if !filter.matches(entries[i]) {
+			continue
+		}
+		res = append(res, entries[i])
+		if filter.Limit > 0 && len(res) == filter.Limit {
+			break
+		}
+	}
+	return res, nil
+}
+
+// Verify checks that no retained entries were modified or removed.
+// The oldest retained entry anchors the chain, as older files are removed by rotation,
+// while the stored head reveals entries removed from the end.
+func (l *Log) Verify() error {
+	entries, err := l.entries()
+	if err != nil {
+		return err
+	}
+
+	for i, e := range entries {
+		hash, err := e.computeHash(l.key)
+		if err != nil {
+			return err
+		}
+		if hash != e.Hash {
+			return fmt.Errorf("entry %d was modified", e.Seq)
+		}
+		if i == 0 {
+			continue
+		}
+		prev := entries[i-1]
+		if e.Seq != prev.Seq+1 || e.PrevHash != prev.Hash {
+			return fmt.Errorf("entries between %d and %d are missing", prev.Seq, e.Seq)
+		}
+	}
+
+	l.lock.Lock()
+	lastSeq, lastHash := l.lastSeq, l.lastHash
+	l.lock.Unlock()
+	if lastSeq == 0 {
+		return nil
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("entries up to %d are missing", lastSeq)
+	}
+	if last := entries[len(entries)-1]; last.Seq != lastSeq || last.Hash != lastHash {
+		return fmt.Errorf("entries after %d are missing", last.Seq)
+	}
+	return nil
+}
+
+func (l *Log) entries() ([]Entry, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	files, err := l.files()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, file := range files {
+		fileEntries, err := readEntries(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range fileEntries {
+			// Rotated file might be seen both before and after compression.
+			if len(entries) > 0 && e.Seq <= entries[len(entries)-1].Seq {
+				continue
+			}
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func (l *Log) lastEntry() (*Entry, error) {
+	files, err := l.files()
+	if err != nil {
+		return nil, err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		entries, err := readEntries(files[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) > 0 {
+			return &entries[len(entries)-1], nil
+		}
+	}
+	return nil, nil
+}
+
+// files lists rotated log files oldest first, followed by the current one.
+func (l *Log) files() ([]string, error) {
+	infos, err := ioutil.ReadDir(l.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read audit log directory")
+	}
+
+	var rotated []os.FileInfo
+	for _, info := range infos {
+		if strings.HasPrefix(info.Name(), l.filename+".") {
+			rotated = append(rotated, info)
+		}
+	}
+	sort.Slice(rotated, func(i, j int) bool {
+		return rotated[i].ModTime().Before(rotated[j].ModTime())
+	})
+
+	var res []string
+	for _, info := range rotated {
+		res = append(res, path.Join(l.dir, info.Name()))
+	}
+	return append(res, path.Join(l.dir, l.filename)), nil
+}
+
+func readEntries(filepath string) ([]Entry, error) {
+	file, err := os.Open(filepath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open audit log file")
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.Contains(path.Base(filepath), ".gz") {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decompress audit log file "+filepath)
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	var entries []Entry
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, errors.Wrap(err, "malformed audit log entry in "+filepath)
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+)
+
+func newTestLog(t *testing.T) (*Log, string, func()) {
+	dir, err := ioutil.TempDir("", "audit-test")
+	require.NoError(t, err)
+	storageDir, err := ioutil.TempDir("", "audit-test-storage")
+	require.NoError(t, err)
+	storage, err := boltdb.NewStorage(storageDir)
+	require.NoError(t, err)
+
+	l, err := NewLog(path.Join(dir, "audit"), storage)
+	require.NoError(t, err)
+	return l, dir, func() {
+		storage.Close()
+		os.RemoveAll(storageDir)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestLog_RecordAndQuery(t *testing.T) {
+	l, _, cleanup := newTestLog(t)
+	defer cleanup()
+
+	now := time.Date(2020, 11, 1, 10, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	require.NoError(t, l.Record(Entry{Actor: "user:myst", Method: "POST", Path: "/stop", Status: 202}))
+	now = now.Add(time.Hour)
+	require.NoError(t, l.Record(Entry{Actor: "token:abc", Method: "POST", Path: "/transactor/settle/sync", Body: RedactBody([]byte(`{"hermes_id":"0x1"}`)), Status: 200}))
+	require.NoError(t, l.Record(Entry{Actor: "token:abc", Method: "DELETE", Path: "/services/1", Status: 202}))
+
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, uint64(3), entries[0].Seq)
+	assert.Equal(t, entries[1].Hash, entries[0].PrevHash)
+	assert.JSONEq(t, `{"hermes_id":"0x1"}`, string(entries[1].Body))
+
+	entries, err = l.Query(Filter{Actor: "token:abc", PathPrefix: "/transactor"})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint64(2), entries[0].Seq)
+
+	from := now
+	entries, err = l.Query(Filter{From: &from, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint64(3), entries[0].Seq)
+
+	assert.NoError(t, l.Verify())
+}
+
+func TestLog_ContinuesChainAfterRestart(t *testing.T) {
+	l, dir, cleanup := newTestLog(t)
+	defer cleanup()
+	require.NoError(t, l.Record(Entry{Method: "POST", Path: "/stop"}))
+
+	reopened, err := NewLog(path.Join(dir, "audit"), l.storage)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Record(Entry{Method: "POST", Path: "/stop"}))
+
+	entries, err := reopened.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(2), entries[0].Seq)
+	assert.NoError(t, reopened.Verify())
+}
+
+func TestLog_VerifyDetectsTampering(t *testing.T) {
+	l, dir, cleanup := newTestLog(t)
+	defer cleanup()
+	for _, p := range []string{"/stop", "/services", "/config/user"} {
+		require.NoError(t, l.Record(Entry{Actor: "user:myst", Method: "POST", Path: p}))
+	}
+	file := path.Join(dir, "audit.log")
+	original, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(original), "\n")
+
+	modified := strings.Replace(string(original), "user:myst", "user:other", 1)
+	require.NoError(t, ioutil.WriteFile(file, []byte(modified), 0600))
+	assert.EqualError(t, l.Verify(), "entry 1 was modified")
+
+	removed := lines[0] + lines[2]
+	require.NoError(t, ioutil.WriteFile(file, []byte(removed), 0600))
+	assert.EqualError(t, l.Verify(), "entries between 1 and 3 are missing")
+}
+
+func TestLog_VerifyDetectsTruncation(t *testing.T) {
+	l, dir, cleanup := newTestLog(t)
+	defer cleanup()
+	for _, p := range []string{"/stop", "/services", "/config/user"} {
+		require.NoError(t, l.Record(Entry{Actor: "user:myst", Method: "POST", Path: p}))
+	}
+	file := path.Join(dir, "audit.log")
+	original, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(original), "\n")
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(lines[0]+lines[1]), 0600))
+	assert.EqualError(t, l.Verify(), "entries after 2 are missing")
+
+	require.NoError(t, ioutil.WriteFile(file, nil, 0600))
+	assert.EqualError(t, l.Verify(), "entries up to 3 are missing")
+
+	reopened, err := NewLog(path.Join(dir, "audit"), l.storage)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Record(Entry{Method: "POST", Path: "/stop"}))
+	entries, err := reopened.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint64(4), entries[0].Seq)
+}
+
+func TestLog_VerifyRejectsEntriesHashedWithoutKey(t *testing.T) {
+	l, dir, cleanup := newTestLog(t)
+	defer cleanup()
+	require.NoError(t, l.Record(Entry{Actor: "user:myst", Method: "POST", Path: "/stop"}))
+
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	forged := entries[0]
+	forged.Actor = "user:other"
+	forged.Hash, err = forged.computeHash([]byte("guessed key"))
+	require.NoError(t, err)
+	line, err := json.Marshal(forged)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "audit.log"), append(line, '\n'), 0600))
+	assert.EqualError(t, l.Verify(), "entry 1 was modified")
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// maxLineSize limits the size of a single audit entry.
+	maxLineSize = 1024 * 1024
+	// maxValueLength is the length of string values kept in audit entries, longer ones like keystore blobs are elided.
+	maxValueLength = 256
+
+	redacted = "[REDACTED]"
+)
+
+// sensitiveWords mark parameters which must never end up in the audit log.
+var sensitiveWords = map[string]struct{}{
+	"password":   {},
+	"passphrase": {},
+	"secret":     {},
+	"token":      {},
+	"key":        {},
+	"mnemonic":   {},
+}
+
+func isSensitive(name string) bool {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == '_' || r == '-' || r == '.'
+	})
+	for _, word := range words {
+		if _, ok := sensitiveWords[word]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactQuery returns query parameters with secrets redacted.
+func RedactQuery(query map[string][]string) map[string][]string {
+	if len(query) == 0 {
+		return nil
+	}
+	res := make(map[string][]string, len(query))
+	for name, values := range query {
+		if isSensitive(name) {
+			res[name] = []string{redacted}
+			continue
+		}
+		res[name] = make([]string, len(values))
+		for i, value := range values {
+			res[name][i] = elide(value)
+		}
+	}
+	return res
+}
+
+// RedactBody returns JSON body with secrets redacted and long values elided.
+// Bodies which are not JSON are replaced by their size.
+func RedactBody(body []byte) json.RawMessage {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return marshal(fmt.Sprintf("[%d bytes of non JSON body]", len(body)))
+	}
+	return marshal(redactValue(value))
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, nested := range v {
+			if isSensitive(name) {
+				v[name] = redacted
+			} else {
+				v[name] = redactValue(nested)
+			}
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+		return v
+	case string:
+		return elide(v)
+	default:
+		return v
+	}
+}
+
+func elide(value string) string {
+	if len(value) <= maxValueLength {
+		return value
+	}
+	return fmt.Sprintf("[%d characters omitted]", len(value))
+}
+
+func marshal(value interface{}) json.RawMessage {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return data
+}
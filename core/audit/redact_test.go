@@ -0,0 +1,57 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := `{
+		"current_password": "old",
+		"new_password": "new",
+		"data": {"mmn.api-key": "abc", "payments.zero-stake-unsettled-amount": 1.5},
+		"sum_tokens": 12345678901234567890,
+		"keystore": "` + strings.Repeat("a", maxValueLength+1) + `",
+		"ids": ["x", {"passphrase": "p"}]
+	}`
+
+	assert.JSONEq(t, `{
+		"current_password": "[REDACTED]",
+		"new_password": "[REDACTED]",
+		"data": {"mmn.api-key": "[REDACTED]", "payments.zero-stake-unsettled-amount": 1.5},
+		"sum_tokens": 12345678901234567890,
+		"keystore": "[257 characters omitted]",
+		"ids": ["x", {"passphrase": "[REDACTED]"}]
+	}`, string(RedactBody([]byte(body))))
+	assert.Contains(t, string(RedactBody([]byte(body))), "12345678901234567890")
+
+	assert.Equal(t, `"[9 bytes of non JSON body]"`, string(RedactBody([]byte("not json!"))))
+	assert.Nil(t, RedactBody(nil))
+}
+
+func TestRedactQuery(t *testing.T) {
+	assert.Equal(t,
+		map[string][]string{"token": {"[REDACTED]"}, "id": {"0x1"}},
+		RedactQuery(map[string][]string{"token": {"secret"}, "id": {"0x1"}}),
+	)
+	assert.Nil(t, RedactQuery(nil))
+}
@@ -22,4 +22,6 @@ import "github.com/pkg/errors"
 var (
 	// ErrUnauthorized unauthorized
 	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden authenticated, but not allowed
+	ErrForbidden = errors.New("forbidden")
 )
@@ -0,0 +1,131 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Scope restricts which part of the API an API token can access.
+type Scope string
+
+const (
+	// ScopeAdmin grants full access, same as logging in with username and password.
+	ScopeAdmin Scope = "admin"
+	// ScopeRead grants read-only access.
+	ScopeRead Scope = "read"
+	// ScopeConnection grants control of consumer connections.
+	ScopeConnection Scope = "connection"
+	// ScopeService grants control of provided services.
+	ScopeService Scope = "service"
+	// ScopePayments grants access to payment operations, e.g. settlement, stake and payout changes.
+	ScopePayments Scope = "payments"
+)
+
+// Scopes lists all known scopes.
+var Scopes = []Scope{ScopeAdmin, ScopeRead, ScopeConnection, ScopeService, ScopePayments}
+
+// ParseScope parses scope from its name.
+func ParseScope(name string) (Scope, error) {
+	for _, s := range Scopes {
+		if string(s) == strings.ToLower(strings.TrimSpace(name)) {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unknown scope %q", name)
+}
+
+type scopeRule struct {
+	path  string
+	scope Scope
+}
+
+// adminRules lists routes which require full access regardless of the request method.
+var adminRules = []string{
+	"audit",
+	"auth",
+	"debug",
+	"identities/*/export",
+	"logs",
+	"mmn/api-key",
+	"webhooks",
+}
+
+// writeRules lists scopes required to change state, the rest of the changes require full access.
+var writeRules = []scopeRule{
+	{path: "connection", scope: ScopeConnection},
+	{path: "services", scope: ScopeService},
+	{path: "transactor", scope: ScopePayments},
+	{path: "identities/*/beneficiary", scope: ScopePayments},
+	{path: "identities/*/payment-order", scope: ScopePayments},
+	{path: "identities/*/payout", scope: ScopePayments},
+	{path: "identities/*/settlement-policy", scope: ScopePayments},
+	{path: "identities/*/spending-limits", scope: ScopePayments},
+}
+
+// RequiredScope returns scope required to perform the given API request.
+func RequiredScope(method, path string) Scope {
+	segments := splitPath(path)
+	for _, rule := range adminRules {
+		if matchPath(rule, segments) {
+			return ScopeAdmin
+		}
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ScopeRead
+	}
+
+	for _, rule := range writeRules {
+		if matchPath(rule.path, segments) {
+			return rule.scope
+		}
+	}
+	return ScopeAdmin
+}
+
+// allows checks if any of the scopes grants the required one.
+func allows(scopes []Scope, required Scope) bool {
+	for _, s := range scopes {
+		if s == ScopeAdmin || s == required {
+			return true
+		}
+	}
+	return false
+}
+
+func splitPath(path string) []string {
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+}
+
+// matchPath checks if the path starts with the pattern, where "*" matches any single segment.
+func matchPath(pattern string, segments []string) bool {
+	parts := splitPath(pattern)
+	if len(segments) < len(parts) {
+		return false
+	}
+	for i, part := range parts {
+		if part != "*" && part != segments[i] {
+			return false
+		}
+	}
+	return true
+}
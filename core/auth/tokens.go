@@ -0,0 +1,260 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/pkg/errors"
+)
+
+// APITokenPrefix distinguishes API tokens from login JWT tokens.
+const APITokenPrefix = "mtk_"
+
+const apiTokenBucket = "api-tokens"
+
+var (
+	// ErrTokenNotFound is returned when API token does not exist.
+	ErrTokenNotFound = errors.New("API token not found")
+	// ErrTokenRevoked is returned when API token was revoked.
+	ErrTokenRevoked = errors.New("API token revoked")
+	// ErrTokenExpired is returned when API token is past its expiry.
+	ErrTokenExpired = errors.New("API token expired")
+)
+
+// APIToken is a named API access token restricted to the given scopes.
+type APIToken struct {
+	ID        string `storm:"id"`
+	Name      string
+	Scopes    []Scope
+	Hash      string
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+}
+
+// Expired checks if token is past its expiry at the given time.
+func (t APIToken) Expired(at time.Time) bool {
+	return t.ExpiresAt != nil && !at.Before(*t.ExpiresAt)
+}
+
+type tokenStorage interface {
+	Store(bucket string, data interface{}) error
+	GetAllFrom(bucket string, data interface{}) error
+	GetOneByField(bucket string, fieldName string, key interface{}, to interface{}) error
+}
+
+// TokenManager issues, validates and revokes API tokens.
+type TokenManager struct {
+	storage tokenStorage
+	lock    sync.Mutex
+	timeNow func() time.Time
+}
+
+// NewTokenManager returns a new instance of TokenManager.
+func NewTokenManager(storage tokenStorage) *TokenManager {
+	return &TokenManager{
+		storage: storage,
+		timeNow: time.Now,
+	}
+}
+
+// Create issues a new API token and returns it along with its secret.
+// The secret is not stored and can not be retrieved later.
+func (tm *TokenManager) Create(name string, scopes []Scope, expiresAt *time.Time) (APIToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return APIToken{}, "", errors.New("token name is required")
+	}
+	if len(scopes) == 0 {
+		return APIToken{}, "", errors.New("at least one scope is required")
+	}
+	for _, s := range scopes {
+		if _, err := ParseScope(string(s)); err != nil {
+			return APIToken{}, "", err
+		}
+	}
+	now := tm.timeNow().UTC()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return APIToken{}, "", errors.New("token expiry must be in the future")
+	}
+
+	idBytes, err := generateRandomBytes(8)
+	if err != nil {
+		return APIToken{}, "", errors.Wrap(err, "failed to generate token ID")
+	}
+	secretBytes, err := generateRandomBytes(32)
+	if err != nil {
+		return APIToken{}, "", errors.Wrap(err, "failed to generate token secret")
+	}
+	id := hex.EncodeToString(idBytes)
+	secret := APITokenPrefix + id + "_" + hex.EncodeToString(secretBytes)
+
+	token := APIToken{
+		ID:        id,
+		Name:      name,
+		Scopes:    scopes,
+		Hash:      hashToken(secret),
+		CreatedAt: now,
+	}
+	if expiresAt != nil {
+		expiry := expiresAt.UTC()
+		token.ExpiresAt = &expiry
+	}
+
+	tm.lock.Lock()
+	defer tm.lock.Unlock()
+	if err := tm.storage.Store(apiTokenBucket, &token); err != nil {
+		return APIToken{}, "", errors.Wrap(err, "failed to store API token")
+	}
+	return token, secret, nil
+}
+
+// List returns all API tokens, including revoked and expired ones.
+func (tm *TokenManager) List() ([]APIToken, error) {
+	var tokens []APIToken
+	err := tm.storage.GetAllFrom(apiTokenBucket, &tokens)
+	if err == storm.ErrNotFound {
+		return []APIToken{}, nil
+	}
+	return tokens, err
+}
+
+// Revoke revokes API token with the given ID.
+func (tm *TokenManager) Revoke(id string) error {
+	tm.lock.Lock()
+	defer tm.lock.Unlock()
+
+	token, err := tm.get(id)
+	if err != nil {
+		return err
+	}
+	if token.RevokedAt != nil {
+		return nil
+	}
+	now := tm.timeNow().UTC()
+	token.RevokedAt = &now
+	return tm.storage.Store(apiTokenBucket, &token)
+}
+
+// Validate checks the API token secret and returns the token it belongs to.
+func (tm *TokenManager) Validate(secret string) (APIToken, error) {
+	id, ok := tokenID(secret)
+	if !ok {
+		return APIToken{}, ErrTokenNotFound
+	}
+	token, err := tm.get(id)
+	if err != nil {
+		return APIToken{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(token.Hash), []byte(hashToken(secret))) != 1 {
+		return APIToken{}, ErrTokenNotFound
+	}
+	if token.RevokedAt != nil {
+		return APIToken{}, ErrTokenRevoked
+	}
+	if token.Expired(tm.timeNow()) {
+		return APIToken{}, ErrTokenExpired
+	}
+	return token, nil
+}
+
+func (tm *TokenManager) get(id string) (APIToken, error) {
+	var token APIToken
+	err := tm.storage.GetOneByField(apiTokenBucket, "ID", id, &token)
+	if err == storm.ErrNotFound {
+		return token, ErrTokenNotFound
+	}
+	return token, err
+}
+
+func tokenID(secret string) (string, bool) {
+	if !strings.HasPrefix(secret, APITokenPrefix) {
+		return "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(secret, APITokenPrefix), "_", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", false
+	}
+	return parts[0], true
+}
+
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// RequestAuthorizer authorizes API requests carrying either login JWT or API token.
+type RequestAuthorizer struct {
+	jwt    *JWTAuthenticator
+	tokens *TokenManager
+}
+
+// NewRequestAuthorizer returns a new instance of RequestAuthorizer.
+func NewRequestAuthorizer(jwt *JWTAuthenticator, tokens *TokenManager) *RequestAuthorizer {
+	return &RequestAuthorizer{
+		jwt:    jwt,
+		tokens: tokens,
+	}
+}
+
+// Authorize checks if the token allows the given API request.
+// Login JWT tokens grant full access, API tokens are limited to their scopes.
+func (ra *RequestAuthorizer) Authorize(token, method, path string) error {
+	if !strings.HasPrefix(token, APITokenPrefix) {
+		if _, err := ra.jwt.ValidateToken(token); err != nil {
+			return ErrUnauthorized
+		}
+		return nil
+	}
+
+	apiToken, err := ra.tokens.Validate(token)
+	if err != nil {
+		return ErrUnauthorized
+	}
+	if !allows(apiToken.Scopes, RequiredScope(method, path)) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// Actor describes who makes API requests with the given token, e.g. for auditing.
+func (ra *RequestAuthorizer) Actor(token string) string {
+	if token == "" {
+		return ""
+	}
+	if strings.HasPrefix(token, APITokenPrefix) {
+		apiToken, err := ra.tokens.Validate(token)
+		if err != nil {
+			return "invalid-token"
+		}
+		return "token:" + apiToken.ID
+	}
+
+	username, err := ra.jwt.Username(token)
+	if err != nil {
+		return "invalid-token"
+	}
+	return "user:" + username
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+)
+
+func newTestTokenManager(t *testing.T) (*TokenManager, func()) {
+	dir, err := ioutil.TempDir("", "apiTokensTest")
+	require.NoError(t, err)
+	bolt, err := boltdb.NewStorage(dir)
+	require.NoError(t, err)
+
+	return NewTokenManager(bolt), func() {
+		bolt.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestTokenManager_CreateValidateRevoke(t *testing.T) {
+	tm, cleanup := newTestTokenManager(t)
+	defer cleanup()
+
+	tokens, err := tm.List()
+	assert.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	token, secret, err := tm.Create("dashboard", []Scope{ScopeRead}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "dashboard", token.Name)
+	assert.Contains(t, secret, APITokenPrefix+token.ID+"_")
+	assert.NotContains(t, token.Hash, secret)
+
+	validated, err := tm.Validate(secret)
+	assert.NoError(t, err)
+	assert.Equal(t, token.ID, validated.ID)
+
+	_, err = tm.Validate(secret + "0")
+	assert.Equal(t, ErrTokenNotFound, err)
+	_, err = tm.Validate("mtk_unknown_secret")
+	assert.Equal(t, ErrTokenNotFound, err)
+
+	assert.NoError(t, tm.Revoke(token.ID))
+	_, err = tm.Validate(secret)
+	assert.Equal(t, ErrTokenRevoked, err)
+	assert.Equal(t, ErrTokenNotFound, tm.Revoke("missing"))
+
+	tokens, err = tm.List()
+	assert.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.NotNil(t, tokens[0].RevokedAt)
+}
+
+func TestTokenManager_Expiry(t *testing.T) {
+	tm, cleanup := newTestTokenManager(t)
+	defer cleanup()
+
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	tm.timeNow = func() time.Time { return now }
+
+	past := now.Add(-time.Minute)
+	_, _, err := tm.Create("automation", []Scope{ScopeConnection}, &past)
+	assert.Error(t, err)
+
+	expiry := now.Add(time.Hour)
+	_, secret, err := tm.Create("automation", []Scope{ScopeConnection}, &expiry)
+	require.NoError(t, err)
+
+	_, err = tm.Validate(secret)
+	assert.NoError(t, err)
+
+	now = expiry
+	_, err = tm.Validate(secret)
+	assert.Equal(t, ErrTokenExpired, err)
+}
+
+func TestTokenManager_CreateValidatesInput(t *testing.T) {
+	tm, cleanup := newTestTokenManager(t)
+	defer cleanup()
+
+	_, _, err := tm.Create(" ", []Scope{ScopeRead}, nil)
+	assert.Error(t, err)
+	_, _, err = tm.Create("empty", nil, nil)
+	assert.Error(t, err)
+	_, _, err = tm.Create("unknown", []Scope{"root"}, nil)
+	assert.Error(t, err)
+}
+
+func TestRequiredScope(t *testing.T) {
+	for _, tc := range []struct {
+		method, path string
+		scope        Scope
+	}{
+		{http.MethodGet, "/identities", ScopeRead},
+		{http.MethodGet, "/connection/statistics", ScopeRead},
+		{http.MethodGet, "/identities/0x1/export", ScopeAdmin},
+		{http.MethodGet, "/auth/tokens", ScopeAdmin},
+		{http.MethodGet, "/mmn/api-key", ScopeAdmin},
+		{http.MethodGet, "/audit", ScopeAdmin},
+		{http.MethodGet, "/logs/stream", ScopeAdmin},
+		{http.MethodGet, "/webhooks/abc/deliveries", ScopeAdmin},
+		{http.MethodPut, "/connection", ScopeConnection},
+		{http.MethodDelete, "/connection", ScopeConnection},
+		{http.MethodPost, "/services", ScopeService},
+		{http.MethodDelete, "/services/123", ScopeService},
+		{http.MethodPost, "/transactor/stake/decrease", ScopePayments},
+		{http.MethodPut, "/identities/0x1/payout", ScopePayments},
+		{http.MethodPost, "/stop", ScopeAdmin},
+		{http.MethodPost, "/identities-import", ScopeAdmin},
+		{http.MethodPut, "/identities/0x1/unlock", ScopeAdmin},
+	} {
+		assert.Equal(t, tc.scope, RequiredScope(tc.method, tc.path), tc.method+" "+tc.path)
+	}
+}
+
+func TestRequestAuthorizer(t *testing.T) {
+	tm, cleanup := newTestTokenManager(t)
+	defer cleanup()
+	jwtAuth := NewJWTAuthenticator([]byte("secret"))
+	authorizer := NewRequestAuthorizer(jwtAuth, tm)
+
+	jwt, err := jwtAuth.CreateToken("myst")
+	require.NoError(t, err)
+	assert.NoError(t, authorizer.Authorize(jwt.Token, http.MethodPost, "/stop"))
+	assert.Equal(t, ErrUnauthorized, authorizer.Authorize("garbage", http.MethodGet, "/identities"))
+
+	_, secret, err := tm.Create("connector", []Scope{ScopeRead, ScopeConnection}, nil)
+	require.NoError(t, err)
+	assert.NoError(t, authorizer.Authorize(secret, http.MethodGet, "/identities"))
+	assert.NoError(t, authorizer.Authorize(secret, http.MethodPut, "/connection"))
+	assert.Equal(t, ErrForbidden, authorizer.Authorize(secret, http.MethodPost, "/transactor/stake/decrease"))
+	assert.Equal(t, ErrForbidden, authorizer.Authorize(secret, http.MethodPost, "/stop"))
+
+	_, admin, err := tm.Create("admin", []Scope{ScopeAdmin}, nil)
+	require.NoError(t, err)
+	assert.NoError(t, authorizer.Authorize(admin, http.MethodPost, "/stop"))
+	assert.Equal(t, ErrUnauthorized, authorizer.Authorize(APITokenPrefix+"x_y", http.MethodGet, "/identities"))
+}
+
+func TestRequestAuthorizer_Actor(t *testing.T) {
+	tm, cleanup := newTestTokenManager(t)
+	defer cleanup()
+	jwtAuth := NewJWTAuthenticator([]byte("secret"))
+	authorizer := NewRequestAuthorizer(jwtAuth, tm)
+
+	jwt, err := jwtAuth.CreateToken("myst")
+	require.NoError(t, err)
+	token, secret, err := tm.Create("connector", []Scope{ScopeRead}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "user:myst", authorizer.Actor(jwt.Token))
+	assert.Equal(t, "token:"+token.ID, authorizer.Actor(secret))
+	assert.Equal(t, "invalid-token", authorizer.Actor("garbage"))
+	assert.Equal(t, "", authorizer.Actor(""))
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package beneficiary
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/metadata"
+)
+
+// Restorer saves beneficiaries restored from identity backups.
+type Restorer struct {
+	st      storage
+	chainID int64
+}
+
+// NewRestorer returns a new beneficiary restorer for the given chain.
+func NewRestorer(chainID int64, st storage) *Restorer {
+	return &Restorer{
+		st:      st,
+		chainID: chainID,
+	}
+}
+
+// RestoreBeneficiary saves the given beneficiary without settling.
+// L1 chains keep beneficiary in the blockchain, so there is nothing to restore.
+func (r *Restorer) RestoreBeneficiary(id identity.Identity, beneficiary common.Address) error {
+	if r.chainID == metadata.DefaultNetwork.Chain1.ChainID {
+		return nil
+	}
+
+	return r.st.SetValue(storageBucket, storageKey(r.chainID, id.Address), beneficiary.Hex())
+}
@@ -20,46 +20,54 @@ package connection
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/market"
 )
 
 type consumerBalanceGetter interface {
-	GetBalance(chainID int64, id identity.Identity) *big.Int
-	ForceBalanceUpdate(chainID int64, id identity.Identity) *big.Int
+	GetHermesBalance(chainID int64, id identity.Identity, hermesID common.Address) *big.Int
+	ForceHermesBalanceUpdate(chainID int64, id identity.Identity, hermesID common.Address) *big.Int
 }
 
 type unlockChecker interface {
 	IsUnlocked(id string) bool
 }
 
+type providerBlocker interface {
+	IsIdentityBlocked(id identity.Identity) bool
+}
+
 // Validator validates pre connection conditions.
 type Validator struct {
 	consumerBalanceGetter consumerBalanceGetter
 	unlockChecker         unlockChecker
+	providerBlocker       providerBlocker
 }
 
 // NewValidator returns a new instance of connection validator.
-func NewValidator(consumerBalanceGetter consumerBalanceGetter, unlockChecker unlockChecker) *Validator {
+func NewValidator(consumerBalanceGetter consumerBalanceGetter, unlockChecker unlockChecker, providerBlocker providerBlocker) *Validator {
 	return &Validator{
 		consumerBalanceGetter: consumerBalanceGetter,
 		unlockChecker:         unlockChecker,
+		providerBlocker:       providerBlocker,
 	}
 }
 
-// validateBalance checks if consumer has enough money for given proposal.
-func (v *Validator) validateBalance(chainID int64, consumerID identity.Identity, proposal market.ServiceProposal) bool {
+// validateBalance checks if consumer has enough money in the Hermes session is paid through for given proposal.
+func (v *Validator) validateBalance(chainID int64, consumerID identity.Identity, hermesID common.Address, proposal market.ServiceProposal) bool {
 	if proposal.PaymentMethodType == "" || proposal.PaymentMethod == nil {
 		return true
 	}
 
 	proposalPrice := proposal.PaymentMethod.GetPrice()
-	balance := v.consumerBalanceGetter.GetBalance(chainID, consumerID)
+	balance := v.consumerBalanceGetter.GetHermesBalance(chainID, consumerID, hermesID)
 	if balance.Cmp(proposalPrice.Amount) >= 0 {
 		return true
 	}
 
-	balance = v.consumerBalanceGetter.ForceBalanceUpdate(chainID, consumerID)
+	balance = v.consumerBalanceGetter.ForceHermesBalanceUpdate(chainID, consumerID, hermesID)
 	return balance.Cmp(proposalPrice.Amount) >= 0
 }
 
@@ -69,12 +77,16 @@ func (v *Validator) isUnlocked(consumerID identity.Identity) bool {
 }
 
 // Validate checks whether the pre-connection conditions are fulfilled.
-func (v *Validator) Validate(chainID int64, consumerID identity.Identity, proposal market.ServiceProposal) error {
+func (v *Validator) Validate(chainID int64, consumerID identity.Identity, hermesID common.Address, proposal market.ServiceProposal) error {
 	if !v.isUnlocked(consumerID) {
 		return ErrUnlockRequired
 	}
 
-	if !v.validateBalance(chainID, consumerID, proposal) {
+	if v.providerBlocker != nil && v.providerBlocker.IsIdentityBlocked(identity.FromAddress(proposal.ProviderID)) {
+		return ErrProviderBlocked
+	}
+
+	if !v.validateBalance(chainID, consumerID, hermesID, proposal) {
 		return ErrInsufficientBalance
 	}
 
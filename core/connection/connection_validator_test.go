@@ -21,16 +21,20 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/market"
 	"github.com/mysteriumnetwork/node/money"
 	"github.com/stretchr/testify/assert"
 )
 
+var validatedHermesID = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
 func TestValidator_Validate(t *testing.T) {
 	type fields struct {
 		consumerBalanceGetter consumerBalanceGetter
 		unlockChecker         unlockChecker
+		providerBlocker       providerBlocker
 	}
 	type args struct {
 		consumerID identity.Identity
@@ -112,6 +116,26 @@ func TestValidator_Validate(t *testing.T) {
 				consumerID: identity.FromAddress("whatever"),
 			},
 		},
+		{
+			name:    "returns provider blocked",
+			wantErr: ErrProviderBlocked,
+			fields: fields{
+				unlockChecker: &mockUnlockChecker{
+					toReturn: true,
+				},
+				providerBlocker: &mockProviderBlocker{
+					blocked: activeProviderID.Address,
+				},
+			},
+			args: args{
+				chainID:    1,
+				consumerID: identity.FromAddress("whatever"),
+				proposal: market.ServiceProposal{
+					ProviderID:  activeProviderID.Address,
+					ServiceType: activeServiceType,
+				},
+			},
+		},
 		{
 			name:    "returns no error if conditions are satisfied",
 			wantErr: nil,
@@ -122,6 +146,9 @@ func TestValidator_Validate(t *testing.T) {
 				consumerBalanceGetter: &mockConsumerBalanceGetter{
 					toReturn: big.NewInt(101),
 				},
+				providerBlocker: &mockProviderBlocker{
+					blocked: "0x0000000000000000000000000000000000000001",
+				},
 			},
 			args: args{
 				chainID:    1,
@@ -145,13 +172,19 @@ func TestValidator_Validate(t *testing.T) {
 			v := &Validator{
 				consumerBalanceGetter: tt.fields.consumerBalanceGetter,
 				unlockChecker:         tt.fields.unlockChecker,
+				providerBlocker:       tt.fields.providerBlocker,
 			}
-			err := v.Validate(tt.args.chainID, tt.args.consumerID, tt.args.proposal)
+			err := v.Validate(tt.args.chainID, tt.args.consumerID, validatedHermesID, tt.args.proposal)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error(), tt.name)
 			} else {
 				assert.NoError(t, err, tt.name)
 			}
+			if getter, ok := tt.fields.consumerBalanceGetter.(*mockConsumerBalanceGetter); ok {
+				for _, hermesID := range getter.calledHermes {
+					assert.Equal(t, validatedHermesID, hermesID, "balance must be checked in the selected hermes")
+				}
+			}
 		})
 	}
 }
@@ -164,15 +197,26 @@ func (muc *mockUnlockChecker) IsUnlocked(id string) bool {
 	return muc.toReturn
 }
 
+type mockProviderBlocker struct {
+	blocked string
+}
+
+func (mpb *mockProviderBlocker) IsIdentityBlocked(id identity.Identity) bool {
+	return id.Address == mpb.blocked
+}
+
 type mockConsumerBalanceGetter struct {
-	toReturn    *big.Int
-	forceReturn *big.Int
+	toReturn     *big.Int
+	forceReturn  *big.Int
+	calledHermes []common.Address
 }
 
-func (mcbg *mockConsumerBalanceGetter) GetBalance(chainID int64, id identity.Identity) *big.Int {
+func (mcbg *mockConsumerBalanceGetter) GetHermesBalance(chainID int64, id identity.Identity, hermesID common.Address) *big.Int {
+	mcbg.calledHermes = append(mcbg.calledHermes, hermesID)
 	return mcbg.toReturn
 }
 
-func (mcbg *mockConsumerBalanceGetter) ForceBalanceUpdate(chainID int64, id identity.Identity) *big.Int {
+func (mcbg *mockConsumerBalanceGetter) ForceHermesBalanceUpdate(chainID int64, id identity.Identity, hermesID common.Address) *big.Int {
+	mcbg.calledHermes = append(mcbg.calledHermes, hermesID)
 	return mcbg.forceReturn
 }
@@ -36,8 +36,28 @@ const (
 	AppTopicConnectionStatistics = "Statistics"
 	// AppTopicConnectionSession represents the session lifetime changes
 	AppTopicConnectionSession = "Session"
+	// AppTopicConnectionQuality represents the connection quality probing topic
+	AppTopicConnectionQuality = "ConnectionQuality"
+	// AppTopicReconnectAttempt represents the automatic reconnect attempts topic
+	AppTopicReconnectAttempt = "ReconnectAttempt"
 )
 
+// AppEventReconnectAttempt is published after every automatic reconnect attempt.
+type AppEventReconnectAttempt struct {
+	// Reason describes what triggered reconnect.
+	Reason string
+	// Attempt is a sequence number of the attempt starting from 1.
+	Attempt     int
+	MaxAttempts int
+	// Backoff is the delay which was waited before the attempt.
+	Backoff  time.Duration
+	Proposal market.ServiceProposal
+	// ProviderSwitched is true if the attempt used a different proposal than the failed session.
+	ProviderSwitched bool
+	// Error is empty if the attempt succeeded.
+	Error string
+}
+
 // AppEventConnectionState is the struct we'll emit on a AppEventConnectionState topic event
 type AppEventConnectionState struct {
 	State       State
@@ -108,3 +128,9 @@ type AppEventConnectionStatistics struct {
 	Stats       Statistics
 	SessionInfo Status
 }
+
+// AppEventConnectionQuality represents a connection quality probing event
+type AppEventConnectionQuality struct {
+	Quality     Quality
+	SessionInfo Status
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package connectionstate
+
+import (
+	"time"
+)
+
+// Quality represents connection quality measured by probing targets through the tunnel.
+type Quality struct {
+	At      time.Time
+	Targets []ProbeResult
+	// Throughput is download speed in bits per second, zero if throughput was not probed.
+	Throughput uint64
+}
+
+// ProbeResult represents probing results of a single target.
+type ProbeResult struct {
+	Target  string
+	Latency time.Duration
+	Jitter  time.Duration
+	// Loss is a ratio of lost probes, from 0 to 1.
+	Loss float64
+}
+
+// MinLoss returns the lowest loss ratio of all probed targets.
+func (q Quality) MinLoss() float64 {
+	if len(q.Targets) == 0 {
+		return 0
+	}
+
+	min := q.Targets[0].Loss
+	for _, t := range q.Targets[1:] {
+		if t.Loss < min {
+			min = t.Loss
+		}
+	}
+	return min
+}
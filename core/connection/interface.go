@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/core/speedtest"
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/market"
 )
@@ -39,6 +40,11 @@ type Connection interface {
 	Statistics() (connectionstate.Statistics, error)
 }
 
+// Rebinder is implemented by connections which can move their sockets to the current local address after network changes.
+type Rebinder interface {
+	Rebind() error
+}
+
 // StateChannel is the channel we receive state change events on
 type StateChannel chan connectionstate.State
 
@@ -54,4 +60,6 @@ type Manager interface {
 	CheckChannel(context.Context) error
 	// Reconnect reconnects current session
 	Reconnect()
+	// SpeedTest measures throughput to the provider of current session over p2p channel
+	SpeedTest(context.Context) (speedtest.Result, error)
 }
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/mysteriumnetwork/node/core/ip"
 	"github.com/mysteriumnetwork/node/core/location"
 	"github.com/mysteriumnetwork/node/core/quality"
+	"github.com/mysteriumnetwork/node/core/speedtest"
 	"github.com/mysteriumnetwork/node/eventbus"
 	"github.com/mysteriumnetwork/node/firewall"
 	"github.com/mysteriumnetwork/node/identity"
@@ -63,6 +65,10 @@ var (
 	ErrInsufficientBalance = errors.New("insufficient balance")
 	// ErrUnlockRequired indicates that the consumer identity has not been unlocked yet
 	ErrUnlockRequired = errors.New("unlock required")
+	// ErrProviderBlocked indicates that the provider is blocked after repeated payment incidents
+	ErrProviderBlocked = errors.New("provider is blocked after repeated payment incidents")
+	// ErrSpendingLimitReached indicates that consumer spending limit does not allow paying for the session any more
+	ErrSpendingLimitReached = errors.New("spending limit reached")
 )
 
 // IPCheckConfig contains common params for connection ip check.
@@ -78,10 +84,32 @@ type KeepAliveConfig struct {
 	MaxSendErrCount int
 }
 
+// ProbeConfig contains connection quality probing options.
+type ProbeConfig struct {
+	// Interval between probing rounds, zero disables probing.
+	Interval time.Duration
+	// Targets are TCP addresses (host:port) probed for latency, jitter and loss.
+	Targets []string
+	Count   int
+	Timeout time.Duration
+	// ThroughputURL is downloaded to measure throughput, empty value disables throughput probing.
+	ThroughputURL   string
+	ThroughputBytes int64
+	// ReconnectLoss is a loss ratio to every target at which connection is reconnected, zero disables reconnects.
+	// Connection is reconnected only if tunnel also received no traffic since previous probing round.
+	ReconnectLoss float64
+}
+
+func (c ProbeConfig) enabled() bool {
+	return c.Interval > 0 && (len(c.Targets) > 0 || c.ThroughputURL != "")
+}
+
 // Config contains common configuration options for connection manager.
 type Config struct {
 	IPCheck   IPCheckConfig
 	KeepAlive KeepAliveConfig
+	Probe     ProbeConfig
+	Reconnect ReconnectConfig
 }
 
 // DefaultConfig returns default params.
@@ -110,7 +138,7 @@ type PaymentIssuer interface {
 }
 
 type validator interface {
-	Validate(chainID int64, consumerID identity.Identity, proposal market.ServiceProposal) error
+	Validate(chainID int64, consumerID identity.Identity, hermesID common.Address, proposal market.ServiceProposal) error
 }
 
 // TimeGetter function returns current time
@@ -130,6 +158,7 @@ type connectionManager struct {
 	statsReportInterval  time.Duration
 	validator            validator
 	p2pDialer            p2p.Dialer
+	proposalLookup       ProposalLookup
 	timeGetter           TimeGetter
 
 	// These are populated by Connect at runtime.
@@ -145,9 +174,13 @@ type connectionManager struct {
 	acknowledge            func()
 	cancel                 func()
 	channel                p2p.Channel
+	activeConnection       Connection
 
 	discoLock      sync.Mutex
 	connectOptions ConnectOptions
+
+	reconnectLock sync.Mutex
+	reconnectStop chan struct{}
 }
 
 // NewManager creates connection manager with given dependencies
@@ -161,6 +194,7 @@ func NewManager(
 	statsReportInterval time.Duration,
 	validator validator,
 	p2pDialer p2p.Dialer,
+	proposalLookup ProposalLookup,
 ) *connectionManager {
 	return &connectionManager{
 		newConnection:        connectionCreator,
@@ -175,6 +209,7 @@ func NewManager(
 		statsReportInterval:  statsReportInterval,
 		validator:            validator,
 		p2pDialer:            p2pDialer,
+		proposalLookup:       proposalLookup,
 		timeGetter:           time.Now,
 	}
 }
@@ -183,7 +218,12 @@ func (m *connectionManager) chainID() int64 {
 	return config.GetInt64(config.FlagChainID)
 }
 
-func (m *connectionManager) Connect(consumerID identity.Identity, hermesID common.Address, proposal market.ServiceProposal, params ConnectParams) (err error) {
+func (m *connectionManager) Connect(consumerID identity.Identity, hermesID common.Address, proposal market.ServiceProposal, params ConnectParams) error {
+	m.stopReconnect()
+	return m.connect(consumerID, hermesID, proposal, params)
+}
+
+func (m *connectionManager) connect(consumerID identity.Identity, hermesID common.Address, proposal market.ServiceProposal, params ConnectParams) (err error) {
 	var sessionID session.ID
 
 	tracer := trace.NewTracer("Consumer whole Connect")
@@ -203,7 +243,7 @@ func (m *connectionManager) Connect(consumerID identity.Identity, hermesID commo
 		return ErrAlreadyExists
 	}
 
-	err = m.validator.Validate(m.chainID(), consumerID, proposal)
+	err = m.validator.Validate(m.chainID(), consumerID, hermesID, proposal)
 	if err != nil {
 		return err
 	}
@@ -231,6 +271,7 @@ func (m *connectionManager) Connect(consumerID identity.Identity, hermesID commo
 	if err != nil {
 		return err
 	}
+	m.activeConnection = connection
 
 	paymentSession, err := m.paymentLoop(m.channel, consumerID, providerID, hermesID, proposal)
 	if err != nil {
@@ -367,7 +408,7 @@ func (m *connectionManager) paymentLoop(channel p2p.Channel, consumerID, provide
 		if err != nil {
 			log.Error().Err(err).Msg("Payment error")
 
-			if config.GetBool(config.FlagKeepConnectedOnFail) {
+			if config.GetBool(config.FlagKeepConnectedOnFail) && err != ErrSpendingLimitReached {
 				m.statusOnHold()
 			} else {
 				err = m.Disconnect()
@@ -577,6 +618,17 @@ func (m *connectionManager) startConnection(ctx context.Context, conn Connection
 		return nil
 	})
 
+	if m.config.Probe.enabled() {
+		prober := newQualityProber(m.eventBus, m.config.Probe)
+		go prober.start(m, conn, func() { go m.reconnect(ReconnectReasonDegraded) })
+		m.addCleanup(func() error {
+			log.Trace().Msg("Cleaning: stopping quality prober")
+			defer log.Trace().Msg("Cleaning: stopping quality prober DONE")
+			prober.stop()
+			return nil
+		})
+	}
+
 	go m.consumeConnectionStates(conn.State())
 
 	// Clear IP cache so session IP check can report that IP has really changed.
@@ -660,10 +712,26 @@ func (m *connectionManager) statusOnHold() {
 
 func (m *connectionManager) Cancel() {
 	m.statusCanceled()
-	logDisconnectError(m.Disconnect())
+	logDisconnectError(m.disconnectSession())
 }
 
 func (m *connectionManager) Disconnect() error {
+	m.stopReconnect()
+	return m.disconnectSession()
+}
+
+// HandleIdentityLock disconnects the session of the locked consumer identity, as it can not pay for it anymore.
+func (m *connectionManager) HandleIdentityLock(e identity.AppEventIdentityLock) {
+	status := m.Status()
+	if status.State == connectionstate.NotConnected || !strings.EqualFold(status.ConsumerID.Address, e.ID.Address) {
+		return
+	}
+
+	log.Info().Msgf("Disconnecting, consumer identity %s was locked", e.ID.Address)
+	logDisconnectError(m.Disconnect())
+}
+
+func (m *connectionManager) disconnectSession() error {
 	if m.Status().State == connectionstate.NotConnected {
 		return ErrNoConnection
 	}
@@ -681,6 +749,23 @@ func (m *connectionManager) CheckChannel(ctx context.Context) error {
 	return nil
 }
 
+func (m *connectionManager) SpeedTest(ctx context.Context) (speedtest.Result, error) {
+	status := m.Status()
+	if status.State != connectionstate.Connected {
+		return speedtest.Result{}, ErrNoConnection
+	}
+
+	result, err := speedtest.Run(ctx, m.channel, string(status.SessionID), speedtest.DefaultConfig())
+	if err != nil {
+		return result, fmt.Errorf("speed test failed: %w", err)
+	}
+	result.ProviderID = status.Proposal.ProviderID
+	result.ServiceType = status.Proposal.ServiceType
+
+	m.eventBus.Publish(speedtest.AppTopicSpeedTest, speedtest.AppEventSpeedTest{Result: result})
+	return result, nil
+}
+
 func (m *connectionManager) disconnect() {
 	m.discoLock.Lock()
 	defer m.discoLock.Unlock()
@@ -741,6 +826,7 @@ func (m *connectionManager) onStateChanged(state connectionstate.State) {
 		m.statusConnected()
 	case connectionstate.Reconnecting:
 		m.statusReconnecting()
+		go m.watchTunnelReconnecting()
 	}
 }
 
@@ -801,6 +887,12 @@ func (m *connectionManager) keepAliveLoop(channel p2p.Channel, sessionID session
 				log.Err(err).Msgf("Failed to send p2p keepalive ping. SessionID=%s", sessionID)
 				errCount++
 				if errCount == m.config.KeepAlive.MaxSendErrCount {
+					if m.config.Reconnect.enabled() {
+						log.Error().Msgf("Max p2p keepalive err count reached, reconnecting. SessionID=%s", sessionID)
+						go m.reconnect(ReconnectReasonKeepAlive)
+						cancel()
+						return
+					}
 					log.Error().Msgf("Max p2p keepalive err count reached, disconnecting. SessionID=%s", sessionID)
 					if config.GetBool(config.FlagKeepConnectedOnFail) {
 						m.statusOnHold()
@@ -814,6 +906,10 @@ func (m *connectionManager) keepAliveLoop(channel p2p.Channel, sessionID session
 				errCount = 0
 			}
 			cancel()
+			m.eventBus.Publish(p2p.AppTopicChannelStats, p2p.AppEventChannelStats{
+				SessionID: string(sessionID),
+				Stats:     channel.Stats(),
+			})
 		}
 	}
 }
@@ -845,19 +941,7 @@ func (m *connectionManager) currentCtx() context.Context {
 }
 
 func (m *connectionManager) Reconnect() {
-	err := m.Disconnect()
-	if err != nil {
-		log.Error().Err(err).Msgf("Failed to disconnect stale session")
-	}
-	log.Info().Msg("Waiting for previous session to cleanup")
-
-	m.cleanupFinishedLock.Lock()
-	defer m.cleanupFinishedLock.Unlock()
-	<-m.cleanupFinished
-	err = m.Connect(m.connectOptions.ConsumerID, m.connectOptions.HermesID, m.connectOptions.Proposal, m.connectOptions.Params)
-	if err != nil {
-		log.Error().Err(err).Msgf("Failed to reconnect")
-	}
+	m.reconnect(ReconnectReasonRequested)
 }
 
 func logDisconnectError(err error) {
@@ -30,6 +30,7 @@ import (
 	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
 	"github.com/mysteriumnetwork/node/core/location"
 	"github.com/mysteriumnetwork/node/core/location/locationstate"
+	"github.com/mysteriumnetwork/node/core/netmon"
 	"github.com/mysteriumnetwork/node/trace"
 
 	"github.com/stretchr/testify/assert"
@@ -152,6 +153,7 @@ func (tc *testContext) SetupTest() {
 		tc.statsReportInterval,
 		&mockValidator{},
 		tc.mockP2P,
+		nil,
 	)
 	tc.connManager.timeGetter = func() time.Time {
 		return tc.mockTime
@@ -241,6 +243,64 @@ func (tc *testContext) TestSessionDoesFullReconnectOnWakeupEvent() {
 	assert.Equal(tc.T(), <-stateCh, connectionstate.Connected)
 }
 
+func (tc *testContext) TestSessionRebindsSocketsOnNetworkChange() {
+	err := tc.connManager.Connect(consumerID, hermesID, activeProposal, ConnectParams{})
+	assert.NoError(tc.T(), err)
+	conn := tc.connManager.activeConnection.(*connectionMock)
+	tc.mockP2P.ch.lock.Lock()
+	tc.mockP2P.ch.keepAlive = true
+	tc.mockP2P.ch.lock.Unlock()
+
+	tc.connManager.HandleNetworkChange(netmon.AppEventNetworkChange{Changes: []netmon.Change{netmon.ChangeAddress}})
+
+	assert.Equal(tc.T(), 1, tc.mockP2P.ch.getRebinds())
+	assert.Equal(tc.T(), 1, conn.getRebinds())
+	assert.Equal(tc.T(), connectionstate.Connected, tc.connManager.Status().State)
+}
+
+func (tc *testContext) TestReconnectSupervisorRetriesWithBackoffAndSwitchesProvider() {
+	tc.connManager.eventBus = eventbus.New()
+	tc.connManager.config.Reconnect = ReconnectConfig{
+		MaxAttempts:         3,
+		Backoff:             time.Millisecond,
+		MaxBackoff:          time.Second,
+		SwitchProviderAfter: 1,
+	}
+	alternativeProposal := activeProposal
+	alternativeProposal.ProviderID = "fake-node-2"
+	tc.connManager.proposalLookup = func(proposal market.ServiceProposal) ([]market.ServiceProposal, error) {
+		return []market.ServiceProposal{activeProposal, alternativeProposal}, nil
+	}
+
+	err := tc.connManager.Connect(consumerID, hermesID, activeProposal, ConnectParams{})
+	assert.NoError(tc.T(), err)
+
+	attempts := make(chan connectionstate.AppEventReconnectAttempt, 3)
+	tc.connManager.eventBus.Subscribe(connectionstate.AppTopicReconnectAttempt, func(e connectionstate.AppEventReconnectAttempt) {
+		attempts <- e
+	})
+
+	tc.fakeConnectionFactory.mockError = errors.New("fatal connection error")
+	tc.connManager.Reconnect()
+
+	first, second, third := <-attempts, <-attempts, <-attempts
+	assert.Equal(tc.T(), 1, first.Attempt)
+	assert.Equal(tc.T(), ReconnectReasonRequested, first.Reason)
+	assert.Equal(tc.T(), activeProposal.ProviderID, first.Proposal.ProviderID)
+	assert.False(tc.T(), first.ProviderSwitched)
+	assert.Equal(tc.T(), "fatal connection error", first.Error)
+
+	assert.Equal(tc.T(), 2, second.Attempt)
+	assert.Equal(tc.T(), time.Millisecond, second.Backoff)
+	assert.Equal(tc.T(), alternativeProposal.ProviderID, second.Proposal.ProviderID)
+	assert.True(tc.T(), second.ProviderSwitched)
+
+	assert.Equal(tc.T(), 3, third.Attempt)
+	assert.Equal(tc.T(), 2*time.Millisecond, third.Backoff)
+	assert.Equal(tc.T(), alternativeProposal.ProviderID, third.Proposal.ProviderID)
+	assert.Equal(tc.T(), connectionstate.NotConnected, tc.connManager.Status().State)
+}
+
 func (tc *testContext) TestStatusReportsConnectingWhenConnectionIsInProgress() {
 	tc.fakeConnectionFactory.mockConnection.onStartReportStates = []fakeState{}
 
@@ -354,6 +414,17 @@ func (tc *testContext) TestDoubleDisconnectResultsInError() {
 	assert.Equal(tc.T(), ErrNoConnection, tc.connManager.Disconnect())
 }
 
+func (tc *testContext) TestLockingConsumerIdentityDisconnects() {
+	assert.NoError(tc.T(), tc.connManager.Connect(consumerID, hermesID, activeProposal, ConnectParams{}))
+
+	tc.connManager.HandleIdentityLock(identity.AppEventIdentityLock{ID: identity.FromAddress("0x2")})
+	assert.Equal(tc.T(), connectionstate.Connected, tc.connManager.Status().State)
+
+	tc.connManager.HandleIdentityLock(identity.AppEventIdentityLock{ID: consumerID})
+	waitABit()
+	assert.Equal(tc.T(), connectionstate.NotConnected, tc.connManager.Status().State)
+}
+
 func (tc *testContext) TestTwoConnectDisconnectCyclesReturnNoError() {
 	assert.NoError(tc.T(), tc.connManager.Connect(consumerID, hermesID, activeProposal, ConnectParams{}))
 	assert.Equal(tc.T(), connectionstate.Connected, tc.connManager.Status().State)
@@ -686,8 +757,10 @@ func (m mockP2PDialer) Dial(ctx context.Context, consumerID identity.Identity, p
 }
 
 type mockP2PChannel struct {
-	status proto.Message
-	lock   sync.Mutex
+	status    proto.Message
+	rebinds   int
+	keepAlive bool
+	lock      sync.Mutex
 }
 
 func (m *mockP2PChannel) Conn() *net.UDPConn {
@@ -716,6 +789,12 @@ func (m *mockP2PChannel) Send(_ context.Context, topic string, msg *p2p.Message)
 		return nil, nil
 	case p2p.TopicSessionAcknowledge:
 		return nil, nil
+	case p2p.TopicKeepAlive:
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if m.keepAlive {
+			return nil, nil
+		}
 	}
 
 	return nil, errors.New("unexpected error")
@@ -734,6 +813,23 @@ func (m *mockP2PChannel) ServiceConn() *net.UDPConn {
 	return conn
 }
 
+func (m *mockP2PChannel) Rebind() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.rebinds++
+	return nil
+}
+
+func (m *mockP2PChannel) getRebinds() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.rebinds
+}
+
+func (m *mockP2PChannel) Stats() p2p.ChannelStats {
+	return p2p.ChannelStats{}
+}
+
 func (m *mockP2PChannel) Close() error {
 	return nil
 }
@@ -742,7 +838,7 @@ type mockValidator struct {
 	errorToReturn error
 }
 
-func (mv *mockValidator) Validate(chainID int64, consumerID identity.Identity, proposal market.ServiceProposal) error {
+func (mv *mockValidator) Validate(chainID int64, consumerID identity.Identity, hermesID common.Address, proposal market.ServiceProposal) error {
 	return mv.errorToReturn
 }
 
@@ -0,0 +1,69 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package connection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/core/netmon"
+)
+
+// HandleNetworkChange moves active session to the current local address after local network changes.
+// P2P channel and tunnel sockets are rebound, then the channel is verified with keep alive ping,
+// which also lets provider learn the new consumer address.
+// When rebinding or keep alive ping fails session is reconnected from scratch.
+func (m *connectionManager) HandleNetworkChange(e netmon.AppEventNetworkChange) {
+	if m.Status().State != connectionstate.Connected {
+		return
+	}
+
+	log.Info().Msgf("Network changed (%v), rebinding connection", e.Changes)
+	m.clearIPCache()
+
+	if err := m.rebind(); err != nil {
+		log.Warn().Err(err).Msg("Could not rebind connection after network change, reconnecting")
+		go m.reconnect(ReconnectReasonNetwork)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(m.currentCtx(), m.config.KeepAlive.SendTimeout)
+	defer cancel()
+	if err := m.CheckChannel(ctx); err != nil {
+		log.Warn().Err(err).Msg("Connection did not survive network change, reconnecting")
+		go m.reconnect(ReconnectReasonNetwork)
+		return
+	}
+
+	log.Info().Msg("Connection survived network change")
+}
+
+func (m *connectionManager) rebind() error {
+	if err := m.channel.Rebind(); err != nil {
+		return fmt.Errorf("could not rebind p2p channel: %w", err)
+	}
+	if conn, ok := m.activeConnection.(Rebinder); ok {
+		if err := conn.Rebind(); err != nil {
+			return fmt.Errorf("could not rebind connection: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/eventbus"
+)
+
+type qualityProber struct {
+	once   sync.Once
+	done   chan struct{}
+	bus    eventbus.Publisher
+	config ProbeConfig
+	dialer *net.Dialer
+	client *http.Client
+}
+
+func newQualityProber(bus eventbus.Publisher, config ProbeConfig) *qualityProber {
+	return &qualityProber{
+		done:   make(chan struct{}),
+		bus:    bus,
+		config: config,
+		dialer: &net.Dialer{Timeout: config.Timeout},
+		client: &http.Client{},
+	}
+}
+
+// start probes connection quality periodically and publishes results. If loss to every target
+// reaches configured ratio and tunnel received no traffic since previous round, onDegraded is called
+// and probing is stopped. Probe loss alone may be caused by probe targets, so it doesn't trigger reconnects.
+func (p *qualityProber) start(sessionSupplier *connectionManager, statsSupplier statsSupplier, onDegraded func()) {
+	lastReceived, known := p.bytesReceived(statsSupplier)
+	for {
+		select {
+		case <-time.After(p.config.Interval):
+			quality := p.probe()
+			p.bus.Publish(connectionstate.AppTopicConnectionQuality, connectionstate.AppEventConnectionQuality{
+				Quality:     quality,
+				SessionInfo: sessionSupplier.Status(),
+			})
+
+			received, ok := p.bytesReceived(statsSupplier)
+			stalled := known && ok && received == lastReceived
+			lastReceived, known = received, ok
+
+			if p.config.ReconnectLoss > 0 && len(quality.Targets) > 0 && quality.MinLoss() >= p.config.ReconnectLoss {
+				if !stalled {
+					log.Info().Msgf("Connection quality probe loss %.2f reached reconnect threshold, but tunnel still receives traffic", quality.MinLoss())
+					continue
+				}
+				log.Warn().Msgf("Connection quality probe loss %.2f reached reconnect threshold and tunnel received no traffic", quality.MinLoss())
+				onDegraded()
+				return
+			}
+		case <-p.done:
+			log.Info().Msg("Stopped probing connection quality")
+			return
+		}
+	}
+}
+
+func (p *qualityProber) bytesReceived(statsSupplier statsSupplier) (uint64, bool) {
+	stats, err := statsSupplier.Statistics()
+	if err != nil {
+		log.Debug().Err(err).Msg("Could not get connection statistics for quality probe")
+		return 0, false
+	}
+	return stats.BytesReceived, true
+}
+
+func (p *qualityProber) stop() {
+	p.once.Do(func() { close(p.done) })
+}
+
+func (p *qualityProber) probe() connectionstate.Quality {
+	quality := connectionstate.Quality{At: time.Now()}
+	for _, target := range p.config.Targets {
+		quality.Targets = append(quality.Targets, p.probeTarget(target))
+	}
+
+	if p.config.ThroughputURL != "" {
+		throughput, err := p.probeThroughput()
+		if err != nil {
+			log.Warn().Err(err).Msg("Could not probe connection throughput")
+		}
+		quality.Throughput = throughput
+	}
+
+	return quality
+}
+
+// probeTarget measures TCP connect time to the target. Jitter is the mean difference between consecutive samples.
+func (p *qualityProber) probeTarget(target string) connectionstate.ProbeResult {
+	res := connectionstate.ProbeResult{Target: target}
+	if p.config.Count <= 0 {
+		return res
+	}
+
+	var samples []time.Duration
+	for i := 0; i < p.config.Count; i++ {
+		rtt, err := p.dial(target)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Connection quality probe to %s failed", target)
+			continue
+		}
+		samples = append(samples, rtt)
+	}
+
+	res.Loss = float64(p.config.Count-len(samples)) / float64(p.config.Count)
+	if len(samples) == 0 {
+		return res
+	}
+
+	var sum, jitterSum time.Duration
+	for i, s := range samples {
+		sum += s
+		if i > 0 {
+			jitterSum += absDuration(s - samples[i-1])
+		}
+	}
+	res.Latency = sum / time.Duration(len(samples))
+	if len(samples) > 1 {
+		res.Jitter = jitterSum / time.Duration(len(samples)-1)
+	}
+	return res
+}
+
+func (p *qualityProber) dial(target string) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := p.dialer.DialContext(ctx, "tcp", target)
+	if err != nil {
+		return 0, err
+	}
+	rtt := time.Since(start)
+	conn.Close()
+	return rtt, nil
+}
+
+// probeThroughput downloads up to configured amount of bytes and returns download speed in bits per second.
+func (p *qualityProber) probeThroughput() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.Interval)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.ThroughputURL, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	res, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected throughput probe response status: %d", res.StatusCode)
+	}
+
+	n, err := io.Copy(ioutil.Discard, io.LimitReader(res.Body, p.config.ThroughputBytes))
+	if err != nil {
+		return 0, err
+	}
+
+	elapsed := time.Since(start)
+	if elapsed <= 0 {
+		return 0, nil
+	}
+	return uint64(float64(n*8) / elapsed.Seconds()), nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
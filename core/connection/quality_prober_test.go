@@ -0,0 +1,160 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package connection
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/mocks"
+)
+
+func TestQualityProber_Probe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(make([]byte, 2048))
+	}))
+	defer server.Close()
+
+	prober := newQualityProber(mocks.NewEventBus(), ProbeConfig{
+		Interval:        time.Second,
+		Targets:         []string{listener.Addr().String(), closedAddr},
+		Count:           3,
+		Timeout:         time.Second,
+		ThroughputURL:   server.URL,
+		ThroughputBytes: 1024,
+	})
+
+	quality := prober.probe()
+	require.Len(t, quality.Targets, 2)
+
+	assert.Equal(t, listener.Addr().String(), quality.Targets[0].Target)
+	assert.Equal(t, 0.0, quality.Targets[0].Loss)
+	assert.NotZero(t, quality.Targets[0].Latency)
+
+	assert.Equal(t, closedAddr, quality.Targets[1].Target)
+	assert.Equal(t, 1.0, quality.Targets[1].Loss)
+	assert.Zero(t, quality.Targets[1].Latency)
+
+	assert.Equal(t, 0.0, quality.MinLoss())
+	assert.NotZero(t, quality.Throughput)
+	assert.False(t, quality.At.IsZero())
+}
+
+func TestQualityProber_CallsOnDegraded(t *testing.T) {
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	prober := newQualityProber(mocks.NewEventBus(), ProbeConfig{
+		Interval:      time.Millisecond,
+		Targets:       []string{closedAddr},
+		Count:         1,
+		Timeout:       time.Second,
+		ReconnectLoss: 0.5,
+	})
+	defer prober.stop()
+
+	degraded := make(chan struct{})
+	go prober.start(&connectionManager{}, &probeStatsSupplier{}, func() { close(degraded) })
+
+	select {
+	case <-degraded:
+	case <-time.After(time.Second):
+		t.Fatal("expected degraded callback to be called")
+	}
+}
+
+func TestQualityProber_DoesNotReconnectOnProbeLossAlone(t *testing.T) {
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	bus := mocks.NewEventBus()
+	prober := newQualityProber(bus, ProbeConfig{
+		Interval:      time.Millisecond,
+		Targets:       []string{closedAddr},
+		Count:         1,
+		Timeout:       time.Second,
+		ReconnectLoss: 0.5,
+	})
+	defer prober.stop()
+
+	degraded := make(chan struct{})
+	go prober.start(&connectionManager{}, &probeStatsSupplier{growing: true}, func() { close(degraded) })
+
+	assert.Eventually(t, func() bool {
+		return len(bus.GetEventHistory()) >= 3
+	}, time.Second, 5*time.Millisecond)
+	select {
+	case <-degraded:
+		t.Fatal("expected connection not to be reconnected while tunnel receives traffic")
+	default:
+	}
+}
+
+func TestProbeConfig_Enabled(t *testing.T) {
+	assert.False(t, ProbeConfig{}.enabled())
+	assert.False(t, ProbeConfig{Interval: time.Minute}.enabled())
+	assert.False(t, ProbeConfig{Targets: []string{"127.0.0.1:443"}}.enabled())
+	assert.True(t, ProbeConfig{Interval: time.Minute, Targets: []string{"127.0.0.1:443"}}.enabled())
+	assert.True(t, ProbeConfig{Interval: time.Minute, ThroughputURL: "http://127.0.0.1/file"}.enabled())
+}
+
+type probeStatsSupplier struct {
+	mu       sync.Mutex
+	growing  bool
+	received uint64
+}
+
+func (s *probeStatsSupplier) Statistics() (connectionstate.Statistics, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.growing {
+		s.received += 1024
+	}
+	return connectionstate.Statistics{BytesReceived: s.received}, nil
+}
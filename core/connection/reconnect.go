@@ -0,0 +1,231 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package connection
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/market"
+)
+
+// Reasons which trigger reconnect.
+const (
+	ReconnectReasonRequested = "requested"
+	ReconnectReasonKeepAlive = "keepalive-failed"
+	ReconnectReasonTunnel    = "tunnel-reconnecting"
+	ReconnectReasonDegraded  = "quality-degraded"
+	ReconnectReasonNetwork   = "network-changed"
+)
+
+// ReconnectConfig describes automatic reconnect policy.
+type ReconnectConfig struct {
+	// MaxAttempts is the max number of reconnect attempts, zero disables supervisor and reconnects once.
+	MaxAttempts int
+	// Backoff is the delay before second attempt, it is doubled after every failed attempt.
+	Backoff time.Duration
+	// MaxBackoff limits the delay between attempts.
+	MaxBackoff time.Duration
+	// SwitchProviderAfter is the number of failed attempts after which alternative proposal is used, zero disables switching.
+	SwitchProviderAfter int
+	// TunnelTimeout is how long tunnel may stay reconnecting before session is reconnected, zero disables.
+	TunnelTimeout time.Duration
+}
+
+// ProposalLookup returns proposals which may replace the given one.
+type ProposalLookup func(proposal market.ServiceProposal) ([]market.ServiceProposal, error)
+
+func (c ReconnectConfig) enabled() bool {
+	return c.MaxAttempts > 0
+}
+
+// reconnect reconnects current session, retrying with backoff and switching providers according to reconnect policy.
+func (m *connectionManager) reconnect(reason string) {
+	if !m.config.Reconnect.enabled() {
+		m.reconnectOnce(m.connectOptions.Proposal)
+		return
+	}
+
+	stop, ok := m.startReconnect()
+	if !ok {
+		log.Debug().Msgf("Reconnect (%s) is already in progress", reason)
+		return
+	}
+	defer m.finishReconnect(stop)
+
+	cfg := m.config.Reconnect
+	original := m.connectOptions.Proposal
+	proposal := original
+	tried := map[string]bool{proposalKey(proposal): true}
+	failures := 0
+	backoff := time.Duration(0)
+
+	for attempt := 1; attempt <= cfg.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			backoff = nextBackoff(backoff, cfg)
+			select {
+			case <-time.After(backoff):
+			case <-stop:
+				log.Info().Msg("Reconnect aborted")
+				return
+			}
+		}
+
+		if cfg.SwitchProviderAfter > 0 && failures >= cfg.SwitchProviderAfter {
+			if alt, ok := m.alternativeProposal(proposal, tried); ok {
+				log.Info().Msgf("Switching to alternative provider %s after %d failed reconnect attempts", alt.ProviderID, failures)
+				proposal = alt
+				tried[proposalKey(alt)] = true
+				failures = 0
+			}
+		}
+
+		log.Info().Msgf("Reconnecting (%s), attempt %d/%d to provider %s", reason, attempt, cfg.MaxAttempts, proposal.ProviderID)
+		err := m.reconnectOnce(proposal)
+
+		evt := connectionstate.AppEventReconnectAttempt{
+			Reason:           reason,
+			Attempt:          attempt,
+			MaxAttempts:      cfg.MaxAttempts,
+			Backoff:          backoff,
+			Proposal:         proposal,
+			ProviderSwitched: proposalKey(proposal) != proposalKey(original),
+		}
+		if err != nil {
+			evt.Error = err.Error()
+		}
+		m.eventBus.Publish(connectionstate.AppTopicReconnectAttempt, evt)
+
+		if err == nil {
+			return
+		}
+		if err == ErrAlreadyExists || err == ErrConnectionCancelled {
+			log.Info().Err(err).Msg("Reconnect superseded, stopping")
+			return
+		}
+		failures++
+	}
+
+	log.Error().Msgf("Giving up reconnecting after %d attempts", cfg.MaxAttempts)
+}
+
+// reconnectOnce tears down current session and connects to the given proposal with the same options.
+func (m *connectionManager) reconnectOnce(proposal market.ServiceProposal) error {
+	opts := m.connectOptions
+
+	logDisconnectError(m.disconnectSession())
+	log.Info().Msg("Waiting for previous session to cleanup")
+
+	m.cleanupFinishedLock.Lock()
+	<-m.cleanupFinished
+	m.cleanupFinishedLock.Unlock()
+
+	err := m.connect(opts.ConsumerID, opts.HermesID, proposal, opts.Params)
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to reconnect")
+	}
+	return err
+}
+
+func (m *connectionManager) startReconnect() (chan struct{}, bool) {
+	m.reconnectLock.Lock()
+	defer m.reconnectLock.Unlock()
+
+	if m.reconnectStop != nil {
+		return nil, false
+	}
+	m.reconnectStop = make(chan struct{})
+	return m.reconnectStop, true
+}
+
+func (m *connectionManager) finishReconnect(stop chan struct{}) {
+	m.reconnectLock.Lock()
+	defer m.reconnectLock.Unlock()
+
+	if m.reconnectStop == stop {
+		m.reconnectStop = nil
+	}
+}
+
+// stopReconnect aborts reconnect in progress, it is called when user takes over connection.
+func (m *connectionManager) stopReconnect() {
+	m.reconnectLock.Lock()
+	defer m.reconnectLock.Unlock()
+
+	if m.reconnectStop != nil {
+		close(m.reconnectStop)
+		m.reconnectStop = nil
+	}
+}
+
+// watchTunnelReconnecting reconnects the session if tunnel does not recover in time.
+func (m *connectionManager) watchTunnelReconnecting() {
+	timeout := m.config.Reconnect.TunnelTimeout
+	if !m.config.Reconnect.enabled() || timeout <= 0 {
+		return
+	}
+
+	sessionID := m.Status().SessionID
+	select {
+	case <-time.After(timeout):
+	case <-m.currentCtx().Done():
+		return
+	}
+
+	status := m.Status()
+	if status.SessionID == sessionID && status.State == connectionstate.Reconnecting {
+		log.Warn().Msgf("Tunnel did not recover in %s, reconnecting session", timeout)
+		m.reconnect(ReconnectReasonTunnel)
+	}
+}
+
+func (m *connectionManager) alternativeProposal(current market.ServiceProposal, tried map[string]bool) (market.ServiceProposal, bool) {
+	if m.proposalLookup == nil {
+		return market.ServiceProposal{}, false
+	}
+
+	proposals, err := m.proposalLookup(current)
+	if err != nil {
+		log.Warn().Err(err).Msg("Could not find alternative proposals")
+		return market.ServiceProposal{}, false
+	}
+
+	for _, p := range proposals {
+		if !tried[proposalKey(p)] {
+			return p, true
+		}
+	}
+	return market.ServiceProposal{}, false
+}
+
+func nextBackoff(prev time.Duration, cfg ReconnectConfig) time.Duration {
+	next := prev * 2
+	if prev == 0 {
+		next = cfg.Backoff
+	}
+	if cfg.MaxBackoff > 0 && next > cfg.MaxBackoff {
+		next = cfg.MaxBackoff
+	}
+	return next
+}
+
+func proposalKey(p market.ServiceProposal) string {
+	return p.ProviderID + p.ServiceType
+}
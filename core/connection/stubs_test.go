@@ -93,6 +93,7 @@ type connectionMock struct {
 	onStartReportStats  connectionstate.Statistics
 	fakeProcess         sync.WaitGroup
 	stopBlock           chan struct{}
+	rebinds             int
 	sync.RWMutex
 }
 
@@ -108,6 +109,19 @@ func (foc *connectionMock) GetConfig() (ConsumerConfig, error) {
 	return nil, nil
 }
 
+func (foc *connectionMock) Rebind() error {
+	foc.Lock()
+	defer foc.Unlock()
+	foc.rebinds++
+	return nil
+}
+
+func (foc *connectionMock) getRebinds() int {
+	foc.RLock()
+	defer foc.RUnlock()
+	return foc.rebinds
+}
+
 func (foc *connectionMock) Start(ctx context.Context, connectionParams ConnectOptions) error {
 	foc.RLock()
 	defer foc.RUnlock()
@@ -104,6 +104,30 @@ func (d *Discovery) Start(ownIdentity identity.Identity, proposal market.Service
 	go d.mainDiscoveryLoop()
 }
 
+// UpdateProposal replaces announced proposal and registers it again if it was already registered
+func (d *Discovery) UpdateProposal(proposal market.ServiceProposal) {
+	d.mu.Lock()
+	d.proposal = proposal
+	registered := d.status == PingProposal
+	d.mu.Unlock()
+
+	if !registered {
+		return
+	}
+
+	if err := d.proposalRegistry.RegisterProposal(proposal, d.signer); err != nil {
+		log.Error().Err(err).Msg("Failed to register updated proposal")
+		return
+	}
+	d.eventBus.Publish(AppTopicProposalAnnounce, proposal)
+}
+
+func (d *Discovery) currentProposal() market.ServiceProposal {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.proposal
+}
+
 // Wait wait for proposal announcements to stop / unregister
 func (d *Discovery) Wait() {
 	d.proposalAnnouncementStopped.Wait()
@@ -183,14 +207,15 @@ func (d *Discovery) registerIdentity() {
 }
 
 func (d *Discovery) registerProposal() {
-	err := d.proposalRegistry.RegisterProposal(d.proposal, d.signer)
+	proposal := d.currentProposal()
+	err := d.proposalRegistry.RegisterProposal(proposal, d.signer)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to register proposal, retrying after 1 min")
 		time.Sleep(1 * time.Minute)
 		d.changeStatus(RegisterProposal)
 		return
 	}
-	d.eventBus.Publish(AppTopicProposalAnnounce, d.proposal)
+	d.eventBus.Publish(AppTopicProposalAnnounce, proposal)
 	d.changeStatus(PingProposal)
 }
 
@@ -199,18 +224,19 @@ func (d *Discovery) pingProposal() {
 	case <-d.stop:
 		return
 	case <-time.After(d.proposalPingTTL):
-		err := d.proposalRegistry.PingProposal(d.proposal, d.signer)
+		proposal := d.currentProposal()
+		err := d.proposalRegistry.PingProposal(proposal, d.signer)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to ping proposal")
 		}
 
-		d.eventBus.Publish(AppTopicProposalAnnounce, d.proposal)
+		d.eventBus.Publish(AppTopicProposalAnnounce, proposal)
 		d.changeStatus(PingProposal)
 	}
 }
 
 func (d *Discovery) unregisterProposal() {
-	err := d.proposalRegistry.UnregisterProposal(d.proposal, d.signer)
+	err := d.proposalRegistry.UnregisterProposal(d.currentProposal(), d.signer)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to unregister proposal: ")
 		d.changeStatus(UnregisterProposalFailed)
@@ -0,0 +1,93 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package proposal
+
+import (
+	"math/big"
+
+	"github.com/mysteriumnetwork/node/market"
+)
+
+// AlternativeFinder finds proposals which may replace a failing one.
+type AlternativeFinder struct {
+	repository Repository
+}
+
+// NewAlternativeFinder returns a new alternative proposals finder.
+func NewAlternativeFinder(repository Repository) *AlternativeFinder {
+	return &AlternativeFinder{repository: repository}
+}
+
+// Alternatives returns proposals of other providers with the same service type and location,
+// which are not more expensive than the given one.
+func (af *AlternativeFinder) Alternatives(current market.ServiceProposal) ([]market.ServiceProposal, error) {
+	filter := &Filter{
+		ServiceType:        current.ServiceType,
+		ExcludeUnsupported: true,
+	}
+	if current.ServiceDefinition != nil {
+		location := current.ServiceDefinition.GetLocation()
+		filter.LocationCountry = location.Country
+		filter.LocationType = location.NodeType
+	}
+
+	proposals, err := af.repository.Proposals(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []market.ServiceProposal
+	for _, p := range proposals {
+		if p.ProviderID == current.ProviderID || !notMoreExpensive(p, current) {
+			continue
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func notMoreExpensive(p, current market.ServiceProposal) bool {
+	if current.PaymentMethod == nil {
+		return true
+	}
+	if p.PaymentMethod == nil {
+		return false
+	}
+
+	price, currentPrice := p.PaymentMethod.GetPrice().Amount, current.PaymentMethod.GetPrice().Amount
+	if price == nil || currentPrice == nil {
+		return true
+	}
+	rate, currentRate := p.PaymentMethod.GetRate(), current.PaymentMethod.GetRate()
+
+	// price/rate <= currentPrice/currentRate
+	return cheaperOrEqual(price, int64(rate.PerTime), currentPrice, int64(currentRate.PerTime)) &&
+		cheaperOrEqual(price, int64(rate.PerByte), currentPrice, int64(currentRate.PerByte))
+}
+
+func cheaperOrEqual(price *big.Int, rate int64, currentPrice *big.Int, currentRate int64) bool {
+	if currentRate == 0 {
+		return true
+	}
+	if rate == 0 {
+		return false
+	}
+	left := new(big.Int).Mul(price, big.NewInt(currentRate))
+	right := new(big.Int).Mul(currentPrice, big.NewInt(rate))
+	return left.Cmp(right) <= 0
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package proposal
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/market"
+	"github.com/mysteriumnetwork/node/money"
+)
+
+type mockRepository struct {
+	proposals []market.ServiceProposal
+	filter    *Filter
+}
+
+func (m *mockRepository) Proposal(id market.ProposalID) (*market.ServiceProposal, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) Proposals(filter *Filter) ([]market.ServiceProposal, error) {
+	m.filter = filter
+	return m.proposals, nil
+}
+
+func proposalWithPrice(providerID string, amount int64) market.ServiceProposal {
+	return market.ServiceProposal{
+		ProviderID:        providerID,
+		ServiceType:       serviceTypeStreaming,
+		ServiceDefinition: mockService{Location: locationDatacenter},
+		PaymentMethod: &mockPaymentMethod{
+			price: money.New(big.NewInt(amount), money.CurrencyMyst),
+			rate:  market.PaymentRate{PerTime: time.Minute, PerByte: bytesInGibibyte},
+		},
+	}
+}
+
+func TestAlternativeFinder_Alternatives(t *testing.T) {
+	current := proposalWithPrice(provider1, 100)
+	cheaper := proposalWithPrice(provider2, 50)
+	expensive := proposalWithPrice("0x3", 150)
+	repo := &mockRepository{proposals: []market.ServiceProposal{current, expensive, cheaper}}
+
+	alternatives, err := NewAlternativeFinder(repo).Alternatives(current)
+	assert.NoError(t, err)
+	assert.Equal(t, []market.ServiceProposal{cheaper}, alternatives)
+	assert.Equal(t, &Filter{
+		ServiceType:        serviceTypeStreaming,
+		LocationCountry:    locationDatacenter.Country,
+		LocationType:       locationDatacenter.NodeType,
+		ExcludeUnsupported: true,
+	}, repo.filter)
+}
@@ -39,6 +39,8 @@ type Filter struct {
 	LowerGBPriceBound   *big.Int
 	ExcludeUnsupported  bool
 	IncludeFailed       bool
+	// Condition is an additional matcher, e.g. parsed with reducer.Parse
+	Condition reducer.AndCondition
 }
 
 // Matches return flag if filter matches given proposal
@@ -73,6 +75,10 @@ func (filter *Filter) Matches(proposal market.ServiceProposal) bool {
 		conditions = append(conditions, reducer.PriceGiB(filter.LowerGBPriceBound, filter.UpperGBPriceBound))
 	}
 
+	if filter.Condition != nil {
+		conditions = append(conditions, filter.Condition)
+	}
+
 	if len(conditions) > 0 {
 		return reducer.And(conditions...)(proposal)
 	}
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mysteriumnetwork/node/core/discovery/reducer"
 	"github.com/mysteriumnetwork/node/datasize"
 	"github.com/mysteriumnetwork/node/market"
 	"github.com/mysteriumnetwork/node/money"
@@ -158,6 +159,20 @@ func Test_ProposalFilter_FiltersByProviderID(t *testing.T) {
 	assert.False(t, filter.Matches(proposalProvider2Streaming))
 }
 
+func Test_ProposalFilter_FiltersByCondition(t *testing.T) {
+	condition, err := reducer.Parse("not(eq(country, DE))")
+	assert.NoError(t, err)
+
+	filter := &Filter{
+		ServiceType: serviceTypeStreaming,
+		Condition:   condition,
+	}
+	assert.False(t, filter.Matches(proposalEmpty))
+	assert.False(t, filter.Matches(proposalProvider1Streaming))
+	assert.False(t, filter.Matches(proposalProvider1Noop))
+	assert.True(t, filter.Matches(proposalProvider2Streaming))
+}
+
 func Test_ProposalFilter_FiltersByLocationCountry(t *testing.T) {
 	filter := &Filter{
 		LocationCountry: "DE",
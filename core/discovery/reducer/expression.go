@@ -0,0 +1,245 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package reducer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mysteriumnetwork/node/market"
+)
+
+// ExpressionFields lists proposal fields which can be referenced in filter expressions.
+var ExpressionFields = map[string]FieldSelector{
+	"provider_id":   ProviderID,
+	"service_type":  ServiceType,
+	"country":       LocationCountry,
+	"location_type": LocationType,
+}
+
+// Parse builds a matcher from filter expression composed of And, Or, Not, Equal and In conditions, e.g.
+//
+//	and(eq(service_type, wireguard), or(in(country, DE, NL), not(eq(location_type, hosting))))
+//
+// Values containing spaces, commas or parentheses should be double quoted.
+func Parse(expression string) (func(market.ServiceProposal) bool, error) {
+	p := &expressionParser{tokens: tokenize(expression)}
+	condition, err := p.condition()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.peek().value, p.peek().pos)
+	}
+	return condition, nil
+}
+
+type expressionToken struct {
+	value  string
+	quoted bool
+	pos    int
+}
+
+func (t expressionToken) is(value string) bool {
+	return !t.quoted && t.value == value
+}
+
+func tokenize(expression string) []expressionToken {
+	var tokens []expressionToken
+	for i := 0; i < len(expression); {
+		switch c := expression[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == ',':
+			tokens = append(tokens, expressionToken{value: string(c), pos: i})
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(expression) && expression[end] != '"' {
+				if expression[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(expression) {
+				// Unterminated string is reported by the parser.
+				tokens = append(tokens, expressionToken{value: expression[i:], quoted: true, pos: i})
+				return tokens
+			}
+			tokens = append(tokens, expressionToken{value: expression[i : end+1], quoted: true, pos: i})
+			i = end + 1
+		default:
+			end := i
+			for end < len(expression) && !strings.ContainsRune(" \t\n\r(),\"", rune(expression[end])) {
+				end++
+			}
+			tokens = append(tokens, expressionToken{value: expression[i:end], pos: i})
+			i = end
+		}
+	}
+	return tokens
+}
+
+type expressionParser struct {
+	tokens []expressionToken
+	next   int
+}
+
+func (p *expressionParser) done() bool {
+	return p.next >= len(p.tokens)
+}
+
+func (p *expressionParser) peek() expressionToken {
+	if p.done() {
+		return expressionToken{pos: -1}
+	}
+	return p.tokens[p.next]
+}
+
+func (p *expressionParser) expect(value string) error {
+	token := p.peek()
+	if p.done() {
+		return fmt.Errorf("expected %q, got end of expression", value)
+	}
+	if !token.is(value) {
+		return fmt.Errorf("expected %q at position %d, got %q", value, token.pos, token.value)
+	}
+	p.next++
+	return nil
+}
+
+func (p *expressionParser) word() (string, error) {
+	token := p.peek()
+	if p.done() {
+		return "", fmt.Errorf("unexpected end of expression")
+	}
+	if token.is("(") || token.is(")") || token.is(",") {
+		return "", fmt.Errorf("unexpected %q at position %d", token.value, token.pos)
+	}
+	p.next++
+	if !token.quoted {
+		return token.value, nil
+	}
+	value, err := strconv.Unquote(token.value)
+	if err != nil {
+		return "", fmt.Errorf("invalid string %s at position %d", token.value, token.pos)
+	}
+	return value, nil
+}
+
+// condition parses a single function call, i.e. `name(arg, ...)`.
+func (p *expressionParser) condition() (func(market.ServiceProposal) bool, error) {
+	pos := p.peek().pos
+	name, err := p.word()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var condition func(market.ServiceProposal) bool
+	switch name {
+	case "and":
+		conditions, err := p.conditions()
+		if err != nil {
+			return nil, err
+		}
+		and := make([]AndCondition, len(conditions))
+		for i := range conditions {
+			and[i] = conditions[i]
+		}
+		condition = And(and...)
+	case "or":
+		conditions, err := p.conditions()
+		if err != nil {
+			return nil, err
+		}
+		or := make([]OrCondition, len(conditions))
+		for i := range conditions {
+			or[i] = conditions[i]
+		}
+		condition = Or(or...)
+	case "not":
+		negated, err := p.condition()
+		if err != nil {
+			return nil, err
+		}
+		condition = Not(negated)
+	case "eq", "in":
+		field, values, err := p.fieldValues()
+		if err != nil {
+			return nil, err
+		}
+		if name == "eq" && len(values) != 1 {
+			return nil, fmt.Errorf("eq at position %d expects a single value", pos)
+		}
+		condition = InString(field, values...)
+	default:
+		return nil, fmt.Errorf("unknown condition %q at position %d", name, pos)
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return condition, nil
+}
+
+// conditions parses a non-empty comma separated list of conditions.
+func (p *expressionParser) conditions() ([]func(market.ServiceProposal) bool, error) {
+	var conditions []func(market.ServiceProposal) bool
+	for {
+		condition, err := p.condition()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+		if !p.peek().is(",") {
+			return conditions, nil
+		}
+		p.next++
+	}
+}
+
+// fieldValues parses field name followed by a non-empty list of values.
+func (p *expressionParser) fieldValues() (FieldSelector, []string, error) {
+	pos := p.peek().pos
+	name, err := p.word()
+	if err != nil {
+		return nil, nil, err
+	}
+	field, ok := ExpressionFields[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown field %q at position %d", name, pos)
+	}
+
+	var values []string
+	for p.peek().is(",") {
+		p.next++
+		value, err := p.word()
+		if err != nil {
+			return nil, nil, err
+		}
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return nil, nil, fmt.Errorf("field %q at position %d expects a value", name, pos)
+	}
+	return field, values, nil
+}
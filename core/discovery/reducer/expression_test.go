@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package reducer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Parse_Equal(t *testing.T) {
+	match, err := Parse("eq(provider_id, 0x1)")
+	assert.NoError(t, err)
+
+	assert.False(t, match(proposalEmpty))
+	assert.True(t, match(proposalProvider1Streaming))
+	assert.True(t, match(proposalProvider1Noop))
+	assert.False(t, match(proposalProvider2Streaming))
+}
+
+func Test_Parse_In(t *testing.T) {
+	match, err := Parse(`in(country, LT, "DE")`)
+	assert.NoError(t, err)
+
+	assert.False(t, match(proposalEmpty))
+	assert.True(t, match(proposalProvider1Streaming))
+	assert.False(t, match(proposalProvider1Noop))
+	assert.True(t, match(proposalProvider2Streaming))
+}
+
+func Test_Parse_Combinators(t *testing.T) {
+	match, err := Parse("and(eq(service_type,streaming), or(not(eq(location_type, residential)), in(provider_id, 0x3)))")
+	assert.NoError(t, err)
+
+	assert.False(t, match(proposalEmpty))
+	assert.True(t, match(proposalProvider1Streaming))
+	assert.False(t, match(proposalProvider1Noop))
+	assert.False(t, match(proposalProvider2Streaming))
+}
+
+func Test_Parse_QuotedValues(t *testing.T) {
+	match, err := Parse(`not(in(location_type, "data center", "a \"quoted\", (value)"))`)
+	assert.NoError(t, err)
+
+	assert.True(t, match(proposalProvider1Streaming))
+}
+
+func Test_Parse_Errors(t *testing.T) {
+	tests := map[string]string{
+		"":                          "unexpected end of expression",
+		"eq(provider_id)":           `field "provider_id" at position 3 expects a value`,
+		"eq(provider_id, 0x1, 0x2)": "eq at position 0 expects a single value",
+		"eq(ip, 1.1.1.1)":           `unknown field "ip" at position 3`,
+		"xor(eq(country, DE))":      `unknown condition "xor" at position 0`,
+		"and()":                     `unexpected ")" at position 4`,
+		"not(eq(country, DE)":       `expected ")", got end of expression`,
+		"eq(country, DE) extra":     `unexpected "extra" at position 16`,
+		`eq(country, "DE)`:          `invalid string "DE) at position 12`,
+		"eq country":                `expected "(" at position 3, got "country"`,
+	}
+	for expression, expected := range tests {
+		t.Run(expression, func(t *testing.T) {
+			_, err := Parse(expression)
+			assert.EqualError(t, err, expected)
+		})
+	}
+}
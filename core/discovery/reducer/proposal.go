@@ -86,8 +86,7 @@ func pricePerTime(lowerBound, upperBound *big.Int, duration time.Duration) func(
 				return lowerBound.Cmp(big.NewInt(0)) == 0
 			}
 
-			chunks := big.NewFloat(float64(duration) / float64(rate))
-			totalPrice, _ := new(big.Float).Mul(chunks, new(big.Float).SetInt(price)).Int(nil)
+			totalPrice := normalizedPrice(price, float64(duration), float64(rate))
 			return totalPrice.Cmp(lowerBound) >= 0 && totalPrice.Cmp(upperBound) <= 0
 		}
 		return true
@@ -103,14 +102,43 @@ func pricePerDataTransfer(lowerBound, upperBound *big.Int, chunk uint64) func(ma
 				return lowerBound.Cmp(big.NewInt(0)) == 0
 			}
 
-			chunks := big.NewFloat(float64(chunk) / float64(rate))
-			totalPrice, _ := new(big.Float).Mul(chunks, new(big.Float).SetInt(price)).Int(nil)
+			totalPrice := normalizedPrice(price, float64(chunk), float64(rate))
 			return totalPrice.Cmp(lowerBound) >= 0 && totalPrice.Cmp(upperBound) <= 0
 		}
 		return true
 	}
 }
 
+// PricePerMinute returns proposal price of a minute of service, zero when service is not charged by time.
+// Nil is returned for proposals without payment method.
+func PricePerMinute(proposal market.ServiceProposal) *big.Int {
+	if proposal.PaymentMethod == nil {
+		return nil
+	}
+	rate := proposal.PaymentMethod.GetRate().PerTime
+	return normalizedPrice(proposal.PaymentMethod.GetPrice().Amount, float64(time.Minute), float64(rate))
+}
+
+// PricePerGiB returns proposal price of a GiB of traffic, zero when service is not charged by traffic.
+// Nil is returned for proposals without payment method.
+func PricePerGiB(proposal market.ServiceProposal) *big.Int {
+	if proposal.PaymentMethod == nil {
+		return nil
+	}
+	rate := proposal.PaymentMethod.GetRate().PerByte
+	return normalizedPrice(proposal.PaymentMethod.GetPrice().Amount, float64(datasize.GiB.Bytes()), float64(rate))
+}
+
+// normalizedPrice converts price charged per rate units to the price of the given amount of units.
+func normalizedPrice(price *big.Int, units, rate float64) *big.Int {
+	if price == nil || rate == 0 {
+		return big.NewInt(0)
+	}
+	chunks := big.NewFloat(units / rate)
+	totalPrice, _ := new(big.Float).Mul(chunks, new(big.Float).SetInt(price)).Int(nil)
+	return totalPrice
+}
+
 // AccessPolicy returns a matcher for checking if proposal allows given access policy
 func AccessPolicy(id, source string) func(market.ServiceProposal) bool {
 	return func(proposal market.ServiceProposal) bool {
@@ -113,3 +113,17 @@ func Test_PriceGiB_FiltersByPrice(t *testing.T) {
 	match = PriceGiB(big.NewInt(0), big.NewInt(7000000))
 	assert.True(t, match(proposalBytesCheap))
 }
+
+func Test_PricePerMinute(t *testing.T) {
+	assert.Nil(t, PricePerMinute(proposalEmpty))
+	assert.Equal(t, big.NewInt(1000000), PricePerMinute(proposalTimeExact))
+	assert.Equal(t, big.NewInt(999960), PricePerMinute(proposalTimeExactSeconds))
+	assert.Equal(t, big.NewInt(0), PricePerMinute(proposalBytesExact))
+}
+
+func Test_PricePerGiB(t *testing.T) {
+	assert.Nil(t, PricePerGiB(proposalEmpty))
+	assert.Equal(t, big.NewInt(7000000), PricePerGiB(proposalBytesExact))
+	assert.Equal(t, big.NewInt(7000000), PricePerGiB(proposalBytesExactInParts))
+	assert.Equal(t, big.NewInt(0), PricePerGiB(proposalTimeExact))
+}
@@ -0,0 +1,347 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package forecast
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/mysteriumnetwork/node/consumer/session"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+// DefaultLookback is the period of session history forecasts are based on.
+const DefaultLookback = 30 * 24 * time.Hour
+
+const (
+	day = 24 * time.Hour
+	gib = 1 << 30
+)
+
+type sessionStorage interface {
+	List(filter *session.Filter) ([]session.History, error)
+	StatsByDay(filter *session.Filter) (map[time.Time]session.Stats, error)
+}
+
+type balanceProvider interface {
+	GetBalance(chainID int64, id identity.Identity) *big.Int
+}
+
+type channelLister interface {
+	List(chainID int64) []pingpong.HermesChannel
+}
+
+type settlementPolicyGetter interface {
+	Get(id identity.Identity) (pingpong.SettlementPolicy, error)
+}
+
+// BalanceForecast describes how long consumer balance lasts at the current spending rate.
+type BalanceForecast struct {
+	Balance  *big.Int
+	Sessions int
+	// SpentPerDay is the average spending per day since the first session in lookback period.
+	SpentPerDay *big.Int
+	// SpentPerHour is the average spending per hour of connected time.
+	SpentPerHour *big.Int
+	// SpentPerGiB is the average spending per GiB of transferred data.
+	SpentPerGiB *big.Int
+	// ConnectedTimeLeft is the connected time balance is enough for.
+	ConnectedTimeLeft time.Duration
+	// DataLeft is the amount of data in bytes balance is enough for.
+	DataLeft uint64
+	// DepletedAt is the time balance runs out, nil when there was no spending.
+	DepletedAt *time.Time
+}
+
+// EarningsForecast describes projected provider earnings and the next automatic settlement.
+type EarningsForecast struct {
+	// EarnedPerDay is the average earnings per day since the first session in lookback period.
+	EarnedPerDay *big.Int
+	Weekly       *big.Int
+	Monthly      *big.Int
+	Unsettled    *big.Int
+	// NextSettlement is the earliest projected automatic settlement, nil when it can't be projected.
+	NextSettlement *Settlement
+}
+
+// Settlement describes a projected automatic settlement.
+type Settlement struct {
+	HermesID common.Address
+	Trigger  pingpong.SettlementTrigger
+	Amount   *big.Int
+	At       time.Time
+}
+
+// Forecaster forecasts consumer balance and provider earnings from session history.
+type Forecaster struct {
+	chainID   int64
+	lookback  time.Duration
+	threshold float64
+	sessions  sessionStorage
+	balances  balanceProvider
+	channels  channelLister
+	policies  settlementPolicyGetter
+	timeNow   func() time.Time
+}
+
+// NewForecaster returns a new instance of forecaster.
+func NewForecaster(
+	chainID int64,
+	lookback time.Duration,
+	threshold float64,
+	sessions sessionStorage,
+	balances balanceProvider,
+	channels channelLister,
+	policies settlementPolicyGetter,
+) *Forecaster {
+	return &Forecaster{
+		chainID:   chainID,
+		lookback:  lookback,
+		threshold: threshold,
+		sessions:  sessions,
+		balances:  balances,
+		channels:  channels,
+		policies:  policies,
+		timeNow:   time.Now,
+	}
+}
+
+// ForecastBalance forecasts depletion of consumer balance based on consumer sessions in lookback period.
+func (f *Forecaster) ForecastBalance(id identity.Identity) (BalanceForecast, error) {
+	now := f.timeNow().UTC()
+	result := BalanceForecast{
+		Balance:      f.balances.GetBalance(f.chainID, id),
+		SpentPerDay:  new(big.Int),
+		SpentPerHour: new(big.Int),
+		SpentPerGiB:  new(big.Int),
+	}
+	if result.Balance == nil {
+		result.Balance = new(big.Int)
+	}
+
+	sessions, err := f.sessions.List(session.NewFilter().
+		SetDirection(session.DirectionConsumed).
+		SetConsumerID(id).
+		SetStartedFrom(now.Add(-f.lookback)).
+		SetStartedTo(now),
+	)
+	if err != nil {
+		return result, err
+	}
+
+	spent := new(big.Int)
+	first := now
+	var connected time.Duration
+	var transferred uint64
+	for _, s := range sessions {
+		if s.Tokens != nil {
+			spent.Add(spent, s.Tokens)
+		}
+		connected += s.GetDuration()
+		transferred += s.DataSent + s.DataReceived
+		if s.Started.Before(first) {
+			first = s.Started
+		}
+	}
+	result.Sessions = len(sessions)
+	if spent.Sign() <= 0 {
+		return result, nil
+	}
+
+	span := now.Sub(first)
+	if span < day {
+		span = day
+	}
+	result.SpentPerDay = ratio(spent, day, span)
+	depletedAt := now.Add(toDuration(scale(result.Balance, big.NewInt(int64(span)), spent)))
+	result.DepletedAt = &depletedAt
+
+	if connected > 0 {
+		result.SpentPerHour = ratio(spent, time.Hour, connected)
+		result.ConnectedTimeLeft = toDuration(scale(result.Balance, big.NewInt(int64(connected)), spent))
+	}
+	if transferred > 0 {
+		data := new(big.Int).SetUint64(transferred)
+		result.SpentPerGiB = scale(spent, big.NewInt(gib), data)
+		left := scale(result.Balance, data, spent)
+		if left.IsUint64() {
+			result.DataLeft = left.Uint64()
+		} else {
+			result.DataLeft = math.MaxUint64
+		}
+	}
+	return result, nil
+}
+
+// ForecastEarnings projects provider earnings and the next automatic settlement.
+// Every channel is projected as if all further earnings went through it.
+func (f *Forecaster) ForecastEarnings(id identity.Identity) (EarningsForecast, error) {
+	now := f.timeNow().UTC()
+	result := EarningsForecast{Unsettled: new(big.Int)}
+
+	stats, err := f.sessions.StatsByDay(session.NewFilter().
+		SetDirection(session.DirectionProvided).
+		SetProviderID(id).
+		SetStartedFrom(now.Add(-f.lookback)).
+		SetStartedTo(now),
+	)
+	if err != nil {
+		return result, err
+	}
+	result.EarnedPerDay = earnedPerDay(stats)
+	result.Weekly = new(big.Int).Mul(result.EarnedPerDay, big.NewInt(7))
+	result.Monthly = new(big.Int).Mul(result.EarnedPerDay, big.NewInt(30))
+
+	policy, err := f.policies.Get(id)
+	if err != nil && !errors.Is(err, pingpong.ErrNotFound) {
+		return result, err
+	}
+
+	for _, channel := range f.channels.List(f.chainID) {
+		if !strings.EqualFold(channel.Identity.Address, id.Address) {
+			continue
+		}
+		result.Unsettled.Add(result.Unsettled, channel.UnsettledBalance())
+
+		next := f.nextSettlement(now, policy, channel, result.EarnedPerDay)
+		if next != nil && (result.NextSettlement == nil || next.At.Before(result.NextSettlement.At)) {
+			result.NextSettlement = next
+		}
+	}
+	return result, nil
+}
+
+func (f *Forecaster) nextSettlement(now time.Time, policy pingpong.SettlementPolicy, channel pingpong.HermesChannel, perDay *big.Int) *Settlement {
+	unsettled := channel.UnsettledBalance()
+	project := func(trigger pingpong.SettlementTrigger, at time.Time) *Settlement {
+		earned := ratio(perDay, at.Sub(now), day)
+		return &Settlement{
+			HermesID: channel.HermesID,
+			Trigger:  trigger,
+			Amount:   new(big.Int).Add(unsettled, earned),
+			At:       at,
+		}
+	}
+
+	var next *Settlement
+	candidate := func(s *Settlement) {
+		if s != nil && (next == nil || s.At.Before(next.At)) {
+			next = s
+		}
+	}
+
+	if at, ok := reachedAt(now, unsettled, channel.ThresholdSettlementAmount(f.threshold), perDay); ok {
+		candidate(project(pingpong.SettlementTriggerThreshold, at))
+	}
+
+	period := policy.Schedule.Period()
+	if period == 0 {
+		if policy.MinAmount != nil && policy.MinAmount.Sign() > 0 {
+			if at, ok := reachedAt(now, unsettled, policy.MinAmount, perDay); ok {
+				candidate(project(pingpong.SettlementTriggerAmount, at))
+			}
+		}
+		return next
+	}
+
+	// Scheduled settlement happens only once unsettled earnings reach policy minimum.
+	minimum := big.NewInt(1)
+	if policy.MinAmount != nil && policy.MinAmount.Sign() > 0 {
+		minimum = policy.MinAmount
+	}
+	reached, ok := reachedAt(now, unsettled, minimum, perDay)
+	if !ok {
+		return next
+	}
+	at := policy.LastSettledAt.Add(period)
+	if at.Before(now) {
+		at = now
+	}
+	if at.Before(reached) {
+		at = at.Add(time.Duration(math.Ceil(float64(reached.Sub(at))/float64(period))) * period)
+	}
+	candidate(project(pingpong.SettlementTriggerSchedule, at))
+	return next
+}
+
+// reachedAt returns the time unsettled earnings reach the target when earning given amount per day.
+func reachedAt(now time.Time, unsettled, target, perDay *big.Int) (time.Time, bool) {
+	missing := new(big.Int).Sub(target, unsettled)
+	if missing.Sign() <= 0 {
+		return now, true
+	}
+	if perDay.Sign() <= 0 {
+		return time.Time{}, false
+	}
+	in := scale(missing, big.NewInt(int64(day)), perDay)
+	if !in.IsInt64() {
+		return time.Time{}, false
+	}
+	return now.Add(time.Duration(in.Int64())), true
+}
+
+// earnedPerDay averages daily earnings since the first day with sessions.
+func earnedPerDay(stats map[time.Time]session.Stats) *big.Int {
+	days := make([]time.Time, 0, len(stats))
+	for d, s := range stats {
+		if s.Count > 0 {
+			days = append(days, d)
+		}
+	}
+	if len(days) == 0 {
+		return new(big.Int)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	total := new(big.Int)
+	count := int64(0)
+	for d, s := range stats {
+		if d.Before(days[0]) {
+			continue
+		}
+		count++
+		if s.SumTokens != nil {
+			total.Add(total, s.SumTokens)
+		}
+	}
+	return new(big.Int).Div(total, big.NewInt(count))
+}
+
+// ratio returns amount * numerator / denominator.
+func ratio(amount *big.Int, numerator, denominator time.Duration) *big.Int {
+	return scale(amount, big.NewInt(int64(numerator)), big.NewInt(int64(denominator)))
+}
+
+// scale returns amount * numerator / denominator.
+func scale(amount, numerator, denominator *big.Int) *big.Int {
+	return new(big.Int).Div(new(big.Int).Mul(amount, numerator), denominator)
+}
+
+func toDuration(nanos *big.Int) time.Duration {
+	if !nanos.IsInt64() {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(nanos.Int64())
+}
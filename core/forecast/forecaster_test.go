@@ -0,0 +1,222 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package forecast
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mysteriumnetwork/payments/client"
+	"github.com/mysteriumnetwork/payments/crypto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/consumer/session"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+var (
+	consumerID = identity.FromAddress("0x000000000000000000000000000000000000000a")
+	providerID = identity.FromAddress("0x000000000000000000000000000000000000000b")
+	hermesID   = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	now        = time.Date(2020, 11, 10, 12, 0, 0, 0, time.UTC)
+)
+
+func TestForecaster_ForecastBalance(t *testing.T) {
+	// given
+	sessions := &mockSessionStorage{
+		sessions: []session.History{
+			{Tokens: big.NewInt(40), DataSent: gib / 2, DataReceived: gib / 2, Started: now.Add(-24 * time.Hour), Updated: now.Add(-23 * time.Hour)},
+			{Tokens: big.NewInt(60), DataReceived: gib, Started: now.Add(-5 * 24 * time.Hour), Updated: now.Add(-5*24*time.Hour + time.Hour)},
+		},
+	}
+	forecaster := newForecaster(sessions, mockBalances{consumerID: big.NewInt(200)}, nil, nil)
+
+	// when
+	result, err := forecaster.ForecastBalance(consumerID)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, session.DirectionConsumed, *sessions.filter.Direction)
+	assert.Equal(t, consumerID, *sessions.filter.ConsumerID)
+	assert.Equal(t, now.Add(-DefaultLookback), *sessions.filter.StartedFrom)
+
+	assert.Equal(t, 2, result.Sessions)
+	assert.Equal(t, big.NewInt(200), result.Balance)
+	assert.Equal(t, big.NewInt(20), result.SpentPerDay)
+	assert.Equal(t, big.NewInt(50), result.SpentPerHour)
+	assert.Equal(t, big.NewInt(50), result.SpentPerGiB)
+	assert.Equal(t, 4*time.Hour, result.ConnectedTimeLeft)
+	assert.Equal(t, uint64(4*gib), result.DataLeft)
+	assert.Equal(t, now.Add(10*24*time.Hour), *result.DepletedAt)
+}
+
+func TestForecaster_ForecastBalance_WithoutSpending(t *testing.T) {
+	// given
+	forecaster := newForecaster(&mockSessionStorage{}, mockBalances{consumerID: big.NewInt(200)}, nil, nil)
+
+	// when
+	result, err := forecaster.ForecastBalance(consumerID)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(200), result.Balance)
+	assert.Equal(t, new(big.Int), result.SpentPerDay)
+	assert.Nil(t, result.DepletedAt)
+}
+
+func TestForecaster_ForecastEarnings(t *testing.T) {
+	today := now.Truncate(24 * time.Hour)
+	stats := map[time.Time]session.Stats{
+		today.Add(-3 * 24 * time.Hour): session.NewStats(),
+		today.Add(-24 * time.Hour):     {Count: 1, SumTokens: big.NewInt(10)},
+		today:                          {Count: 2, SumTokens: big.NewInt(30)},
+	}
+	channels := mockChannels{
+		pingpong.NewHermesChannel(
+			"channel",
+			providerID,
+			hermesID,
+			client.ProviderChannel{Stake: big.NewInt(100), Settled: big.NewInt(0)},
+			pingpong.HermesPromise{Promise: crypto.Promise{Amount: big.NewInt(50)}},
+		),
+		pingpong.NewHermesChannel(
+			"other",
+			consumerID,
+			hermesID,
+			client.ProviderChannel{Stake: big.NewInt(100), Settled: big.NewInt(0)},
+			pingpong.HermesPromise{Promise: crypto.Promise{Amount: big.NewInt(99)}},
+		),
+	}
+
+	tests := []struct {
+		name     string
+		policies mockPolicies
+		expected *Settlement
+	}{
+		{
+			name:     "threshold without policy",
+			policies: mockPolicies{},
+			expected: &Settlement{HermesID: hermesID, Trigger: pingpong.SettlementTriggerThreshold, Amount: big.NewInt(90), At: now.Add(48 * time.Hour)},
+		},
+		{
+			name:     "policy minimum amount",
+			policies: mockPolicies{providerID: {MinAmount: big.NewInt(60)}},
+			expected: &Settlement{HermesID: hermesID, Trigger: pingpong.SettlementTriggerAmount, Amount: big.NewInt(60), At: now.Add(12 * time.Hour)},
+		},
+		{
+			name:     "policy schedule",
+			policies: mockPolicies{providerID: {Schedule: pingpong.SettlementScheduleDaily, LastSettledAt: now.Add(-2 * time.Hour)}},
+			expected: &Settlement{HermesID: hermesID, Trigger: pingpong.SettlementTriggerSchedule, Amount: big.NewInt(68), At: now.Add(22 * time.Hour)},
+		},
+		{
+			name:     "policy schedule waits for minimum amount",
+			policies: mockPolicies{providerID: {Schedule: pingpong.SettlementScheduleDaily, MinAmount: big.NewInt(80), LastSettledAt: now.Add(-2 * time.Hour)}},
+			expected: &Settlement{HermesID: hermesID, Trigger: pingpong.SettlementTriggerSchedule, Amount: big.NewInt(88), At: now.Add(46 * time.Hour)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			sessions := &mockSessionStorage{stats: stats}
+			forecaster := newForecaster(sessions, nil, channels, tt.policies)
+
+			// when
+			result, err := forecaster.ForecastEarnings(providerID)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, session.DirectionProvided, *sessions.filter.Direction)
+			assert.Equal(t, providerID, *sessions.filter.ProviderID)
+			assert.Equal(t, big.NewInt(20), result.EarnedPerDay)
+			assert.Equal(t, big.NewInt(140), result.Weekly)
+			assert.Equal(t, big.NewInt(600), result.Monthly)
+			assert.Equal(t, big.NewInt(50), result.Unsettled)
+			assert.Equal(t, tt.expected, result.NextSettlement)
+		})
+	}
+}
+
+func TestForecaster_ForecastEarnings_WithoutEarnings(t *testing.T) {
+	// given
+	channels := mockChannels{
+		pingpong.NewHermesChannel(
+			"channel",
+			providerID,
+			hermesID,
+			client.ProviderChannel{Stake: big.NewInt(100), Settled: big.NewInt(0)},
+			pingpong.HermesPromise{Promise: crypto.Promise{Amount: big.NewInt(50)}},
+		),
+	}
+	forecaster := newForecaster(&mockSessionStorage{stats: map[time.Time]session.Stats{}}, nil, channels, mockPolicies{})
+
+	// when
+	result, err := forecaster.ForecastEarnings(providerID)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int), result.EarnedPerDay)
+	assert.Equal(t, big.NewInt(50), result.Unsettled)
+	assert.Nil(t, result.NextSettlement)
+}
+
+func newForecaster(sessions sessionStorage, balances mockBalances, channels mockChannels, policies mockPolicies) *Forecaster {
+	forecaster := NewForecaster(1, DefaultLookback, 0.1, sessions, balances, channels, policies)
+	forecaster.timeNow = func() time.Time { return now }
+	return forecaster
+}
+
+type mockSessionStorage struct {
+	sessions []session.History
+	stats    map[time.Time]session.Stats
+	filter   *session.Filter
+}
+
+func (m *mockSessionStorage) List(filter *session.Filter) ([]session.History, error) {
+	m.filter = filter
+	return m.sessions, nil
+}
+
+func (m *mockSessionStorage) StatsByDay(filter *session.Filter) (map[time.Time]session.Stats, error) {
+	m.filter = filter
+	return m.stats, nil
+}
+
+type mockBalances map[identity.Identity]*big.Int
+
+func (m mockBalances) GetBalance(_ int64, id identity.Identity) *big.Int {
+	return m[id]
+}
+
+type mockChannels []pingpong.HermesChannel
+
+func (m mockChannels) List(_ int64) []pingpong.HermesChannel {
+	return m
+}
+
+type mockPolicies map[identity.Identity]pingpong.SettlementPolicy
+
+func (m mockPolicies) Get(id identity.Identity) (pingpong.SettlementPolicy, error) {
+	policy, ok := m[id]
+	if !ok {
+		return policy, pingpong.ErrNotFound
+	}
+	return policy, nil
+}
@@ -88,6 +88,18 @@ func (c *Cache) DetectLocation() (locationstate.Location, error) {
 	return c.fetchAndSave()
 }
 
+// Refresh forces location to be fetched again, e.g. after public IP has changed.
+func (c *Cache) Refresh() (locationstate.Location, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	loc, err := c.fetchAndSave()
+	if err != nil {
+		c.lastFetched = time.Time{}
+	}
+	return loc, err
+}
+
 // HandleConnectionEvent handles connection state change and fetches the location info accordingly.
 // On the consumer side, we'll need to re-fetch the location once the user is connected or disconnected from a service.
 func (c *Cache) HandleConnectionEvent(se connectionstate.AppEventConnectionState) {
@@ -0,0 +1,104 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package netmon
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mysteriumnetwork/node/eventbus"
+)
+
+// AppTopicNetworkChange represents network change topic.
+const AppTopicNetworkChange = "network-change"
+
+// Change describes kind of network change.
+type Change string
+
+const (
+	// ChangeLink means that network interface appeared, disappeared or changed its state.
+	ChangeLink = Change("link")
+	// ChangeAddress means that address was added to or removed from network interface.
+	ChangeAddress = Change("address")
+	// ChangeRoute means that routing table changed.
+	ChangeRoute = Change("route")
+)
+
+// AppEventNetworkChange is published after burst of network changes settles.
+type AppEventNetworkChange struct {
+	Changes []Change
+	At      time.Time
+}
+
+// DefaultSettleDuration is how long monitor waits for more changes before publishing event.
+const DefaultSettleDuration = 2 * time.Second
+
+// Monitor watches OS network configuration and publishes changes.
+type Monitor struct {
+	bus      eventbus.Publisher
+	settle   time.Duration
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewMonitor creates new network change monitor.
+func NewMonitor(bus eventbus.Publisher, settle time.Duration) *Monitor {
+	return &Monitor{
+		bus:    bus,
+		settle: settle,
+		stop:   make(chan struct{}),
+	}
+}
+
+// Stop stops network change monitor.
+func (m *Monitor) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stop)
+	})
+}
+
+// publishSettled groups changes which arrive within settle duration and publishes them as a single event.
+func (m *Monitor) publishSettled(changes <-chan Change) {
+	var (
+		pending = map[Change]bool{}
+		timer   <-chan time.Time
+	)
+
+	for {
+		select {
+		case c, ok := <-changes:
+			if !ok {
+				return
+			}
+			pending[c] = true
+			timer = time.After(m.settle)
+		case <-timer:
+			evt := AppEventNetworkChange{At: time.Now()}
+			for _, c := range []Change{ChangeLink, ChangeAddress, ChangeRoute} {
+				if pending[c] {
+					evt.Changes = append(evt.Changes, c)
+				}
+			}
+			m.bus.Publish(AppTopicNetworkChange, evt)
+			pending = map[Change]bool{}
+			timer = nil
+		case <-m.stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// +build linux,!android
+
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package netmon
+
+import (
+	"net"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sys/unix"
+)
+
+const netlinkGroups = unix.RTMGRP_LINK |
+	unix.RTMGRP_IPV4_IFADDR | unix.RTMGRP_IPV6_IFADDR |
+	unix.RTMGRP_IPV4_ROUTE | unix.RTMGRP_IPV6_ROUTE
+
+// tunnelInterfacePrefixes are prefixes of interfaces created by node itself: WireGuard ("myst") and OpenVPN ("tun").
+// Their changes come from node's own connections and services, reacting to them would only disturb those.
+var tunnelInterfacePrefixes = []string{"myst", "tun"}
+
+// Start listens for netlink link, address and route notifications until monitor is stopped.
+func (m *Monitor) Start() {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_ROUTE)
+	if err != nil {
+		log.Error().Err(err).Msg("Could not open netlink socket, network changes won't be detected")
+		return
+	}
+	defer unix.Close(fd)
+
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: netlinkGroups}); err != nil {
+		log.Error().Err(err).Msg("Could not subscribe to netlink notifications, network changes won't be detected")
+		return
+	}
+
+	// Read timeout allows to notice monitor stop while waiting for notifications.
+	tv := unix.Timeval{Sec: 1}
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		log.Error().Err(err).Msg("Could not set netlink socket timeout")
+		return
+	}
+
+	log.Debug().Msg("Register for network change events")
+
+	changes := make(chan Change)
+	go m.publishSettled(changes)
+	defer close(changes)
+
+	buf := make([]byte, unix.Getpagesize())
+	for {
+		select {
+		case <-m.stop:
+			log.Debug().Msg("Unregister network change events")
+			return
+		default:
+		}
+
+		n, _, err := unix.Recvfrom(fd, buf, 0)
+		if err == unix.EAGAIN || err == unix.EWOULDBLOCK || err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to read netlink notifications, network changes won't be detected")
+			return
+		}
+
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to parse netlink notification")
+			continue
+		}
+		for _, msg := range msgs {
+			if c, ok := changeOf(msg); ok {
+				select {
+				case changes <- c:
+				case <-m.stop:
+					return
+				}
+			}
+		}
+	}
+}
+
+func changeOf(msg syscall.NetlinkMessage) (Change, bool) {
+	var change Change
+	switch msg.Header.Type {
+	case unix.RTM_NEWLINK, unix.RTM_DELLINK:
+		change = ChangeLink
+	case unix.RTM_NEWADDR, unix.RTM_DELADDR:
+		change = ChangeAddress
+	case unix.RTM_NEWROUTE, unix.RTM_DELROUTE:
+		change = ChangeRoute
+	default:
+		return "", false
+	}
+
+	if isTunnelInterface(interfaceOf(msg)) {
+		return "", false
+	}
+	return change, true
+}
+
+// interfaceOf returns name of the interface netlink message is about, or empty string when it is unknown.
+func interfaceOf(msg syscall.NetlinkMessage) string {
+	attrs, err := syscall.ParseNetlinkRouteAttr(&msg)
+	if err != nil {
+		return ""
+	}
+
+	var index uint32
+	switch msg.Header.Type {
+	case unix.RTM_NEWLINK, unix.RTM_DELLINK:
+		for _, a := range attrs {
+			if a.Attr.Type == unix.IFLA_IFNAME {
+				return strings.TrimRight(string(a.Value), "\x00")
+			}
+		}
+		return ""
+	case unix.RTM_NEWADDR, unix.RTM_DELADDR:
+		if len(msg.Data) < unix.SizeofIfAddrmsg {
+			return ""
+		}
+		index = (*unix.IfAddrmsg)(unsafe.Pointer(&msg.Data[0])).Index
+	case unix.RTM_NEWROUTE, unix.RTM_DELROUTE:
+		for _, a := range attrs {
+			if a.Attr.Type == unix.RTA_OIF && len(a.Value) >= 4 {
+				index = *(*uint32)(unsafe.Pointer(&a.Value[0]))
+			}
+		}
+	}
+	if index == 0 {
+		return ""
+	}
+
+	// Interface may be gone already, its changes are reported then.
+	iface, err := net.InterfaceByIndex(int(index))
+	if err != nil {
+		return ""
+	}
+	return iface.Name
+}
+
+func isTunnelInterface(name string) bool {
+	for _, prefix := range tunnelInterfacePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+// +build linux,!android
+
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package netmon
+
+import (
+	"net"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestChangeOf_IgnoresTunnelInterfaces(t *testing.T) {
+	loopback, err := net.InterfaceByName("lo")
+	require.NoError(t, err)
+
+	_, ok := changeOf(linkMessage("myst0"))
+	assert.False(t, ok)
+
+	_, ok = changeOf(linkMessage("tun0"))
+	assert.False(t, ok)
+
+	change, ok := changeOf(linkMessage("eth0"))
+	assert.True(t, ok)
+	assert.Equal(t, ChangeLink, change)
+
+	change, ok = changeOf(routeMessage(uint32(loopback.Index)))
+	assert.True(t, ok)
+	assert.Equal(t, ChangeRoute, change)
+
+	_, ok = changeOf(syscall.NetlinkMessage{Header: syscall.NlMsghdr{Type: unix.RTM_NEWNEIGH}})
+	assert.False(t, ok)
+}
+
+func linkMessage(name string) syscall.NetlinkMessage {
+	data := make([]byte, unix.SizeofIfInfomsg)
+	data = append(data, routeAttr(unix.IFLA_IFNAME, append([]byte(name), 0))...)
+	return syscall.NetlinkMessage{Header: syscall.NlMsghdr{Type: unix.RTM_NEWLINK}, Data: data}
+}
+
+func routeMessage(oif uint32) syscall.NetlinkMessage {
+	value := make([]byte, 4)
+	*(*uint32)(unsafe.Pointer(&value[0])) = oif
+	data := make([]byte, unix.SizeofRtMsg)
+	data = append(data, routeAttr(unix.RTA_OIF, value)...)
+	return syscall.NetlinkMessage{Header: syscall.NlMsghdr{Type: unix.RTM_NEWROUTE}, Data: data}
+}
+
+func routeAttr(attrType uint16, value []byte) []byte {
+	b := make([]byte, unix.SizeofRtAttr, unix.SizeofRtAttr+len(value)+unix.RTA_ALIGNTO)
+	attr := (*unix.RtAttr)(unsafe.Pointer(&b[0]))
+	attr.Len = uint16(unix.SizeofRtAttr + len(value))
+	attr.Type = attrType
+	b = append(b, value...)
+	for len(b)%unix.RTA_ALIGNTO != 0 {
+		b = append(b, 0)
+	}
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package netmon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mysteriumnetwork/node/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitor_PublishesSettledChanges(t *testing.T) {
+	bus := mocks.NewEventBus()
+	m := NewMonitor(bus, 20*time.Millisecond)
+	defer m.Stop()
+
+	changes := make(chan Change)
+	go m.publishSettled(changes)
+
+	changes <- ChangeRoute
+	changes <- ChangeAddress
+	changes <- ChangeRoute
+
+	assert.Eventually(t, func() bool {
+		return len(bus.GetEventHistory()) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	entry := bus.GetEventHistory()[0]
+	assert.Equal(t, AppTopicNetworkChange, entry.Topic)
+	assert.Equal(t, []Change{ChangeAddress, ChangeRoute}, entry.Event.(AppEventNetworkChange).Changes)
+
+	changes <- ChangeLink
+	assert.Eventually(t, func() bool {
+		return len(bus.GetEventHistory()) == 2
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []Change{ChangeLink}, bus.GetEventHistory()[1].Event.(AppEventNetworkChange).Changes)
+}
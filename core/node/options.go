@@ -60,8 +60,10 @@ type Options struct {
 	TequilapiAddress string
 	TequilapiPort    int
 	TequilapiEnabled bool
+	Tequilapi        OptionsTequilapi
 	BindAddress      string
 	UI               OptionsUI
+	GRPC             OptionsGRPC
 	FeedbackURL      string
 
 	Keystore OptionsKeystore
@@ -69,6 +71,7 @@ type Options struct {
 	logconfig.LogOptions
 	OptionsNetwork
 	Discovery  OptionsDiscovery
+	Connection OptionsConnection
 	Quality    OptionsQuality
 	Location   OptionsLocation
 	Transactor OptionsTransactor
@@ -116,20 +119,28 @@ func GetOptions() *Options {
 		TequilapiAddress: config.GetString(config.FlagTequilapiAddress),
 		TequilapiPort:    config.GetInt(config.FlagTequilapiPort),
 		TequilapiEnabled: true,
+		Tequilapi:        GetOptionsTequilapi(),
 		BindAddress:      config.GetString(config.FlagBindAddress),
 		UI: OptionsUI{
 			UIEnabled:     config.GetBool(config.FlagUIEnable),
 			UIBindAddress: config.GetString(config.FlagUIAddress),
 			UIPort:        config.GetInt(config.FlagUIPort),
 		},
+		GRPC: OptionsGRPC{
+			GRPCEnabled: config.GetBool(config.FlagGRPCEnable),
+			GRPCAddress: config.GetString(config.FlagGRPCAddress),
+		},
 		SwarmDialerDNSHeadstart: config.GetDuration(config.FlagDNSResolutionHeadstart),
 		FeedbackURL:             config.GetString(config.FlagFeedbackURL),
 		Keystore: OptionsKeystore{
-			UseLightweight: config.GetBool(config.FlagKeystoreLightweight),
+			UseLightweight:        config.GetBool(config.FlagKeystoreLightweight),
+			ExternalSigner:        config.GetString(config.FlagKeystoreExternalSigner),
+			ExternalSignerTimeout: config.GetDuration(config.FlagKeystoreExternalSignerTimeout),
 		},
 		LogOptions:     *GetLogOptions(),
 		OptionsNetwork: network,
 		Discovery:      *GetDiscoveryOptions(),
+		Connection:     *GetConnectionOptions(),
 		Quality: OptionsQuality{
 			Type:    QualityType(config.GetString(config.FlagQualityType)),
 			Address: config.GetString(config.FlagQualityAddress),
@@ -154,6 +165,10 @@ func GetOptions() *Options {
 			BCTimeout:                      config.GetDuration(config.FlagPaymentsBCTimeout),
 			HermesPromiseSettlingThreshold: config.GetFloat64(config.FlagPaymentsHermesPromiseSettleThreshold),
 			SettlementTimeout:              config.GetDuration(config.FlagPaymentsHermesPromiseSettleTimeout),
+			SettlementPolicyCheckInterval:  config.GetDuration(config.FlagPaymentsSettlementPolicyCheckInterval),
+			IncidentBlockThreshold:         config.GetInt(config.FlagPaymentsIncidentBlockThreshold),
+			IncidentBlockWindow:            config.GetDuration(config.FlagPaymentsIncidentBlockWindow),
+			AcceptedHermes:                 config.GetStringSlice(config.FlagPaymentsProviderAcceptedHermes),
 			ConsumerUpperGBPriceBound:      config.GetBigInt(config.FlagPaymentsConsumerPricePerGBUpperBound),
 			ConsumerLowerGBPriceBound:      config.GetBigInt(config.FlagPaymentsConsumerPricePerGBLowerBound),
 			ConsumerUpperMinutePriceBound:  config.GetBigInt(config.FlagPaymentsConsumerPricePerMinuteUpperBound),
@@ -162,6 +177,12 @@ func GetOptions() *Options {
 			ProviderInvoiceFrequency:       config.GetDuration(config.FlagPaymentsProviderInvoiceFrequency),
 			MaxUnpaidInvoiceValue:          config.GetBigInt(config.FlagPaymentsMaxUnpaidInvoiceValue),
 			HermesStatusRecheckInterval:    config.GetDuration(config.FlagPaymentsHermesStatusRecheckInterval),
+			ProviderPricing: OptionsProviderPricing{
+				Currency:       config.GetString(config.FlagPaymentsProviderPriceCurrency),
+				UpdateInterval: config.GetDuration(config.FlagPaymentsProviderPriceUpdateInterval),
+				Smoothing:      config.GetFloat64(config.FlagPaymentsProviderPriceSmoothing),
+				MaxChange:      config.GetFloat64(config.FlagPaymentsProviderPriceMaxChange),
+			},
 		},
 		Chains: OptionsChains{
 			Chain1: metadata.ChainDefinition{
@@ -238,7 +259,9 @@ func GetDHTOptions() *OptionsDHT {
 
 // OptionsKeystore stores the keystore configuration
 type OptionsKeystore struct {
-	UseLightweight bool
+	UseLightweight        bool
+	ExternalSigner        string
+	ExternalSignerTimeout time.Duration
 }
 
 func getP2PListenPorts() *port.Range {
@@ -0,0 +1,60 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node
+
+import (
+	"time"
+
+	"github.com/mysteriumnetwork/node/config"
+)
+
+// OptionsConnection describes consumer connection options.
+type OptionsConnection struct {
+	ProbeInterval        time.Duration
+	ProbeTargets         []string
+	ProbeCount           int
+	ProbeTimeout         time.Duration
+	ProbeThroughputURL   string
+	ProbeThroughputBytes int64
+	ProbeReconnectLoss   float64
+
+	ReconnectMaxAttempts         int
+	ReconnectBackoff             time.Duration
+	ReconnectMaxBackoff          time.Duration
+	ReconnectSwitchProviderAfter int
+	ReconnectTunnelTimeout       time.Duration
+}
+
+// GetConnectionOptions retrieves connection options from the app configuration.
+func GetConnectionOptions() *OptionsConnection {
+	return &OptionsConnection{
+		ProbeInterval:        config.GetDuration(config.FlagConnectionProbeInterval),
+		ProbeTargets:         config.GetStringSlice(config.FlagConnectionProbeTargets),
+		ProbeCount:           config.GetInt(config.FlagConnectionProbeCount),
+		ProbeTimeout:         config.GetDuration(config.FlagConnectionProbeTimeout),
+		ProbeThroughputURL:   config.GetString(config.FlagConnectionProbeThroughputURL),
+		ProbeThroughputBytes: config.GetInt64(config.FlagConnectionProbeThroughputBytes),
+		ProbeReconnectLoss:   config.GetFloat64(config.FlagConnectionProbeReconnectLoss),
+
+		ReconnectMaxAttempts:         config.GetInt(config.FlagConnectionReconnectMaxAttempts),
+		ReconnectBackoff:             config.GetDuration(config.FlagConnectionReconnectBackoff),
+		ReconnectMaxBackoff:          config.GetDuration(config.FlagConnectionReconnectMaxBackoff),
+		ReconnectSwitchProviderAfter: config.GetInt(config.FlagConnectionReconnectSwitchProviderAfter),
+		ReconnectTunnelTimeout:       config.GetDuration(config.FlagConnectionReconnectTunnelTimeout),
+	}
+}
@@ -28,6 +28,10 @@ type OptionsPayments struct {
 	BCTimeout                      time.Duration
 	HermesPromiseSettlingThreshold float64
 	SettlementTimeout              time.Duration
+	SettlementPolicyCheckInterval  time.Duration
+	IncidentBlockThreshold         int
+	IncidentBlockWindow            time.Duration
+	AcceptedHermes                 []string
 	ConsumerUpperGBPriceBound      *big.Int
 	ConsumerLowerGBPriceBound      *big.Int
 	ConsumerUpperMinutePriceBound  *big.Int
@@ -36,4 +40,13 @@ type OptionsPayments struct {
 	ProviderInvoiceFrequency       time.Duration
 	MaxUnpaidInvoiceValue          *big.Int
 	HermesStatusRecheckInterval    time.Duration
+	ProviderPricing                OptionsProviderPricing
+}
+
+// OptionsProviderPricing controls conversion of fiat denominated service prices to MYST
+type OptionsProviderPricing struct {
+	Currency       string
+	UpdateInterval time.Duration
+	Smoothing      float64
+	MaxChange      float64
 }
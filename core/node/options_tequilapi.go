@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node
+
+import (
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/config"
+)
+
+const defaultTequilapiSocketMode os.FileMode = 0600
+
+// OptionsTequilapi describes transport security parameters of tequilapi
+type OptionsTequilapi struct {
+	TLSCert       string
+	TLSKey        string
+	TLSSelfSigned bool
+	TLSClientCA   string
+
+	Socket     string
+	SocketMode os.FileMode
+
+	// AuditLog is the path of privileged operations audit log, auditing is disabled when empty.
+	AuditLog string
+}
+
+// GetOptionsTequilapi retrieves tequilapi transport options from the app configuration.
+func GetOptionsTequilapi() OptionsTequilapi {
+	return OptionsTequilapi{
+		TLSCert:       config.GetString(config.FlagTequilapiTLSCert),
+		TLSKey:        config.GetString(config.FlagTequilapiTLSKey),
+		TLSSelfSigned: config.GetBool(config.FlagTequilapiTLSSelfSigned),
+		TLSClientCA:   config.GetString(config.FlagTequilapiTLSClientCA),
+		Socket:        config.GetString(config.FlagTequilapiSocket),
+		SocketMode:    parseSocketMode(config.GetString(config.FlagTequilapiSocketMode)),
+		AuditLog:      auditLogPath(),
+	}
+}
+
+func auditLogPath() string {
+	logDir := config.GetString(config.FlagLogDir)
+	if logDir == "" {
+		return ""
+	}
+	return path.Join(logDir, "audit")
+}
+
+// TLSEnabled returns true if tequilapi should be served over HTTPS.
+func (o OptionsTequilapi) TLSEnabled() bool {
+	return o.TLSSelfSigned || (o.TLSCert != "" && o.TLSKey != "")
+}
+
+func parseSocketMode(value string) os.FileMode {
+	if value == "" {
+		return defaultTequilapiSocketMode
+	}
+	mode, err := strconv.ParseUint(value, 8, 32)
+	if err != nil || mode > 0777 {
+		log.Warn().Msgf("Invalid tequilapi socket mode %q, using %#o", value, defaultTequilapiSocketMode)
+		return defaultTequilapiSocketMode
+	}
+	return os.FileMode(mode)
+}
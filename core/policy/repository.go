@@ -31,10 +31,16 @@ type listItem struct {
 	rules  market.AccessPolicyRuleSet
 }
 
+// IdentityBlocker tells if identity is blocked regardless of access policies.
+type IdentityBlocker interface {
+	IsIdentityBlocked(identity identity.Identity) bool
+}
+
 // Repository represents async policy fetcher from TrustOracle
 type Repository struct {
-	lock  sync.RWMutex
-	items []listItem
+	lock    sync.RWMutex
+	items   []listItem
+	blocker IdentityBlocker
 }
 
 // NewRepository create instance of policy repository
@@ -60,6 +66,14 @@ func (r *Repository) SetPolicyRules(policy market.AccessPolicy, policyRules mark
 	}
 }
 
+// SetIdentityBlocker sets blocker which is consulted before access policy rules
+func (r *Repository) SetIdentityBlocker(blocker IdentityBlocker) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.blocker = blocker
+}
+
 // Policies list policies in repository
 func (r *Repository) Policies() []market.AccessPolicy {
 	r.lock.RLock()
@@ -118,6 +132,15 @@ func (r *Repository) Rules() []market.AccessPolicyRuleSet {
 
 // IsIdentityAllowed returns flag if given identity should be allowed by rules
 func (r *Repository) IsIdentityAllowed(identity identity.Identity) bool {
+	r.lock.RLock()
+	blocker := r.blocker
+	r.lock.RUnlock()
+
+	// Blocker may query storage, so it is not called under repository lock.
+	if blocker != nil && blocker.IsIdentityBlocked(identity) {
+		return false
+	}
+
 	r.lock.RLock()
 	defer r.lock.RUnlock()
 
@@ -161,3 +161,38 @@ func createFullRepo() *Repository {
 	)
 	return repo
 }
+
+type mockIdentityBlocker struct {
+	blocked map[string]bool
+	onCheck func()
+}
+
+func (m *mockIdentityBlocker) IsIdentityBlocked(id identity.Identity) bool {
+	if m.onCheck != nil {
+		m.onCheck()
+	}
+	return m.blocked[id.Address]
+}
+
+func Test_Repository_IsIdentityAllowed_WithBlocker(t *testing.T) {
+	repo := NewRepository()
+	assert.True(t, repo.IsIdentityAllowed(identity.FromAddress("0x1")))
+
+	repo.SetIdentityBlocker(&mockIdentityBlocker{blocked: map[string]bool{"0x1": true}})
+	assert.False(t, repo.IsIdentityAllowed(identity.FromAddress("0x1")))
+	assert.True(t, repo.IsIdentityAllowed(identity.FromAddress("0x2")))
+
+	repo.SetPolicyRules(policyOne, policyOneRules)
+	assert.False(t, repo.IsIdentityAllowed(identity.FromAddress("0x1")))
+	assert.False(t, repo.IsIdentityAllowed(identity.FromAddress("0x2")))
+}
+
+func Test_Repository_IsIdentityAllowed_BlockerIsNotCalledUnderLock(t *testing.T) {
+	repo := NewRepository()
+	repo.SetIdentityBlocker(&mockIdentityBlocker{onCheck: func() {
+		// Would deadlock if blocker was called with repository lock held.
+		repo.SetPolicyRules(policyOne, policyOneRules)
+	}})
+
+	assert.False(t, repo.IsIdentityAllowed(identity.FromAddress("0x2")))
+}
@@ -0,0 +1,316 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pricing
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/service"
+	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/market"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+// minChange is the relative MYST price change below which proposals are not announced again.
+const minChange = 0.01
+
+type exchangeRateProvider interface {
+	ExchangeRate(quote string) (float64, error)
+}
+
+type serviceManager interface {
+	List() map[service.ID]*service.Instance
+	UpdatePaymentMethod(id service.ID, pm market.PaymentMethod) error
+}
+
+// Config describes how fiat denominated service prices are converted to MYST.
+type Config struct {
+	// Currency prices are set in, empty when prices are set in MYST.
+	Currency string
+	// UpdateInterval is how often prices are converted again.
+	UpdateInterval time.Duration
+	// Smoothing is the weight of the latest exchange rate in the smoothed rate.
+	Smoothing float64
+	// MaxChange is the maximum relative change of MYST price in a single update.
+	MaxChange float64
+}
+
+// Price describes fiat service price together with its current MYST price.
+type Price struct {
+	Currency string
+	// Rate is the smoothed price of MYST in Currency.
+	Rate          float64
+	PerGiB        *big.Int
+	PerMinute     *big.Int
+	MystPerGiB    *big.Int
+	MystPerMinute *big.Int
+}
+
+type servicePrice struct {
+	perGiB        *big.Int
+	perMinute     *big.Int
+	mystPerGiB    *big.Int
+	mystPerMinute *big.Int
+}
+
+// Repricer keeps MYST prices of provided services in line with their fiat prices.
+type Repricer struct {
+	config   Config
+	rates    exchangeRateProvider
+	services serviceManager
+
+	lock   sync.Mutex
+	rate   float64
+	prices map[string]servicePrice
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewRepricer returns a new instance of repricer.
+func NewRepricer(config Config, rates exchangeRateProvider, services serviceManager) *Repricer {
+	config.Currency = strings.ToUpper(config.Currency)
+	if config.Smoothing <= 0 || config.Smoothing > 1 {
+		config.Smoothing = 1
+	}
+	return &Repricer{
+		config:   config,
+		rates:    rates,
+		services: services,
+		prices:   make(map[string]servicePrice),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Enabled checks if service prices are set in fiat currency.
+func (r *Repricer) Enabled() bool {
+	return r.config.Currency != ""
+}
+
+// ToMyst converts fiat prices of the given service to MYST and keeps them up to date while the service runs.
+// Prices are returned as is when they are set in MYST.
+func (r *Repricer) ToMyst(providerID identity.Identity, serviceType string, perGiB, perMinute *big.Int) (*big.Int, *big.Int, error) {
+	if !r.Enabled() {
+		return perGiB, perMinute, nil
+	}
+
+	rate, err := r.currentRate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	price := servicePrice{
+		perGiB:        valueOrZero(perGiB),
+		perMinute:     valueOrZero(perMinute),
+		mystPerGiB:    toMyst(perGiB, rate),
+		mystPerMinute: toMyst(perMinute, rate),
+	}
+	r.lock.Lock()
+	r.prices[priceKey(providerID.Address, serviceType)] = price
+	r.lock.Unlock()
+
+	return price.mystPerGiB, price.mystPerMinute, nil
+}
+
+// Price returns fiat price of the given service, false if the service is not priced in fiat currency.
+func (r *Repricer) Price(providerID identity.Identity, serviceType string) (Price, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	price, ok := r.prices[priceKey(providerID.Address, serviceType)]
+	if !ok {
+		return Price{}, false
+	}
+	return Price{
+		Currency:      r.config.Currency,
+		Rate:          r.rate,
+		PerGiB:        price.perGiB,
+		PerMinute:     price.perMinute,
+		MystPerGiB:    price.mystPerGiB,
+		MystPerMinute: price.mystPerMinute,
+	}, true
+}
+
+// HandleServiceStatus forgets prices of stopped services.
+func (r *Repricer) HandleServiceStatus(e servicestate.AppEventServiceStatus) {
+	if e.Status != string(servicestate.NotRunning) {
+		return
+	}
+
+	key := priceKey(e.ProviderID, e.Type)
+	// Service of the same type might have been started again before the event was handled.
+	for _, instance := range r.services.List() {
+		if priceKey(instance.ProviderID.Address, instance.Type) == key {
+			return
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.prices, key)
+}
+
+// Start periodically updates MYST prices of running services.
+func (r *Repricer) Start() {
+	if !r.Enabled() || r.config.UpdateInterval <= 0 {
+		return
+	}
+
+	log.Info().Msgf("Service prices are set in %s, updating them every %s", r.config.Currency, r.config.UpdateInterval)
+	ticker := time.NewTicker(r.config.UpdateInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.update()
+		}
+	}
+}
+
+// Stop stops price updates.
+func (r *Repricer) Stop() {
+	r.once.Do(func() {
+		close(r.stop)
+	})
+}
+
+func (r *Repricer) update() {
+	latest, err := r.rates.ExchangeRate(r.config.Currency)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Could not get MYST exchange rate to %s, keeping service prices", r.config.Currency)
+		return
+	}
+	if latest <= 0 {
+		log.Warn().Msgf("Invalid MYST exchange rate to %s: %v", r.config.Currency, latest)
+		return
+	}
+
+	r.lock.Lock()
+	if r.rate == 0 {
+		r.rate = latest
+	} else {
+		r.rate = r.config.Smoothing*latest + (1-r.config.Smoothing)*r.rate
+	}
+	rate := r.rate
+	r.lock.Unlock()
+
+	for id, instance := range r.services.List() {
+		key := priceKey(instance.ProviderID.Address, instance.Type)
+
+		r.lock.Lock()
+		price, ok := r.prices[key]
+		if !ok {
+			r.lock.Unlock()
+			continue
+		}
+		perGiB := bound(toMyst(price.perGiB, rate), price.mystPerGiB, r.config.MaxChange)
+		perMinute := bound(toMyst(price.perMinute, rate), price.mystPerMinute, r.config.MaxChange)
+		changed := significant(perGiB, price.mystPerGiB) || significant(perMinute, price.mystPerMinute)
+		if changed {
+			price.mystPerGiB, price.mystPerMinute = perGiB, perMinute
+			r.prices[key] = price
+		}
+		r.lock.Unlock()
+
+		if !changed {
+			continue
+		}
+		log.Info().Msgf("Updating %s service prices to %s MYST per GiB and %s MYST per minute", instance.Type, perGiB, perMinute)
+		if err := r.services.UpdatePaymentMethod(id, pingpong.NewPaymentMethod(perGiB, perMinute)); err != nil {
+			log.Error().Err(err).Msgf("Could not update %s service prices", instance.Type)
+		}
+	}
+}
+
+func (r *Repricer) currentRate() (float64, error) {
+	r.lock.Lock()
+	rate := r.rate
+	r.lock.Unlock()
+	if rate > 0 {
+		return rate, nil
+	}
+
+	rate, err := r.rates.ExchangeRate(r.config.Currency)
+	if err != nil {
+		return 0, fmt.Errorf("could not get MYST exchange rate to %s: %w", r.config.Currency, err)
+	}
+	if rate <= 0 {
+		return 0, fmt.Errorf("invalid MYST exchange rate to %s: %v", r.config.Currency, rate)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.rate == 0 {
+		r.rate = rate
+	}
+	return r.rate, nil
+}
+
+func priceKey(providerID, serviceType string) string {
+	return strings.ToLower(providerID) + "|" + serviceType
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return value
+}
+
+// toMyst converts fiat amount to MYST using the price of MYST in fiat currency.
+func toMyst(fiat *big.Int, rate float64) *big.Int {
+	res, _ := new(big.Float).Quo(new(big.Float).SetInt(valueOrZero(fiat)), big.NewFloat(rate)).Int(nil)
+	return res
+}
+
+// bound limits the change of price from the previous one to the given fraction.
+func bound(price, previous *big.Int, maxChange float64) *big.Int {
+	if maxChange <= 0 || previous.Sign() == 0 {
+		return price
+	}
+
+	low, _ := new(big.Float).Mul(new(big.Float).SetInt(previous), big.NewFloat(1-maxChange)).Int(nil)
+	high, _ := new(big.Float).Mul(new(big.Float).SetInt(previous), big.NewFloat(1+maxChange)).Int(nil)
+	if price.Cmp(low) < 0 {
+		return low
+	}
+	if price.Cmp(high) > 0 {
+		return high
+	}
+	return price
+}
+
+// significant checks if price changed enough to be announced again.
+func significant(price, previous *big.Int) bool {
+	if previous.Sign() == 0 {
+		return price.Sign() != 0
+	}
+
+	diff := new(big.Float).SetInt(new(big.Int).Abs(new(big.Int).Sub(price, previous)))
+	change, _ := diff.Quo(diff, new(big.Float).SetInt(previous)).Float64()
+	return change >= minChange
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pricing
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/core/service"
+	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/market"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+var providerID = identity.FromAddress("0x000000000000000000000000000000000000000a")
+
+func TestRepricer_ToMyst_Disabled(t *testing.T) {
+	// given
+	rates := &mockRates{rate: 0.5}
+	repricer := NewRepricer(Config{}, rates, &mockServices{})
+
+	// when
+	perGiB, perMinute, err := repricer.ToMyst(providerID, "wireguard", big.NewInt(100), big.NewInt(1))
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), perGiB)
+	assert.Equal(t, big.NewInt(1), perMinute)
+	_, ok := repricer.Price(providerID, "wireguard")
+	assert.False(t, ok)
+	assert.Equal(t, 0, rates.calls)
+}
+
+func TestRepricer_ToMyst(t *testing.T) {
+	// given
+	rates := &mockRates{rate: 0.5}
+	repricer := NewRepricer(Config{Currency: "usd"}, rates, &mockServices{})
+
+	// when
+	perGiB, perMinute, err := repricer.ToMyst(providerID, "wireguard", big.NewInt(1e17), big.NewInt(1e12))
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(2e17), perGiB)
+	assert.Equal(t, big.NewInt(2e12), perMinute)
+	assert.Equal(t, "USD", rates.quote)
+
+	price, ok := repricer.Price(providerID, "wireguard")
+	assert.True(t, ok)
+	assert.Equal(t, Price{
+		Currency:      "USD",
+		Rate:          0.5,
+		PerGiB:        big.NewInt(1e17),
+		PerMinute:     big.NewInt(1e12),
+		MystPerGiB:    big.NewInt(2e17),
+		MystPerMinute: big.NewInt(2e12),
+	}, price)
+}
+
+func TestRepricer_ToMyst_RateUnavailable(t *testing.T) {
+	// given
+	repricer := NewRepricer(Config{Currency: "USD"}, &mockRates{err: errors.New("unavailable")}, &mockServices{})
+
+	// when
+	_, _, err := repricer.ToMyst(providerID, "wireguard", big.NewInt(1e17), big.NewInt(1e12))
+
+	// then
+	assert.Error(t, err)
+	_, ok := repricer.Price(providerID, "wireguard")
+	assert.False(t, ok)
+}
+
+func TestRepricer_Update(t *testing.T) {
+	tests := []struct {
+		name              string
+		rate              float64
+		err               error
+		expectedUpdate    bool
+		expectedRate      float64
+		expectedPerGiB    *big.Int
+		expectedPerMinute *big.Int
+	}{
+		{
+			name:              "smoothed and bounded change",
+			rate:              0.1,
+			expectedUpdate:    true,
+			expectedRate:      0.3,
+			expectedPerGiB:    big.NewInt(3e17),
+			expectedPerMinute: big.NewInt(3e12),
+		},
+		{
+			name:              "change within bounds",
+			rate:              0.75,
+			expectedUpdate:    true,
+			expectedRate:      0.625,
+			expectedPerGiB:    big.NewInt(16e16),
+			expectedPerMinute: big.NewInt(16e11),
+		},
+		{
+			name:              "insignificant change",
+			rate:              0.499,
+			expectedRate:      0.4995,
+			expectedPerGiB:    big.NewInt(2e17),
+			expectedPerMinute: big.NewInt(2e12),
+		},
+		{
+			name:              "rate unavailable",
+			err:               errors.New("unavailable"),
+			expectedRate:      0.5,
+			expectedPerGiB:    big.NewInt(2e17),
+			expectedPerMinute: big.NewInt(2e12),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			rates := &mockRates{rate: 0.5}
+			services := &mockServices{instances: map[service.ID]*service.Instance{
+				"wg":    {ID: "wg", ProviderID: providerID, Type: "wireguard"},
+				"other": {ID: "other", ProviderID: providerID, Type: "openvpn"},
+			}}
+			repricer := NewRepricer(Config{Currency: "USD", Smoothing: 0.5, MaxChange: 0.5}, rates, services)
+			_, _, err := repricer.ToMyst(providerID, "wireguard", big.NewInt(1e17), big.NewInt(1e12))
+			assert.NoError(t, err)
+
+			// when
+			rates.rate, rates.err = tt.rate, tt.err
+			repricer.update()
+
+			// then
+			price, _ := repricer.Price(providerID, "wireguard")
+			assert.Equal(t, tt.expectedRate, price.Rate)
+			assert.Equal(t, tt.expectedPerGiB, price.MystPerGiB)
+			assert.Equal(t, tt.expectedPerMinute, price.MystPerMinute)
+			if tt.expectedUpdate {
+				assert.Equal(t, map[service.ID]market.PaymentMethod{
+					"wg": pingpong.NewPaymentMethod(tt.expectedPerGiB, tt.expectedPerMinute),
+				}, services.updated)
+			} else {
+				assert.Empty(t, services.updated)
+			}
+		})
+	}
+}
+
+func TestRepricer_HandleServiceStatus(t *testing.T) {
+	// given
+	services := &mockServices{instances: map[service.ID]*service.Instance{
+		"other": {ID: "other", ProviderID: providerID, Type: "openvpn"},
+	}}
+	repricer := NewRepricer(Config{Currency: "USD"}, &mockRates{rate: 0.5}, services)
+	_, _, err := repricer.ToMyst(providerID, "wireguard", big.NewInt(1e17), big.NewInt(1e12))
+	assert.NoError(t, err)
+	_, _, err = repricer.ToMyst(providerID, "openvpn", big.NewInt(1e17), big.NewInt(1e12))
+	assert.NoError(t, err)
+
+	// when
+	repricer.HandleServiceStatus(servicestate.AppEventServiceStatus{ProviderID: providerID.Address, Type: "wireguard", Status: string(servicestate.Running)})
+
+	// then
+	_, ok := repricer.Price(providerID, "wireguard")
+	assert.True(t, ok)
+
+	// when
+	repricer.HandleServiceStatus(servicestate.AppEventServiceStatus{ProviderID: providerID.Address, Type: "wireguard", Status: string(servicestate.NotRunning)})
+	repricer.HandleServiceStatus(servicestate.AppEventServiceStatus{ProviderID: providerID.Address, Type: "openvpn", Status: string(servicestate.NotRunning)})
+
+	// then
+	_, ok = repricer.Price(providerID, "wireguard")
+	assert.False(t, ok)
+	_, ok = repricer.Price(providerID, "openvpn")
+	assert.True(t, ok, "price of restarted service should be kept")
+}
+
+type mockRates struct {
+	rate  float64
+	err   error
+	quote string
+	calls int
+}
+
+func (m *mockRates) ExchangeRate(quote string) (float64, error) {
+	m.calls++
+	m.quote = quote
+	return m.rate, m.err
+}
+
+type mockServices struct {
+	instances map[service.ID]*service.Instance
+	updated   map[service.ID]market.PaymentMethod
+}
+
+func (m *mockServices) List() map[service.ID]*service.Instance {
+	return m.instances
+}
+
+func (m *mockServices) UpdatePaymentMethod(id service.ID, pm market.PaymentMethod) error {
+	if m.updated == nil {
+		m.updated = make(map[service.ID]market.PaymentMethod)
+	}
+	m.updated[id] = pm
+	return nil
+}
@@ -19,10 +19,12 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/mysteriumnetwork/node/core/policy"
 	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/core/speedtest"
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/market"
 	"github.com/mysteriumnetwork/node/p2p"
@@ -39,6 +41,15 @@ var (
 	ErrUnsupportedServiceType = errors.New("unsupported service type")
 	// ErrUnsupportedAccessPolicy indicates that manager tried to create service with unsupported access policy
 	ErrUnsupportedAccessPolicy = errors.New("unsupported access policy")
+	// ErrIdentityLocked indicates that manager tried to start service with provider identity which is not unlocked
+	ErrIdentityLocked = errors.New("provider identity is locked")
+	// ErrLocationNotUpdatable indicates that service proposal location can not be changed without restarting the service
+	ErrLocationNotUpdatable = errors.New("service location can not be updated")
+)
+
+var (
+	restartAttempts   = 3
+	restartRetryDelay = 2 * time.Second
 )
 
 // Service interface represents pluggable Mysterium service
@@ -56,6 +67,11 @@ type Discovery interface {
 	Start(ownIdentity identity.Identity, proposal market.ServiceProposal)
 	Stop()
 	Wait()
+	UpdateProposal(proposal market.ServiceProposal)
+}
+
+type identityUnlockChecker interface {
+	IsUnlocked(address string) bool
 }
 
 // WaitForNATHole blocks until NAT hole is punched towards consumer through local NAT or until hole punching failed
@@ -67,6 +83,9 @@ func NewManager(
 	discoveryFactory DiscoveryFactory,
 	eventPublisher Publisher,
 	policyOracle *policy.Oracle,
+	identityBlocker policy.IdentityBlocker,
+	acceptedHermes []string,
+	unlockChecker identityUnlockChecker,
 	p2pListener p2p.Listener,
 	sessionManager func(service *Instance, channel p2p.Channel) *SessionManager,
 	statusStorage connectivity.StatusStorage,
@@ -77,6 +96,9 @@ func NewManager(
 		discoveryFactory: discoveryFactory,
 		eventPublisher:   eventPublisher,
 		policyOracle:     policyOracle,
+		identityBlocker:  identityBlocker,
+		acceptedHermes:   acceptedHermes,
+		unlockChecker:    unlockChecker,
 		p2pListener:      p2pListener,
 		sessionManager:   sessionManager,
 		statusStorage:    statusStorage,
@@ -91,6 +113,9 @@ type Manager struct {
 	discoveryFactory DiscoveryFactory
 	eventPublisher   Publisher
 	policyOracle     *policy.Oracle
+	identityBlocker  policy.IdentityBlocker
+	acceptedHermes   []string
+	unlockChecker    identityUnlockChecker
 
 	p2pListener    p2p.Listener
 	sessionManager func(service *Instance, channel p2p.Channel) *SessionManager
@@ -101,14 +126,22 @@ type Manager struct {
 // It passes the options to the start method of the service.
 // If an error occurs in the underlying service, the error is then returned.
 func (manager *Manager) Start(providerID identity.Identity, serviceType string, policyIDs []string, options Options, pm market.PaymentMethod) (id ID, err error) {
+	if manager.unlockChecker != nil && !manager.unlockChecker.IsUnlocked(providerID.Address) {
+		return id, ErrIdentityLocked
+	}
+
 	service, proposal, err := manager.serviceRegistry.Create(serviceType, options)
 	if err != nil {
 		return id, err
 	}
 
 	proposal.SetPaymentMethod(pm)
+	proposal.SetAcceptedHermes(manager.acceptedHermes)
 	proposal.SetAccessPolicies(nil)
 	policyRules := policy.NewRepository()
+	if manager.identityBlocker != nil {
+		policyRules.SetIdentityBlocker(manager.identityBlocker)
+	}
 	if len(policyIDs) > 0 {
 		policies := manager.policyOracle.Policies(policyIDs)
 		if err = manager.policyOracle.SubscribePolicies(policies, policyRules); err != nil {
@@ -135,7 +168,7 @@ func (manager *Manager) Start(providerID identity.Identity, serviceType string,
 		state:          servicestate.Starting,
 		Options:        options,
 		service:        service,
-		Proposal:       proposal,
+		proposal:       proposal,
 		policies:       policyRules,
 		discovery:      discovery,
 		eventPublisher: manager.eventPublisher,
@@ -149,6 +182,7 @@ func (manager *Manager) Start(providerID identity.Identity, serviceType string,
 		subscribeSessionAcknowledge(mng, ch)
 		subscribeSessionDestroy(mng, ch)
 		subscribeSessionPayments(mng, ch)
+		speedtest.Serve(ch)
 	}
 	stopP2PListener, err := manager.p2pListener.Listen(providerID, serviceType, channelHandlers)
 	if err != nil {
@@ -208,6 +242,91 @@ func (manager *Manager) Stop(id ID) error {
 	return nil
 }
 
+// Restart stops the service and starts it again with the same configuration, so that a fresh proposal is announced.
+func (manager *Manager) Restart(id ID) (ID, error) {
+	instance := manager.servicePool.Instance(id)
+	if instance == nil {
+		return id, ErrNoSuchInstance
+	}
+
+	proposal := instance.CopyProposal()
+	var policyIDs []string
+	if proposal.AccessPolicies != nil {
+		for _, p := range *proposal.AccessPolicies {
+			policyIDs = append(policyIDs, p.ID)
+		}
+	}
+
+	// Do not stop the old instance when the new one is certain to fail starting.
+	if manager.unlockChecker != nil && !manager.unlockChecker.IsUnlocked(instance.ProviderID.Address) {
+		return id, ErrIdentityLocked
+	}
+
+	if err := manager.Stop(id); err != nil {
+		return id, fmt.Errorf("could not stop service: %w", err)
+	}
+
+	// Resources of the stopped instance (ports, interfaces) may be released with a delay.
+	var newID ID
+	var err error
+	for attempt := 1; attempt <= restartAttempts; attempt++ {
+		newID, err = manager.Start(instance.ProviderID, instance.Type, policyIDs, instance.Options, proposal.PaymentMethod)
+		if err == nil || err == ErrIdentityLocked {
+			return newID, err
+		}
+		log.Warn().Err(err).Msgf("Could not start service %s on attempt %d/%d", id, attempt, restartAttempts)
+		if attempt < restartAttempts {
+			time.Sleep(restartRetryDelay)
+		}
+	}
+	return newID, fmt.Errorf("could not start service: %w", err)
+}
+
+// UpdatePaymentMethod changes prices of the running service and announces the updated proposal.
+func (manager *Manager) UpdatePaymentMethod(id ID, pm market.PaymentMethod) error {
+	instance := manager.servicePool.Instance(id)
+	if instance == nil {
+		return ErrNoSuchInstance
+	}
+
+	proposal := instance.setPaymentMethod(pm)
+	if instance.discovery != nil {
+		instance.discovery.UpdateProposal(proposal)
+	}
+	return nil
+}
+
+// UpdateLocation changes location of the running service and announces the updated proposal.
+func (manager *Manager) UpdateLocation(id ID, loc market.Location) error {
+	instance := manager.servicePool.Instance(id)
+	if instance == nil {
+		return ErrNoSuchInstance
+	}
+
+	proposal, ok := instance.setLocation(loc)
+	if !ok {
+		return ErrLocationNotUpdatable
+	}
+	if instance.discovery != nil {
+		instance.discovery.UpdateProposal(proposal)
+	}
+	return nil
+}
+
+// HandleIdentityLock stops services provided by the locked identity, as they can not sign anything anymore.
+func (manager *Manager) HandleIdentityLock(e identity.AppEventIdentityLock) {
+	for id, instance := range manager.List() {
+		if instance.ProviderID.Address != e.ID.Address {
+			continue
+		}
+
+		log.Info().Msgf("Stopping service %s, provider identity %s was locked", id, e.ID.Address)
+		if err := manager.Stop(id); err != nil {
+			log.Error().Err(err).Msgf("Could not stop service %s", id)
+		}
+	}
+}
+
 // Service returns a service instance by requested id.
 func (manager *Manager) Service(id ID) *Instance {
 	return manager.servicePool.Instance(id)
@@ -57,6 +57,9 @@ func TestManager_StartRemovesServiceFromPoolIfServiceCrashes(t *testing.T) {
 		discoveryFactory,
 		mocks.NewEventBus(),
 		mockPolicyOracle,
+		nil,
+		nil,
+		nil,
 		&mockP2PListener{}, nil, nil,
 	)
 	_, err := manager.Start(identity.FromAddress(proposalMock.ProviderID), serviceType, nil, struct{}{}, nil)
@@ -81,6 +84,9 @@ func TestManager_StartDoesNotCrashIfStoppedByUser(t *testing.T) {
 		discoveryFactory,
 		mocks.NewEventBus(),
 		mockPolicyOracle,
+		nil,
+		nil,
+		nil,
 		&mockP2PListener{}, nil, nil,
 	)
 	id, err := manager.Start(identity.FromAddress(proposalMock.ProviderID), serviceType, nil, struct{}{}, nil)
@@ -107,6 +113,9 @@ func TestManager_StopSendsEvent_SucceedsAndPublishesEvent(t *testing.T) {
 		discoveryFactory,
 		eventBus,
 		mockPolicyOracle,
+		nil,
+		nil,
+		nil,
 		&mockP2PListener{}, nil, nil,
 	)
 
@@ -155,3 +164,227 @@ func (m mockP2PListener) GetContact() market.Contact {
 func (m mockP2PListener) Listen(providerID identity.Identity, serviceType string, channelHandler func(ch p2p.Channel)) (func(), error) {
 	return func() {}, nil
 }
+
+type mockUnlockChecker struct {
+	unlocked bool
+}
+
+func (m *mockUnlockChecker) IsUnlocked(address string) bool {
+	return m.unlocked
+}
+
+func TestManager_StartFailsForLockedIdentity(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(serviceType, func(options Options) (Service, market.ServiceProposal, error) {
+		return serviceMock, proposalMock, nil
+	})
+
+	manager := NewManager(
+		registry,
+		MockDiscoveryFactoryFunc(&mockDiscovery{}),
+		mocks.NewEventBus(),
+		mockPolicyOracle,
+		nil,
+		nil,
+		&mockUnlockChecker{unlocked: false},
+		&mockP2PListener{}, nil, nil,
+	)
+	_, err := manager.Start(identity.FromAddress(proposalMock.ProviderID), serviceType, nil, struct{}{}, nil)
+	assert.Equal(t, ErrIdentityLocked, err)
+	assert.Len(t, manager.servicePool.List(), 0)
+}
+
+func TestManager_HandleIdentityLockStopsIdentityServices(t *testing.T) {
+	registry := NewRegistry()
+	mockCopy := *serviceMock
+	mockCopy.mockProcess = make(chan struct{})
+	registry.Register(serviceType, func(options Options) (Service, market.ServiceProposal, error) {
+		return &mockCopy, proposalMock, nil
+	})
+
+	discovery := mockDiscovery{}
+	manager := NewManager(
+		registry,
+		MockDiscoveryFactoryFunc(&discovery),
+		mocks.NewEventBus(),
+		mockPolicyOracle,
+		nil,
+		nil,
+		&mockUnlockChecker{unlocked: true},
+		&mockP2PListener{}, nil, nil,
+	)
+	providerID := identity.FromAddress(proposalMock.ProviderID)
+	_, err := manager.Start(providerID, serviceType, nil, struct{}{}, nil)
+	assert.NoError(t, err)
+
+	manager.HandleIdentityLock(identity.AppEventIdentityLock{ID: identity.FromAddress("0x1")})
+	assert.Len(t, manager.servicePool.List(), 1)
+
+	manager.HandleIdentityLock(identity.AppEventIdentityLock{ID: providerID})
+	discovery.Wait()
+	assert.Len(t, manager.servicePool.List(), 0)
+}
+
+func TestManager_UpdatePaymentMethodAnnouncesProposal(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(serviceType, func(options Options) (Service, market.ServiceProposal, error) {
+		return serviceMock, proposalMock, nil
+	})
+
+	discovery := mockDiscovery{}
+	eventBus := mocks.NewEventBus()
+	manager := NewManager(
+		registry,
+		MockDiscoveryFactoryFunc(&discovery),
+		eventBus,
+		mockPolicyOracle,
+		nil,
+		nil,
+		nil,
+		&mockP2PListener{}, nil, nil,
+	)
+	id, err := manager.Start(identity.FromAddress(proposalMock.ProviderID), serviceType, nil, struct{}{}, mocks.DefaultPaymentMethod())
+	assert.NoError(t, err)
+	eventBus.Clear()
+
+	pm := &mocks.PaymentMethod{PaymentType: "BYTES_TRANSFERRED_WITH_TIME", Rate: market.PaymentRate{PerTime: time.Hour}}
+	err = manager.UpdatePaymentMethod(id, pm)
+	assert.NoError(t, err)
+	assert.Equal(t, pm, manager.Service(id).CopyProposal().PaymentMethod)
+	assert.Equal(t, pm, discovery.proposal.PaymentMethod)
+	var announced []interface{}
+	for _, e := range eventBus.GetEventHistory() {
+		if e.Topic == servicestate.AppTopicServiceProposal {
+			announced = append(announced, e.Event.(servicestate.AppEventServiceStatus).ID)
+		}
+	}
+	assert.Equal(t, []interface{}{string(id)}, announced)
+
+	assert.Equal(t, ErrNoSuchInstance, manager.UpdatePaymentMethod("unknown", pm))
+}
+
+type relocatableDefinition struct {
+	Location market.Location
+}
+
+func (d relocatableDefinition) GetLocation() market.Location {
+	return d.Location
+}
+
+func (d relocatableDefinition) WithLocation(loc market.Location) market.ServiceDefinition {
+	d.Location = loc
+	return d
+}
+
+func TestManager_UpdateLocationAnnouncesProposal(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(serviceType, func(options Options) (Service, market.ServiceProposal, error) {
+		return serviceMock, market.ServiceProposal{
+			ServiceType:       serviceType,
+			ServiceDefinition: relocatableDefinition{Location: market.Location{Country: "LT"}},
+		}, nil
+	})
+	registry.Register("static", func(options Options) (Service, market.ServiceProposal, error) {
+		return serviceMock, proposalMock, nil
+	})
+
+	discovery := mockDiscovery{}
+	eventBus := mocks.NewEventBus()
+	manager := NewManager(
+		registry,
+		MockDiscoveryFactoryFunc(&discovery),
+		eventBus,
+		mockPolicyOracle,
+		nil,
+		nil,
+		nil,
+		&mockP2PListener{}, nil, nil,
+	)
+	id, err := manager.Start(identity.FromAddress(proposalMock.ProviderID), serviceType, nil, struct{}{}, mocks.DefaultPaymentMethod())
+	assert.NoError(t, err)
+	eventBus.Clear()
+
+	loc := market.Location{Country: "DE", ASN: 123}
+	err = manager.UpdateLocation(id, loc)
+	assert.NoError(t, err)
+	assert.Equal(t, loc, manager.Service(id).CopyProposal().ServiceDefinition.GetLocation())
+	assert.Equal(t, loc, discovery.proposal.ServiceDefinition.GetLocation())
+	var announced []interface{}
+	for _, e := range eventBus.GetEventHistory() {
+		if e.Topic == servicestate.AppTopicServiceProposal {
+			announced = append(announced, e.Event.(servicestate.AppEventServiceStatus).ID)
+		}
+	}
+	assert.Equal(t, []interface{}{string(id)}, announced)
+
+	assert.Equal(t, ErrNoSuchInstance, manager.UpdateLocation("unknown", loc))
+
+	staticID, err := manager.Start(identity.FromAddress(proposalMock.ProviderID), "static", nil, struct{}{}, mocks.DefaultPaymentMethod())
+	assert.NoError(t, err)
+	assert.Equal(t, ErrLocationNotUpdatable, manager.UpdateLocation(staticID, loc))
+}
+
+func TestManager_RestartRetriesStart(t *testing.T) {
+	restartRetryDelay = time.Millisecond
+	defer func() { restartRetryDelay = 2 * time.Second }()
+
+	registry := NewRegistry()
+	created := 0
+	registry.Register(serviceType, func(options Options) (Service, market.ServiceProposal, error) {
+		created++
+		if created == 2 {
+			return nil, proposalMock, errors.New("port is still in use")
+		}
+		mockCopy := *serviceMock
+		mockCopy.mockProcess = make(chan struct{})
+		return &mockCopy, proposalMock, nil
+	})
+
+	manager := NewManager(
+		registry,
+		MockDiscoveryFactoryFunc(&mockDiscovery{}),
+		mocks.NewEventBus(),
+		mockPolicyOracle,
+		nil,
+		nil,
+		nil,
+		&mockP2PListener{}, nil, nil,
+	)
+	id, err := manager.Start(identity.FromAddress(proposalMock.ProviderID), serviceType, nil, struct{}{}, nil)
+	assert.NoError(t, err)
+
+	newID, err := manager.Restart(id)
+	assert.NoError(t, err)
+	assert.NotEqual(t, id, newID)
+	assert.Equal(t, 3, created)
+	assert.Nil(t, manager.Service(id))
+	assert.NotNil(t, manager.Service(newID))
+}
+
+func TestManager_RestartKeepsServiceForLockedIdentity(t *testing.T) {
+	registry := NewRegistry()
+	mockCopy := *serviceMock
+	mockCopy.mockProcess = make(chan struct{})
+	registry.Register(serviceType, func(options Options) (Service, market.ServiceProposal, error) {
+		return &mockCopy, proposalMock, nil
+	})
+
+	unlockChecker := &mockUnlockChecker{unlocked: true}
+	manager := NewManager(
+		registry,
+		MockDiscoveryFactoryFunc(&mockDiscovery{}),
+		mocks.NewEventBus(),
+		mockPolicyOracle,
+		nil,
+		nil,
+		unlockChecker,
+		&mockP2PListener{}, nil, nil,
+	)
+	id, err := manager.Start(identity.FromAddress(proposalMock.ProviderID), serviceType, nil, struct{}{}, nil)
+	assert.NoError(t, err)
+
+	unlockChecker.unlocked = false
+	_, err = manager.Restart(id)
+	assert.Equal(t, ErrIdentityLocked, err)
+	assert.NotNil(t, manager.Service(id))
+}
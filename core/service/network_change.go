@@ -0,0 +1,166 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/location/locationstate"
+	"github.com/mysteriumnetwork/node/core/netmon"
+	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/market"
+)
+
+type serviceAnnouncer interface {
+	List() map[ID]*Instance
+	UpdateLocation(id ID, loc market.Location) error
+	Restart(id ID) (ID, error)
+}
+
+type publicIPResolver interface {
+	GetPublicIP() (string, error)
+	ClearCache()
+}
+
+type locationRefresher interface {
+	Refresh() (locationstate.Location, error)
+}
+
+// NetworkChangeHandler re-announces running services when provider's public IP changes.
+// Services keep running, so their sessions survive: new sessions resolve the public IP on their own,
+// only proposal location is updated.
+type NetworkChangeHandler struct {
+	services serviceAnnouncer
+	ip       publicIPResolver
+	location locationRefresher
+
+	lock sync.Mutex
+	// announcedIP is the public IP running services were announced with.
+	announcedIP string
+}
+
+// NewNetworkChangeHandler creates new network change handler for provider services.
+func NewNetworkChangeHandler(services serviceAnnouncer, ip publicIPResolver, location locationRefresher) *NetworkChangeHandler {
+	return &NetworkChangeHandler{
+		services: services,
+		ip:       ip,
+		location: location,
+	}
+}
+
+// Handle checks whether public IP has changed and updates location of running services if it did.
+func (h *NetworkChangeHandler) Handle(e netmon.AppEventNetworkChange) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	running := h.runningServices()
+	if len(running) == 0 {
+		// Services started later will record the IP they are announced with.
+		h.announcedIP = ""
+		return
+	}
+
+	oldIP := h.announcedIP
+	if oldIP == "" {
+		// Services were started before any IP was recorded, cached value is the best guess.
+		oldIP, _ = h.ip.GetPublicIP()
+	}
+	h.ip.ClearCache()
+	newIP, err := h.ip.GetPublicIP()
+	if err != nil {
+		log.Warn().Err(err).Msg("Could not resolve public IP after network change")
+		return
+	}
+	h.announcedIP = newIP
+	if newIP == oldIP {
+		log.Debug().Msgf("Network changed (%v), public IP is the same", e.Changes)
+		return
+	}
+
+	log.Info().Msgf("Public IP changed after network change, re-announcing %d service(s)", len(running))
+	loc, err := h.location.Refresh()
+	if err != nil {
+		log.Warn().Err(err).Msg("Could not refresh location after network change")
+		// Retry on the next network change.
+		h.announcedIP = oldIP
+		return
+	}
+
+	for _, id := range running {
+		err := h.services.UpdateLocation(id, marketLocation(loc))
+		if err == nil {
+			log.Info().Msgf("Service %s re-announced from %s after network change", id, loc.Country)
+			continue
+		}
+		if err != ErrLocationNotUpdatable {
+			log.Error().Err(err).Msgf("Could not update location of service %s after network change", id)
+			continue
+		}
+
+		newID, err := h.services.Restart(id)
+		if err != nil {
+			log.Error().Err(err).Msgf("Could not restart service %s after network change", id)
+			continue
+		}
+		log.Info().Msgf("Service %s restarted as %s after network change", id, newID)
+	}
+}
+
+// HandleServiceStatus records public IP the service is announced with once it starts running.
+func (h *NetworkChangeHandler) HandleServiceStatus(e servicestate.AppEventServiceStatus) {
+	if e.Status != string(servicestate.Running) {
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.announcedIP != "" {
+		return
+	}
+	ip, err := h.ip.GetPublicIP()
+	if err != nil {
+		log.Warn().Err(err).Msg("Could not resolve public IP of started service")
+		return
+	}
+	h.announcedIP = ip
+}
+
+func marketLocation(loc locationstate.Location) market.Location {
+	return market.Location{
+		Continent: loc.Continent,
+		Country:   loc.Country,
+		City:      loc.City,
+
+		ASN:      loc.ASN,
+		ISP:      loc.ISP,
+		NodeType: loc.NodeType,
+	}
+}
+
+func (h *NetworkChangeHandler) runningServices() []ID {
+	var ids []ID
+	for id, instance := range h.services.List() {
+		if instance.State() == servicestate.Running {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
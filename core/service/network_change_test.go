@@ -0,0 +1,145 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/mysteriumnetwork/node/core/location/locationstate"
+	"github.com/mysteriumnetwork/node/core/netmon"
+	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/market"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockAnnouncer struct {
+	instances map[ID]*Instance
+	static    map[ID]bool
+	relocated map[ID]market.Location
+	restarted []ID
+}
+
+func (m *mockAnnouncer) List() map[ID]*Instance {
+	return m.instances
+}
+
+func (m *mockAnnouncer) UpdateLocation(id ID, loc market.Location) error {
+	if m.static[id] {
+		return ErrLocationNotUpdatable
+	}
+	if m.relocated == nil {
+		m.relocated = map[ID]market.Location{}
+	}
+	m.relocated[id] = loc
+	return nil
+}
+
+func (m *mockAnnouncer) Restart(id ID) (ID, error) {
+	m.restarted = append(m.restarted, id)
+	return id + "-new", nil
+}
+
+type mockIPResolver struct {
+	cached, actual string
+}
+
+func (m *mockIPResolver) GetPublicIP() (string, error) {
+	if m.cached == "" {
+		m.cached = m.actual
+	}
+	return m.cached, nil
+}
+
+func (m *mockIPResolver) ClearCache() {
+	m.cached = ""
+}
+
+type mockLocationRefresher struct {
+	refreshed int
+}
+
+func (m *mockLocationRefresher) Refresh() (locationstate.Location, error) {
+	m.refreshed++
+	return locationstate.Location{Country: "DE", ASN: 123}, nil
+}
+
+func TestNetworkChangeHandler_UpdatesLocationWhenIPChanges(t *testing.T) {
+	services := &mockAnnouncer{instances: map[ID]*Instance{
+		"running": {state: servicestate.Running},
+		"stopped": {state: servicestate.NotRunning},
+	}}
+	resolver := &mockIPResolver{cached: "1.1.1.1", actual: "1.1.1.1"}
+	location := &mockLocationRefresher{}
+	handler := NewNetworkChangeHandler(services, resolver, location)
+
+	handler.Handle(netmon.AppEventNetworkChange{Changes: []netmon.Change{netmon.ChangeRoute}})
+	assert.Empty(t, services.relocated)
+	assert.Equal(t, 0, location.refreshed)
+
+	resolver.actual = "2.2.2.2"
+	handler.Handle(netmon.AppEventNetworkChange{Changes: []netmon.Change{netmon.ChangeAddress}})
+	assert.Equal(t, map[ID]market.Location{"running": {Country: "DE", ASN: 123}}, services.relocated)
+	assert.Empty(t, services.restarted)
+	assert.Equal(t, 1, location.refreshed)
+}
+
+func TestNetworkChangeHandler_RestartsServicesWithStaticLocation(t *testing.T) {
+	services := &mockAnnouncer{
+		instances: map[ID]*Instance{
+			"static":      {state: servicestate.Running},
+			"relocatable": {state: servicestate.Running},
+		},
+		static: map[ID]bool{"static": true},
+	}
+	resolver := &mockIPResolver{cached: "1.1.1.1", actual: "2.2.2.2"}
+	handler := NewNetworkChangeHandler(services, resolver, &mockLocationRefresher{})
+
+	handler.Handle(netmon.AppEventNetworkChange{Changes: []netmon.Change{netmon.ChangeAddress}})
+	assert.Equal(t, []ID{"static"}, services.restarted)
+	assert.Contains(t, services.relocated, ID("relocatable"))
+}
+
+func TestNetworkChangeHandler_ComparesWithAnnouncedIPWhenCacheExpired(t *testing.T) {
+	services := &mockAnnouncer{instances: map[ID]*Instance{
+		"running": {state: servicestate.Running},
+	}}
+	resolver := &expiredIPResolver{actual: "1.1.1.1"}
+	location := &mockLocationRefresher{}
+	handler := NewNetworkChangeHandler(services, resolver, location)
+
+	handler.HandleServiceStatus(servicestate.AppEventServiceStatus{ID: "running", Status: string(servicestate.Running)})
+
+	resolver.actual = "2.2.2.2"
+	handler.Handle(netmon.AppEventNetworkChange{Changes: []netmon.Change{netmon.ChangeAddress}})
+	assert.Len(t, services.relocated, 1)
+
+	services.relocated = nil
+	handler.Handle(netmon.AppEventNetworkChange{Changes: []netmon.Change{netmon.ChangeRoute}})
+	assert.Empty(t, services.relocated)
+}
+
+// expiredIPResolver behaves like a cached resolver whose cache has already expired.
+type expiredIPResolver struct {
+	actual string
+}
+
+func (m *expiredIPResolver) GetPublicIP() (string, error) {
+	return m.actual, nil
+}
+
+func (m *expiredIPResolver) ClearCache() {}
@@ -132,7 +132,7 @@ func NewInstance(
 		ProviderID: providerID,
 		Type:       serviceType,
 		Options:    options,
-		Proposal:   proposal,
+		proposal:   proposal,
 		state:      state,
 		service:    service,
 		policies:   policies,
@@ -149,7 +149,8 @@ type Instance struct {
 	Type            string
 	Options         Options
 	service         Service
-	Proposal        market.ServiceProposal
+	proposal        market.ServiceProposal
+	proposalLock    sync.RWMutex
 	policies        *policy.Repository
 	discovery       Discovery
 	eventPublisher  Publisher
@@ -162,6 +163,44 @@ func (i *Instance) Service() Service {
 	return i.service
 }
 
+// CopyProposal returns a copy of the currently announced service proposal.
+func (i *Instance) CopyProposal() market.ServiceProposal {
+	i.proposalLock.RLock()
+	defer i.proposalLock.RUnlock()
+	return i.proposal
+}
+
+func (i *Instance) setPaymentMethod(pm market.PaymentMethod) market.ServiceProposal {
+	i.proposalLock.Lock()
+	i.proposal.SetPaymentMethod(pm)
+	proposal := i.proposal
+	i.proposalLock.Unlock()
+
+	if i.eventPublisher != nil {
+		i.stateLock.RLock()
+		defer i.stateLock.RUnlock()
+		i.eventPublisher.Publish(servicestate.AppTopicServiceProposal, i.toEvent())
+	}
+	return proposal
+}
+
+func (i *Instance) setLocation(loc market.Location) (market.ServiceProposal, bool) {
+	i.proposalLock.Lock()
+	definition, ok := i.proposal.ServiceDefinition.(market.RelocatableServiceDefinition)
+	if ok {
+		i.proposal.ServiceDefinition = definition.WithLocation(loc)
+	}
+	proposal := i.proposal
+	i.proposalLock.Unlock()
+
+	if ok && i.eventPublisher != nil {
+		i.stateLock.RLock()
+		defer i.stateLock.RUnlock()
+		i.eventPublisher.Publish(servicestate.AppTopicServiceProposal, i.toEvent())
+	}
+	return proposal, ok
+}
+
 // Policies returns service policies of the running service instance.
 func (i *Instance) Policies() *policy.Repository {
 	return i.policies
@@ -226,10 +265,11 @@ func (i *Instance) stop() error {
 
 // toEvent returns an event representation of the instance
 func (i *Instance) toEvent() servicestate.AppEventServiceStatus {
+	proposal := i.CopyProposal()
 	return servicestate.AppEventServiceStatus{
 		ID:         string(i.ID),
-		ProviderID: i.Proposal.ProviderID,
-		Type:       i.Proposal.ServiceType,
+		ProviderID: proposal.ProviderID,
+		Type:       proposal.ServiceType,
 		Status:     string(i.state),
 	}
 }
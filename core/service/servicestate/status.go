@@ -20,6 +20,8 @@ package servicestate
 const (
 	// AppTopicServiceStatus is used in event bus to announce the service status.
 	AppTopicServiceStatus = "Service status"
+	// AppTopicServiceProposal is used in event bus to announce changes of the running service proposal, e.g. prices.
+	AppTopicServiceProposal = "Service proposal"
 )
 
 // AppEventServiceStatus represents the service event related information
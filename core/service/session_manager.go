@@ -47,6 +47,8 @@ var (
 	ErrorSessionNotExists = errors.New("session does not exists")
 	// ErrorWrongSessionOwner returned when consumer tries to destroy session that does not belongs to him
 	ErrorWrongSessionOwner = errors.New("wrong session owner")
+	// ErrorHermesNotAccepted returned when consumer wants to pay through Hermes provider does not accept
+	ErrorHermesNotAccepted = errors.New("hermes is not accepted by provider")
 )
 
 // IDGenerator defines method for session id generation
@@ -221,10 +223,15 @@ func (manager *SessionManager) startSession(session *Session) error {
 }
 
 func (manager *SessionManager) validateSession(session *Session) error {
-	if manager.service.Proposal.ID != int(session.request.GetProposalID()) {
+	proposal := manager.service.CopyProposal()
+	if proposal.ID != int(session.request.GetProposalID()) {
 		return ErrorInvalidProposal
 	}
 
+	if !proposal.AcceptsHermes(session.HermesID.Hex()) {
+		return ErrorHermesNotAccepted
+	}
+
 	if !manager.service.Policies().IsIdentityAllowed(session.ConsumerID) {
 		return fmt.Errorf("consumer identity is not allowed: %s", session.ConsumerID.Address)
 	}
@@ -356,6 +363,10 @@ func (manager *SessionManager) keepAliveLoop(sess *Session, channel p2p.Channel)
 			} else {
 				errCount = 0
 			}
+			manager.publisher.Publish(p2p.AppTopicChannelStats, p2p.AppEventChannelStats{
+				SessionID: string(sess.ID),
+				Stats:     channel.Stats(),
+			})
 		}
 	}
 }
@@ -95,6 +95,10 @@ func (m *mockP2PChannel) ServiceConn() *net.UDPConn { return nil }
 
 func (m *mockP2PChannel) Conn() *net.UDPConn { return nil }
 
+func (m *mockP2PChannel) Rebind() error { return nil }
+
+func (m *mockP2PChannel) Stats() p2p.ChannelStats { return p2p.ChannelStats{} }
+
 func (m *mockP2PChannel) Close() error { return nil }
 
 func TestManager_Start_StoresSession(t *testing.T) {
@@ -272,6 +276,34 @@ func TestManager_Start_RejectsUnknownProposal(t *testing.T) {
 	}, 2*time.Second, 10*time.Millisecond)
 }
 
+func TestManager_Start_RejectsNotAcceptedHermes(t *testing.T) {
+	proposal := currentProposal
+	proposal.SetAcceptedHermes([]string{"0x0000000000000000000000000000000000000002"})
+	service := NewInstance(
+		identity.FromAddress(proposal.ProviderID),
+		proposal.ServiceType,
+		struct{}{},
+		proposal,
+		servicestate.Running,
+		&mockService{},
+		policy.NewRepository(),
+		&mockDiscovery{},
+	)
+	sessionStore := NewSessionPool(mocks.NewEventBus())
+	manager := newManager(service, sessionStore, mocks.NewEventBus(), &mockBalanceTracker{})
+
+	_, err := manager.Start(&pb.SessionRequest{
+		Consumer: &pb.ConsumerInfo{
+			Id:       consumerID.Address,
+			HermesID: hermesID.String(),
+		},
+		ProposalID: int64(currentProposalID),
+	})
+
+	assert.Exactly(t, err, ErrorHermesNotAccepted)
+	assert.Len(t, sessionStore.GetAll(), 0)
+}
+
 type MockNatEventTracker struct {
 }
 
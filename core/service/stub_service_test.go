@@ -57,7 +57,8 @@ func (service *serviceFake) ProvideConfig(_ string, _ json.RawMessage, _ *net.UD
 }
 
 type mockDiscovery struct {
-	wg sync.WaitGroup
+	wg       sync.WaitGroup
+	proposal market.ServiceProposal
 }
 
 func (mds *mockDiscovery) Start(ownIdentity identity.Identity, proposal market.ServiceProposal) {
@@ -71,6 +72,10 @@ func (mds *mockDiscovery) Wait() {
 	mds.wg.Wait()
 }
 
+func (mds *mockDiscovery) UpdateProposal(proposal market.ServiceProposal) {
+	mds.proposal = proposal
+}
+
 // MockDiscoveryFactoryFunc returns a discovery factory which in turn returns the discovery service.
 func MockDiscoveryFactoryFunc(ds Discovery) DiscoveryFactory {
 	return func() Discovery {
@@ -0,0 +1,84 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package speedtest
+
+import (
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/p2p"
+	"github.com/mysteriumnetwork/node/pb"
+)
+
+// MaxChannelBytes limits the amount of speed test data provider sends and receives over a single p2p channel.
+const MaxChannelBytes = 64 * 1024 * 1024
+
+// Serve registers speed test handler on the given provider p2p channel.
+func Serve(ch p2p.ChannelHandler) {
+	r := &responder{budget: MaxChannelBytes}
+	ch.Handle(p2p.TopicSpeedTest, r.handle)
+}
+
+type responder struct {
+	mu     sync.Mutex
+	budget int64
+	chunk  []byte
+}
+
+func (r *responder) handle(c p2p.Context) error {
+	var req pb.SpeedTestRequest
+	if err := c.Request().UnmarshalProto(&req); err != nil {
+		return err
+	}
+
+	size := req.GetDownloadSize()
+	if size > MaxChunkSize {
+		size = MaxChunkSize
+	}
+	if size < 0 {
+		size = 0
+	}
+
+	data := r.take(int64(len(req.GetData())), size)
+	if int64(len(data)) < size {
+		log.Warn().Msgf("Speed test budget exhausted for session %s", req.GetSessionID())
+	}
+
+	return c.OkWithReply(p2p.ProtoMessage(&pb.SpeedTestResponse{Data: data}))
+}
+
+// take accounts received bytes and returns up to size bytes of reply data which fit into channel budget.
+func (r *responder) take(received, size int64) []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.budget -= received
+	if size > r.budget {
+		size = r.budget
+	}
+	if size <= 0 {
+		return nil
+	}
+	r.budget -= size
+
+	if int64(len(r.chunk)) < size {
+		r.chunk = make([]byte, MaxChunkSize)
+	}
+	return r.chunk[:size]
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package speedtest
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mysteriumnetwork/node/p2p"
+	"github.com/mysteriumnetwork/node/pb"
+)
+
+// AppTopicSpeedTest represents speed test result topic.
+const AppTopicSpeedTest = "speed-test"
+
+// AppEventSpeedTest is published when speed test to provider completes.
+type AppEventSpeedTest struct {
+	Result Result
+}
+
+const (
+	// ChunkSize is the size of data sent in a single speed test request or reply.
+	ChunkSize = 64 * 1024
+	// MaxChunkSize is the max download size provider replies with to a single request.
+	MaxChunkSize = 1024 * 1024
+)
+
+// Result holds speed test measurements to the provider.
+// Measurements are taken over the p2p channel, not through the VPN tunnel.
+type Result struct {
+	ID          int    `storm:"id,increment"`
+	ProviderID  string `storm:"index"`
+	ServiceType string
+	SessionID   string
+
+	// Upload is consumer to provider throughput in bits per second.
+	Upload uint64
+	// Download is provider to consumer throughput in bits per second.
+	Download uint64
+	// Latency is round trip time of empty speed test request.
+	Latency time.Duration
+
+	TestedAt time.Time
+}
+
+// Config describes the amount of data transferred during speed test.
+type Config struct {
+	UploadBytes   int64
+	DownloadBytes int64
+	Timeout       time.Duration
+}
+
+// DefaultConfig returns default speed test config.
+func DefaultConfig() Config {
+	return Config{
+		UploadBytes:   4 * 1024 * 1024,
+		DownloadBytes: 4 * 1024 * 1024,
+		Timeout:       30 * time.Second,
+	}
+}
+
+// Run measures latency, upload and download throughput over given p2p channel.
+func Run(ctx context.Context, ch p2p.ChannelSender, sessionID string, config Config) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.Timeout)
+	defer cancel()
+
+	res := Result{SessionID: sessionID, TestedAt: time.Now().UTC()}
+
+	latency, err := measure(func() error {
+		return send(ctx, ch, &pb.SpeedTestRequest{SessionID: sessionID}, nil)
+	})
+	if err != nil {
+		return res, fmt.Errorf("could not measure latency: %w", err)
+	}
+	res.Latency = latency
+
+	chunk := make([]byte, ChunkSize)
+	if _, err := rand.Read(chunk); err != nil {
+		return res, fmt.Errorf("could not generate speed test data: %w", err)
+	}
+
+	var sent int64
+	upload, err := measure(func() error {
+		for sent < config.UploadBytes {
+			if err := send(ctx, ch, &pb.SpeedTestRequest{SessionID: sessionID, Data: chunk}, nil); err != nil {
+				return err
+			}
+			sent += int64(len(chunk))
+		}
+		return nil
+	})
+	if err != nil {
+		return res, fmt.Errorf("could not measure upload: %w", err)
+	}
+	res.Upload = bitsPerSecond(sent, upload)
+
+	var received int64
+	download, err := measure(func() error {
+		for received < config.DownloadBytes {
+			var reply pb.SpeedTestResponse
+			size := config.DownloadBytes - received
+			if size > MaxChunkSize {
+				size = MaxChunkSize
+			}
+			if err := send(ctx, ch, &pb.SpeedTestRequest{SessionID: sessionID, DownloadSize: size}, &reply); err != nil {
+				return err
+			}
+			if len(reply.Data) == 0 {
+				return errors.New("provider refused to send more speed test data")
+			}
+			received += int64(len(reply.Data))
+		}
+		return nil
+	})
+	if err != nil {
+		return res, fmt.Errorf("could not measure download: %w", err)
+	}
+	res.Download = bitsPerSecond(received, download)
+
+	return res, nil
+}
+
+func measure(fn func() error) (time.Duration, error) {
+	start := time.Now()
+	if err := fn(); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+func send(ctx context.Context, ch p2p.ChannelSender, req *pb.SpeedTestRequest, reply *pb.SpeedTestResponse) error {
+	msg, err := ch.Send(ctx, p2p.TopicSpeedTest, p2p.ProtoMessage(req))
+	if err != nil {
+		return err
+	}
+	if reply == nil {
+		return nil
+	}
+	return msg.UnmarshalProto(reply)
+}
+
+func bitsPerSecond(bytes int64, elapsed time.Duration) uint64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return uint64(float64(bytes*8) / elapsed.Seconds())
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package speedtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mysteriumnetwork/node/p2p"
+)
+
+type mockContext struct {
+	req *p2p.Message
+	res *p2p.Message
+}
+
+func (m *mockContext) Request() *p2p.Message { return m.req }
+
+func (m *mockContext) Error(err error) error { return err }
+
+func (m *mockContext) OkWithReply(msg *p2p.Message) error {
+	m.res = msg
+	return nil
+}
+
+func (m *mockContext) OK() error { return nil }
+
+// loopbackChannel passes sent messages directly to registered handlers.
+type loopbackChannel struct {
+	handlers map[string]p2p.HandlerFunc
+}
+
+func (l *loopbackChannel) Handle(topic string, handler p2p.HandlerFunc) {
+	l.handlers[topic] = handler
+}
+
+func (l *loopbackChannel) Send(_ context.Context, topic string, msg *p2p.Message) (*p2p.Message, error) {
+	c := &mockContext{req: msg, res: &p2p.Message{}}
+	if err := l.handlers[topic](c); err != nil {
+		return nil, err
+	}
+	return c.res, nil
+}
+
+func TestRun(t *testing.T) {
+	ch := &loopbackChannel{handlers: map[string]p2p.HandlerFunc{}}
+	Serve(ch)
+
+	res, err := Run(context.Background(), ch, "session", Config{
+		UploadBytes:   ChunkSize * 2,
+		DownloadBytes: MaxChunkSize + ChunkSize,
+		Timeout:       time.Second,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "session", res.SessionID)
+	assert.NotZero(t, res.Upload)
+	assert.NotZero(t, res.Download)
+	assert.False(t, res.TestedAt.IsZero())
+}
+
+func TestRun_BudgetExhausted(t *testing.T) {
+	ch := &loopbackChannel{handlers: map[string]p2p.HandlerFunc{}}
+	Serve(ch)
+
+	_, err := Run(context.Background(), ch, "session", Config{
+		DownloadBytes: MaxChannelBytes + 1,
+		Timeout:       time.Second,
+	})
+	assert.EqualError(t, err, "could not measure download: provider refused to send more speed test data")
+}
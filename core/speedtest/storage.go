@@ -0,0 +1,156 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package speedtest
+
+import (
+	"errors"
+
+	"github.com/asdine/storm/v3"
+	"github.com/asdine/storm/v3/q"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/eventbus"
+)
+
+const (
+	speedTestStorageBucketName = "speed-test-results"
+	latestSpeedTestBucketName  = "speed-test-latest"
+	// resultsPerProvider is how many latest results are kept per provider and service type.
+	resultsPerProvider = 10
+)
+
+// latestResult indexes the latest result of provider and service type.
+type latestResult struct {
+	Key    string `storm:"id"`
+	Result Result
+}
+
+func latestKey(r Result) string {
+	return r.ProviderID + r.ServiceType
+}
+
+// Storage keeps speed test results per provider.
+type Storage struct {
+	storage *boltdb.Bolt
+}
+
+// NewStorage returns a new speed test results storage.
+func NewStorage(storage *boltdb.Bolt) *Storage {
+	return &Storage{storage: storage}
+}
+
+// Subscribe subscribes to speed test results to store them.
+func (s *Storage) Subscribe(bus eventbus.Subscriber) error {
+	return bus.SubscribeAsync(AppTopicSpeedTest, s.consumeSpeedTestEvent)
+}
+
+func (s *Storage) consumeSpeedTestEvent(e AppEventSpeedTest) {
+	if err := s.Store(e.Result); err != nil {
+		log.Err(err).Msgf("Could not store speed test result for provider %s", e.Result.ProviderID)
+	}
+}
+
+// Store stores the speed test result. Only the latest results of provider and service type are kept.
+func (s *Storage) Store(result Result) error {
+	result.ID = 0
+	if err := s.storage.Store(speedTestStorageBucketName, &result); err != nil {
+		return err
+	}
+
+	var latest latestResult
+	err := s.storage.DB().From(latestSpeedTestBucketName).One("Key", latestKey(result), &latest)
+	if err != nil && !errors.Is(err, storm.ErrNotFound) {
+		return err
+	}
+	if err != nil || !latest.Result.TestedAt.After(result.TestedAt) {
+		latest = latestResult{Key: latestKey(result), Result: result}
+		if err := s.storage.Store(latestSpeedTestBucketName, &latest); err != nil {
+			return err
+		}
+	}
+
+	err = s.storage.DB().
+		From(speedTestStorageBucketName).
+		Select(q.Eq("ProviderID", result.ProviderID), q.Eq("ServiceType", result.ServiceType)).
+		OrderBy("TestedAt").
+		Reverse().
+		Skip(resultsPerProvider).
+		Delete(new(Result))
+	if errors.Is(err, storm.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// List returns speed test results, newest first. If providerID is not empty, only results of the provider are returned.
+func (s *Storage) List(providerID string) ([]Result, error) {
+	var matchers []q.Matcher
+	if providerID != "" {
+		matchers = append(matchers, q.Eq("ProviderID", providerID))
+	}
+
+	var results []Result
+	err := s.storage.DB().
+		From(speedTestStorageBucketName).
+		Select(matchers...).
+		OrderBy("TestedAt").
+		Reverse().
+		Find(&results)
+	if errors.Is(err, storm.ErrNotFound) {
+		return []Result{}, nil
+	}
+	return results, err
+}
+
+// Latest returns the latest speed test result of every provider and service type.
+func (s *Storage) Latest() (map[string]Result, error) {
+	var records []latestResult
+	if err := s.storage.GetAllFrom(latestSpeedTestBucketName, &records); err != nil && !errors.Is(err, storm.ErrNotFound) {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return s.indexLatest()
+	}
+
+	latest := make(map[string]Result, len(records))
+	for _, r := range records {
+		latest[r.Key] = r.Result
+	}
+	return latest, nil
+}
+
+// indexLatest builds the latest result index from results stored before the index existed.
+func (s *Storage) indexLatest() (map[string]Result, error) {
+	results, err := s.List("")
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]Result)
+	for _, r := range results {
+		if _, ok := latest[latestKey(r)]; ok {
+			continue
+		}
+		latest[latestKey(r)] = r
+		if err := s.storage.Store(latestSpeedTestBucketName, &latestResult{Key: latestKey(r), Result: r}); err != nil {
+			return nil, err
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package speedtest
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+)
+
+func TestStorage_ListAndLatest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "speedTestStorageTest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := boltdb.NewStorage(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	storage := NewStorage(db)
+	now := time.Now().UTC()
+	require.NoError(t, storage.Store(Result{ProviderID: "p1", ServiceType: "wireguard", Download: 1, TestedAt: now.Add(-time.Hour)}))
+	require.NoError(t, storage.Store(Result{ProviderID: "p1", ServiceType: "wireguard", Download: 2, TestedAt: now}))
+	require.NoError(t, storage.Store(Result{ProviderID: "p2", ServiceType: "wireguard", Download: 3, TestedAt: now}))
+
+	results, err := storage.List("p1")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, uint64(2), results[0].Download)
+	assert.Equal(t, uint64(1), results[1].Download)
+
+	results, err = storage.List("p3")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	latest, err := storage.Latest()
+	require.NoError(t, err)
+	assert.Len(t, latest, 2)
+	assert.Equal(t, uint64(2), latest["p1wireguard"].Download)
+	assert.Equal(t, uint64(3), latest["p2wireguard"].Download)
+}
+
+func TestStorage_KeepsLatestResultsPerProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "speedTestStorageTest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := boltdb.NewStorage(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	storage := NewStorage(db)
+	now := time.Now().UTC()
+	for i := 0; i < resultsPerProvider+5; i++ {
+		require.NoError(t, storage.Store(Result{ProviderID: "p1", ServiceType: "wireguard", Download: uint64(i), TestedAt: now.Add(time.Duration(i) * time.Minute)}))
+	}
+	require.NoError(t, storage.Store(Result{ProviderID: "p1", ServiceType: "openvpn", Download: 100, TestedAt: now}))
+	// Results arriving late do not replace the latest one.
+	require.NoError(t, storage.Store(Result{ProviderID: "p1", ServiceType: "wireguard", Download: 200, TestedAt: now.Add(-time.Hour)}))
+
+	results, err := storage.List("p1")
+	require.NoError(t, err)
+	assert.Len(t, results, resultsPerProvider+1)
+	assert.Equal(t, uint64(resultsPerProvider+4), results[0].Download)
+
+	latest, err := storage.Latest()
+	require.NoError(t, err)
+	assert.Len(t, latest, 2)
+	assert.Equal(t, uint64(resultsPerProvider+4), latest["p1wireguard"].Download)
+	assert.Equal(t, uint64(100), latest["p1openvpn"].Download)
+}
+
+func TestStorage_LatestIndexesResultsStoredBeforeIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "speedTestStorageTest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := boltdb.NewStorage(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now().UTC()
+	require.NoError(t, db.Store(speedTestStorageBucketName, &Result{ProviderID: "p1", ServiceType: "wireguard", Download: 1, TestedAt: now.Add(-time.Hour)}))
+	require.NoError(t, db.Store(speedTestStorageBucketName, &Result{ProviderID: "p1", ServiceType: "wireguard", Download: 2, TestedAt: now}))
+
+	storage := NewStorage(db)
+	latest, err := storage.Latest()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), latest["p1wireguard"].Download)
+
+	var records []latestResult
+	require.NoError(t, db.GetAllFrom(latestSpeedTestBucketName, &records))
+	assert.Len(t, records, 1)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/mysteriumnetwork/node/datasize"
 	"github.com/mysteriumnetwork/node/identity/registry"
 	"github.com/mysteriumnetwork/node/money"
+	"github.com/mysteriumnetwork/node/p2p"
 	"github.com/mysteriumnetwork/node/session/pingpong"
 	"github.com/mysteriumnetwork/node/tequilapi/contract"
 	"github.com/mysteriumnetwork/payments/crypto"
@@ -59,10 +60,12 @@ type Identity struct {
 
 // Connection represents consumer connection state.
 type Connection struct {
-	Session    connectionstate.Status
-	Statistics connectionstate.Statistics
-	Throughput bandwidth.Throughput
-	Invoice    crypto.Invoice
+	Session      connectionstate.Status
+	Statistics   connectionstate.Statistics
+	Throughput   bandwidth.Throughput
+	Invoice      crypto.Invoice
+	ChannelStats p2p.ChannelStats
+	Quality      connectionstate.Quality
 }
 
 func (c Connection) String() string {
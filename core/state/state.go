@@ -35,6 +35,7 @@ import (
 	"github.com/mysteriumnetwork/node/identity/registry"
 	"github.com/mysteriumnetwork/node/nat"
 	natEvent "github.com/mysteriumnetwork/node/nat/event"
+	"github.com/mysteriumnetwork/node/p2p"
 	nodeSession "github.com/mysteriumnetwork/node/session"
 	sevent "github.com/mysteriumnetwork/node/session/event"
 	"github.com/mysteriumnetwork/node/session/pingpong"
@@ -92,6 +93,7 @@ type Keeper struct {
 	consumeConnectionStatisticsEvent func(interface{})
 	consumeConnectionThroughputEvent func(interface{})
 	consumeConnectionSpendingEvent   func(interface{})
+	consumeConnectionQualityEvent    func(interface{})
 
 	announceStateChanges func(e interface{})
 }
@@ -138,6 +140,7 @@ func NewKeeper(deps KeeperDeps, debounceDuration time.Duration) *Keeper {
 	k.consumeConnectionStatisticsEvent = debounce(k.updateConnectionStats, debounceDuration)
 	k.consumeConnectionThroughputEvent = debounce(k.updateConnectionThroughput, debounceDuration)
 	k.consumeConnectionSpendingEvent = debounce(k.updateConnectionSpending, debounceDuration)
+	k.consumeConnectionQualityEvent = debounce(k.updateConnectionQuality, debounceDuration)
 	k.announceStateChanges = debounce(k.announceState, debounceDuration)
 
 	return k
@@ -177,6 +180,9 @@ func (k *Keeper) Subscribe(bus eventbus.Subscriber) error {
 	if err := bus.SubscribeAsync(servicestate.AppTopicServiceStatus, k.consumeServiceStateEvent); err != nil {
 		return err
 	}
+	if err := bus.SubscribeAsync(servicestate.AppTopicServiceProposal, k.consumeServiceStateEvent); err != nil {
+		return err
+	}
 	if err := bus.SubscribeAsync(sevent.AppTopicSession, k.consumeServiceSessionEvent); err != nil {
 		return err
 	}
@@ -186,6 +192,9 @@ func (k *Keeper) Subscribe(bus eventbus.Subscriber) error {
 	if err := bus.SubscribeAsync(sevent.AppTopicTokensEarned, k.consumeServiceSessionEarningsEvent); err != nil {
 		return err
 	}
+	if err := bus.SubscribeAsync(p2p.AppTopicChannelStats, k.consumeChannelStatsEvent); err != nil {
+		return err
+	}
 	if err := bus.SubscribeAsync(natEvent.AppTopicTraversal, k.consumeNATEvent); err != nil {
 		return err
 	}
@@ -198,6 +207,9 @@ func (k *Keeper) Subscribe(bus eventbus.Subscriber) error {
 	if err := bus.SubscribeAsync(bandwidth.AppTopicConnectionThroughput, k.consumeConnectionThroughputEvent); err != nil {
 		return err
 	}
+	if err := bus.SubscribeAsync(connectionstate.AppTopicConnectionQuality, k.consumeConnectionQualityEvent); err != nil {
+		return err
+	}
 	if err := bus.SubscribeAsync(pingpongEvent.AppTopicInvoicePaid, k.consumeConnectionSpendingEvent); err != nil {
 		return err
 	}
@@ -244,7 +256,7 @@ func (k *Keeper) updateServices() {
 			Type:                 v.Type,
 			Options:              v.Options,
 			Status:               string(v.State()),
-			Proposal:             contract.NewProposalDTO(v.Proposal),
+			Proposal:             contract.NewProposalDTO(v.CopyProposal()),
 			ConnectionStatistics: match.ConnectionStatistics,
 		}
 		i++
@@ -388,6 +400,28 @@ func (k *Keeper) updateSessionEarnings(e interface{}) {
 	go k.announceStateChanges(nil)
 }
 
+// consumeChannelStatsEvent updates p2p channel stats of consumer connection or provided session.
+func (k *Keeper) consumeChannelStatsEvent(evt p2p.AppEventChannelStats) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if string(k.state.Connection.Session.SessionID) == evt.SessionID {
+		k.state.Connection.ChannelStats = evt.Stats
+		go k.announceStateChanges(nil)
+		return
+	}
+
+	for i := range k.state.Sessions {
+		if string(k.state.Sessions[i].SessionID) == evt.SessionID {
+			stats := evt.Stats
+			k.state.Sessions[i].ChannelStats = &stats
+			go k.announceStateChanges(nil)
+			return
+		}
+	}
+	log.Debug().Msgf("Couldn't find a matching session for channel stats change: %s", evt.SessionID)
+}
+
 func (k *Keeper) consumeConnectionStateEvent(e interface{}) {
 	k.lock.Lock()
 	defer k.lock.Unlock()
@@ -434,6 +468,20 @@ func (k *Keeper) updateConnectionThroughput(e interface{}) {
 	go k.announceStateChanges(nil)
 }
 
+func (k *Keeper) updateConnectionQuality(e interface{}) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	evt, ok := e.(connectionstate.AppEventConnectionQuality)
+	if !ok {
+		log.Warn().Msg("Received a wrong kind of event for connection quality update")
+		return
+	}
+
+	k.state.Connection.Quality = evt.Quality
+
+	go k.announceStateChanges(nil)
+}
+
 func (k *Keeper) updateConnectionSpending(e interface{}) {
 	k.lock.Lock()
 	defer k.lock.Unlock()
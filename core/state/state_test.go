@@ -37,6 +37,7 @@ import (
 	"github.com/mysteriumnetwork/node/mocks"
 	"github.com/mysteriumnetwork/node/nat"
 	natEvent "github.com/mysteriumnetwork/node/nat/event"
+	"github.com/mysteriumnetwork/node/p2p"
 	nodeSession "github.com/mysteriumnetwork/node/session"
 	sessionEvent "github.com/mysteriumnetwork/node/session/event"
 	"github.com/mysteriumnetwork/node/session/pingpong"
@@ -333,6 +334,39 @@ func Test_consumeServiceSessionStatisticsEvent(t *testing.T) {
 	)
 }
 
+func Test_ConsumesChannelStatsEvents(t *testing.T) {
+	// given
+	eventBus := eventbus.New()
+	deps := KeeperDeps{
+		NATStatusProvider: &natStatusProviderMock{statusToReturn: mockNATStatus},
+		Publisher:         eventBus,
+		ServiceLister:     &serviceListerMock{},
+		IdentityProvider:  &mocks.IdentityProvider{},
+		EarningsProvider:  &mockEarningsProvider{},
+	}
+	keeper := NewKeeper(deps, time.Millisecond)
+	err := keeper.Subscribe(eventBus)
+	assert.NoError(t, err)
+	keeper.state.Connection.Session = connectionstate.Status{State: connectionstate.Connected, SessionID: "1"}
+	keeper.state.Sessions = []session.History{
+		{SessionID: nodeSession.ID("2")},
+	}
+	consumerStats := p2p.ChannelStats{RTT: 30 * time.Millisecond, RTTVar: 5 * time.Millisecond}
+	providerStats := p2p.ChannelStats{RTT: 40 * time.Millisecond, NodeTransportRetransmits: 3}
+
+	// when
+	eventBus.Publish(p2p.AppTopicChannelStats, p2p.AppEventChannelStats{SessionID: "3", Stats: p2p.ChannelStats{RTT: time.Second}})
+	eventBus.Publish(p2p.AppTopicChannelStats, p2p.AppEventChannelStats{SessionID: "1", Stats: consumerStats})
+	eventBus.Publish(p2p.AppTopicChannelStats, p2p.AppEventChannelStats{SessionID: "2", Stats: providerStats})
+
+	// then
+	assert.Eventually(t, func() bool {
+		state := keeper.GetState()
+		return state.Connection.ChannelStats == consumerStats &&
+			state.Sessions[0].ChannelStats != nil && *state.Sessions[0].ChannelStats == providerStats
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
 func Test_ConsumesServiceEvents(t *testing.T) {
 	expected := service.Instance{}
 	var id service.ID
@@ -370,7 +404,7 @@ func Test_ConsumesServiceEvents(t *testing.T) {
 	assert.Equal(t, expected.ProviderID.Address, actual.ProviderID)
 	assert.Equal(t, expected.Options, actual.Options)
 	assert.Equal(t, string(expected.State()), actual.Status)
-	assert.EqualValues(t, contract.NewProposalDTO(expected.Proposal), actual.Proposal)
+	assert.EqualValues(t, contract.NewProposalDTO(expected.CopyProposal()), actual.Proposal)
 }
 
 func Test_ConsumesConnectionStateEvents(t *testing.T) {
@@ -0,0 +1,388 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/eventbus"
+	"github.com/mysteriumnetwork/node/identity/registry"
+	natEvent "github.com/mysteriumnetwork/node/nat/event"
+	pingpongEvent "github.com/mysteriumnetwork/node/session/pingpong/event"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+)
+
+// Config configures delivery of notifications.
+type Config struct {
+	// MaxAttempts is the number of delivery attempts before giving up.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubled after every failed attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the delay between attempts.
+	MaxBackoff time.Duration
+	// Timeout limits a single delivery attempt.
+	Timeout time.Duration
+	// HistorySize is the number of most recent deliveries kept in history.
+	HistorySize int
+	// Concurrency limits simultaneous delivery attempts.
+	Concurrency int
+	// QueueSize limits pending deliveries per webhook, further notifications are dropped.
+	QueueSize int
+}
+
+// DefaultConfig returns default delivery configuration.
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts:    6,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     5 * time.Minute,
+		Timeout:        10 * time.Second,
+		HistorySize:    1000,
+		Concurrency:    8,
+		QueueSize:      100,
+	}
+}
+
+// Notification is the JSON body of webhook requests.
+type Notification struct {
+	ID        string      `json:"id"`
+	Topic     Topic       `json:"topic"`
+	CreatedAt time.Time   `json:"created_at"`
+	Payload   interface{} `json:"payload"`
+}
+
+// Dispatcher manages webhooks and delivers notifications about node events to them.
+type Dispatcher struct {
+	storage   storage
+	client    *http.Client
+	config    Config
+	history   *history
+	semaphore chan struct{}
+	queueLock sync.Mutex
+	queues    map[string]chan job
+	stop      chan struct{}
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+	now       func() time.Time
+}
+
+// job is a pending delivery of a notification to a webhook.
+type job struct {
+	deliveryID string
+	topic      Topic
+	body       []byte
+}
+
+// NewDispatcher returns a new instance of Dispatcher.
+func NewDispatcher(storage storage, config Config) *Dispatcher {
+	if config.Concurrency < 1 {
+		config.Concurrency = 1
+	}
+	if config.QueueSize < 1 {
+		config.QueueSize = 1
+	}
+	return &Dispatcher{
+		storage:   storage,
+		client:    &http.Client{Timeout: config.Timeout},
+		config:    config,
+		history:   newHistory(config.HistorySize),
+		semaphore: make(chan struct{}, config.Concurrency),
+		queues:    make(map[string]chan job),
+		stop:      make(chan struct{}),
+		now:       time.Now,
+	}
+}
+
+// Subscribe subscribes to the event bus, notifying webhooks about events of their topics.
+func (d *Dispatcher) Subscribe(bus eventbus.Subscriber) error {
+	subscriptions := map[string]interface{}{
+		connectionstate.AppTopicConnectionState: func(e connectionstate.AppEventConnectionState) {
+			d.Notify(TopicConnectionState, contract.NewConnectionStateEventDTO(e))
+		},
+		pingpongEvent.AppTopicBalanceChanged: func(e pingpongEvent.AppEventBalanceChanged) {
+			d.Notify(TopicBalance, contract.NewBalanceEventDTO(e))
+		},
+		pingpongEvent.AppTopicSettlementComplete: func(e pingpongEvent.AppEventSettlementComplete) {
+			d.Notify(TopicSettlement, contract.NewSettlementEventDTO(e))
+		},
+		pingpongEvent.AppTopicSettlementFailed: func(e pingpongEvent.AppEventSettlementFailed) {
+			d.Notify(TopicSettlementFailed, contract.NewSettlementFailedEventDTO(e))
+		},
+		registry.AppTopicIdentityRegistration: func(e registry.AppEventIdentityRegistration) {
+			d.Notify(TopicRegistration, contract.NewRegistrationEventDTO(e))
+		},
+		servicestate.AppTopicServiceStatus: func(e servicestate.AppEventServiceStatus) {
+			d.Notify(TopicServiceStatus, contract.NewServiceStatusEventDTO(e))
+		},
+		natEvent.AppTopicTraversal: func(e natEvent.Event) {
+			if !e.Successful {
+				d.Notify(TopicNATFailure, contract.NewNATEventDTO(e))
+			}
+		},
+	}
+	for topic, fn := range subscriptions {
+		if err := bus.SubscribeAsync(topic, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Notify delivers notification with the given payload to webhooks subscribed to the topic.
+func (d *Dispatcher) Notify(topic Topic, payload interface{}) {
+	webhooks, err := d.List()
+	if err != nil {
+		log.Error().Err(err).Msg("Could not list webhooks")
+		return
+	}
+
+	var subscribed []Webhook
+	for _, w := range webhooks {
+		if w.subscribed(topic) {
+			subscribed = append(subscribed, w)
+		}
+	}
+	if len(subscribed) == 0 {
+		return
+	}
+
+	n, body, err := d.notification(topic, payload)
+	if err != nil {
+		log.Error().Err(err).Msgf("Could not prepare %s webhook notification", topic)
+		return
+	}
+	for _, w := range subscribed {
+		d.deliver(w, n, body)
+	}
+}
+
+// Test sends ping notification to the webhook and returns its delivery.
+func (d *Dispatcher) Test(id string) (Delivery, error) {
+	w, err := d.Get(id)
+	if err != nil {
+		return Delivery{}, err
+	}
+	n, body, err := d.notification(TopicPing, struct{}{})
+	if err != nil {
+		return Delivery{}, err
+	}
+	return d.deliver(w, n, body), nil
+}
+
+// Deliveries returns history of deliveries to the webhook, latest first.
+func (d *Dispatcher) Deliveries(webhookID string) []Delivery {
+	return d.history.list(webhookID)
+}
+
+// Stop stops retrying pending deliveries and waits for attempts in progress.
+func (d *Dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.stop)
+	})
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) notification(topic Topic, payload interface{}) (Notification, []byte, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return Notification{}, nil, errors.Wrap(err, "failed to generate notification ID")
+	}
+	n := Notification{
+		ID:        id.String(),
+		Topic:     topic,
+		CreatedAt: d.now().UTC(),
+		Payload:   payload,
+	}
+	body, err := json.Marshal(n)
+	if err != nil {
+		return Notification{}, nil, errors.Wrap(err, "failed to encode notification")
+	}
+	return n, body, nil
+}
+
+func (d *Dispatcher) deliver(w Webhook, n Notification, body []byte) Delivery {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to generate delivery ID")
+		return Delivery{}
+	}
+	delivery := d.history.add(Delivery{
+		ID:             id.String(),
+		WebhookID:      w.ID,
+		NotificationID: n.ID,
+		Topic:          n.Topic,
+		URL:            w.URL,
+		Status:         DeliveryPending,
+		CreatedAt:      n.CreatedAt,
+	})
+
+	if !d.enqueue(w.ID, job{deliveryID: delivery.ID, topic: n.Topic, body: body}) {
+		reason := "delivery queue is full"
+		d.history.finish(delivery.ID, DeliveryFailed, reason)
+		log.Warn().Msgf("Webhook %s delivery queue is full, dropping %s notification", w.ID, n.Topic)
+		delivery.Status, delivery.Error = DeliveryFailed, reason
+	}
+	return delivery
+}
+
+// enqueue queues the job for the webhook worker, starting one if the webhook has none.
+// It returns false if the webhook queue is full.
+func (d *Dispatcher) enqueue(webhookID string, j job) bool {
+	d.queueLock.Lock()
+	defer d.queueLock.Unlock()
+
+	queue, ok := d.queues[webhookID]
+	if !ok {
+		queue = make(chan job, d.config.QueueSize)
+		d.queues[webhookID] = queue
+		d.wg.Add(1)
+		go d.work(webhookID, queue)
+	}
+	select {
+	case queue <- j:
+		return true
+	default:
+		return false
+	}
+}
+
+// work delivers queued jobs of the webhook one by one and exits once the queue is drained.
+func (d *Dispatcher) work(webhookID string, queue chan job) {
+	defer d.wg.Done()
+	for {
+		d.queueLock.Lock()
+		select {
+		case j := <-queue:
+			d.queueLock.Unlock()
+			d.run(webhookID, j.deliveryID, j.topic, j.body)
+		default:
+			delete(d.queues, webhookID)
+			d.queueLock.Unlock()
+			return
+		}
+	}
+}
+
+// run attempts delivery until it succeeds, fails permanently or runs out of attempts.
+// Webhook is reloaded before every attempt, so changes and removal take effect on retries.
+func (d *Dispatcher) run(webhookID string, deliveryID string, topic Topic, body []byte) {
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-d.stop:
+			d.history.finish(deliveryID, DeliveryFailed, "node stopped")
+			return
+		case d.semaphore <- struct{}{}:
+		}
+		w, err := d.Get(webhookID)
+		if err == ErrWebhookNotFound {
+			<-d.semaphore
+			d.history.finish(deliveryID, DeliveryFailed, "webhook deleted")
+			return
+		}
+		var result Attempt
+		var retryable bool
+		if err != nil {
+			result, retryable = Attempt{Time: d.now().UTC(), Error: err.Error()}, true
+		} else {
+			result, retryable = d.attempt(w, deliveryID, topic, body)
+		}
+		<-d.semaphore
+
+		if result.Error == "" {
+			d.history.record(deliveryID, result, nil)
+			d.history.finish(deliveryID, DeliveryDelivered, "")
+			return
+		}
+		if !retryable || attempt >= d.config.MaxAttempts {
+			d.history.record(deliveryID, result, nil)
+			d.history.finish(deliveryID, DeliveryFailed, result.Error)
+			log.Warn().Msgf("Webhook %s delivery %s failed after %d attempts: %s", webhookID, deliveryID, attempt, result.Error)
+			return
+		}
+
+		delay := d.backoff(attempt)
+		next := d.now().Add(delay).UTC()
+		d.history.record(deliveryID, result, &next)
+		select {
+		case <-d.stop:
+			d.history.finish(deliveryID, DeliveryFailed, "node stopped")
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// attempt sends signed notification once, reporting if failure is worth retrying.
+func (d *Dispatcher) attempt(w Webhook, deliveryID string, topic Topic, body []byte) (Attempt, bool) {
+	started := d.now()
+	result := Attempt{Time: started.UTC()}
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		result.Error = err.Error()
+		return result, false
+	}
+	timestamp := started.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTopic, string(topic))
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(w.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	result.Duration = d.now().Sub(started)
+	if err != nil {
+		result.Error = err.Error()
+		return result, true
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	result.StatusCode = resp.StatusCode
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return result, false
+	}
+	result.Error = fmt.Sprintf("unexpected response status %s", resp.Status)
+	retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusRequestTimeout
+	return result, retryable
+}
+
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.config.InitialBackoff
+	for i := 1; i < attempt && delay < d.config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.config.MaxBackoff {
+		delay = d.config.MaxBackoff
+	}
+	return delay
+}
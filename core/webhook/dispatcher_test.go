@@ -0,0 +1,291 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/eventbus"
+	pingpongEvent "github.com/mysteriumnetwork/node/session/pingpong/event"
+)
+
+func newTestDispatcher(t *testing.T, config Config) (*Dispatcher, func()) {
+	dir, err := ioutil.TempDir("", "webhookTest")
+	require.NoError(t, err)
+	bolt, err := boltdb.NewStorage(dir)
+	require.NoError(t, err)
+
+	d := NewDispatcher(bolt, config)
+	return d, func() {
+		d.Stop()
+		bolt.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func testConfig() Config {
+	config := DefaultConfig()
+	config.MaxAttempts = 3
+	config.InitialBackoff = time.Millisecond
+	config.MaxBackoff = 2 * time.Millisecond
+	return config
+}
+
+func waitForStatus(t *testing.T, d *Dispatcher, webhookID string, status DeliveryStatus) Delivery {
+	var delivery Delivery
+	assert.Eventually(t, func() bool {
+		deliveries := d.Deliveries(webhookID)
+		if len(deliveries) == 0 {
+			return false
+		}
+		delivery = deliveries[0]
+		return delivery.Status == status
+	}, 2*time.Second, 5*time.Millisecond)
+	return delivery
+}
+
+func TestDispatcher_CreateListDelete(t *testing.T) {
+	d, cleanup := newTestDispatcher(t, testConfig())
+	defer cleanup()
+
+	_, err := d.Create("ftp://example.com", []Topic{TopicBalance}, "")
+	assert.Error(t, err)
+	_, err = d.Create("https://example.com/hook", nil, "")
+	assert.Error(t, err)
+	_, err = d.Create("https://example.com/hook", []Topic{"unknown"}, "")
+	assert.Error(t, err)
+
+	w, err := d.Create("https://example.com/hook", []Topic{TopicBalance}, "")
+	require.NoError(t, err)
+	assert.Len(t, w.Secret, 64)
+
+	webhooks, err := d.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{w.ID}, []string{webhooks[0].ID})
+
+	assert.NoError(t, d.Delete(w.ID))
+	assert.Equal(t, ErrWebhookNotFound, d.Delete(w.ID))
+	webhooks, err = d.List()
+	assert.NoError(t, err)
+	assert.Empty(t, webhooks)
+}
+
+func TestDispatcher_DeliversSignedNotificationsOfSubscribedTopics(t *testing.T) {
+	received := make(chan *http.Request, 10)
+	bodies := make(chan []byte, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	d, cleanup := newTestDispatcher(t, testConfig())
+	defer cleanup()
+	w, err := d.Create(server.URL, []Topic{TopicSettlementFailed}, "secret")
+	require.NoError(t, err)
+
+	bus := eventbus.New()
+	require.NoError(t, d.Subscribe(bus))
+	bus.Publish(pingpongEvent.AppTopicBalanceChanged, pingpongEvent.AppEventBalanceChanged{})
+	bus.Publish(pingpongEvent.AppTopicSettlementFailed, pingpongEvent.AppEventSettlementFailed{ChainID: 5, Error: "settle timeout"})
+
+	req := <-received
+	body := <-bodies
+	assert.Equal(t, string(TopicSettlementFailed), req.Header.Get(HeaderTopic))
+	timestamp, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.True(t, Verify("secret", timestamp, body, req.Header.Get(HeaderSignature)))
+	assert.False(t, Verify("other", timestamp, body, req.Header.Get(HeaderSignature)))
+
+	var n struct {
+		Topic   Topic `json:"topic"`
+		Payload struct {
+			ChainID int64  `json:"chain_id"`
+			Error   string `json:"error"`
+		} `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(body, &n))
+	assert.Equal(t, TopicSettlementFailed, n.Topic)
+	assert.Equal(t, int64(5), n.Payload.ChainID)
+	assert.Equal(t, "settle timeout", n.Payload.Error)
+
+	delivery := waitForStatus(t, d, w.ID, DeliveryDelivered)
+	assert.Equal(t, req.Header.Get(HeaderDelivery), delivery.ID)
+	assert.Len(t, delivery.Attempts, 1)
+	assert.Equal(t, http.StatusOK, delivery.Attempts[0].StatusCode)
+	select {
+	case <-received:
+		t.Fatal("notification of unsubscribed topic delivered")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestDispatcher_RetriesWithBackoff(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	d, cleanup := newTestDispatcher(t, testConfig())
+	defer cleanup()
+	w, err := d.Create(server.URL, []Topic{TopicBalance}, "")
+	require.NoError(t, err)
+
+	_, err = d.Test(w.ID)
+	require.NoError(t, err)
+
+	delivery := waitForStatus(t, d, w.ID, DeliveryDelivered)
+	assert.Equal(t, TopicPing, delivery.Topic)
+	require.Len(t, delivery.Attempts, 3)
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.Attempts[0].StatusCode)
+	assert.Equal(t, http.StatusOK, delivery.Attempts[2].StatusCode)
+	assert.Empty(t, delivery.Error)
+}
+
+func TestDispatcher_GivesUp(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	d, cleanup := newTestDispatcher(t, testConfig())
+	defer cleanup()
+
+	failing, err := d.Create(server.URL+"/failing", []Topic{TopicBalance}, "")
+	require.NoError(t, err)
+	d.Notify(TopicBalance, struct{}{})
+	delivery := waitForStatus(t, d, failing.ID, DeliveryFailed)
+	assert.Len(t, delivery.Attempts, 3)
+	assert.Contains(t, delivery.Error, "500")
+
+	gone, err := d.Create(server.URL+"/gone", []Topic{TopicNATFailure}, "")
+	require.NoError(t, err)
+	d.Notify(TopicNATFailure, struct{}{})
+	delivery = waitForStatus(t, d, gone.ID, DeliveryFailed)
+	assert.Len(t, delivery.Attempts, 1, "client errors are not retried")
+}
+
+func TestDispatcher_DropsNotificationsWhenQueueIsFull(t *testing.T) {
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.QueueSize = 1
+	d, cleanup := newTestDispatcher(t, config)
+	defer cleanup()
+	w, err := d.Create(server.URL, []Topic{TopicBalance}, "")
+	require.NoError(t, err)
+
+	inFlight, err := d.Test(w.ID)
+	require.NoError(t, err)
+	<-started
+	queued, err := d.Test(w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, DeliveryPending, queued.Status)
+	dropped, err := d.Test(w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, DeliveryFailed, dropped.Status)
+	assert.Equal(t, "delivery queue is full", dropped.Error)
+	close(release)
+
+	assert.Eventually(t, func() bool {
+		statuses := map[string]DeliveryStatus{}
+		for _, delivery := range d.Deliveries(w.ID) {
+			statuses[delivery.ID] = delivery.Status
+		}
+		return statuses[inFlight.ID] == DeliveryDelivered && statuses[queued.ID] == DeliveryDelivered
+	}, 2*time.Second, 5*time.Millisecond)
+	assert.Len(t, started, 1)
+}
+
+func TestDispatcher_StopsRetryingDeletedWebhook(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.InitialBackoff = 100 * time.Millisecond
+	config.MaxBackoff = 100 * time.Millisecond
+	d, cleanup := newTestDispatcher(t, config)
+	defer cleanup()
+	w, err := d.Create(server.URL, []Topic{TopicBalance}, "")
+	require.NoError(t, err)
+
+	_, err = d.Test(w.ID)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		deliveries := d.Deliveries(w.ID)
+		return len(deliveries) == 1 && len(deliveries[0].Attempts) == 1
+	}, 2*time.Second, 5*time.Millisecond)
+	require.NoError(t, d.Delete(w.ID))
+
+	delivery := waitForStatus(t, d, w.ID, DeliveryFailed)
+	assert.Equal(t, "webhook deleted", delivery.Error)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestDispatcher_backoff(t *testing.T) {
+	d := &Dispatcher{config: Config{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}}
+	assert.Equal(t, time.Second, d.backoff(1))
+	assert.Equal(t, 2*time.Second, d.backoff(2))
+	assert.Equal(t, 4*time.Second, d.backoff(3))
+	assert.Equal(t, 5*time.Second, d.backoff(4))
+	assert.Equal(t, 5*time.Second, d.backoff(10))
+}
+
+func TestHistory_KeepsMostRecentDeliveries(t *testing.T) {
+	h := newHistory(2)
+	h.add(Delivery{ID: "1", WebhookID: "w"})
+	h.add(Delivery{ID: "2", WebhookID: "w"})
+	h.add(Delivery{ID: "3", WebhookID: "w"})
+	h.add(Delivery{ID: "4", WebhookID: "other"})
+
+	deliveries := h.list("w")
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, "3", deliveries[0].ID)
+}
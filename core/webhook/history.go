@@ -0,0 +1,126 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package webhook
+
+import (
+	"sync"
+	"time"
+)
+
+// DeliveryStatus represents state of notification delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryPending means delivery is being attempted or waits for retry.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliveryDelivered means webhook accepted the notification.
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryFailed means delivery was given up.
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// Attempt is a single attempt to deliver notification.
+type Attempt struct {
+	Time time.Time
+	// StatusCode is zero when no response was received.
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+}
+
+// Delivery tracks delivery of a notification to a webhook.
+type Delivery struct {
+	ID             string
+	WebhookID      string
+	NotificationID string
+	Topic          Topic
+	URL            string
+	Status         DeliveryStatus
+	Error          string
+	CreatedAt      time.Time
+	NextAttemptAt  *time.Time
+	Attempts       []Attempt
+}
+
+// history keeps the most recent deliveries in memory.
+type history struct {
+	lock       sync.Mutex
+	size       int
+	deliveries []*Delivery
+	byID       map[string]*Delivery
+}
+
+func newHistory(size int) *history {
+	return &history{
+		size: size,
+		byID: make(map[string]*Delivery),
+	}
+}
+
+func (h *history) add(d Delivery) Delivery {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.size > 0 && len(h.deliveries) >= h.size {
+		delete(h.byID, h.deliveries[0].ID)
+		h.deliveries = h.deliveries[1:]
+	}
+	h.deliveries = append(h.deliveries, &d)
+	h.byID[d.ID] = &d
+	return d
+}
+
+func (h *history) record(id string, attempt Attempt, nextAttemptAt *time.Time) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if d, ok := h.byID[id]; ok {
+		d.Attempts = append(d.Attempts, attempt)
+		d.Error = attempt.Error
+		d.NextAttemptAt = nextAttemptAt
+	}
+}
+
+func (h *history) finish(id string, status DeliveryStatus, reason string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if d, ok := h.byID[id]; ok {
+		d.Status = status
+		d.Error = reason
+		d.NextAttemptAt = nil
+	}
+}
+
+// list returns copies of deliveries to the webhook, latest first.
+func (h *history) list(webhookID string) []Delivery {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	result := []Delivery{}
+	for i := len(h.deliveries) - 1; i >= 0; i-- {
+		d := h.deliveries[i]
+		if d.WebhookID != webhookID {
+			continue
+		}
+		copied := *d
+		copied.Attempts = append([]Attempt(nil), d.Attempts...)
+		result = append(result, copied)
+	}
+	return result
+}
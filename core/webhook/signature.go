@@ -0,0 +1,54 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	// HeaderSignature holds HMAC-SHA256 signature of the notification, see Sign.
+	HeaderSignature = "X-Mysterium-Signature"
+	// HeaderTimestamp holds unix time the notification was signed at.
+	HeaderTimestamp = "X-Mysterium-Timestamp"
+	// HeaderTopic holds topic of the notification.
+	HeaderTopic = "X-Mysterium-Topic"
+	// HeaderDelivery holds ID of the delivery, which stays the same across retries.
+	HeaderDelivery = "X-Mysterium-Delivery"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns signature of the notification body sent at the given unix time.
+// It is HMAC-SHA256 of "<timestamp>.<body>" keyed by the webhook secret, formatted as "sha256=<hex>".
+// Timestamp is part of the signed content, so that receivers can reject replayed notifications.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks signature of the notification body, for use by receivers of webhooks.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// Topic names a kind of node events webhooks can subscribe to.
+type Topic string
+
+const (
+	// TopicConnectionState is sent when consumer connection state changes.
+	TopicConnectionState Topic = "connection-state"
+	// TopicBalance is sent when consumer balance of identity changes.
+	TopicBalance Topic = "balance"
+	// TopicSettlement is sent when provider promises get settled.
+	TopicSettlement Topic = "settlement"
+	// TopicSettlementFailed is sent when settlement of provider promises fails.
+	TopicSettlementFailed Topic = "settlement-failed"
+	// TopicRegistration is sent when identity registration status changes.
+	TopicRegistration Topic = "registration"
+	// TopicServiceStatus is sent when status of provided service changes.
+	TopicServiceStatus Topic = "service-status"
+	// TopicNATFailure is sent when NAT traversal stage fails.
+	TopicNATFailure Topic = "nat-failure"
+	// TopicPing is sent by test deliveries, regardless of subscribed topics.
+	TopicPing Topic = "ping"
+)
+
+// Topics lists topics webhooks can subscribe to.
+var Topics = []Topic{
+	TopicConnectionState,
+	TopicBalance,
+	TopicSettlement,
+	TopicSettlementFailed,
+	TopicRegistration,
+	TopicServiceStatus,
+	TopicNATFailure,
+}
+
+// ParseTopic parses topic from its name.
+func ParseTopic(name string) (Topic, error) {
+	for _, t := range Topics {
+		if string(t) == strings.TrimSpace(name) {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unknown topic %q", name)
+}
+
+const webhookBucket = "webhooks"
+
+// ErrWebhookNotFound is returned when webhook does not exist.
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+// Webhook is an outbound HTTP endpoint notified about events of subscribed topics.
+type Webhook struct {
+	ID  string `storm:"id"`
+	URL string
+	// Secret signs notifications, so that receivers can verify they are sent by the node.
+	Secret    string
+	Topics    []Topic
+	CreatedAt time.Time
+}
+
+func (w Webhook) subscribed(topic Topic) bool {
+	if topic == TopicPing {
+		return true
+	}
+	for _, t := range w.Topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+type storage interface {
+	Store(bucket string, data interface{}) error
+	GetAllFrom(bucket string, data interface{}) error
+	GetOneByField(bucket string, fieldName string, key interface{}, to interface{}) error
+	Delete(bucket string, data interface{}) error
+}
+
+// ValidateURL checks that webhook URL is an absolute HTTP or HTTPS URL.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrap(err, "invalid URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("URL scheme must be http or https")
+	}
+	if u.Host == "" {
+		return errors.New("URL host is required")
+	}
+	return nil
+}
+
+// Create registers a new webhook. Secret is generated when not given.
+func (d *Dispatcher) Create(rawURL string, topics []Topic, secret string) (Webhook, error) {
+	if err := ValidateURL(rawURL); err != nil {
+		return Webhook{}, err
+	}
+	if len(topics) == 0 {
+		return Webhook{}, errors.New("at least one topic is required")
+	}
+	for _, t := range topics {
+		if _, err := ParseTopic(string(t)); err != nil {
+			return Webhook{}, err
+		}
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return Webhook{}, errors.Wrap(err, "failed to generate webhook ID")
+	}
+	if secret == "" {
+		if secret, err = generateSecret(); err != nil {
+			return Webhook{}, errors.Wrap(err, "failed to generate webhook secret")
+		}
+	}
+
+	w := Webhook{
+		ID:        id.String(),
+		URL:       rawURL,
+		Secret:    secret,
+		Topics:    topics,
+		CreatedAt: d.now().UTC(),
+	}
+	if err := d.storage.Store(webhookBucket, &w); err != nil {
+		return Webhook{}, errors.Wrap(err, "failed to store webhook")
+	}
+	return w, nil
+}
+
+// List returns all registered webhooks.
+func (d *Dispatcher) List() ([]Webhook, error) {
+	var webhooks []Webhook
+	err := d.storage.GetAllFrom(webhookBucket, &webhooks)
+	if err == storm.ErrNotFound {
+		return []Webhook{}, nil
+	}
+	return webhooks, err
+}
+
+// Get returns webhook by its ID.
+func (d *Dispatcher) Get(id string) (Webhook, error) {
+	var w Webhook
+	err := d.storage.GetOneByField(webhookBucket, "ID", id, &w)
+	if err == storm.ErrNotFound {
+		return w, ErrWebhookNotFound
+	}
+	return w, err
+}
+
+// Delete removes webhook by its ID, its pending deliveries are not retried anymore.
+func (d *Dispatcher) Delete(id string) error {
+	w, err := d.Get(id)
+	if err != nil {
+		return err
+	}
+	return d.storage.Delete(webhookBucket, &w)
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
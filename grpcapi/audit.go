@@ -0,0 +1,126 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/mysteriumnetwork/node/core/audit"
+	"github.com/mysteriumnetwork/node/core/auth"
+)
+
+// auditMethod marks gRPC calls in the audit log shared with tequilapi.
+const auditMethod = "GRPC"
+
+// AuditLog records privileged calls, the same log is used for tequilapi requests.
+type AuditLog interface {
+	Record(e audit.Entry) error
+}
+
+func unaryAuditInterceptor(auditLog AuditLog, actors actorResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if audited(info.FullMethod) {
+			body, marshalErr := json.Marshal(req)
+			if marshalErr != nil {
+				log.Warn().Err(marshalErr).Msg("Could not marshal gRPC request for audit")
+			}
+			record(ctx, auditLog, actors, info.FullMethod, body, err)
+		}
+		return resp, err
+	}
+}
+
+func streamAuditInterceptor(auditLog AuditLog, actors actorResolver) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, stream)
+		if audited(info.FullMethod) {
+			record(stream.Context(), auditLog, actors, info.FullMethod, nil, err)
+		}
+		return err
+	}
+}
+
+// audited selects state changing calls and privileged reads, the same way as tequilapi requests are selected.
+func audited(fullMethod string) bool {
+	r := routeOf(fullMethod)
+	switch r.method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return auth.RequiredScope(r.method, r.path) == auth.ScopeAdmin
+	default:
+		return true
+	}
+}
+
+func record(ctx context.Context, auditLog AuditLog, actors actorResolver, fullMethod string, body []byte, callErr error) {
+	actor := actors.Actor(bearerToken(ctx))
+	if actor == "" {
+		actor = "anonymous"
+	}
+	entry := audit.Entry{
+		Actor:  actor,
+		Method: auditMethod,
+		Path:   fullMethod,
+		Body:   audit.RedactBody(body),
+		Status: httpStatus(status.Code(callErr)),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		entry.RemoteAddr = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			entry.ForwardedFor = forwarded[0]
+		}
+	}
+	if err := auditLog.Record(entry); err != nil {
+		log.Error().Err(err).Msgf("Could not audit %s", fullMethod)
+	}
+}
+
+// httpStatus maps gRPC status to HTTP one, so audit entries of both APIs are queried alike.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
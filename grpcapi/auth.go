@@ -0,0 +1,117 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mysteriumnetwork/node/core/auth"
+)
+
+type requestAuthorizer interface {
+	Authorize(token, method, path string) error
+}
+
+type actorResolver interface {
+	Actor(token string) string
+}
+
+type route struct {
+	method string
+	path   string
+}
+
+// rpcRoutes maps RPCs to equivalent tequilapi routes, so API token scopes apply the same way.
+// RPCs missing here require admin scope.
+var rpcRoutes = map[string]route{
+	"/control.Control/ListIdentities": {http.MethodGet, "/identities"},
+	"/control.Control/GetIdentity":    {http.MethodGet, "/identities/_"},
+	"/control.Control/CreateIdentity": {http.MethodPost, "/identities"},
+	"/control.Control/UnlockIdentity": {http.MethodPut, "/identities/_/unlock"},
+	"/control.Control/LockIdentity":   {http.MethodDelete, "/identities/_/lock"},
+	"/control.Control/GetConnection":  {http.MethodGet, "/connection"},
+	"/control.Control/Connect":        {http.MethodPut, "/connection"},
+	"/control.Control/Disconnect":     {http.MethodDelete, "/connection"},
+	"/control.Control/ListServices":   {http.MethodGet, "/services"},
+	"/control.Control/StartService":   {http.MethodPost, "/services"},
+	"/control.Control/StopService":    {http.MethodDelete, "/services/_"},
+	"/control.Control/ListSessions":   {http.MethodGet, "/sessions"},
+	"/control.Control/Settle":         {http.MethodPost, "/transactor/settle/sync"},
+	"/control.Control/WatchState":     {http.MethodGet, "/events/state"},
+	"/control.Control/WatchEvents":    {http.MethodGet, "/events"},
+}
+
+func unaryAuthInterceptor(authorizer requestAuthorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, authorizer, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamAuthInterceptor(authorizer requestAuthorizer) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(stream.Context(), authorizer, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// routeOf returns tequilapi route equivalent to the RPC.
+func routeOf(fullMethod string) route {
+	if r, ok := rpcRoutes[fullMethod]; ok {
+		return r
+	}
+	return route{method: http.MethodPost, path: "/grpc" + fullMethod}
+}
+
+func authorize(ctx context.Context, authorizer requestAuthorizer, fullMethod string) error {
+	r := routeOf(fullMethod)
+	err := authorizer.Authorize(bearerToken(ctx), r.method, r.path)
+	switch err {
+	case nil:
+		return nil
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Unauthenticated, auth.ErrUnauthorized.Error())
+	}
+}
+
+// bearerToken extracts token from "authorization: Bearer <token>" metadata.
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		if strings.HasPrefix(strings.ToLower(value), "bearer ") {
+			return strings.TrimSpace(value[len("bearer "):])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,483 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mysteriumnetwork/node/consumer/session"
+	"github.com/mysteriumnetwork/node/core/connection"
+	"github.com/mysteriumnetwork/node/core/service"
+	stateEvent "github.com/mysteriumnetwork/node/core/state/event"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/identity/registry"
+	"github.com/mysteriumnetwork/node/market"
+	pb "github.com/mysteriumnetwork/node/pb/control"
+	"github.com/mysteriumnetwork/node/services"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/tequilapi/endpoints"
+)
+
+type identityRegistry interface {
+	GetRegistrationStatus(int64, identity.Identity) (registry.RegistrationStatus, error)
+}
+
+type proposalRepository interface {
+	Proposal(id market.ProposalID) (*market.ServiceProposal, error)
+}
+
+type hermesSelector interface {
+	SelectHermes(chainID int64, consumerID identity.Identity, proposal market.ServiceProposal) (common.Address, error)
+}
+
+type serviceManager interface {
+	Start(providerID identity.Identity, serviceType string, policies []string, options service.Options, pm market.PaymentMethod) (service.ID, error)
+	Stop(id service.ID) error
+	Service(id service.ID) *service.Instance
+	List() map[service.ID]*service.Instance
+}
+
+type servicePricer interface {
+	ToMyst(providerID identity.Identity, serviceType string, perGiB, perMinute *big.Int) (*big.Int, *big.Int, error)
+}
+
+type sessionStorage interface {
+	List(*session.Filter) ([]session.History, error)
+}
+
+type promiseSettler interface {
+	ForceSettle(chainID int64, providerID identity.Identity, hermesID common.Address) error
+}
+
+type stateProvider interface {
+	GetState() stateEvent.State
+}
+
+type eventStream interface {
+	Listen(topics []string, lastEventID uint64) (*endpoints.EventSubscription, error)
+}
+
+// Deps holds node components used by the control API
+type Deps struct {
+	ChainID            int64
+	IdentityManager    identity.Manager
+	IdentityRegistry   identityRegistry
+	ConnectionManager  connection.Manager
+	ProposalRepository proposalRepository
+	HermesSelector     hermesSelector
+	ServiceManager     serviceManager
+	ServiceOptions     map[string]services.ServiceOptionsParser
+	ServicePricer      servicePricer
+	SessionStorage     sessionStorage
+	PromiseSettler     promiseSettler
+	StateProvider      stateProvider
+	EventStream        eventStream
+}
+
+type controlService struct {
+	pb.UnimplementedControlServer
+	deps Deps
+}
+
+// NewControlService returns gRPC control service operating on given node components.
+func NewControlService(deps Deps) pb.ControlServer {
+	return &controlService{deps: deps}
+}
+
+func (cs *controlService) ListIdentities(context.Context, *pb.Empty) (*pb.IdentityList, error) {
+	state := cs.deps.StateProvider.GetState()
+	res := &pb.IdentityList{}
+	for _, id := range cs.deps.IdentityManager.GetIdentities() {
+		res.Identities = append(res.Identities, cs.toIdentity(id.Address, state))
+	}
+	return res, nil
+}
+
+func (cs *controlService) GetIdentity(_ context.Context, req *pb.IdentityRequest) (*pb.Identity, error) {
+	id, err := cs.deps.IdentityManager.GetIdentity(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return cs.toIdentity(id.Address, cs.deps.StateProvider.GetState()), nil
+}
+
+func (cs *controlService) CreateIdentity(_ context.Context, req *pb.CreateIdentityRequest) (*pb.Identity, error) {
+	id, err := cs.deps.IdentityManager.CreateNewIdentity(req.Passphrase)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return cs.toIdentity(id.Address, cs.deps.StateProvider.GetState()), nil
+}
+
+func (cs *controlService) UnlockIdentity(_ context.Context, req *pb.UnlockIdentityRequest) (*pb.Empty, error) {
+	id, err := cs.deps.IdentityManager.GetIdentity(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	err = cs.deps.IdentityManager.UnlockTimed(cs.deps.ChainID, id.Address, req.Passphrase, timeout)
+	if err == identity.ErrLockUnsupported {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
+
+func (cs *controlService) LockIdentity(_ context.Context, req *pb.IdentityRequest) (*pb.Empty, error) {
+	id, err := cs.deps.IdentityManager.GetIdentity(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	err = cs.deps.IdentityManager.Lock(id.Address)
+	if err == identity.ErrLockUnsupported {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
+
+func (cs *controlService) GetConnection(context.Context, *pb.Empty) (*pb.ConnectionStatus, error) {
+	return toConnectionStatus(cs.deps.ConnectionManager.Status()), nil
+}
+
+func (cs *controlService) Connect(_ context.Context, req *pb.ConnectRequest) (*pb.ConnectionStatus, error) {
+	if req.ConsumerId == "" || req.ProviderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "consumer_id and provider_id are required")
+	}
+	consumerID := identity.FromAddress(req.ConsumerId)
+	registrationStatus, err := cs.deps.IdentityRegistry.GetRegistrationStatus(cs.deps.ChainID, consumerID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	switch registrationStatus {
+	case registry.Registered, registry.InProgress:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "identity %q is not registered. Please register the identity first", req.ConsumerId)
+	}
+
+	proposal, err := cs.deps.ProposalRepository.Proposal(market.ProposalID{
+		ProviderID:  req.ProviderId,
+		ServiceType: req.ServiceType,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if proposal == nil {
+		return nil, status.Error(codes.NotFound, "provider has no service proposals")
+	}
+
+	hermesID := req.HermesId
+	if hermesID == "" {
+		hermes, err := cs.deps.HermesSelector.SelectHermes(cs.deps.ChainID, consumerID, *proposal)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		hermesID = hermes.Hex()
+	} else if !proposal.AcceptsHermes(hermesID) {
+		return nil, status.Error(codes.InvalidArgument, "hermes is not accepted by provider")
+	}
+
+	dns := connection.DNSOptionAuto
+	if req.Dns != "" {
+		dns = connection.DNSOption(req.Dns)
+	}
+	err = cs.deps.ConnectionManager.Connect(consumerID, common.HexToAddress(hermesID), *proposal, connection.ConnectParams{
+		DisableKillSwitch: req.DisableKillSwitch,
+		DNS:               dns,
+	})
+	switch err {
+	case nil:
+		return toConnectionStatus(cs.deps.ConnectionManager.Status()), nil
+	case connection.ErrAlreadyExists:
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case connection.ErrConnectionCancelled:
+		return nil, status.Error(codes.Canceled, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (cs *controlService) Disconnect(context.Context, *pb.Empty) (*pb.Empty, error) {
+	switch err := cs.deps.ConnectionManager.Disconnect(); err {
+	case nil:
+		return &pb.Empty{}, nil
+	case connection.ErrNoConnection:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (cs *controlService) ListServices(context.Context, *pb.Empty) (*pb.ServiceList, error) {
+	res := &pb.ServiceList{}
+	for id, instance := range cs.deps.ServiceManager.List() {
+		res.Services = append(res.Services, toService(string(id), instance.ProviderID.Address, instance.Type, string(instance.State()), instance.Options))
+	}
+	return res, nil
+}
+
+func (cs *controlService) StartService(_ context.Context, req *pb.StartServiceRequest) (*pb.Service, error) {
+	if req.ProviderId == "" || req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider_id and type are required")
+	}
+	optionsParser, ok := cs.deps.ServiceOptions[req.Type]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid service type %q", req.Type)
+	}
+	var rawOptions *json.RawMessage
+	if len(req.Options) > 0 {
+		raw := json.RawMessage(req.Options)
+		rawOptions = &raw
+	}
+	options, err := optionsParser(rawOptions)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid options: "+err.Error())
+	}
+
+	for _, instance := range cs.deps.ServiceManager.List() {
+		if instance.ProviderID.Address == req.ProviderId && instance.Type == req.Type {
+			return nil, status.Error(codes.AlreadyExists, "service already running")
+		}
+	}
+
+	defaults, _ := services.GetStartOptions(req.Type)
+	priceGiB, err := toAmount(req.PricePerGib, defaults.PaymentPricePerGB)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid price_per_gib")
+	}
+	priceMinute, err := toAmount(req.PricePerMinute, defaults.PaymentPricePerMinute)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid price_per_minute")
+	}
+	policies := defaults.AccessPolicyList
+	if len(req.AccessPolicies) > 0 {
+		policies = req.AccessPolicies
+	}
+
+	providerID := identity.FromAddress(req.ProviderId)
+	priceGiB, priceMinute, err = cs.deps.ServicePricer.ToMyst(providerID, req.Type, priceGiB, priceMinute)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	id, err := cs.deps.ServiceManager.Start(providerID, req.Type, policies, options, pingpong.NewPaymentMethod(priceGiB, priceMinute))
+	switch err {
+	case nil:
+	case service.ErrorLocation:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case service.ErrIdentityLocked:
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	instance := cs.deps.ServiceManager.Service(id)
+	if instance == nil {
+		return nil, status.Error(codes.Internal, "service stopped right after start")
+	}
+	return toService(string(id), instance.ProviderID.Address, instance.Type, string(instance.State()), instance.Options), nil
+}
+
+func (cs *controlService) StopService(_ context.Context, req *pb.ServiceRequest) (*pb.Empty, error) {
+	id := service.ID(req.Id)
+	if cs.deps.ServiceManager.Service(id) == nil {
+		return nil, status.Error(codes.NotFound, "service not found")
+	}
+	if err := cs.deps.ServiceManager.Stop(id); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
+
+func (cs *controlService) ListSessions(_ context.Context, req *pb.SessionFilter) (*pb.SessionList, error) {
+	filter := session.NewFilter()
+	if req.Direction != "" {
+		filter.SetDirection(req.Direction)
+	}
+	if req.ServiceType != "" {
+		filter.SetServiceType(req.ServiceType)
+	}
+	if req.Status != "" {
+		filter.SetStatus(req.Status)
+	}
+	if req.DateFrom != nil {
+		filter.SetStartedFrom(req.DateFrom.AsTime())
+	}
+	if req.DateTo != nil {
+		filter.SetStartedTo(req.DateTo.AsTime())
+	}
+
+	sessions, err := cs.deps.SessionStorage.List(filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &pb.SessionList{}
+	for _, se := range sessions {
+		res.Sessions = append(res.Sessions, toSession(se))
+	}
+	return res, nil
+}
+
+func (cs *controlService) Settle(_ context.Context, req *pb.SettleRequest) (*pb.Empty, error) {
+	if req.ProviderId == "" || req.HermesId == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider_id and hermes_id are required")
+	}
+	err := cs.deps.PromiseSettler.ForceSettle(cs.deps.ChainID, identity.FromAddress(req.ProviderId), common.HexToAddress(req.HermesId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
+
+func (cs *controlService) WatchState(_ *pb.Empty, stream pb.Control_WatchStateServer) error {
+	sub, err := cs.deps.EventStream.Listen([]string{string(endpoints.StateChangeEvent)}, 0)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer sub.Close()
+
+	// Events only signal a change, the latest state is sent as it might have changed since.
+	send := func() error {
+		return stream.Send(cs.toState(cs.deps.StateProvider.GetState()))
+	}
+	if err := send(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case _, open := <-sub.Messages():
+			if !open {
+				return status.Error(codes.Unavailable, "client is not keeping up with state changes")
+			}
+			if err := send(); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-sub.Done():
+			return nil
+		}
+	}
+}
+
+func (cs *controlService) WatchEvents(req *pb.WatchEventsRequest, stream pb.Control_WatchEventsServer) error {
+	sub, err := cs.deps.EventStream.Listen(req.Topics, req.LastEventId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer sub.Close()
+
+	for _, msg := range sub.Backlog {
+		if err := stream.Send(toEvent(msg)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case msg, open := <-sub.Messages():
+			if !open {
+				return status.Error(codes.Unavailable, "client is not keeping up with events, resume with last_event_id")
+			}
+			if err := stream.Send(toEvent(msg)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-sub.Done():
+			return nil
+		}
+	}
+}
+
+func (cs *controlService) toIdentity(address string, state stateEvent.State) *pb.Identity {
+	res := &pb.Identity{
+		Address:            address,
+		RegistrationStatus: registry.Unregistered.String(),
+		Balance:            "0",
+		Earnings:           "0",
+		EarningsTotal:      "0",
+		Unlocked:           cs.deps.IdentityManager.IsUnlocked(address),
+	}
+	for _, id := range state.Identities {
+		if id.Address != address {
+			continue
+		}
+		res.RegistrationStatus = id.RegistrationStatus.String()
+		res.ChannelAddress = id.ChannelAddress.Hex()
+		res.Balance = fromAmount(id.Balance)
+		res.Earnings = fromAmount(id.Earnings)
+		res.EarningsTotal = fromAmount(id.EarningsTotal)
+	}
+	return res
+}
+
+func (cs *controlService) toState(state stateEvent.State) *pb.State {
+	res := &pb.State{
+		NatStatus:  state.NATStatus.Status,
+		Connection: toConnectionStatus(state.Connection.Session),
+	}
+	for _, id := range state.Identities {
+		res.Identities = append(res.Identities, cs.toIdentity(id.Address, state))
+	}
+	for _, s := range state.Services {
+		res.Services = append(res.Services, toService(s.ID, s.ProviderID, s.Type, s.Status, s.Options))
+	}
+	for _, se := range state.Sessions {
+		res.Sessions = append(res.Sessions, toSession(se))
+	}
+	return res
+}
+
+func toEvent(msg endpoints.EventMessage) *pb.Event {
+	return &pb.Event{
+		Id:      msg.ID,
+		Type:    string(msg.Type),
+		Payload: []byte(msg.Data),
+	}
+}
+
+func toAmount(value string, fallback *big.Int) (*big.Int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
+
+func fromAmount(amount *big.Int) string {
+	if amount == nil {
+		return "0"
+	}
+	return amount.String()
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcapi
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mysteriumnetwork/node/consumer/session"
+	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	pb "github.com/mysteriumnetwork/node/pb/control"
+)
+
+func toConnectionStatus(status connectionstate.Status) *pb.ConnectionStatus {
+	res := &pb.ConnectionStatus{
+		State:       string(status.State),
+		SessionId:   string(status.SessionID),
+		ConsumerId:  status.ConsumerID.Address,
+		ProviderId:  status.Proposal.ProviderID,
+		ServiceType: status.Proposal.ServiceType,
+	}
+	if status.HermesID != (common.Address{}) {
+		res.HermesId = status.HermesID.Hex()
+	}
+	if !status.StartedAt.IsZero() {
+		res.StartedAt = timestamppb.New(status.StartedAt)
+	}
+	return res
+}
+
+func toService(id, providerID, serviceType, state string, options interface{}) *pb.Service {
+	res := &pb.Service{
+		Id:         id,
+		ProviderId: providerID,
+		Type:       serviceType,
+		Status:     state,
+	}
+	if options != nil {
+		optionsJSON, err := json.Marshal(options)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Could not marshal options of service %s", id)
+		}
+		res.Options = optionsJSON
+	}
+	return res
+}
+
+func toSession(se session.History) *pb.Session {
+	return &pb.Session{
+		Id:              string(se.SessionID),
+		Direction:       se.Direction,
+		ConsumerId:      se.ConsumerID.Address,
+		HermesId:        se.HermesID,
+		ProviderId:      se.ProviderID.Address,
+		ServiceType:     se.ServiceType,
+		ConsumerCountry: se.ConsumerCountry,
+		ProviderCountry: se.ProviderCountry,
+		Status:          se.Status,
+		StartedAt:       timestamppb.New(se.Started),
+		DurationSeconds: uint64(se.GetDuration().Seconds()),
+		BytesSent:       se.DataSent,
+		BytesReceived:   se.DataReceived,
+		Tokens:          fromAmount(se.Tokens),
+	}
+}
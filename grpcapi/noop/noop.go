@@ -0,0 +1,41 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package noop
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+// Server is used when gRPC control API is disabled
+type Server struct {
+}
+
+// NewServer returns a new noop server
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Serve does nothing
+func (s *Server) Serve() {
+	log.Debug().Msg("Start: NOOP gRPC server")
+}
+
+// Stop does nothing
+func (s *Server) Stop() {
+	log.Debug().Msg("Stop: NOOP gRPC server")
+}
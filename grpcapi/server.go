@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcapi
+
+import (
+	"crypto/tls"
+	"net"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	pb "github.com/mysteriumnetwork/node/pb/control"
+)
+
+// Server serves node control API over gRPC
+type Server struct {
+	listener net.Listener
+	server   *grpc.Server
+}
+
+type callAuthorizer interface {
+	requestAuthorizer
+	actorResolver
+}
+
+// NewServer creates gRPC server for the given control service.
+// Connections are encrypted when tlsConfig is given, e.g. the same as used by tequilapi.
+// Privileged calls are recorded to auditLog, unless it is nil. Denied calls are recorded as well.
+func NewServer(listener net.Listener, tlsConfig *tls.Config, authorizer callAuthorizer, auditLog AuditLog, control pb.ControlServer) *Server {
+	unary := []grpc.UnaryServerInterceptor{unaryAuthInterceptor(authorizer)}
+	stream := []grpc.StreamServerInterceptor{streamAuthInterceptor(authorizer)}
+	if auditLog != nil {
+		unary = append([]grpc.UnaryServerInterceptor{unaryAuditInterceptor(auditLog, authorizer)}, unary...)
+		stream = append([]grpc.StreamServerInterceptor{streamAuditInterceptor(auditLog, authorizer)}, stream...)
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	server := grpc.NewServer(opts...)
+	pb.RegisterControlServer(server, control)
+
+	return &Server{
+		listener: listener,
+		server:   server,
+	}
+}
+
+// Serve starts serving gRPC requests in the background
+func (s *Server) Serve() {
+	go func() {
+		if err := s.server.Serve(s.listener); err != nil {
+			log.Error().Err(err).Msg("gRPC control API stopped")
+		}
+	}()
+	log.Info().Msgf("gRPC control API started on: %s", s.listener.Addr())
+}
+
+// Stop stops the server closing all open streams
+func (s *Server) Stop() {
+	s.server.Stop()
+}
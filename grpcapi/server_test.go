@@ -0,0 +1,293 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/mysteriumnetwork/node/consumer/session"
+	"github.com/mysteriumnetwork/node/core/audit"
+	"github.com/mysteriumnetwork/node/core/auth"
+	"github.com/mysteriumnetwork/node/core/service"
+	stateEvent "github.com/mysteriumnetwork/node/core/state/event"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/identity/registry"
+	pb "github.com/mysteriumnetwork/node/pb/control"
+	"github.com/mysteriumnetwork/node/tequilapi/endpoints"
+	"github.com/mysteriumnetwork/node/trace"
+)
+
+type mockAuthorizer struct {
+	err    error
+	method string
+	path   string
+	token  string
+}
+
+func (ma *mockAuthorizer) Authorize(token, method, path string) error {
+	ma.token, ma.method, ma.path = token, method, path
+	return ma.err
+}
+
+func (ma *mockAuthorizer) Actor(token string) string {
+	if token == "" {
+		return ""
+	}
+	return "token:" + token
+}
+
+type mockAuditLog struct {
+	lock    sync.Mutex
+	entries []audit.Entry
+}
+
+func (mal *mockAuditLog) Record(e audit.Entry) error {
+	mal.lock.Lock()
+	defer mal.lock.Unlock()
+	mal.entries = append(mal.entries, e)
+	return nil
+}
+
+func (mal *mockAuditLog) Entries() []audit.Entry {
+	mal.lock.Lock()
+	defer mal.lock.Unlock()
+	return mal.entries
+}
+
+type mockStateProvider struct {
+	state stateEvent.State
+}
+
+func (msp *mockStateProvider) GetState() stateEvent.State {
+	return msp.state
+}
+
+type mockServiceManager struct {
+	serviceManager
+	services map[service.ID]*service.Instance
+}
+
+func (msm *mockServiceManager) Service(id service.ID) *service.Instance {
+	return msm.services[id]
+}
+
+type mockSessionStorage struct {
+	sessions []session.History
+	filter   *session.Filter
+}
+
+func (mss *mockSessionStorage) List(filter *session.Filter) ([]session.History, error) {
+	mss.filter = filter
+	return mss.sessions, nil
+}
+
+func newTestClient(t *testing.T, authorizer callAuthorizer, deps Deps) (pb.ControlClient, func()) {
+	return newAuditedTestClient(t, authorizer, nil, deps)
+}
+
+func newAuditedTestClient(t *testing.T, authorizer callAuthorizer, auditLog AuditLog, deps Deps) (pb.ControlClient, func()) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer(listener, nil, authorizer, auditLog, NewControlService(deps))
+	server.Serve()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+	return pb.NewControlClient(conn), func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func TestServer_Authorization(t *testing.T) {
+	authorizer := &mockAuthorizer{}
+	sessions := &mockSessionStorage{}
+	client, stop := newTestClient(t, authorizer, Deps{SessionStorage: sessions})
+	defer stop()
+
+	_, err := client.ListSessions(withToken("mtk_token"), &pb.SessionFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, "mtk_token", authorizer.token)
+	assert.Equal(t, "GET", authorizer.method)
+	assert.Equal(t, "/sessions", authorizer.path)
+
+	authorizer.err = auth.ErrForbidden
+	_, err = client.ListSessions(withToken("mtk_token"), &pb.SessionFilter{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	authorizer.err = auth.ErrUnauthorized
+	_, err = client.ListSessions(context.Background(), &pb.SessionFilter{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Empty(t, authorizer.token)
+}
+
+func TestServer_AuthorizesStreams(t *testing.T) {
+	authorizer := &mockAuthorizer{err: auth.ErrForbidden}
+	client, stop := newTestClient(t, authorizer, Deps{})
+	defer stop()
+
+	stream, err := client.WatchEvents(withToken("token"), &pb.WatchEventsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "/events", authorizer.path)
+}
+
+func TestServer_AuditsCalls(t *testing.T) {
+	authorizer := &mockAuthorizer{}
+	auditLog := &mockAuditLog{}
+	client, stop := newAuditedTestClient(t, authorizer, auditLog, Deps{
+		ServiceManager: &mockServiceManager{},
+		SessionStorage: &mockSessionStorage{},
+	})
+	defer stop()
+
+	_, err := client.ListSessions(withToken("abc"), &pb.SessionFilter{})
+	assert.NoError(t, err)
+	assert.Empty(t, auditLog.Entries(), "reads are not audited")
+
+	_, err = client.StopService(withToken("abc"), &pb.ServiceRequest{Id: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	authorizer.err = auth.ErrForbidden
+	_, err = client.CreateIdentity(withToken("abc"), &pb.CreateIdentityRequest{Passphrase: "secret"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	entries := auditLog.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "token:abc", entries[0].Actor)
+	assert.Equal(t, "GRPC", entries[0].Method)
+	assert.Equal(t, "/control.Control/StopService", entries[0].Path)
+	assert.JSONEq(t, `{"id":"unknown"}`, string(entries[0].Body))
+	assert.Equal(t, http.StatusNotFound, entries[0].Status)
+	assert.NotEmpty(t, entries[0].RemoteAddr)
+
+	assert.Equal(t, "/control.Control/CreateIdentity", entries[1].Path)
+	assert.JSONEq(t, `{"passphrase":"[REDACTED]"}`, string(entries[1].Body))
+	assert.Equal(t, http.StatusForbidden, entries[1].Status)
+}
+
+func TestControl_ListSessions(t *testing.T) {
+	started := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	sessions := &mockSessionStorage{sessions: []session.History{{
+		SessionID:    "session1",
+		Direction:    session.DirectionConsumed,
+		ConsumerID:   identity.FromAddress("0x1"),
+		ProviderID:   identity.FromAddress("0x2"),
+		ServiceType:  "wireguard",
+		DataSent:     10,
+		DataReceived: 20,
+		Tokens:       big.NewInt(500),
+		Status:       "Completed",
+		Started:      started,
+		Updated:      started.Add(time.Minute),
+	}}}
+	client, stop := newTestClient(t, &mockAuthorizer{}, Deps{SessionStorage: sessions})
+	defer stop()
+
+	res, err := client.ListSessions(context.Background(), &pb.SessionFilter{ServiceType: "wireguard"})
+	require.NoError(t, err)
+	require.Len(t, res.Sessions, 1)
+
+	se := res.Sessions[0]
+	assert.Equal(t, "session1", se.Id)
+	assert.Equal(t, "0x2", se.ProviderId)
+	assert.Equal(t, uint64(60), se.DurationSeconds)
+	assert.Equal(t, uint64(20), se.BytesReceived)
+	assert.Equal(t, "500", se.Tokens)
+	assert.Equal(t, started, se.StartedAt.AsTime())
+	assert.Equal(t, "wireguard", *sessions.filter.ServiceType)
+}
+
+func TestControl_GetIdentity(t *testing.T) {
+	idm := identity.NewIdentityManagerFake([]identity.Identity{identity.FromAddress("0x1")}, identity.Identity{})
+	state := &mockStateProvider{state: stateEvent.State{Identities: []stateEvent.Identity{{
+		Address:            "0x1",
+		RegistrationStatus: registry.Registered,
+		Balance:            big.NewInt(100),
+	}}}}
+	client, stop := newTestClient(t, &mockAuthorizer{}, Deps{IdentityManager: idm, StateProvider: state})
+	defer stop()
+
+	res, err := client.GetIdentity(context.Background(), &pb.IdentityRequest{Address: "0x1"})
+	require.NoError(t, err)
+	assert.Equal(t, "Registered", res.RegistrationStatus)
+	assert.Equal(t, "100", res.Balance)
+	assert.Equal(t, "0", res.Earnings)
+
+	_, err = client.GetIdentity(context.Background(), &pb.IdentityRequest{Address: "0x2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestControl_StopUnknownService(t *testing.T) {
+	client, stop := newTestClient(t, &mockAuthorizer{}, Deps{ServiceManager: &mockServiceManager{}})
+	defer stop()
+
+	_, err := client.StopService(context.Background(), &pb.ServiceRequest{Id: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestControl_WatchEvents(t *testing.T) {
+	events := endpoints.NewSSEHandler(&mockStateProvider{})
+	events.ConsumeTraceEvent(trace.Event{ID: "first"})
+	events.ConsumeTraceEvent(trace.Event{ID: "second"})
+	client, stop := newTestClient(t, &mockAuthorizer{}, Deps{EventStream: events})
+	defer stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.WatchEvents(ctx, &pb.WatchEventsRequest{Topics: []string{"trace"}, LastEventId: 1})
+	require.NoError(t, err)
+
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), event.Id)
+	assert.Equal(t, "trace", event.Type)
+	assert.Contains(t, string(event.Payload), `"id":"second"`)
+}
+
+func TestControl_WatchEventsRejectsUnknownTopics(t *testing.T) {
+	client, stop := newTestClient(t, &mockAuthorizer{}, Deps{EventStream: endpoints.NewSSEHandler(&mockStateProvider{})})
+	defer stop()
+
+	stream, err := client.WatchEvents(context.Background(), &pb.WatchEventsRequest{Topics: []string{"unknown"}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/mysteriumnetwork/node/identity/registry"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+const (
+	bundleType    = "myst-identity-backup"
+	bundleVersion = 1
+
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLength   = 32
+)
+
+// ErrInvalidPassphrase is returned when bundle can not be decrypted with the given passphrase.
+var ErrInvalidPassphrase = errors.New("could not decrypt bundle, invalid passphrase")
+
+// Bundle holds everything needed to migrate identity to another node.
+type Bundle struct {
+	CreatedAt time.Time `json:"created_at"`
+	ChainID   int64     `json:"chain_id"`
+	Identity  string    `json:"identity"`
+
+	// Key is an ethereum keystore JSON encrypted with the bundle passphrase.
+	Key json.RawMessage `json:"key"`
+
+	Registration *registry.StoredRegistrationStatus `json:"registration,omitempty"`
+	Beneficiary  string                             `json:"beneficiary,omitempty"`
+	Promises     []pingpong.HermesPromise           `json:"hermes_promises,omitempty"`
+	Settlements  []pingpong.SettlementHistoryEntry  `json:"settlement_history,omitempty"`
+}
+
+type envelope struct {
+	Type       string `json:"type"`
+	Version    int    `json:"version"`
+	ScryptN    int    `json:"scrypt_n"`
+	ScryptR    int    `json:"scrypt_r"`
+	ScryptP    int    `json:"scrypt_p"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// IsBundle checks whether given data is an encrypted backup bundle.
+func IsBundle(data []byte) bool {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return false
+	}
+	return env.Type == bundleType
+}
+
+// Seal encrypts the bundle with the given passphrase.
+func Seal(bundle Bundle, passphrase string) ([]byte, error) {
+	plaintext, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal bundle: %w", err)
+	}
+
+	env := envelope{
+		Type:    bundleType,
+		Version: bundleVersion,
+		ScryptN: scryptN,
+		ScryptR: scryptR,
+		ScryptP: scryptP,
+		Salt:    make([]byte, saltLength),
+	}
+	if _, err := io.ReadFull(rand.Reader, env.Salt); err != nil {
+		return nil, err
+	}
+
+	gcm, err := env.cipher(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	env.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, env.Nonce); err != nil {
+		return nil, err
+	}
+	env.Ciphertext = gcm.Seal(nil, env.Nonce, plaintext, nil)
+
+	return json.Marshal(env)
+}
+
+// Open decrypts the bundle with the given passphrase.
+func Open(data []byte, passphrase string) (Bundle, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return Bundle{}, fmt.Errorf("could not unmarshal bundle: %w", err)
+	}
+	if env.Type != bundleType {
+		return Bundle{}, errors.New("data is not an identity backup bundle")
+	}
+	if env.Version != bundleVersion {
+		return Bundle{}, fmt.Errorf("unsupported bundle version %d", env.Version)
+	}
+
+	gcm, err := env.cipher(passphrase)
+	if err != nil {
+		return Bundle{}, err
+	}
+	if len(env.Nonce) != gcm.NonceSize() {
+		return Bundle{}, errors.New("invalid bundle nonce")
+	}
+	plaintext, err := gcm.Open(nil, env.Nonce, env.Ciphertext, nil)
+	if err != nil {
+		return Bundle{}, ErrInvalidPassphrase
+	}
+
+	var bundle Bundle
+	if err := json.Unmarshal(plaintext, &bundle); err != nil {
+		return Bundle{}, fmt.Errorf("could not unmarshal bundle contents: %w", err)
+	}
+	return bundle, nil
+}
+
+func (env envelope) cipher(passphrase string) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), env.Salt, env.ScryptN, env.ScryptR, env.ScryptP, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("could not derive bundle key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
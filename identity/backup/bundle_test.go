@@ -0,0 +1,56 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package backup
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/identity/registry"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+func TestBundle_SealAndOpen(t *testing.T) {
+	bundle := Bundle{
+		ChainID:      5,
+		Identity:     "0x1",
+		Key:          json.RawMessage(`{"address":"0000000000000000000000000000000000000001"}`),
+		Registration: &registry.StoredRegistrationStatus{RegistrationStatus: registry.Registered, ChainID: 5},
+		Beneficiary:  "0x0000000000000000000000000000000000000002",
+		Promises:     []pingpong.HermesPromise{{ChannelID: "0x3", AgreementID: big.NewInt(7)}},
+	}
+
+	sealed, err := Seal(bundle, "secret")
+	assert.NoError(t, err)
+	assert.True(t, IsBundle(sealed))
+	assert.NotContains(t, string(sealed), "0x0000000000000000000000000000000000000002")
+
+	opened, err := Open(sealed, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, bundle, opened)
+
+	_, err = Open(sealed, "wrong")
+	assert.Equal(t, ErrInvalidPassphrase, err)
+}
+
+func TestIsBundle(t *testing.T) {
+	assert.False(t, IsBundle([]byte(`{"address":"0000000000000000000000000000000000000001","crypto":{}}`)))
+	assert.False(t, IsBundle([]byte("not json")))
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/identity/registry"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+type keyMover interface {
+	Export(address, currPass, newPass string) ([]byte, error)
+	Import(blob []byte, currPass, newPass string) (identity.Identity, error)
+}
+
+type registrationStorage interface {
+	Get(chainID int64, id identity.Identity) (registry.StoredRegistrationStatus, error)
+	Store(status registry.StoredRegistrationStatus) error
+}
+
+type beneficiaryProvider interface {
+	GetBeneficiary(identity common.Address) (common.Address, error)
+}
+
+type beneficiaryRestorer interface {
+	RestoreBeneficiary(id identity.Identity, beneficiary common.Address) error
+}
+
+type promiseStorage interface {
+	List(filter pingpong.HermesPromiseFilter) ([]pingpong.HermesPromise, error)
+	Store(promise pingpong.HermesPromise) error
+}
+
+type settlementStorage interface {
+	List(filter pingpong.SettlementHistoryFilter) ([]pingpong.SettlementHistoryEntry, error)
+	Store(entry pingpong.SettlementHistoryEntry) error
+}
+
+// Mover exports and imports identities either as plain keys or as encrypted backup bundles.
+type Mover struct {
+	keys          keyMover
+	chainID       int64
+	registrations registrationStorage
+	beneficiaries beneficiaryProvider
+	restorer      beneficiaryRestorer
+	promises      promiseStorage
+	settlements   settlementStorage
+}
+
+// NewMover returns a new backup mover.
+func NewMover(
+	keys keyMover,
+	chainID int64,
+	registrations registrationStorage,
+	beneficiaries beneficiaryProvider,
+	restorer beneficiaryRestorer,
+	promises promiseStorage,
+	settlements settlementStorage,
+) *Mover {
+	return &Mover{
+		keys:          keys,
+		chainID:       chainID,
+		registrations: registrations,
+		beneficiaries: beneficiaries,
+		restorer:      restorer,
+		promises:      promises,
+		settlements:   settlements,
+	}
+}
+
+// Export exports a given identity key as keystore json encrypted with newPass.
+func (m *Mover) Export(address, currPass, newPass string) ([]byte, error) {
+	return m.keys.Export(address, currPass, newPass)
+}
+
+// Bundle exports identity key together with its registration, beneficiary, Hermes promises
+// and settlement history as a bundle encrypted with the given passphrase.
+func (m *Mover) Bundle(address, currPass, passphrase string) ([]byte, error) {
+	key, err := m.keys.Export(address, currPass, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	id := identity.FromAddress(strings.ToLower(address))
+	bundle := Bundle{
+		CreatedAt: time.Now().UTC(),
+		ChainID:   m.chainID,
+		Identity:  id.Address,
+		Key:       key,
+	}
+
+	if status, err := m.registrations.Get(m.chainID, id); err == nil {
+		bundle.Registration = &status
+	} else if err != registry.ErrNotFound {
+		return nil, fmt.Errorf("could not get registration status: %w", err)
+	}
+
+	if beneficiary, err := m.beneficiaries.GetBeneficiary(id.ToCommonAddress()); err == nil {
+		if beneficiary != (common.Address{}) {
+			bundle.Beneficiary = beneficiary.Hex()
+		}
+	} else {
+		log.Warn().Err(err).Msgf("Could not get beneficiary of %s, skipping it in backup", id.Address)
+	}
+
+	bundle.Promises, err = m.promises.List(pingpong.HermesPromiseFilter{Identity: &id, ChainID: m.chainID})
+	if err != nil {
+		return nil, fmt.Errorf("could not list hermes promises: %w", err)
+	}
+
+	bundle.Settlements, err = m.settlements.List(pingpong.SettlementHistoryFilter{ProviderID: &id})
+	if err != nil {
+		return nil, fmt.Errorf("could not list settlement history: %w", err)
+	}
+
+	return Seal(bundle, passphrase)
+}
+
+// Import imports a given blob as a new identity.
+// Blob is either a keystore json or a backup bundle, in which case identity state is restored as well.
+func (m *Mover) Import(blob []byte, currPass, newPass string) (identity.Identity, error) {
+	if !IsBundle(blob) {
+		return m.keys.Import(blob, currPass, newPass)
+	}
+
+	bundle, err := Open(blob, currPass)
+	if err != nil {
+		return identity.Identity{}, err
+	}
+
+	id, err := m.keys.Import(bundle.Key, currPass, newPass)
+	if err != nil {
+		return identity.Identity{}, err
+	}
+
+	if err := m.restore(id, bundle); err != nil {
+		return id, fmt.Errorf("identity key imported, but state could not be restored: %w", err)
+	}
+	return id, nil
+}
+
+func (m *Mover) restore(id identity.Identity, bundle Bundle) error {
+	if bundle.Registration != nil {
+		status := *bundle.Registration
+		status.Identity = id
+		if err := m.registrations.Store(status); err != nil {
+			return fmt.Errorf("could not restore registration status: %w", err)
+		}
+	}
+
+	if bundle.Beneficiary != "" && bundle.ChainID == m.chainID {
+		if err := m.restorer.RestoreBeneficiary(id, common.HexToAddress(bundle.Beneficiary)); err != nil {
+			return fmt.Errorf("could not restore beneficiary: %w", err)
+		}
+	}
+
+	for _, promise := range bundle.Promises {
+		err := m.promises.Store(promise)
+		if err != nil && err != pingpong.ErrAttemptToOverwrite {
+			return fmt.Errorf("could not restore hermes promise: %w", err)
+		}
+	}
+
+	for _, entry := range bundle.Settlements {
+		if err := m.settlements.Store(entry); err != nil {
+			return fmt.Errorf("could not restore settlement history: %w", err)
+		}
+	}
+
+	log.Info().Msgf("Restored identity %s: %d promises, %d settlements", id.Address, len(bundle.Promises), len(bundle.Settlements))
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package backup
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/identity/registry"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+const testKey = `{"address":"0000000000000000000000000000000000000001","crypto":{}}`
+
+type mockKeyMover struct {
+	importedBlob []byte
+}
+
+func (m *mockKeyMover) Export(address, currPass, newPass string) ([]byte, error) {
+	return []byte(testKey), nil
+}
+
+func (m *mockKeyMover) Import(blob []byte, currPass, newPass string) (identity.Identity, error) {
+	m.importedBlob = blob
+	return identity.FromAddress("0x0000000000000000000000000000000000000001"), nil
+}
+
+type mockRegistrationStorage struct {
+	statuses map[string]registry.StoredRegistrationStatus
+}
+
+func (m *mockRegistrationStorage) Get(chainID int64, id identity.Identity) (registry.StoredRegistrationStatus, error) {
+	status, ok := m.statuses[id.Address]
+	if !ok {
+		return status, registry.ErrNotFound
+	}
+	return status, nil
+}
+
+func (m *mockRegistrationStorage) Store(status registry.StoredRegistrationStatus) error {
+	m.statuses[status.Identity.Address] = status
+	return nil
+}
+
+type mockBeneficiaries struct {
+	beneficiary common.Address
+	restored    map[string]common.Address
+}
+
+func (m *mockBeneficiaries) GetBeneficiary(identity common.Address) (common.Address, error) {
+	if m.beneficiary == (common.Address{}) {
+		return common.Address{}, errors.New("not found")
+	}
+	return m.beneficiary, nil
+}
+
+func (m *mockBeneficiaries) RestoreBeneficiary(id identity.Identity, beneficiary common.Address) error {
+	m.restored[id.Address] = beneficiary
+	return nil
+}
+
+type mockPromiseStorage struct {
+	promises []pingpong.HermesPromise
+}
+
+func (m *mockPromiseStorage) List(filter pingpong.HermesPromiseFilter) ([]pingpong.HermesPromise, error) {
+	return m.promises, nil
+}
+
+func (m *mockPromiseStorage) Store(promise pingpong.HermesPromise) error {
+	m.promises = append(m.promises, promise)
+	return nil
+}
+
+type mockSettlementStorage struct {
+	entries []pingpong.SettlementHistoryEntry
+}
+
+func (m *mockSettlementStorage) List(filter pingpong.SettlementHistoryFilter) ([]pingpong.SettlementHistoryEntry, error) {
+	return m.entries, nil
+}
+
+func (m *mockSettlementStorage) Store(entry pingpong.SettlementHistoryEntry) error {
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func TestMover_BundleAndImport(t *testing.T) {
+	id := identity.FromAddress("0x0000000000000000000000000000000000000001")
+	beneficiary := common.HexToAddress("0x2")
+
+	source := NewMover(
+		&mockKeyMover{},
+		5,
+		&mockRegistrationStorage{statuses: map[string]registry.StoredRegistrationStatus{
+			id.Address: {Identity: id, ChainID: 5, RegistrationStatus: registry.Registered},
+		}},
+		&mockBeneficiaries{beneficiary: beneficiary},
+		nil,
+		&mockPromiseStorage{promises: []pingpong.HermesPromise{{ChannelID: "0x3", Identity: id, AgreementID: big.NewInt(1)}}},
+		&mockSettlementStorage{entries: []pingpong.SettlementHistoryEntry{{TxHash: common.HexToHash("0x4"), ProviderID: id, Amount: big.NewInt(10)}}},
+	)
+	blob, err := source.Bundle(id.Address, "", "secret")
+	assert.NoError(t, err)
+
+	keys := &mockKeyMover{}
+	registrations := &mockRegistrationStorage{statuses: map[string]registry.StoredRegistrationStatus{}}
+	beneficiaries := &mockBeneficiaries{restored: map[string]common.Address{}}
+	promises := &mockPromiseStorage{}
+	settlements := &mockSettlementStorage{}
+	target := NewMover(keys, 5, registrations, beneficiaries, beneficiaries, promises, settlements)
+
+	_, err = target.Import(blob, "wrong", "")
+	assert.Equal(t, ErrInvalidPassphrase, err)
+
+	imported, err := target.Import(blob, "secret", "new")
+	assert.NoError(t, err)
+	assert.Equal(t, id, imported)
+	assert.JSONEq(t, testKey, string(keys.importedBlob))
+	assert.Equal(t, registry.Registered, registrations.statuses[id.Address].RegistrationStatus)
+	assert.Equal(t, beneficiary, beneficiaries.restored[id.Address])
+	assert.Len(t, promises.promises, 1)
+	assert.Len(t, settlements.entries, 1)
+}
+
+func TestMover_ImportsPlainKey(t *testing.T) {
+	keys := &mockKeyMover{}
+	mover := NewMover(keys, 5, nil, nil, nil, nil, nil)
+
+	_, err := mover.Import([]byte(testKey), "pass", "")
+	assert.NoError(t, err)
+	assert.Equal(t, testKey, string(keys.importedBlob))
+}
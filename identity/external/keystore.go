@@ -0,0 +1,256 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+// Package external implements identity keystore backed by an external signer.
+//
+// The signer is reached over JSON-RPC (HTTP, websocket or IPC) and must implement:
+//   account_list()                  -> [address]
+//   account_new()                   -> address
+//   account_signHash(address, hash) -> 65 byte [R || S || V] signature of the given 32 byte hash
+//   account_encrypt(address, data)  -> data encrypted with a key derived from the account
+//   account_decrypt(address, data)  -> data decrypted with a key derived from the account
+//   account_export(address, passphrase, newPassphrase)  -> key JSON encrypted with newPassphrase
+//   account_import(keyJSON, passphrase, newPassphrase)  -> address
+//
+// account_list and account_new follow Clef's external API. Clef does not sign raw hashes,
+// so the rest has to be provided by the signing service (or a proxy in front of Clef).
+// CheckSupport verifies that the signer serves everything the node needs.
+// Private keys never leave the signer: unlocking only checks that the signer holds the account
+// and identities cannot be locked or unlocked for a limited time.
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethKs "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// URLScheme is used for accounts held by the external signer.
+	URLScheme = "extsigner"
+
+	signatureLength = 65
+
+	rpcMethodNotFound = -32601
+)
+
+// requiredMethods are probed by CheckSupport. account_new is left out as calling it creates an account.
+var requiredMethods = []string{
+	"account_list",
+	"account_signHash",
+	"account_encrypt",
+	"account_decrypt",
+	"account_export",
+	"account_import",
+}
+
+type rpcCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Keystore keeps identities in an external signer.
+type Keystore struct {
+	client  rpcCaller
+	address string
+	timeout time.Duration
+}
+
+// NewKeystore connects to the external signer at the given address.
+// Timeout should leave enough time for manual approval if the signer requires it.
+func NewKeystore(address string, timeout time.Duration) (*Keystore, error) {
+	client, err := rpc.Dial(address)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial external signer: %w", err)
+	}
+
+	return newKeystore(client, address, timeout), nil
+}
+
+func newKeystore(client rpcCaller, address string, timeout time.Duration) *Keystore {
+	return &Keystore{
+		client:  client,
+		address: address,
+		timeout: timeout,
+	}
+}
+
+// Accounts returns all accounts held by the external signer.
+func (ks *Keystore) Accounts() []accounts.Account {
+	addresses, err := ks.list()
+	if err != nil {
+		log.Error().Err(err).Msg("Could not list external signer accounts")
+		return nil
+	}
+
+	result := make([]accounts.Account, len(addresses))
+	for i, address := range addresses {
+		result[i] = ks.account(address)
+	}
+	return result
+}
+
+// NewAccount asks the external signer to create a new account.
+// Passphrase is ignored, signer protects its keys on its own.
+func (ks *Keystore) NewAccount(_ string) (accounts.Account, error) {
+	var address common.Address
+	if err := ks.call(&address, "account_new"); err != nil {
+		return accounts.Account{}, err
+	}
+
+	return ks.account(address), nil
+}
+
+// Find returns the account if it is held by the external signer.
+func (ks *Keystore) Find(a accounts.Account) (accounts.Account, error) {
+	addresses, err := ks.list()
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	for _, address := range addresses {
+		if address == a.Address {
+			return ks.account(address), nil
+		}
+	}
+	return accounts.Account{}, ethKs.ErrNoMatch
+}
+
+// Unlock checks that the account is held by the external signer.
+// Passphrase is ignored, signer approves signing on its own.
+func (ks *Keystore) Unlock(a accounts.Account, _ string) error {
+	_, err := ks.Find(a)
+	return err
+}
+
+// Lock does nothing, signer keeps keys on its own.
+func (ks *Keystore) Lock(_ common.Address) error {
+	return nil
+}
+
+// LockSupported tells that accounts of the external signer cannot be locked, so timed unlocks and locks are rejected.
+func (ks *Keystore) LockSupported() bool {
+	return false
+}
+
+// SignHash asks the external signer to sign the given hash.
+func (ks *Keystore) SignHash(a accounts.Account, hash []byte) ([]byte, error) {
+	var signature hexutil.Bytes
+	if err := ks.call(&signature, "account_signHash", a.Address, hexutil.Bytes(hash)); err != nil {
+		return nil, err
+	}
+	if len(signature) != signatureLength {
+		return nil, fmt.Errorf("external signer returned signature of invalid length %d", len(signature))
+	}
+
+	// Signers following Ethereum conventions return V as 27/28 while we recover from 0/1.
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+	return signature, nil
+}
+
+// Encrypt asks the external signer to encrypt the plaintext with a key derived from the given account.
+func (ks *Keystore) Encrypt(addr common.Address, plaintext []byte) ([]byte, error) {
+	var encrypted hexutil.Bytes
+	if err := ks.call(&encrypted, "account_encrypt", addr, hexutil.Bytes(plaintext)); err != nil {
+		return nil, err
+	}
+	return encrypted, nil
+}
+
+// Decrypt asks the external signer to decrypt the data encrypted by Encrypt.
+func (ks *Keystore) Decrypt(addr common.Address, encrypted []byte) ([]byte, error) {
+	var plaintext hexutil.Bytes
+	if err := ks.call(&plaintext, "account_decrypt", addr, hexutil.Bytes(encrypted)); err != nil {
+		return nil, err
+	}
+	return plaintext, nil
+}
+
+// Export asks the external signer to export the account key encrypted with newPassphrase.
+func (ks *Keystore) Export(a accounts.Account, passphrase, newPassphrase string) ([]byte, error) {
+	var keyJSON hexutil.Bytes
+	if err := ks.call(&keyJSON, "account_export", a.Address, passphrase, newPassphrase); err != nil {
+		return nil, err
+	}
+	return keyJSON, nil
+}
+
+// Import hands the encrypted account key over to the external signer.
+func (ks *Keystore) Import(keyJSON []byte, passphrase, newPassphrase string) (accounts.Account, error) {
+	var address common.Address
+	if err := ks.call(&address, "account_import", hexutil.Bytes(keyJSON), passphrase, newPassphrase); err != nil {
+		return accounts.Account{}, err
+	}
+	return ks.account(address), nil
+}
+
+// CheckSupport verifies that the external signer is reachable and serves all methods the node relies on.
+// Methods are probed without arguments, so signers reject them before doing any work.
+func (ks *Keystore) CheckSupport() error {
+	var missing []string
+	for _, method := range requiredMethods {
+		err := ks.call(nil, method)
+		if err == nil {
+			continue
+		}
+
+		var rpcErr rpc.Error
+		if !errors.As(err, &rpcErr) {
+			return err
+		}
+		if rpcErr.ErrorCode() == rpcMethodNotFound {
+			missing = append(missing, method)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("external signer at %s does not support: %s", ks.address, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (ks *Keystore) list() ([]common.Address, error) {
+	var addresses []common.Address
+	err := ks.call(&addresses, "account_list")
+	return addresses, err
+}
+
+func (ks *Keystore) call(result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), ks.timeout)
+	defer cancel()
+
+	if err := ks.client.CallContext(ctx, result, method, args...); err != nil {
+		return fmt.Errorf("external signer call %s failed: %w", method, err)
+	}
+	return nil
+}
+
+func (ks *Keystore) account(address common.Address) accounts.Account {
+	return accounts.Account{
+		Address: address,
+		URL:     accounts.URL{Scheme: URLScheme, Path: ks.address},
+	}
+}
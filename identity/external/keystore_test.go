@@ -0,0 +1,212 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package external
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethKs "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/identity"
+)
+
+// mockSigner is a minimal external signer returning Ethereum style (27/28) recovery IDs.
+type mockSigner struct {
+	keys map[common.Address]*ecdsa.PrivateKey
+}
+
+func (s *mockSigner) List() []common.Address {
+	result := make([]common.Address, 0, len(s.keys))
+	for address := range s.keys {
+		result = append(result, address)
+	}
+	return result
+}
+
+func (s *mockSigner) New() (common.Address, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return common.Address{}, err
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	s.keys[address] = key
+	return address, nil
+}
+
+func (s *mockSigner) SignHash(address common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	key, ok := s.keys[address]
+	if !ok {
+		return nil, errors.New("unknown account")
+	}
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, err
+	}
+	signature[64] += 27
+	return signature, nil
+}
+
+// Encrypt xors data with the account address, which is enough to check the round trip.
+func (s *mockSigner) Encrypt(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if _, ok := s.keys[address]; !ok {
+		return nil, errors.New("unknown account")
+	}
+	result := make(hexutil.Bytes, len(data))
+	for i := range data {
+		result[i] = data[i] ^ address[i%common.AddressLength]
+	}
+	return result, nil
+}
+
+func (s *mockSigner) Decrypt(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.Encrypt(address, data)
+}
+
+func (s *mockSigner) Export(address common.Address, _, newPassphrase string) (hexutil.Bytes, error) {
+	key, ok := s.keys[address]
+	if !ok {
+		return nil, errors.New("unknown account")
+	}
+	return ethKs.EncryptKey(&ethKs.Key{Address: address, PrivateKey: key}, newPassphrase, ethKs.LightScryptN, ethKs.LightScryptP)
+}
+
+func (s *mockSigner) Import(keyJSON hexutil.Bytes, passphrase, _ string) (common.Address, error) {
+	key, err := ethKs.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return common.Address{}, err
+	}
+	s.keys[key.Address] = key.PrivateKey
+	return key.Address, nil
+}
+
+// clefSigner serves only the methods Clef does.
+type clefSigner struct {
+	signer mockSigner
+}
+
+func (s *clefSigner) List() []common.Address {
+	return s.signer.List()
+}
+
+func (s *clefSigner) New() (common.Address, error) {
+	return s.signer.New()
+}
+
+func newMockSignerServer(t *testing.T) *httptest.Server {
+	return newSignerServer(t, &mockSigner{keys: make(map[common.Address]*ecdsa.PrivateKey)})
+}
+
+func newSignerServer(t *testing.T, signer interface{}) *httptest.Server {
+	server := rpc.NewServer()
+	err := server.RegisterName("account", signer)
+	assert.NoError(t, err)
+	return httptest.NewServer(server)
+}
+
+func TestKeystore_SignsWithExternalSigner(t *testing.T) {
+	server := newMockSignerServer(t)
+	defer server.Close()
+
+	ks, err := NewKeystore(server.URL, time.Second)
+	assert.NoError(t, err)
+
+	account, err := ks.NewAccount("ignored")
+	assert.NoError(t, err)
+	assert.Equal(t, URLScheme, account.URL.Scheme)
+	assert.Equal(t, []accounts.Account{account}, ks.Accounts())
+	assert.NoError(t, ks.Unlock(account, ""))
+
+	id := identity.FromAddress(account.Address.Hex())
+	message := []byte("message to sign")
+	signature, err := identity.NewSigner(ks, id).Sign(message)
+	assert.NoError(t, err)
+	assert.True(t, identity.NewVerifierIdentity(id).Verify(message, signature))
+	assert.False(t, identity.NewVerifierIdentity(identity.FromAddress("0x1")).Verify(message, signature))
+}
+
+func TestKeystore_UnknownAccount(t *testing.T) {
+	server := newMockSignerServer(t)
+	defer server.Close()
+
+	ks, err := NewKeystore(server.URL, time.Second)
+	assert.NoError(t, err)
+
+	unknown := accounts.Account{Address: common.HexToAddress("0x1")}
+	assert.Equal(t, ethKs.ErrNoMatch, ks.Unlock(unknown, ""))
+
+	_, err = ks.SignHash(unknown, crypto.Keccak256([]byte("message")))
+	assert.Error(t, err)
+}
+
+func TestKeystore_EncryptsAndMovesKeysWithExternalSigner(t *testing.T) {
+	server := newMockSignerServer(t)
+	defer server.Close()
+
+	ks, err := NewKeystore(server.URL, time.Second)
+	assert.NoError(t, err)
+	account, err := ks.NewAccount("")
+	assert.NoError(t, err)
+
+	encrypted, err := ks.Encrypt(account.Address, []byte("secret"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, []byte("secret"), encrypted)
+	decrypted, err := ks.Decrypt(account.Address, encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), decrypted)
+
+	keyJSON, err := ks.Export(account, "", "export")
+	assert.NoError(t, err)
+
+	otherServer := newMockSignerServer(t)
+	defer otherServer.Close()
+	other, err := NewKeystore(otherServer.URL, time.Second)
+	assert.NoError(t, err)
+
+	imported, err := other.Import(keyJSON, "export", "")
+	assert.NoError(t, err)
+	assert.Equal(t, account.Address, imported.Address)
+	assert.Equal(t, []accounts.Account{imported}, other.Accounts())
+}
+
+func TestKeystore_CheckSupport(t *testing.T) {
+	server := newMockSignerServer(t)
+	defer server.Close()
+	ks, err := NewKeystore(server.URL, time.Second)
+	assert.NoError(t, err)
+	assert.NoError(t, ks.CheckSupport())
+
+	clefServer := newSignerServer(t, &clefSigner{})
+	defer clefServer.Close()
+	clef, err := NewKeystore(clefServer.URL, time.Second)
+	assert.NoError(t, err)
+	err = clef.CheckSupport()
+	assert.EqualError(
+		t,
+		err,
+		"external signer at "+clefServer.URL+" does not support: account_signHash, account_encrypt, account_decrypt, account_export, account_import",
+	)
+}
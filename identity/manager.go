@@ -22,6 +22,7 @@ package identity
 
 import (
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
@@ -34,6 +35,7 @@ import (
 // Identity events
 const (
 	AppTopicIdentityUnlock  = "identity-unlocked"
+	AppTopicIdentityLock    = "identity-locked"
 	AppTopicIdentityCreated = "identity-created"
 )
 
@@ -43,6 +45,14 @@ type AppEventIdentityUnlock struct {
 	ID      Identity
 }
 
+// AppEventIdentityLock represents the payload that is sent on identity lock.
+type AppEventIdentityLock struct {
+	ID Identity
+}
+
+// ErrLockUnsupported is returned when identities of the keystore cannot be locked, e.g. external signer keeps its keys unlocked.
+var ErrLockUnsupported = errors.New("keystore does not support locking identities")
+
 // ResidentCountryEvent represent actual resident country changed event
 type ResidentCountryEvent struct {
 	ID      string
@@ -52,17 +62,37 @@ type ResidentCountryEvent struct {
 type identityManager struct {
 	keystoreManager keystore
 	residentCountry *ResidentCountry
-	unlocked        map[string]bool // Currently unlocked addresses
+	unlocked        map[string]bool        // Currently unlocked addresses
+	expiry          map[string]expiryTimer // Timers locking temporarily unlocked addresses
+	afterFunc       afterFunc              // Schedules unlock timeouts
 	unlockedMu      sync.RWMutex
 	eventBus        eventbus.EventBus
 }
 
+// lockReporter is implemented by keystores which might not lock their keys
+type lockReporter interface {
+	LockSupported() bool
+}
+
+// expiryTimer is a scheduled identity lock which can be cancelled
+type expiryTimer interface {
+	Stop() bool
+}
+
+// afterFunc calls f in its own goroutine after the duration elapses
+type afterFunc func(d time.Duration, f func()) expiryTimer
+
+func timeAfterFunc(d time.Duration, f func()) expiryTimer {
+	return time.AfterFunc(d, f)
+}
+
 // keystore allows actions with accounts (listing, creating, unlocking, signing)
 type keystore interface {
 	Accounts() []accounts.Account
 	NewAccount(passphrase string) (accounts.Account, error)
 	Find(a accounts.Account) (accounts.Account, error)
 	Unlock(a accounts.Account, passphrase string) error
+	Lock(addr common.Address) error
 	SignHash(a accounts.Account, hash []byte) ([]byte, error)
 }
 
@@ -72,6 +102,8 @@ func NewIdentityManager(keystore keystore, eventBus eventbus.EventBus, residentC
 		keystoreManager: keystore,
 		residentCountry: residentCountry,
 		unlocked:        map[string]bool{},
+		expiry:          map[string]expiryTimer{},
+		afterFunc:       timeAfterFunc,
 		eventBus:        eventBus,
 	}
 }
@@ -136,10 +168,20 @@ func (idm *identityManager) HasIdentity(address string) bool {
 }
 
 func (idm *identityManager) Unlock(chainID int64, address string, passphrase string) error {
+	return idm.UnlockTimed(chainID, address, passphrase, 0)
+}
+
+// UnlockTimed unlocks identity for the given duration, zero timeout unlocks it until it is locked explicitly.
+// Unlocking an already unlocked identity replaces its timeout.
+func (idm *identityManager) UnlockTimed(chainID int64, address string, passphrase string, timeout time.Duration) error {
+	if timeout > 0 && !idm.lockSupported() {
+		return ErrLockUnsupported
+	}
+
 	idm.unlockedMu.Lock()
 	defer idm.unlockedMu.Unlock()
 
-	if idm.unlocked[address] {
+	if idm.unlocked[address] && idm.expiry[address] == nil && timeout == 0 {
 		log.Debug().Msg("Unlocked identity found in cache, skipping keystore: " + address)
 		return nil
 	}
@@ -153,8 +195,14 @@ func (idm *identityManager) Unlock(chainID int64, address string, passphrase str
 	if err != nil {
 		return errors.Wrapf(err, "keystore failed to unlock identity: %s", address)
 	}
+
+	wasUnlocked := idm.unlocked[address]
 	log.Debug().Msgf("Caching unlocked address: %s", address)
 	idm.unlocked[address] = true
+	idm.scheduleLock(address, timeout)
+	if wasUnlocked {
+		return nil
+	}
 
 	go func() {
 		idm.eventBus.Publish(AppTopicIdentityUnlock, AppEventIdentityUnlock{
@@ -167,6 +215,74 @@ func (idm *identityManager) Unlock(chainID int64, address string, passphrase str
 	return nil
 }
 
+// Lock removes identity key from memory, identity has to be unlocked again before signing.
+func (idm *identityManager) Lock(address string) error {
+	if !idm.lockSupported() {
+		return ErrLockUnsupported
+	}
+
+	idm.unlockedMu.Lock()
+	defer idm.unlockedMu.Unlock()
+
+	return idm.lock(address)
+}
+
+func (idm *identityManager) lock(address string) error {
+	account, err := idm.findAccount(address)
+	if err != nil {
+		return err
+	}
+
+	if err := idm.keystoreManager.Lock(account.Address); err != nil {
+		return errors.Wrapf(err, "keystore failed to lock identity: %s", address)
+	}
+
+	idm.scheduleLock(address, 0)
+	if !idm.unlocked[address] {
+		return nil
+	}
+	delete(idm.unlocked, address)
+
+	log.Info().Msgf("Identity locked: %s", address)
+	go idm.eventBus.Publish(AppTopicIdentityLock, AppEventIdentityLock{ID: FromAddress(address)})
+	return nil
+}
+
+func (idm *identityManager) lockSupported() bool {
+	if ks, ok := idm.keystoreManager.(lockReporter); ok {
+		return ks.LockSupported()
+	}
+	return true
+}
+
+// scheduleLock replaces identity unlock timeout, zero timeout keeps identity unlocked.
+// Must be called holding unlockedMu.
+func (idm *identityManager) scheduleLock(address string, timeout time.Duration) {
+	if timer, ok := idm.expiry[address]; ok {
+		timer.Stop()
+		delete(idm.expiry, address)
+	}
+	if timeout <= 0 {
+		return
+	}
+
+	var timer expiryTimer
+	timer = idm.afterFunc(timeout, func() {
+		idm.unlockedMu.Lock()
+		defer idm.unlockedMu.Unlock()
+
+		// Timer might have been replaced while waiting for the lock.
+		if idm.expiry[address] != timer {
+			return
+		}
+		log.Info().Msgf("Identity unlock timeout of %s expired", address)
+		if err := idm.lock(address); err != nil {
+			log.Error().Err(err).Msgf("Could not lock identity %s", address)
+		}
+	})
+	idm.expiry[address] = timer
+}
+
 func (idm *identityManager) findAccount(address string) (accounts.Account, error) {
 	account, err := idm.keystoreManager.Find(addressToAccount(address))
 	if err != nil {
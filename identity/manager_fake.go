@@ -17,12 +17,18 @@
 
 package identity
 
-import "github.com/pkg/errors"
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 type idmFake struct {
 	LastUnlockAddress    string
 	LastUnlockPassphrase string
 	LastUnlockChainID    int64
+	LastUnlockTimeout    time.Duration
+	LastLockAddress      string
 	existingIdentities   []Identity
 	newIdentity          Identity
 	unlockFails          bool
@@ -32,7 +38,7 @@ type idmFake struct {
 // NewIdentityManagerFake creates fake identity manager for testing purposes
 // TODO each caller should use it's own mocked manager part instead of global one
 func NewIdentityManagerFake(existingIdentities []Identity, newIdentity Identity) *idmFake {
-	return &idmFake{"", "", 0, existingIdentities, newIdentity, false, true}
+	return &idmFake{"", "", 0, 0, "", existingIdentities, newIdentity, false, true}
 }
 
 func (fakeIdm *idmFake) IsUnlocked(id string) bool {
@@ -70,3 +76,13 @@ func (fakeIdm *idmFake) Unlock(chainID int64, address string, passphrase string)
 	}
 	return nil
 }
+
+func (fakeIdm *idmFake) UnlockTimed(chainID int64, address string, passphrase string, timeout time.Duration) error {
+	fakeIdm.LastUnlockTimeout = timeout
+	return fakeIdm.Unlock(chainID, address, passphrase)
+}
+
+func (fakeIdm *idmFake) Lock(address string) error {
+	fakeIdm.LastLockAddress = address
+	return nil
+}
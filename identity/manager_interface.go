@@ -17,6 +17,8 @@
 
 package identity
 
+import "time"
+
 // Manager interface exposes identity management methods
 // TODO this interface must decay into caller specific smaller interfaces
 type Manager interface {
@@ -25,5 +27,7 @@ type Manager interface {
 	GetIdentity(address string) (Identity, error)
 	HasIdentity(address string) bool
 	Unlock(chainID int64, address string, passphrase string) error
+	UnlockTimed(chainID int64, address string, passphrase string, timeout time.Duration) error
+	Lock(address string) error
 	IsUnlocked(address string) bool
 }
@@ -18,7 +18,9 @@
 package identity
 
 import (
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -69,3 +71,132 @@ func Test_IdentityManager(t *testing.T) {
 		assert.False(t, idm.HasIdentity("0x000000000000000000000000000000000000000B"))
 	})
 }
+
+func Test_IdentityManager_Lock(t *testing.T) {
+	bus := eventbus.New()
+	locked := make(chan AppEventIdentityLock, 2)
+	err := bus.Subscribe(AppTopicIdentityLock, func(e AppEventIdentityLock) {
+		locked <- e
+	})
+	assert.NoError(t, err)
+
+	ks := NewMockKeystoreWith(MockKeys)
+	idm := NewIdentityManager(ks, bus, NewResidentCountry(bus, newMockLocationResolver("LT")))
+	timers := &mockTimers{}
+	idm.afterFunc = timers.afterFunc
+	address := "0x53a835143c0ef3bbcbfa796d7eb738ca7dd28f68"
+	account := addressToAccount(address)
+
+	t.Run("locks identity explicitly", func(t *testing.T) {
+		assert.NoError(t, idm.Unlock(1, address, ""))
+		assert.True(t, idm.IsUnlocked(address))
+
+		assert.NoError(t, idm.Lock(address))
+		assert.False(t, idm.IsUnlocked(address))
+		_, err := ks.SignHash(account, make([]byte, 32))
+		assert.Error(t, err)
+		assert.Equal(t, AppEventIdentityLock{ID: FromAddress(address)}, <-locked)
+	})
+
+	t.Run("locks identity after timeout", func(t *testing.T) {
+		assert.NoError(t, idm.UnlockTimed(1, address, "", 10*time.Millisecond))
+		assert.True(t, idm.IsUnlocked(address))
+
+		timer := timers.last()
+		assert.Equal(t, 10*time.Millisecond, timer.duration)
+		timer.fire()
+
+		assert.Equal(t, AppEventIdentityLock{ID: FromAddress(address)}, <-locked)
+		assert.False(t, idm.IsUnlocked(address))
+	})
+
+	t.Run("unlocking indefinitely cancels timeout", func(t *testing.T) {
+		assert.NoError(t, idm.UnlockTimed(1, address, "", 100*time.Millisecond))
+		timer := timers.last()
+		assert.NoError(t, idm.Unlock(1, address, ""))
+		assert.True(t, timer.isStopped())
+
+		// Timer which already fired while unlocking must not lock identity.
+		timer.fire()
+		assert.True(t, idm.IsUnlocked(address))
+	})
+
+	t.Run("fails to unlock with wrong passphrase", func(t *testing.T) {
+		assert.NoError(t, idm.Lock(address))
+		<-locked
+		assert.Error(t, idm.UnlockTimed(1, address, "wrong", time.Minute))
+		assert.False(t, idm.IsUnlocked(address))
+	})
+}
+
+func Test_IdentityManager_LockUnsupported(t *testing.T) {
+	bus := eventbus.New()
+	ks := &nonLockingKeystore{NewMockKeystoreWith(MockKeys)}
+	idm := NewIdentityManager(ks, bus, NewResidentCountry(bus, newMockLocationResolver("LT")))
+	address := "0x53a835143c0ef3bbcbfa796d7eb738ca7dd28f68"
+
+	assert.Equal(t, ErrLockUnsupported, idm.UnlockTimed(1, address, "", time.Minute))
+	assert.False(t, idm.IsUnlocked(address))
+
+	assert.NoError(t, idm.Unlock(1, address, ""))
+	assert.True(t, idm.IsUnlocked(address))
+
+	assert.Equal(t, ErrLockUnsupported, idm.Lock(address))
+	assert.True(t, idm.IsUnlocked(address))
+}
+
+type nonLockingKeystore struct {
+	*mockKeystore
+}
+
+func (ks *nonLockingKeystore) LockSupported() bool {
+	return false
+}
+
+type mockTimers struct {
+	lock   sync.Mutex
+	timers []*mockTimer
+}
+
+func (mt *mockTimers) afterFunc(d time.Duration, f func()) expiryTimer {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	timer := &mockTimer{duration: d, f: f}
+	mt.timers = append(mt.timers, timer)
+	return timer
+}
+
+func (mt *mockTimers) last() *mockTimer {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	return mt.timers[len(mt.timers)-1]
+}
+
+type mockTimer struct {
+	lock     sync.Mutex
+	duration time.Duration
+	f        func()
+	stopped  bool
+}
+
+func (t *mockTimer) Stop() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	wasActive := !t.stopped
+	t.stopped = true
+	return wasActive
+}
+
+func (t *mockTimer) isStopped() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.stopped
+}
+
+func (t *mockTimer) fire() {
+	t.f()
+}
@@ -65,7 +65,37 @@ func Bootstrap() {
 // SetLogLevel sets global log level to the given one.
 func SetLogLevel(level zerolog.Level) {
 	CurrentLogOptions.LogLevel = level
-	log.Logger = log.Logger.Level(level)
+	levels.setGlobal(level)
+	applyLogLevels()
+}
+
+// SetComponentLogLevel overrides global log level for the given component (package path) and its subcomponents, e.g. "session/pingpong".
+func SetComponentLogLevel(component string, level zerolog.Level) {
+	levels.setComponent(component, level)
+	applyLogLevels()
+}
+
+// ResetComponentLogLevel removes log level override of the given component.
+func ResetComponentLogLevel(component string) {
+	levels.resetComponent(component)
+	applyLogLevels()
+}
+
+// ComponentLogLevels returns log level overrides of components.
+func ComponentLogLevels() map[string]zerolog.Level {
+	return levels.componentLevels()
+}
+
+// SubscribeLogs subscribes to JSON encoded log events matching the filter.
+// Subscription must be closed when no longer needed.
+func SubscribeLogs(filter LogFilter) *LogSubscription {
+	return streams.subscribe(filter)
+}
+
+// applyLogLevels makes global logger produce events for the most verbose of the levels,
+// the ones not needed are dropped by filterWriter.
+func applyLogLevels() {
+	log.Logger = log.Logger.Level(levels.minimum())
 }
 
 // Configure configures logger using app config (console + file, level).
@@ -86,7 +116,7 @@ func Configure(opts *LogOptions) {
 			log.Err(err).Msg("Failed to cleanup obsolete logs")
 		}
 	}
-	log.Logger = log.Logger.Level(opts.LogLevel)
+	SetLogLevel(opts.LogLevel)
 }
 
 func consoleWriter() io.Writer {
@@ -105,8 +135,8 @@ func zeroLogger(out io.Writer) io.Writer {
 }
 
 func makeLogger(w io.Writer) zerolog.Logger {
-	return log.Output(w).
-		Level(zerolog.DebugLevel).
+	return log.Output(&filterWriter{out: w, levels: levels, streams: streams}).
+		Level(levels.minimum()).
 		With().
 		Caller().
 		Timestamp().
@@ -0,0 +1,52 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package logconfig
+
+import "github.com/rs/zerolog"
+
+// LogControl allows changing log levels and streaming logs of the running node.
+type LogControl struct{}
+
+// LogLevel returns global log level.
+func (LogControl) LogLevel() zerolog.Level {
+	return CurrentLogOptions.LogLevel
+}
+
+// SetLogLevel sets global log level.
+func (LogControl) SetLogLevel(level zerolog.Level) {
+	SetLogLevel(level)
+}
+
+// ComponentLogLevels returns log level overrides of components.
+func (LogControl) ComponentLogLevels() map[string]zerolog.Level {
+	return ComponentLogLevels()
+}
+
+// SetComponentLogLevel overrides global log level for the given component.
+func (LogControl) SetComponentLogLevel(component string, level zerolog.Level) {
+	SetComponentLogLevel(component, level)
+}
+
+// ResetComponentLogLevel removes log level override of the given component.
+func (LogControl) ResetComponentLogLevel(component string) {
+	ResetComponentLogLevel(component)
+}
+
+// SubscribeLogs subscribes to JSON encoded log events matching the filter.
+func (LogControl) SubscribeLogs(filter LogFilter) *LogSubscription {
+	return SubscribeLogs(filter)
+}
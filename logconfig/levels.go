@@ -0,0 +1,159 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package logconfig
+
+import (
+	"encoding/json"
+	"io"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// levels stores global log level and its overrides for components, changeable at runtime.
+var levels = &levelFilter{
+	global:     CurrentLogOptions.LogLevel,
+	components: map[string]zerolog.Level{},
+}
+
+type levelFilter struct {
+	lock       sync.RWMutex
+	global     zerolog.Level
+	components map[string]zerolog.Level
+}
+
+func (lf *levelFilter) setGlobal(level zerolog.Level) {
+	lf.lock.Lock()
+	defer lf.lock.Unlock()
+
+	lf.global = level
+}
+
+func (lf *levelFilter) setComponent(component string, level zerolog.Level) {
+	lf.lock.Lock()
+	defer lf.lock.Unlock()
+
+	lf.components[component] = level
+}
+
+func (lf *levelFilter) resetComponent(component string) {
+	lf.lock.Lock()
+	defer lf.lock.Unlock()
+
+	delete(lf.components, component)
+}
+
+func (lf *levelFilter) componentLevels() map[string]zerolog.Level {
+	lf.lock.RLock()
+	defer lf.lock.RUnlock()
+
+	result := make(map[string]zerolog.Level, len(lf.components))
+	for component, level := range lf.components {
+		result[component] = level
+	}
+	return result
+}
+
+func (lf *levelFilter) hasComponents() bool {
+	lf.lock.RLock()
+	defer lf.lock.RUnlock()
+
+	return len(lf.components) > 0
+}
+
+// minimum returns the most verbose level needed to produce events for all components.
+func (lf *levelFilter) minimum() zerolog.Level {
+	lf.lock.RLock()
+	defer lf.lock.RUnlock()
+
+	min := lf.global
+	for _, level := range lf.components {
+		if level < min {
+			min = level
+		}
+	}
+	return min
+}
+
+// levelFor returns level of the closest configured component, falling back to global level.
+func (lf *levelFilter) levelFor(component string) zerolog.Level {
+	lf.lock.RLock()
+	defer lf.lock.RUnlock()
+
+	level, matched := lf.global, ""
+	for name, componentLevel := range lf.components {
+		if matchesComponent(component, name) && len(name) >= len(matched) {
+			level, matched = componentLevel, name
+		}
+	}
+	return level
+}
+
+// matchesComponent checks whether component is the given one or belongs to it, e.g. "session/pingpong" belongs to "session".
+func matchesComponent(component, name string) bool {
+	return component == name || strings.HasPrefix(component, name+"/")
+}
+
+// componentOf returns component (package path) of the caller, e.g. "session/pingpong/invoice_tracker.go:120" belongs to "session/pingpong".
+func componentOf(caller string) string {
+	caller = strings.TrimSpace(caller)
+	if i := strings.LastIndex(caller, ":"); i != -1 {
+		caller = caller[:i]
+	}
+	caller = strings.TrimPrefix(caller, "/")
+	if caller == "" {
+		return ""
+	}
+	return path.Dir(caller)
+}
+
+type logEvent struct {
+	Level  string `json:"level"`
+	Caller string `json:"caller"`
+}
+
+// filterWriter drops events below the level of their component and passes the rest to the output and log streams.
+type filterWriter struct {
+	out     io.Writer
+	levels  *levelFilter
+	streams *logStream
+}
+
+func (fw *filterWriter) Write(p []byte) (int, error) {
+	if !fw.levels.hasComponents() && !fw.streams.hasSubscribers() {
+		return fw.out.Write(p)
+	}
+
+	var event logEvent
+	if err := json.Unmarshal(p, &event); err != nil {
+		return fw.out.Write(p)
+	}
+
+	level, err := zerolog.ParseLevel(event.Level)
+	if err != nil {
+		level = zerolog.NoLevel
+	}
+	component := componentOf(event.Caller)
+	if level < fw.levels.levelFor(component) {
+		return len(p), nil
+	}
+
+	fw.streams.publish(p, level, component)
+	return fw.out.Write(p)
+}
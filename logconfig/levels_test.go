@@ -0,0 +1,96 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package logconfig
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_componentOf(t *testing.T) {
+	assert.Equal(t, "session/pingpong", componentOf("/session/pingpong/invoice_tracker.go:120      "))
+	assert.Equal(t, "cmd", componentOf("cmd/di.go:12"))
+	assert.Equal(t, "github.com/rs/zerolog@v1.17.2", componentOf("/github.com/rs/zerolog@v1.17.2/log.go:1"))
+	assert.Equal(t, "", componentOf(""))
+}
+
+func Test_levelFilter_levelFor(t *testing.T) {
+	lf := &levelFilter{global: zerolog.InfoLevel, components: map[string]zerolog.Level{}}
+	assert.Equal(t, zerolog.InfoLevel, lf.minimum())
+
+	lf.setComponent("session", zerolog.DebugLevel)
+	lf.setComponent("session/pingpong", zerolog.TraceLevel)
+	lf.setComponent("p2p", zerolog.ErrorLevel)
+
+	assert.Equal(t, zerolog.TraceLevel, lf.minimum())
+	assert.Equal(t, zerolog.TraceLevel, lf.levelFor("session/pingpong"))
+	assert.Equal(t, zerolog.TraceLevel, lf.levelFor("session/pingpong/event"))
+	assert.Equal(t, zerolog.DebugLevel, lf.levelFor("session/connectivity"))
+	assert.Equal(t, zerolog.ErrorLevel, lf.levelFor("p2p"))
+	assert.Equal(t, zerolog.InfoLevel, lf.levelFor("p2pnat"))
+	assert.Equal(t, zerolog.InfoLevel, lf.levelFor(""))
+
+	lf.resetComponent("session/pingpong")
+	assert.Equal(t, zerolog.DebugLevel, lf.levelFor("session/pingpong"))
+	assert.Equal(t, map[string]zerolog.Level{"session": zerolog.DebugLevel, "p2p": zerolog.ErrorLevel}, lf.componentLevels())
+}
+
+func Test_filterWriter(t *testing.T) {
+	// given
+	lf := &levelFilter{global: zerolog.WarnLevel, components: map[string]zerolog.Level{"session": zerolog.DebugLevel}}
+	ls := &logStream{subscribers: map[*LogSubscription]struct{}{}}
+	var out bytes.Buffer
+	logger := zerolog.New(&filterWriter{out: &out, levels: lf, streams: ls}).Level(lf.minimum())
+
+	all := ls.subscribe(LogFilter{Level: zerolog.TraceLevel})
+	defer all.Close()
+	sessions := ls.subscribe(LogFilter{Level: zerolog.InfoLevel, Component: "session"})
+	defer sessions.Close()
+
+	// when
+	logger.Debug().Str("caller", "/session/manager.go:10").Msg("session debug")
+	logger.Info().Str("caller", "/session/manager.go:11").Msg("session info")
+	logger.Info().Str("caller", "/p2p/dialer.go:10").Msg("p2p info")
+	logger.Warn().Str("caller", "/p2p/dialer.go:11").Msg("p2p warn")
+
+	// then
+	assert.NotContains(t, out.String(), "p2p info")
+	for _, msg := range []string{"session debug", "session info", "p2p warn"} {
+		assert.Contains(t, out.String(), msg)
+		assert.Contains(t, string(<-all.Events()), msg)
+	}
+	assert.Contains(t, string(<-sessions.Events()), "session info")
+	assert.Len(t, sessions.Events(), 0)
+}
+
+func Test_logStream_DropsEventsOfSlowSubscriber(t *testing.T) {
+	ls := &logStream{subscribers: map[*LogSubscription]struct{}{}}
+	sub := ls.subscribe(LogFilter{})
+
+	for i := 0; i < streamBufferSize+5; i++ {
+		ls.publish([]byte(`{}`), zerolog.InfoLevel, "")
+	}
+	assert.Len(t, sub.Events(), streamBufferSize)
+	assert.Equal(t, uint64(5), sub.Dropped())
+
+	sub.Close()
+	sub.Close()
+	assert.False(t, ls.hasSubscribers())
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package logconfig
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+const streamBufferSize = 1000
+
+// streams broadcasts written log events to subscribers.
+var streams = &logStream{subscribers: map[*LogSubscription]struct{}{}}
+
+// LogFilter selects log events for subscription.
+type LogFilter struct {
+	// Level is the minimum level of events.
+	Level zerolog.Level
+	// Component selects events of the given component and its subcomponents, e.g. "session" or "session/pingpong". Empty selects all.
+	Component string
+}
+
+func (f LogFilter) matches(level zerolog.Level, component string) bool {
+	if level < f.Level {
+		return false
+	}
+	return f.Component == "" || matchesComponent(component, f.Component)
+}
+
+// LogSubscription receives JSON encoded log events until closed.
+type LogSubscription struct {
+	filter  LogFilter
+	events  chan []byte
+	dropped uint64
+	stream  *logStream
+	once    sync.Once
+}
+
+// Events returns channel of JSON encoded log events.
+func (s *LogSubscription) Events() <-chan []byte {
+	return s.events
+}
+
+// Dropped returns number of events dropped because subscriber was not reading them fast enough.
+func (s *LogSubscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Close stops the subscription.
+func (s *LogSubscription) Close() {
+	s.once.Do(func() {
+		s.stream.unsubscribe(s)
+	})
+}
+
+type logStream struct {
+	lock        sync.RWMutex
+	subscribers map[*LogSubscription]struct{}
+}
+
+func (ls *logStream) subscribe(filter LogFilter) *LogSubscription {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+
+	sub := &LogSubscription{
+		filter: filter,
+		events: make(chan []byte, streamBufferSize),
+		stream: ls,
+	}
+	ls.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (ls *logStream) unsubscribe(sub *LogSubscription) {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+
+	delete(ls.subscribers, sub)
+}
+
+func (ls *logStream) hasSubscribers() bool {
+	ls.lock.RLock()
+	defer ls.lock.RUnlock()
+
+	return len(ls.subscribers) > 0
+}
+
+// publish passes event to matching subscribers, without ever blocking the logger.
+func (ls *logStream) publish(event []byte, level zerolog.Level, component string) {
+	ls.lock.RLock()
+	defer ls.lock.RUnlock()
+
+	var copied []byte
+	for sub := range ls.subscribers {
+		if !sub.filter.matches(level, component) {
+			continue
+		}
+		if copied == nil {
+			// Logger reuses the buffer once write returns.
+			copied = append([]byte(nil), event...)
+		}
+		select {
+		case sub.events <- copied:
+		default:
+			atomic.AddUint64(&sub.dropped, 1)
+		}
+	}
+}
@@ -28,6 +28,11 @@ type ServiceDefinition interface {
 	GetLocation() Location
 }
 
+// RelocatableServiceDefinition is implemented by service definitions which can be re-announced with a new location
+type RelocatableServiceDefinition interface {
+	WithLocation(loc Location) ServiceDefinition
+}
+
 // UnsupportedServiceDefinition represents unknown or unsupported service definition returned by deserializer
 type UnsupportedServiceDefinition struct {
 }
@@ -19,6 +19,7 @@ package market
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/mysteriumnetwork/node/identity"
 )
@@ -57,6 +58,9 @@ type ServiceProposal struct {
 
 	// AccessPolicies represents the access controls for proposal
 	AccessPolicies *[]AccessPolicy `json:"access_policies,omitempty"`
+
+	// AcceptedHermes lists Hermes addresses provider accepts payments through, in order of preference
+	AcceptedHermes []string `json:"accepted_hermes,omitempty"`
 }
 
 // UniqueID returns unique proposal composite ID
@@ -79,6 +83,7 @@ func (proposal *ServiceProposal) UnmarshalJSON(data []byte) error {
 		PaymentMethod     *json.RawMessage `json:"payment_method"`
 		ProviderContacts  *json.RawMessage `json:"provider_contacts"`
 		AccessPolicies    *[]AccessPolicy  `json:"access_policies,omitempty"`
+		AcceptedHermes    []string         `json:"accepted_hermes,omitempty"`
 	}
 	if err := json.Unmarshal(data, &jsonData); err != nil {
 		return err
@@ -106,6 +111,7 @@ func (proposal *ServiceProposal) UnmarshalJSON(data []byte) error {
 	proposal.ProviderContacts = unserializeContacts(jsonData.ProviderContacts)
 
 	proposal.AccessPolicies = jsonData.AccessPolicies
+	proposal.AcceptedHermes = jsonData.AcceptedHermes
 	return nil
 }
 
@@ -123,6 +129,26 @@ func (proposal *ServiceProposal) SetAccessPolicies(ap *[]AccessPolicy) {
 	proposal.AccessPolicies = ap
 }
 
+// SetAcceptedHermes updates service proposal with Hermes addresses provider accepts.
+func (proposal *ServiceProposal) SetAcceptedHermes(hermes []string) {
+	proposal.AcceptedHermes = hermes
+}
+
+// AcceptsHermes returns true if provider accepts payments through the given Hermes.
+// Proposals without accepted Hermes list come from older providers which accept their active Hermes only,
+// so any Hermes is assumed to be accepted.
+func (proposal *ServiceProposal) AcceptsHermes(hermesID string) bool {
+	if len(proposal.AcceptedHermes) == 0 {
+		return true
+	}
+	for _, accepted := range proposal.AcceptedHermes {
+		if strings.EqualFold(accepted, hermesID) {
+			return true
+		}
+	}
+	return false
+}
+
 // SetPaymentMethod updates payment method in the proposal.
 func (proposal *ServiceProposal) SetPaymentMethod(pm PaymentMethod) {
 	if pm != nil {
@@ -257,3 +257,27 @@ func Test_ServiceProposal_UnserializeAccessPolicy(t *testing.T) {
 	assert.Equal(t, expected, actual)
 	assert.True(t, actual.IsSupported())
 }
+
+func Test_ServiceProposal_AcceptedHermes(t *testing.T) {
+	jsonData := []byte(`{
+		"id": 1,
+		"format": "format/X",
+		"service_type": "mock_service",
+		"payment_method_type": "mock_payment",
+		"payment_method": {},
+		"provider_id": "node",
+		"accepted_hermes": ["0x1", "0xAbC"]
+	}`)
+
+	var proposal ServiceProposal
+	err := json.Unmarshal(jsonData, &proposal)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0x1", "0xAbC"}, proposal.AcceptedHermes)
+
+	assert.True(t, proposal.AcceptsHermes("0x1"))
+	assert.True(t, proposal.AcceptsHermes("0xabc"))
+	assert.False(t, proposal.AcceptsHermes("0x2"))
+
+	proposal.SetAcceptedHermes(nil)
+	assert.True(t, proposal.AcceptsHermes("0x2"))
+}
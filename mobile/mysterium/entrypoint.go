@@ -34,6 +34,7 @@ import (
 	"github.com/mysteriumnetwork/node/config"
 	"github.com/mysteriumnetwork/node/core/connection"
 	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/core/forecast"
 	"github.com/mysteriumnetwork/node/core/ip"
 	"github.com/mysteriumnetwork/node/core/location"
 	"github.com/mysteriumnetwork/node/core/node"
@@ -74,13 +75,16 @@ type MobileNode struct {
 	transactor                *registry.Transactor
 	identityRegistry          registry.IdentityRegistry
 	identityChannelCalculator *pingpong.AddressProvider
+	hermesSelector            *pingpong.HermesSelector
 	consumerBalanceTracker    *pingpong.ConsumerBalanceTracker
 	pilvytis                  *pilvytis.Service
 	chainID                   int64
 	startTime                 time.Time
 	sessionStorage            SessionStorage
 	entertainmentEstimator    *entertainment.Estimator
+	forecaster                *forecast.Forecaster
 	residentCountry           *identity.ResidentCountry
+	spendingLimitStorage      *pingpong.SpendingLimitStorage
 }
 
 // MobileNodeOptions contains common mobile node options.
@@ -269,6 +273,7 @@ func NewNode(appPath string, options *MobileNodeOptions) (*MobileNode, error) {
 		identityRegistry:          di.IdentityRegistry,
 		consumerBalanceTracker:    di.ConsumerBalanceTracker,
 		identityChannelCalculator: di.AddressProvider,
+		hermesSelector:            di.HermesSelector,
 		proposalsManager: newProposalsManager(
 			di.ProposalRepository,
 			di.MysteriumAPI,
@@ -283,7 +288,9 @@ func NewNode(appPath string, options *MobileNodeOptions) (*MobileNode, error) {
 			config.FlagPaymentPricePerGB.Value,
 			config.FlagPaymentPricePerMinute.Value,
 		),
-		residentCountry: di.ResidentCountry,
+		forecaster:           di.Forecaster,
+		residentCountry:      di.ResidentCountry,
+		spendingLimitStorage: di.SpendingLimitStorage,
 	}
 
 	return mobileNode, nil
@@ -464,7 +471,7 @@ func (mb *MobileNode) Connect(req *ConnectRequest) *ConnectResponse {
 		DNS:               dnsOption,
 	}
 
-	hermes, err := mb.identityChannelCalculator.GetActiveHermes(mb.chainID)
+	hermes, err := mb.hermesSelector.SelectHermes(mb.chainID, identity.FromAddress(req.IdentityAddress), *proposal)
 	if err != nil {
 		return &ConnectResponse{
 			ErrorCode:    connectErrUnknown,
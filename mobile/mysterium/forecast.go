@@ -0,0 +1,73 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mysterium
+
+import (
+	"github.com/mysteriumnetwork/payments/crypto"
+
+	"github.com/mysteriumnetwork/node/core/forecast"
+	"github.com/mysteriumnetwork/node/identity"
+)
+
+// BalanceForecast represents how long consumer balance lasts at the current spending rate.
+type BalanceForecast struct {
+	Balance      float64
+	Sessions     int64
+	SpentPerDay  float64
+	SpentPerHour float64
+	SpentPerGiB  float64
+	// ConnectedSecondsLeft is the connected time balance is enough for.
+	ConnectedSecondsLeft int64
+	// BytesLeft is the amount of data balance is enough for.
+	BytesLeft int64
+	// DepletedAt is unix time balance runs out, zero when there was no spending.
+	DepletedAt int64
+}
+
+func newBalanceForecast(f forecast.BalanceForecast) *BalanceForecast {
+	res := &BalanceForecast{
+		Balance:              crypto.BigMystToFloat(f.Balance),
+		Sessions:             int64(f.Sessions),
+		SpentPerDay:          crypto.BigMystToFloat(f.SpentPerDay),
+		SpentPerHour:         crypto.BigMystToFloat(f.SpentPerHour),
+		SpentPerGiB:          crypto.BigMystToFloat(f.SpentPerGiB),
+		ConnectedSecondsLeft: int64(f.ConnectedTimeLeft.Seconds()),
+		BytesLeft:            int64(f.DataLeft),
+	}
+	if f.DataLeft > 1<<63-1 {
+		res.BytesLeft = 1<<63 - 1
+	}
+	if f.DepletedAt != nil {
+		res.DepletedAt = f.DepletedAt.Unix()
+	}
+	return res
+}
+
+// ForecastBalanceRequest represents balance forecast request.
+type ForecastBalanceRequest struct {
+	IdentityAddress string
+}
+
+// ForecastBalance forecasts how long consumer balance lasts based on session history.
+func (mb *MobileNode) ForecastBalance(req *ForecastBalanceRequest) (*BalanceForecast, error) {
+	result, err := mb.forecaster.ForecastBalance(identity.FromAddress(req.IdentityAddress))
+	if err != nil {
+		return nil, err
+	}
+	return newBalanceForecast(result), nil
+}
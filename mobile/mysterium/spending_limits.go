@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mysterium
+
+import (
+	"math/big"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/session/pingpong/event"
+	"github.com/mysteriumnetwork/payments/crypto"
+)
+
+// SpendingLimitsRequest represents consumer spending limits in MYST, zero means unlimited.
+type SpendingLimitsRequest struct {
+	IdentityAddress string
+	PerSession      float64
+	PerDay          float64
+	PerMonth        float64
+}
+
+// SetSpendingLimits sets consumer spending limits.
+func (mb *MobileNode) SetSpendingLimits(req *SpendingLimitsRequest) error {
+	return mb.spendingLimitStorage.Store(identity.FromAddress(req.IdentityAddress), pingpong.SpendingLimits{
+		PerSession: mystLimit(req.PerSession),
+		PerDay:     mystLimit(req.PerDay),
+		PerMonth:   mystLimit(req.PerMonth),
+	})
+}
+
+// RemoveSpendingLimits removes consumer spending limits.
+func (mb *MobileNode) RemoveSpendingLimits(identityAddress string) error {
+	return mb.spendingLimitStorage.Delete(identity.FromAddress(identityAddress))
+}
+
+func mystLimit(amount float64) *big.Int {
+	if amount <= 0 {
+		return nil
+	}
+	return crypto.FloatToBigMyst(amount)
+}
+
+// SpendingLimitCallback represents spending limit alert callback.
+type SpendingLimitCallback interface {
+	OnChange(identityAddress string, period string, threshold int64, spent float64, limit float64)
+}
+
+// RegisterSpendingLimitCallback registers callback which is called when spending crosses 50, 80 or 100% of a limit.
+func (mb *MobileNode) RegisterSpendingLimitCallback(cb SpendingLimitCallback) {
+	_ = mb.eventBus.SubscribeAsync(event.AppTopicSpendingLimit, func(e event.AppEventSpendingLimit) {
+		cb.OnChange(e.ConsumerID.Address, e.Period, int64(e.Threshold), crypto.BigMystToFloat(e.Spent), crypto.BigMystToFloat(e.Limit))
+	})
+}
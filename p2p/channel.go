@@ -78,6 +78,13 @@ type Channel interface {
 	// Conn returns underlying channel's UDP connection.
 	Conn() *net.UDPConn
 
+	// Rebind reopens underlying UDP connection on the same local port,
+	// so packets are sent from the current local address after network changes.
+	Rebind() error
+
+	// Stats returns channel transport metrics.
+	Stats() ChannelStats
+
 	// Close closes p2p communication channel.
 	Close() error
 }
@@ -140,6 +147,9 @@ type channel struct {
 	// tr is transport containing network related connections for p2p to work.
 	tr *transport
 
+	// remoteConnLock guards remote conn of the transport, which is replaced on rebind.
+	remoteConnLock sync.RWMutex
+
 	tracer *trace.Tracer
 
 	// serviceConn is separate connection which is created outside of p2p channel when
@@ -179,6 +189,9 @@ type channel struct {
 
 	// terminate remote aliveness checking only once
 	remoteAliveOnce sync.Once
+
+	// stats collects channel transport metrics.
+	stats channelStats
 }
 
 // newChannel creates new p2p channel with initialized crypto primitives for data encryption
@@ -230,6 +243,7 @@ func newChannel(remoteConn *net.UDPConn, privateKey PrivateKey, peerPubKey Publi
 		sendQueue:        make(chan *transportMsg, 100),
 		remoteAlive:      make(chan struct{}, 1),
 	}
+	c.stats.setPeerAddr(peerAddr.String())
 
 	return &c, nil
 }
@@ -256,8 +270,13 @@ func (c *channel) remoteReadLoop() {
 		default:
 		}
 
-		n, addr, err := c.tr.remoteConn.ReadFrom(buf)
+		conn := c.remoteConn()
+		n, addr, err := conn.ReadFrom(buf)
 		if err != nil {
+			if c.remoteConn() != conn {
+				// Conn was replaced by rebind, continue reading from the new one.
+				continue
+			}
 			if !errNetClose(err) {
 				log.Error().Err(err).Msg("Read from remote conn failed")
 			}
@@ -267,12 +286,14 @@ func (c *channel) remoteReadLoop() {
 		c.remoteAliveOnce.Do(func() {
 			close(c.remoteAlive)
 		})
+		c.stats.packetReceived(n, time.Now())
 
 		// Check if peer address changed.
 		if addr, ok := addr.(*net.UDPAddr); ok {
 			if !addr.IP.Equal(latestPeerAddr.IP) || addr.Port != latestPeerAddr.Port {
 				log.Debug().Msgf("Peer address changed from %v to %v", latestPeerAddr, addr)
 				c.peer.updateAddr(addr)
+				c.stats.peerAddrChanged(addr.String(), time.Now())
 				latestPeerAddr = addr
 			}
 		}
@@ -306,13 +327,19 @@ func (c *channel) remoteSendLoop() {
 			return
 		}
 
-		_, err = c.tr.remoteConn.WriteToUDP(buf[:n], c.peer.addr())
+		conn := c.remoteConn()
+		_, err = conn.WriteToUDP(buf[:n], c.peer.addr())
 		if err != nil {
+			if c.remoteConn() != conn {
+				// Conn was replaced by rebind, packet will be retransmitted by KCP.
+				continue
+			}
 			if !errNetClose(err) {
 				log.Error().Err(err).Msgf("Write to remote peer conn failed")
 			}
 			return
 		}
+		c.stats.packetSent(n)
 	}
 }
 
@@ -443,7 +470,7 @@ func (c *channel) Close() error {
 			release()
 		}
 
-		if err := c.tr.remoteConn.Close(); err != nil {
+		if err := c.remoteConn().Close(); err != nil {
 			closeErr = fmt.Errorf("could not close remote conn: %w", err)
 		}
 
@@ -469,12 +496,56 @@ func (c *channel) Close() error {
 
 // Conn returns underlying channel's UDP connection.
 func (c *channel) Conn() *net.UDPConn {
+	return c.remoteConn()
+}
+
+// Rebind reopens underlying UDP connection on the same local port. New connection is not bound
+// to the local IP the channel was created from, so it keeps working once that address goes away.
+// Peer learns the new address from the next packet it receives.
+func (c *channel) Rebind() error {
+	c.remoteConnLock.Lock()
+	defer c.remoteConnLock.Unlock()
+
+	select {
+	case <-c.stop:
+		return errors.New("channel is closed")
+	default:
+	}
+
+	old := c.tr.remoteConn
+	localAddr := old.LocalAddr().(*net.UDPAddr)
+	// Port can be reused only after the old conn is closed.
+	old.Close()
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{Port: localAddr.Port})
+	if err != nil {
+		return fmt.Errorf("could not listen UDP on port %d: %w", localAddr.Port, err)
+	}
+	c.tr.remoteConn = conn
+	log.Debug().Msgf("P2P channel rebound from %s to %s", localAddr, conn.LocalAddr())
+	return nil
+}
+
+func (c *channel) remoteConn() *net.UDPConn {
+	c.remoteConnLock.RLock()
+	defer c.remoteConnLock.RUnlock()
+
 	return c.tr.remoteConn
 }
 
+// Stats returns channel transport metrics.
+func (c *channel) Stats() ChannelStats {
+	stats := c.stats.get()
+	counters := readKCPCounters()
+	stats.NodeTransportRetransmits = counters.retransmits
+	stats.NodeTransportLostSegments = counters.lost
+	return stats
+}
+
 // Send sends message to given topic. Peer listening to topic will receive message.
 func (c *channel) Send(ctx context.Context, topic string, msg *Message) (*Message, error) {
+	start := time.Now()
 	reply, err := c.sendRequest(ctx, topic, msg)
+	c.stats.requestDone(time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -258,6 +258,76 @@ func TestChannel_Detect_And_Update_Peer_Addr(t *testing.T) {
 	_, err = consumer.Send(ctx, "ping", &Message{Data: []byte("pingasssas")})
 }
 
+func TestChannel_Rebind(t *testing.T) {
+	provider, consumer, err := createTestChannels()
+	require.NoError(t, err)
+	defer consumer.Close()
+	defer provider.Close()
+
+	provider.Handle("ping", func(c Context) error {
+		return c.OK()
+	})
+	_, err = consumer.Send(context.Background(), "ping", &Message{Data: []byte("ping")})
+	require.NoError(t, err)
+
+	oldConn := consumer.Conn()
+	require.NoError(t, consumer.Rebind())
+	assert.True(t, oldConn != consumer.Conn(), "conn is not reopened")
+	assert.Equal(t, oldConn.LocalAddr().(*net.UDPAddr).Port, consumer.Conn().LocalAddr().(*net.UDPAddr).Port)
+	assert.True(t, consumer.Conn().LocalAddr().(*net.UDPAddr).IP.IsUnspecified(), "rebound conn is not tied to local IP")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = consumer.Send(ctx, "ping", &Message{Data: []byte("ping")})
+	assert.NoError(t, err)
+
+	consumer.Close()
+	assert.Error(t, consumer.Rebind())
+}
+
+func TestChannel_Stats(t *testing.T) {
+	provider, consumer, err := createTestChannels()
+	require.NoError(t, err)
+	defer consumer.Close()
+	defer provider.Close()
+
+	provider.Handle("ping", func(c Context) error {
+		return c.OK()
+	})
+
+	_, err = consumer.Send(context.Background(), "ping", &Message{Data: []byte("ping")})
+	require.NoError(t, err)
+	_, err = consumer.Send(context.Background(), "pong", &Message{Data: []byte("pong")})
+	require.Error(t, err)
+
+	stats := consumer.Stats()
+	assert.Equal(t, uint64(2), stats.RequestsSent)
+	assert.Equal(t, uint64(1), stats.RequestsFailed)
+	assert.NotZero(t, stats.RTT)
+	assert.NotZero(t, stats.PacketsSent)
+	assert.NotZero(t, stats.PacketsReceived)
+	assert.NotZero(t, stats.BytesSent)
+	assert.NotZero(t, stats.BytesReceived)
+	assert.False(t, stats.LastReceivedAt.IsZero())
+	assert.Equal(t, provider.Conn().LocalAddr().String(), stats.PeerAddr)
+	assert.Zero(t, stats.PeerAddrChanges)
+	assert.NotZero(t, stats.RTTVar)
+
+	// Reopen consumer with new local addr so provider sees peer address change.
+	consumer.Close()
+	consumer, err = reopenChannelWithNewLocalAddr(consumer.(*channel))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	consumer.Send(ctx, "ping", &Message{Data: []byte("ping")})
+
+	stats = provider.Stats()
+	assert.Equal(t, uint64(1), stats.PeerAddrChanges)
+	assert.Equal(t, consumer.Conn().LocalAddr().String(), stats.PeerAddr)
+	assert.False(t, stats.PeerAddrChangedAt.IsZero())
+}
+
 func BenchmarkChannel_Send(b *testing.B) {
 	provider, consumer, err := createTestChannels()
 	require.NoError(b, err)
@@ -278,7 +348,7 @@ func BenchmarkChannel_Send(b *testing.B) {
 }
 
 func reopenChannel(c *channel) (*channel, error) {
-	punchedConn, err := net.DialUDP("udp4", c.tr.remoteConn.LocalAddr().(*net.UDPAddr), c.peer.addr())
+	punchedConn, err := net.DialUDP("udp4", c.Conn().LocalAddr().(*net.UDPAddr), c.peer.addr())
 	if err != nil {
 		return nil, err
 	}
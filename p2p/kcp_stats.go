@@ -0,0 +1,37 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package p2p
+
+import (
+	"github.com/xtaci/kcp-go/v5"
+)
+
+// kcpCounters holds KCP retransmission counters.
+type kcpCounters struct {
+	retransmits uint64
+	lost        uint64
+}
+
+// readKCPCounters reads KCP retransmission counters. kcp-go collects them per process, not per session.
+func readKCPCounters() kcpCounters {
+	snmp := kcp.DefaultSnmp.Copy()
+	return kcpCounters{
+		retransmits: snmp.RetransSegs,
+		lost:        snmp.LostSegs,
+	}
+}
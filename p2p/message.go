@@ -51,6 +51,9 @@ const (
 	TopicPaymentMessage = "p2p-payment-message"
 	// TopicPaymentInvoice is a payment invoices endpoint for p2p communication.
 	TopicPaymentInvoice = "p2p-payment-invoice"
+
+	// TopicSpeedTest is a speed test endpoint for p2p communication.
+	TopicSpeedTest = "p2p-speed-test"
 )
 
 // Message represent message with data bytes.
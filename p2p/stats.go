@@ -0,0 +1,147 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package p2p
+
+import (
+	"sync"
+	"time"
+)
+
+// AppTopicChannelStats represents p2p channel statistics topic.
+const AppTopicChannelStats = "p2p-channel-stats"
+
+// AppEventChannelStats is published periodically for every p2p channel which has an active session.
+type AppEventChannelStats struct {
+	SessionID string
+	Stats     ChannelStats
+}
+
+// ChannelStats holds p2p channel transport metrics.
+type ChannelStats struct {
+	// RTT is smoothed round trip time of request/reply messages.
+	RTT time.Duration
+	// RTTVar is round trip time variation of request/reply messages.
+	RTTVar time.Duration
+	// LastRTT is round trip time of the latest request/reply message.
+	LastRTT time.Duration
+
+	PacketsSent     uint64
+	PacketsReceived uint64
+	BytesSent       uint64
+	BytesReceived   uint64
+
+	// RequestsSent is a number of requests sent to peer.
+	RequestsSent uint64
+	// RequestsFailed is a number of requests which failed or timed out waiting for reply.
+	RequestsFailed uint64
+
+	// PeerAddr is the latest known remote peer address.
+	PeerAddr string
+	// PeerAddrChanges is a number of times remote peer address changed.
+	PeerAddrChanges uint64
+	// PeerAddrChangedAt is the time of last remote peer address change.
+	PeerAddrChangedAt time.Time
+
+	// LastReceivedAt is the time of last packet received from remote peer.
+	LastReceivedAt time.Time
+
+	// NodeTransportRetransmits is a number of segments retransmitted by KCP since node start.
+	// kcp-go counts them per process, so the value is shared by all channels of the node.
+	NodeTransportRetransmits uint64
+	// NodeTransportLostSegments is a number of segments KCP inferred as lost since node start.
+	// kcp-go counts them per process, so the value is shared by all channels of the node.
+	NodeTransportLostSegments uint64
+}
+
+// rttSmoothingFactor and rttVarSmoothingFactor are inverse weights of new sample
+// in smoothed RTT and RTT variation calculation (same as in RFC 6298).
+const (
+	rttSmoothingFactor    = 8
+	rttVarSmoothingFactor = 4
+)
+
+// channelStats collects channel metrics from read, send loops and request streams.
+type channelStats struct {
+	mu    sync.Mutex
+	stats ChannelStats
+}
+
+func (s *channelStats) packetSent(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats.PacketsSent++
+	s.stats.BytesSent += uint64(n)
+}
+
+func (s *channelStats) packetReceived(n int, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats.PacketsReceived++
+	s.stats.BytesReceived += uint64(n)
+	s.stats.LastReceivedAt = at
+}
+
+func (s *channelStats) peerAddrChanged(addr string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats.PeerAddr = addr
+	s.stats.PeerAddrChanges++
+	s.stats.PeerAddrChangedAt = at
+}
+
+func (s *channelStats) setPeerAddr(addr string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats.PeerAddr = addr
+}
+
+func (s *channelStats) requestDone(rtt time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats.RequestsSent++
+	if err != nil {
+		s.stats.RequestsFailed++
+		return
+	}
+
+	s.stats.LastRTT = rtt
+	if s.stats.RTT == 0 {
+		s.stats.RTT = rtt
+		s.stats.RTTVar = rtt / 2
+		return
+	}
+
+	diff := s.stats.RTT - rtt
+	if diff < 0 {
+		diff = -diff
+	}
+	s.stats.RTTVar += (diff - s.stats.RTTVar) / rttVarSmoothingFactor
+	s.stats.RTT += (rtt - s.stats.RTT) / rttSmoothingFactor
+}
+
+func (s *channelStats) get() ChannelStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stats
+}
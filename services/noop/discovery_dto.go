@@ -34,3 +34,11 @@ type ServiceDefinition struct {
 func (service ServiceDefinition) GetLocation() market.Location {
 	return service.Location
 }
+
+// WithLocation returns service definition provided from the given location
+func (service ServiceDefinition) WithLocation(loc market.Location) market.ServiceDefinition {
+	service.Location = loc
+	return service
+}
+
+var _ market.RelocatableServiceDefinition = ServiceDefinition{}
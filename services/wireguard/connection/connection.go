@@ -78,6 +78,7 @@ type Connection struct {
 }
 
 var _ connection.Connection = &Connection{}
+var _ connection.Rebinder = &Connection{}
 
 // State returns connection state channel.
 func (c *Connection) State() <-chan connectionstate.State {
@@ -174,6 +175,14 @@ func (c *Connection) startConn(conf wgcfg.DeviceConfig) (wg.ConnectionEndpoint,
 	return conn, nil
 }
 
+// Rebind rebinds wireguard UDP socket to the current local address after network changes.
+func (c *Connection) Rebind() error {
+	if c.connectionEndpoint == nil {
+		return errors.New("connection is not started")
+	}
+	return c.connectionEndpoint.Rebind()
+}
+
 // GetConfig returns the consumer configuration for session creation
 func (c *Connection) GetConfig() (connection.ConsumerConfig, error) {
 	publicKey, err := key.PrivateKeyToPublicKey(c.privateKey)
@@ -138,6 +138,7 @@ func (mce *mockConnectionEndpoint) StartProviderMode(ip string, config wgcfg.Dev
 }
 func (mce *mockConnectionEndpoint) InterfaceName() string                { return "mce0" }
 func (mce *mockConnectionEndpoint) Stop() error                          { return nil }
+func (mce *mockConnectionEndpoint) Rebind() error                        { return nil }
 func (mce *mockConnectionEndpoint) Config() (wg.ServiceConfig, error)    { return wg.ServiceConfig{}, nil }
 func (mce *mockConnectionEndpoint) AddPeer(_ string, _ wgcfg.Peer) error { return nil }
 func (mce *mockConnectionEndpoint) RemovePeer(_ string) error            { return nil }
@@ -32,5 +32,6 @@ type ConnectionEndpoint interface {
 	PeerStats() (*wgcfg.Stats, error)
 	Config() (ServiceConfig, error)
 	InterfaceName() string
+	Rebind() error
 	Stop() error
 }
@@ -107,6 +107,11 @@ func (ce *connectionEndpoint) PeerStats() (*wgcfg.Stats, error) {
 	return ce.wgClient.PeerStats(ce.cfg.IfaceName)
 }
 
+// Rebind rebinds wireguard UDP socket to the current local address.
+func (ce *connectionEndpoint) Rebind() error {
+	return ce.wgClient.Rebind(ce.cfg.IfaceName)
+}
+
 // Config provides wireguard service configuration for the current connection endpoint.
 func (ce *connectionEndpoint) Config() (wg.ServiceConfig, error) {
 	publicKey, err := key.PrivateKeyToPublicKey(ce.cfg.PrivateKey)
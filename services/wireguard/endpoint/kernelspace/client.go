@@ -139,6 +139,31 @@ func (c *client) PeerStats(string) (*wgcfg.Stats, error) {
 	}, nil
 }
 
+// Rebind sets peer endpoints again, which resets cached source addresses
+// so kernel picks the current local address for the next packets.
+func (c *client) Rebind(string) error {
+	d, err := c.wgClient.Device(c.iface)
+	if err != nil {
+		return err
+	}
+
+	var peers []wgtypes.PeerConfig
+	for _, p := range d.Peers {
+		if p.Endpoint == nil {
+			continue
+		}
+		peers = append(peers, wgtypes.PeerConfig{
+			PublicKey:  p.PublicKey,
+			UpdateOnly: true,
+			Endpoint:   p.Endpoint,
+		})
+	}
+	if err := c.wgClient.ConfigureDevice(c.iface, wgtypes.Config{Peers: peers}); err != nil {
+		return fmt.Errorf("could not reset peer endpoints: %w", err)
+	}
+	return nil
+}
+
 func (c *client) DestroyDevice(name string) error {
 	return cmdutil.SudoExec("ip", "link", "del", "dev", name)
 }
@@ -87,6 +87,13 @@ func (c *client) PeerStats(iface string) (*wgcfg.Stats, error) {
 	return &stats, nil
 }
 
+func (c *client) Rebind(iface string) error {
+	if _, err := supervisorclient.Command("wg-rebind", "-iface", iface); err != nil {
+		return fmt.Errorf("failed to rebind wg interface: %w", err)
+	}
+	return nil
+}
+
 func (c *client) Close() (err error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
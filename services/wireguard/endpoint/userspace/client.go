@@ -97,6 +97,13 @@ func (c *client) PeerStats(string) (*wgcfg.Stats, error) {
 	return stats, nil
 }
 
+func (c *client) Rebind(string) error {
+	if err := c.devAPI.BindUpdate(); err != nil {
+		return fmt.Errorf("could not rebind device: %w", err)
+	}
+	return nil
+}
+
 func (c *client) DestroyDevice(name string) error {
 	return destroyDevice(name)
 }
@@ -34,6 +34,7 @@ type WgClient interface {
 	ConfigureDevice(config wgcfg.DeviceConfig) error
 	DestroyDevice(name string) error
 	PeerStats(iface string) (*wgcfg.Stats, error)
+	Rebind(iface string) error
 	Close() error
 }
 
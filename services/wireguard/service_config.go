@@ -42,6 +42,15 @@ func (service ServiceDefinition) GetLocation() market.Location {
 	return service.Location
 }
 
+// WithLocation returns service definition provided from the given location
+func (service ServiceDefinition) WithLocation(loc market.Location) market.ServiceDefinition {
+	service.Location = loc
+	service.LocationOriginate = loc
+	return service
+}
+
+var _ market.RelocatableServiceDefinition = ServiceDefinition{}
+
 // ServiceConfig represent a Wireguard service provider configuration that will be passed to the consumer for establishing a connection.
 type ServiceConfig struct {
 	// LocalPort and RemotePort are needed for NAT hole punching only.
@@ -402,6 +402,74 @@ func (cbt *ConsumerBalanceTracker) ForceBalanceUpdate(chainID int64, id identity
 	return currentBalance.GetBalance()
 }
 
+// GetHermesBalance returns the balance identity can spend through the given Hermes.
+// Balance with the active Hermes is tracked, balances with other Hermes are fetched from blockchain.
+func (cbt *ConsumerBalanceTracker) GetHermesBalance(chainID int64, id identity.Identity, hermesID common.Address) *big.Int {
+	if cbt.isActiveHermes(chainID, hermesID) {
+		return cbt.GetBalance(chainID, id)
+	}
+	return cbt.fetchHermesBalance(chainID, id, hermesID)
+}
+
+// ForceHermesBalanceUpdate forces a balance update with the given Hermes and returns the updated balance.
+func (cbt *ConsumerBalanceTracker) ForceHermesBalanceUpdate(chainID int64, id identity.Identity, hermesID common.Address) *big.Int {
+	if cbt.isActiveHermes(chainID, hermesID) {
+		return cbt.ForceBalanceUpdate(chainID, id)
+	}
+	return cbt.fetchHermesBalance(chainID, id, hermesID)
+}
+
+func (cbt *ConsumerBalanceTracker) isActiveHermes(chainID int64, hermesID common.Address) bool {
+	active, err := cbt.addressProvider.GetActiveHermes(chainID)
+	if err != nil {
+		log.Error().Err(err).Msg("could not get active hermes address")
+		return true
+	}
+	return hermesID == common.Address{} || hermesID == active
+}
+
+// fetchHermesBalance calculates balance of identity channel with the given Hermes from blockchain and promised totals.
+func (cbt *ConsumerBalanceTracker) fetchHermesBalance(chainID int64, id identity.Identity, hermesID common.Address) *big.Int {
+	registryAddress, err := cbt.addressProvider.GetRegistryAddress(chainID)
+	if err != nil {
+		log.Error().Err(err).Msg("could not get registry address")
+		return new(big.Int)
+	}
+	chimp, err := cbt.addressProvider.GetChannelImplementation(chainID)
+	if err != nil {
+		log.Error().Err(err).Msg("could not get channel implementation")
+		return new(big.Int)
+	}
+	addr, err := cbt.addressProvider.GetArbitraryChannelAddress(hermesID, registryAddress, chimp, id)
+	if err != nil {
+		log.Error().Err(err).Msg("Could not calculate channel address")
+		return new(big.Int)
+	}
+	myst, err := cbt.addressProvider.GetMystAddress(chainID)
+	if err != nil {
+		log.Error().Err(err).Msg("could not get myst address")
+		return new(big.Int)
+	}
+
+	cc, err := cbt.consumerBalanceChecker.GetConsumerChannel(chainID, addr, myst)
+	if err != nil {
+		log.Error().Err(err).Msgf("Could not get consumer channel with hermes %s", hermesID.Hex())
+		return new(big.Int)
+	}
+
+	grandTotal, err := cbt.consumerGrandTotalsStorage.Get(chainID, id, hermesID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		log.Error().Err(err).Msg("Could not get consumer grand total promised")
+		return new(big.Int)
+	}
+
+	return ConsumerBalance{
+		BCBalance:          cc.Balance,
+		BCSettled:          cc.Settled,
+		GrandTotalPromised: grandTotal,
+	}.GetBalance()
+}
+
 func (cbt *ConsumerBalanceTracker) handleRegistrationEvent(event registry.AppEventIdentityRegistration) {
 	switch event.Status {
 	case registry.InProgress:
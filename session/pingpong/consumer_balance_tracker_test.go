@@ -588,3 +588,25 @@ func (ma *mockAddressProvider) GetRegistryAddress(chainID int64) (common.Address
 func (ma *mockAddressProvider) GetArbitraryChannelAddress(hermes, registry, channel common.Address, id identity.Identity) (common.Address, error) {
 	return ma.addrToReturn, nil
 }
+
+func TestConsumerBalanceTracker_GetHermesBalance(t *testing.T) {
+	id := identity.FromAddress("0x000000001")
+	mcts := mockConsumerTotalsStorage{res: big.NewInt(100)}
+	bc := mockConsumerBalanceChecker{
+		channelToReturn: client.ConsumerChannel{
+			Balance: initialBalance,
+			Settled: big.NewInt(0),
+		},
+	}
+	calc := mockAddressProvider{}
+	cbt := NewConsumerBalanceTracker(eventbus.New(), &bc, &mcts, &mockconsumerInfoGetter{}, &mockTransactor{}, &mockRegistrationStatusProvider{}, &calc)
+
+	// balance with the active hermes is tracked, nothing is tracked yet
+	assert.Equal(t, big.NewInt(0), cbt.GetHermesBalance(1, id, common.Address{}))
+
+	// balance with other hermes is fetched from its channel
+	otherHermes := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	expected := new(big.Int).Sub(initialBalance, big.NewInt(100))
+	assert.Equal(t, expected, cbt.GetHermesBalance(1, id, otherHermes))
+	assert.Equal(t, expected, cbt.ForceHermesBalanceUpdate(1, id, otherHermes))
+}
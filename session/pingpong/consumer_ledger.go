@@ -0,0 +1,238 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/asdine/storm/v3/q"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mysteriumnetwork/payments/crypto"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/identity"
+)
+
+// ConsumerLedgerEntry is a record of invoice received by consumer and exchange message signed to pay it.
+type ConsumerLedgerEntry struct {
+	ID         int    `storm:"id,increment"`
+	SessionID  string `storm:"index"`
+	ConsumerID identity.Identity
+	HermesID   common.Address
+	Time       time.Time
+	Invoice    crypto.Invoice
+	Message    crypto.ExchangeMessage
+	// Promised is the amount this exchange message added to the total promised amount.
+	Promised *big.Int
+}
+
+// ConsumerLedgerFilter defines fields for filtering consumer ledger entries.
+type ConsumerLedgerFilter struct {
+	SessionID  *string
+	ConsumerID *identity.Identity
+}
+
+// ConsumerLedgerStorage keeps a durable record of every invoice paid by consumer.
+type ConsumerLedgerStorage struct {
+	bolt *boltdb.Bolt
+}
+
+// NewConsumerLedgerStorage returns a new instance of the ConsumerLedgerStorage.
+func NewConsumerLedgerStorage(bolt *boltdb.Bolt) *ConsumerLedgerStorage {
+	return &ConsumerLedgerStorage{
+		bolt: bolt,
+	}
+}
+
+const consumerLedgerBucket = "consumer-ledger"
+
+// Store stores a given ledger entry.
+func (cls *ConsumerLedgerStorage) Store(entry ConsumerLedgerEntry) error {
+	return cls.bolt.DB().From(consumerLedgerBucket).Save(&entry)
+}
+
+// List retrieves stored entries in the order they were recorded.
+func (cls *ConsumerLedgerStorage) List(filter ConsumerLedgerFilter) (result []ConsumerLedgerEntry, err error) {
+	where := make([]q.Matcher, 0)
+	if filter.SessionID != nil {
+		where = append(where, q.Eq("SessionID", *filter.SessionID))
+	}
+	if filter.ConsumerID != nil {
+		where = append(where, q.Eq("ConsumerID", *filter.ConsumerID))
+	}
+
+	err = cls.bolt.DB().
+		From(consumerLedgerBucket).
+		Select(q.And(where...)).
+		OrderBy("ID").
+		Find(&result)
+	if errors.Is(err, storm.ErrNotFound) {
+		return []ConsumerLedgerEntry{}, nil
+	}
+
+	return result, err
+}
+
+// LedgerProblem describes ledger entry which failed verification.
+type LedgerProblem struct {
+	EntryID   int
+	SessionID string
+	Reason    string
+}
+
+// LedgerReport is the result of consumer ledger verification.
+type LedgerReport struct {
+	Entries  int
+	Invoiced *big.Int
+	Promised *big.Int
+	Problems []LedgerProblem
+}
+
+// Valid returns true if no problems were found.
+func (r LedgerReport) Valid() bool {
+	return len(r.Problems) == 0
+}
+
+// LedgerVerifier checks consumer ledger entries offline.
+type LedgerVerifier struct {
+	totals consumerTotalsStorage
+}
+
+// NewLedgerVerifier returns a new instance of the LedgerVerifier.
+func NewLedgerVerifier(totals consumerTotalsStorage) *LedgerVerifier {
+	return &LedgerVerifier{
+		totals: totals,
+	}
+}
+
+// Verify checks that every exchange message and promise is signed by the consumer, that promises match
+// the invoices they pay and that promised amounts add up. Entries must be in the order they were recorded.
+func (lv *LedgerVerifier) Verify(entries []ConsumerLedgerEntry) LedgerReport {
+	report := LedgerReport{
+		Entries:  len(entries),
+		Invoiced: new(big.Int),
+		Promised: new(big.Int),
+		Problems: []LedgerProblem{},
+	}
+
+	lastPromised := make(map[string]*big.Int)
+	lastInvoiced := make(map[string]*big.Int)
+	for _, e := range entries {
+		problem := func(format string, args ...interface{}) {
+			report.Problems = append(report.Problems, LedgerProblem{
+				EntryID:   e.ID,
+				SessionID: e.SessionID,
+				Reason:    fmt.Sprintf(format, args...),
+			})
+		}
+
+		signer := e.ConsumerID.ToCommonAddress()
+		if !e.Message.IsMessageValid(signer) {
+			problem("exchange message is not signed by consumer")
+		}
+		if !e.Message.Promise.IsPromiseValid(signer) {
+			problem("promise is not signed by consumer")
+		}
+		if !bytes.Equal(e.Message.Promise.Hashlock, common.FromHex(e.Invoice.Hashlock)) {
+			problem("promise hashlock does not match invoice hashlock")
+		}
+		if safeCmp(e.Message.AgreementID, e.Invoice.AgreementID) != 0 || safeCmp(e.Message.AgreementTotal, e.Invoice.AgreementTotal) != 0 {
+			problem("exchange message does not match invoice agreement")
+		}
+
+		promised := e.Promised
+		if promised == nil {
+			promised = new(big.Int)
+		}
+		report.Promised.Add(report.Promised, promised)
+
+		agreement := e.SessionID + "|" + bigString(e.Invoice.AgreementID)
+		invoiced := e.Invoice.AgreementTotal
+		if prev, ok := lastInvoiced[agreement]; ok {
+			invoiced = safeSub(e.Invoice.AgreementTotal, prev)
+		}
+		if invoiced == nil {
+			invoiced = new(big.Int)
+		}
+		lastInvoiced[agreement] = e.Invoice.AgreementTotal
+		report.Invoiced.Add(report.Invoiced, invoiced)
+		if promised.Cmp(invoiced) != 0 {
+			problem("promised %v for invoiced %v", promised, invoiced)
+		}
+
+		channel := common.Bytes2Hex(e.Message.Promise.ChannelID)
+		if prev, ok := lastPromised[channel]; ok {
+			if diff := safeSub(e.Message.Promise.Amount, prev); diff.Cmp(promised) != 0 {
+				problem("promise amount grew by %v, expected %v", diff, promised)
+			}
+		}
+		lastPromised[channel] = e.Message.Promise.Amount
+	}
+
+	return report
+}
+
+// VerifyTotals additionally checks that the latest promise matches consumer grand total.
+// It only makes sense for the complete ledger of the consumer.
+func (lv *LedgerVerifier) VerifyTotals(entries []ConsumerLedgerEntry) LedgerReport {
+	report := lv.Verify(entries)
+	if len(entries) == 0 {
+		return report
+	}
+
+	last := entries[len(entries)-1]
+	total, err := lv.totals.Get(last.Message.ChainID, last.ConsumerID, last.HermesID)
+	if err != nil {
+		report.Problems = append(report.Problems, LedgerProblem{
+			EntryID:   last.ID,
+			SessionID: last.SessionID,
+			Reason:    fmt.Sprintf("could not get consumer grand total: %v", err),
+		})
+		return report
+	}
+	if safeCmp(last.Message.Promise.Amount, total) != 0 {
+		report.Problems = append(report.Problems, LedgerProblem{
+			EntryID:   last.ID,
+			SessionID: last.SessionID,
+			Reason:    fmt.Sprintf("latest promise amount %v does not match grand total %v", last.Message.Promise.Amount, total),
+		})
+	}
+	return report
+}
+
+func safeCmp(a, b *big.Int) int {
+	if a == nil {
+		a = new(big.Int)
+	}
+	if b == nil {
+		b = new(big.Int)
+	}
+	return a.Cmp(b)
+}
+
+func bigString(v *big.Int) string {
+	if v == nil {
+		return "0"
+	}
+	return v.String()
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mysteriumnetwork/payments/crypto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/identity"
+)
+
+func TestConsumerLedgerStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consumerLedgerTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bolt, err := boltdb.NewStorage(dir)
+	assert.NoError(t, err)
+	defer bolt.Close()
+
+	storage := NewConsumerLedgerStorage(bolt)
+	consumer1 := identity.FromAddress("0x1")
+	consumer2 := identity.FromAddress("0x2")
+
+	entries, err := storage.List(ConsumerLedgerFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 0)
+
+	assert.NoError(t, storage.Store(ConsumerLedgerEntry{SessionID: "s1", ConsumerID: consumer1, Promised: big.NewInt(1)}))
+	assert.NoError(t, storage.Store(ConsumerLedgerEntry{SessionID: "s2", ConsumerID: consumer2, Promised: big.NewInt(2)}))
+	assert.NoError(t, storage.Store(ConsumerLedgerEntry{SessionID: "s1", ConsumerID: consumer1, Promised: big.NewInt(3)}))
+
+	entries, err = storage.List(ConsumerLedgerFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, []int{1, 2, 3}, []int{entries[0].ID, entries[1].ID, entries[2].ID})
+
+	session := "s1"
+	entries, err = storage.List(ConsumerLedgerFilter{SessionID: &session})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, big.NewInt(1), entries[0].Promised)
+	assert.Equal(t, big.NewInt(3), entries[1].Promised)
+
+	entries, err = storage.List(ConsumerLedgerFilter{ConsumerID: &consumer2})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "s2", entries[0].SessionID)
+}
+
+func TestLedgerVerifier(t *testing.T) {
+	ks := identity.NewMockKeystore()
+	acc, err := ks.NewAccount("")
+	assert.NoError(t, err)
+	assert.NoError(t, ks.Unlock(acc, ""))
+	consumer := identity.FromAddress(acc.Address.Hex())
+	hermes := common.HexToAddress(mockHermesAddress)
+	channel, err := crypto.GenerateChannelAddress(acc.Address.Hex(), mockHermesAddress, mockRegistryAddress, mockChannelImplementation)
+	assert.NoError(t, err)
+
+	entry := func(id int, agreementID, agreementTotal, promiseAmount, promised int64) ConsumerLedgerEntry {
+		invoice := crypto.CreateInvoice(big.NewInt(agreementID), big.NewInt(agreementTotal), new(big.Int), nil, 1)
+		msg, err := crypto.CreateExchangeMessage(1, invoice, big.NewInt(promiseAmount), channel, mockHermesAddress, ks, acc.Address)
+		assert.NoError(t, err)
+		return ConsumerLedgerEntry{
+			ID:         id,
+			SessionID:  "session",
+			ConsumerID: consumer,
+			HermesID:   hermes,
+			Time:       time.Now(),
+			Invoice:    invoice,
+			Message:    *msg,
+			Promised:   big.NewInt(promised),
+		}
+	}
+	entries := []ConsumerLedgerEntry{
+		entry(1, 1, 10, 110, 10),
+		entry(2, 1, 25, 125, 15),
+		entry(3, 2, 5, 130, 5),
+	}
+
+	totals := &mockConsumerTotalsStorage{res: big.NewInt(130)}
+	verifier := NewLedgerVerifier(totals)
+
+	report := verifier.VerifyTotals(entries)
+	assert.True(t, report.Valid(), "%v", report.Problems)
+	assert.Equal(t, 3, report.Entries)
+	assert.Equal(t, big.NewInt(30), report.Invoiced)
+	assert.Equal(t, big.NewInt(30), report.Promised)
+
+	t.Run("detects grand total mismatch", func(t *testing.T) {
+		report := NewLedgerVerifier(&mockConsumerTotalsStorage{res: big.NewInt(200)}).VerifyTotals(entries)
+		assert.Len(t, report.Problems, 1)
+		assert.Equal(t, 3, report.Problems[0].EntryID)
+	})
+
+	t.Run("detects overpayment", func(t *testing.T) {
+		tampered := append([]ConsumerLedgerEntry{}, entries...)
+		tampered[1] = entry(2, 1, 25, 126, 16)
+		report := verifier.Verify(tampered)
+		assert.False(t, report.Valid())
+		assert.Equal(t, 2, report.Problems[0].EntryID)
+	})
+
+	t.Run("detects tampered promise", func(t *testing.T) {
+		tampered := append([]ConsumerLedgerEntry{}, entries...)
+		tampered[2].Message.Promise.Amount = big.NewInt(1000)
+		report := verifier.Verify(tampered)
+		assert.False(t, report.Valid())
+		for _, p := range report.Problems {
+			assert.Equal(t, 3, p.EntryID)
+		}
+	})
+
+	t.Run("detects hashlock mismatch", func(t *testing.T) {
+		tampered := append([]ConsumerLedgerEntry{}, entries...)
+		tampered[0].Invoice.Hashlock = "0x01"
+		report := verifier.Verify(tampered)
+		assert.Len(t, report.Problems, 1)
+		assert.Equal(t, "promise hashlock does not match invoice hashlock", report.Problems[0].Reason)
+	})
+}
@@ -36,8 +36,33 @@ const (
 	AppTopicInvoicePaid = "invoice_paid"
 	// AppTopicSettlementRequest forces the settlement of promises for given provider/hermes.
 	AppTopicSettlementRequest = "settlement_request"
+	// AppTopicSettlementComplete represents a topic to which successful settlements are published.
+	AppTopicSettlementComplete = "settlement_complete"
+	// AppTopicSettlementFailed represents a topic to which failed settlements are published.
+	AppTopicSettlementFailed = "settlement_failed"
 )
 
+// AppEventSettlementComplete represents the payload that is sent on the AppTopicSettlementComplete topic.
+type AppEventSettlementComplete struct {
+	ProviderID   identity.Identity
+	HermesID     common.Address
+	ChainID      int64
+	TxHash       common.Hash
+	Amount       *big.Int
+	Fees         *big.Int
+	TotalSettled *big.Int
+	Trigger      string
+}
+
+// AppEventSettlementFailed represents the payload that is sent on the AppTopicSettlementFailed topic.
+type AppEventSettlementFailed struct {
+	ProviderID identity.Identity
+	HermesID   common.Address
+	ChainID    int64
+	Trigger    string
+	Error      string
+}
+
 // AppEventSettlementRequest represents the payload that is sent on the AppTopicSettlementRequest topic.
 type AppEventSettlementRequest struct {
 	HermesID   common.Address
@@ -89,3 +114,18 @@ type AppEventGrandTotalChanged struct {
 	HermesID   common.Address
 	ConsumerID identity.Identity
 }
+
+// AppTopicSpendingLimit represents a topic to which consumer spending limit alerts are sent.
+const AppTopicSpendingLimit = "consumer_spending_limit"
+
+// AppEventSpendingLimit is sent when consumer spending crosses a share of the configured limit.
+type AppEventSpendingLimit struct {
+	ConsumerID identity.Identity
+	SessionID  string
+	// Period is the limit period: session, day or month.
+	Period string
+	// Threshold is the share of limit in percents which was crossed.
+	Threshold int
+	Spent     *big.Int
+	Limit     *big.Int
+}
@@ -118,6 +118,7 @@ func InvoiceFactoryCreator(
 	proposal market.ServiceProposal,
 	promiseHandler promiseHandler,
 	addressProvider addressProvider,
+	incidentRecorder paymentIncidentRecorder,
 ) func(identity.Identity, identity.Identity, int64, common.Address, string, chan crypto.ExchangeMessage) (service.PaymentEngine, error) {
 	return func(providerID, consumerID identity.Identity, chainID int64, hermesID common.Address, sessionID string, exchangeChan chan crypto.ExchangeMessage) (service.PaymentEngine, error) {
 		timeTracker := session.NewTracker(mbtime.Now)
@@ -142,6 +143,7 @@ func InvoiceFactoryCreator(
 			MaxNotPaidInvoice:          maxUnpaidInvoiceValue,
 			ChainID:                    chainID,
 			AddressProvider:            addressProvider,
+			IncidentRecorder:           incidentRecorder,
 		}
 		paymentEngine := NewInvoiceTracker(deps)
 		return paymentEngine, nil
@@ -155,7 +157,10 @@ func ExchangeFactoryFunc(
 	totalStorage consumerTotalsStorage,
 	addressProvider addressProvider,
 	eventBus eventbus.EventBus,
-	dataLeewayMegabytes uint64) func(channel p2p.Channel, consumer, provider identity.Identity, hermes common.Address, proposal market.ServiceProposal) (connection.PaymentIssuer, error) {
+	dataLeewayMegabytes uint64,
+	spendingGuard spendingGuard,
+	ledger consumerLedger,
+	incidentRecorder paymentIncidentRecorder) func(channel p2p.Channel, consumer, provider identity.Identity, hermes common.Address, proposal market.ServiceProposal) (connection.PaymentIssuer, error) {
 	return func(channel p2p.Channel, consumer, provider identity.Identity, hermes common.Address, proposal market.ServiceProposal) (connection.PaymentIssuer, error) {
 		invoices, err := invoiceReceiver(channel)
 		if err != nil {
@@ -176,6 +181,9 @@ func ExchangeFactoryFunc(
 			HermesAddress:             hermes,
 			DataLeeway:                datasize.MiB * datasize.BitSize(dataLeewayMegabytes),
 			ChainID:                   config.GetInt64(config.FlagChainID),
+			SpendingGuard:             spendingGuard,
+			Ledger:                    ledger,
+			IncidentRecorder:          incidentRecorder,
 		}
 		return NewInvoicePayer(deps), nil
 	}
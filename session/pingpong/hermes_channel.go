@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/payments/client"
+	"github.com/mysteriumnetwork/payments/crypto"
 )
 
 // NewHermesChannel creates HermesChannel model.
@@ -69,6 +70,29 @@ func (hc HermesChannel) UnsettledBalance() *big.Int {
 	return safeSub(lastPromise, settled)
 }
 
+// ThresholdSettlementAmount returns unsettled earnings at which the channel gets settled
+// automatically because of its balance falling below the given threshold.
+func (hc HermesChannel) ThresholdSettlementAmount(threshold float64) *big.Int {
+	stake := new(big.Int)
+	if hc.Channel.Stake != nil {
+		stake = hc.Channel.Stake
+	}
+
+	// Mirrors settlementState.needsSettling: unsettled earnings have to reach threshold of available balance
+	// and channel balance, which is stake less unsettled earnings, has to drop to that threshold.
+	floated := new(big.Float).SetInt(hc.availableBalance())
+	calculatedThreshold, _ := new(big.Float).Mul(big.NewFloat(threshold), floated).Int(nil)
+
+	amount := new(big.Int).Sub(stake, calculatedThreshold)
+	if amount.Cmp(calculatedThreshold) < 0 {
+		amount = calculatedThreshold
+	}
+	if stake.Sign() == 0 && amount.Cmp(new(big.Int).SetUint64(crypto.Myst)) < 0 {
+		amount = new(big.Int).SetUint64(crypto.Myst)
+	}
+	return amount
+}
+
 func (hc HermesChannel) availableBalance() *big.Int {
 	balance := new(big.Int)
 	if hc.Channel.Stake != nil {
@@ -53,3 +53,24 @@ func TestHermesChannel_balance(t *testing.T) {
 	assert.Equal(t, big.NewInt(94), channel.balance())
 	assert.Equal(t, big.NewInt(6), channel.UnsettledBalance())
 }
+
+func TestHermesChannel_ThresholdSettlementAmount(t *testing.T) {
+	withPromise := func(channel HermesChannel, amount *big.Int) HermesChannel {
+		channel.lastPromise = HermesPromise{Promise: crypto.Promise{Amount: amount}}
+		return channel
+	}
+	state := settlementState{registered: true}
+
+	channel := HermesChannel{Channel: client.ProviderChannel{Stake: big.NewInt(100), Settled: big.NewInt(0)}}
+	amount := channel.ThresholdSettlementAmount(0.1)
+	assert.Equal(t, big.NewInt(90), amount)
+	assert.True(t, state.needsSettling(0.1, withPromise(channel, amount)))
+	assert.False(t, state.needsSettling(0.1, withPromise(channel, new(big.Int).Sub(amount, big.NewInt(1)))))
+
+	channel = HermesChannel{Channel: client.ProviderChannel{Stake: big.NewInt(0), Settled: big.NewInt(10)}}
+	amount = channel.ThresholdSettlementAmount(0.1)
+	assert.Equal(t, new(big.Int).SetUint64(crypto.Myst), amount)
+	promised := new(big.Int).Add(amount, big.NewInt(10))
+	assert.True(t, state.needsSettling(0.1, withPromise(channel, promised)))
+	assert.False(t, state.needsSettling(0.1, withPromise(channel, new(big.Int).Sub(promised, big.NewInt(1)))))
+}
@@ -43,6 +43,11 @@ type settlementHistoryStorage interface {
 	Store(she SettlementHistoryEntry) error
 }
 
+type settlementPolicyProvider interface {
+	Get(id identity.Identity) (SettlementPolicy, error)
+	MarkSettled(id identity.Identity, at time.Time) error
+}
+
 type providerChannelStatusProvider interface {
 	SubscribeToPromiseSettledEvent(chainID int64, providerID, hermesID common.Address) (sink chan *bindings.HermesImplementationPromiseSettled, cancel func(), err error)
 	GetHermesFee(chainID int64, hermesAddress common.Address) (uint16, error)
@@ -67,6 +72,7 @@ type transactor interface {
 type hermesChannelProvider interface {
 	Get(chainID int64, id identity.Identity, hermesID common.Address) (HermesChannel, bool)
 	Fetch(chainID int64, id identity.Identity, hermesID common.Address) (HermesChannel, error)
+	List(chainID int64) []HermesChannel
 }
 
 type hermesCaller interface {
@@ -78,6 +84,8 @@ type receivedPromise struct {
 	hermesID    common.Address
 	promise     crypto.Promise
 	beneficiary common.Address
+	trigger     SettlementTrigger
+	policy      SettlementPolicy
 }
 
 // HermesPromiseSettler is responsible for settling the hermes promises.
@@ -98,6 +106,8 @@ type hermesPromiseSettler struct {
 	transactor                 transactor
 	channelProvider            hermesChannelProvider
 	settlementHistoryStorage   settlementHistoryStorage
+	policies                   settlementPolicyProvider
+	publisher                  eventbus.Publisher
 	hermesURLGetter            hermesURLGetter
 	hermesCallerFactory        HermesCallerFactory
 
@@ -112,10 +122,12 @@ type hermesPromiseSettler struct {
 type HermesPromiseSettlerConfig struct {
 	Threshold            float64
 	MaxWaitForSettlement time.Duration
+	// PolicyCheckInterval is how often scheduled settlement policies are evaluated.
+	PolicyCheckInterval time.Duration
 }
 
 // NewHermesPromiseSettler creates a new instance of hermes promise settler.
-func NewHermesPromiseSettler(transactor transactor, hermesCallerFactory HermesCallerFactory, hermesURLGetter hermesURLGetter, channelProvider hermesChannelProvider, providerChannelStatusProvider providerChannelStatusProvider, registrationStatusProvider registrationStatusProvider, ks ks, settlementHistoryStorage settlementHistoryStorage, config HermesPromiseSettlerConfig) *hermesPromiseSettler {
+func NewHermesPromiseSettler(transactor transactor, hermesCallerFactory HermesCallerFactory, hermesURLGetter hermesURLGetter, channelProvider hermesChannelProvider, providerChannelStatusProvider providerChannelStatusProvider, registrationStatusProvider registrationStatusProvider, ks ks, settlementHistoryStorage settlementHistoryStorage, policies settlementPolicyProvider, publisher eventbus.Publisher, config HermesPromiseSettlerConfig) *hermesPromiseSettler {
 	return &hermesPromiseSettler{
 		bc:                         providerChannelStatusProvider,
 		ks:                         ks,
@@ -124,6 +136,8 @@ func NewHermesPromiseSettler(transactor transactor, hermesCallerFactory HermesCa
 		currentState:               make(map[identity.Identity]settlementState),
 		channelProvider:            channelProvider,
 		settlementHistoryStorage:   settlementHistoryStorage,
+		policies:                   policies,
+		publisher:                  publisher,
 		hermesCallerFactory:        hermesCallerFactory,
 		hermesURLGetter:            hermesURLGetter,
 
@@ -246,6 +260,14 @@ func (aps *hermesPromiseSettler) handleRegistrationEvent(payload registry.AppEve
 }
 
 func (aps *hermesPromiseSettler) handleHermesPromiseReceived(apep event.AppEventHermesPromise) {
+	// queue is sent to without holding the lock, as its reader takes the lock too.
+	if p, ok := aps.promiseToSettle(apep); ok {
+		aps.settleQueue <- p
+	}
+}
+
+// promiseToSettle updates state of the provider with the received promise and returns promise to settle if settlement is needed.
+func (aps *hermesPromiseSettler) promiseToSettle(apep event.AppEventHermesPromise) (receivedPromise, bool) {
 	id := apep.ProviderID
 	log.Info().Msgf("Received hermes promise for %q", id)
 	aps.lock.Lock()
@@ -254,11 +276,11 @@ func (aps *hermesPromiseSettler) handleHermesPromiseReceived(apep event.AppEvent
 	s, ok := aps.currentState[apep.ProviderID]
 	if !ok {
 		log.Error().Msgf("Have no info on provider %q, skipping", id)
-		return
+		return receivedPromise{}, false
 	}
 	if !s.registered {
 		log.Error().Msgf("provider %q not registered, skipping", id)
-		return
+		return receivedPromise{}, false
 	}
 
 	var channel HermesChannel
@@ -269,14 +291,15 @@ func (aps *hermesPromiseSettler) handleHermesPromiseReceived(apep event.AppEvent
 		hc, err := aps.channelProvider.Fetch(apep.Promise.ChainID, id, apep.HermesID)
 		if err != nil && !errors.Is(err, ErrNotFound) {
 			log.Error().Err(err).Msgf("could not sync state for provider %v, hermesID %v", apep.ProviderID, apep.HermesID.Hex())
-			return
+			return receivedPromise{}, false
 		}
 		channel = hc
 	}
 
 	log.Info().Msgf("Hermes %q promise state updated for provider %q", apep.HermesID.Hex(), id)
 
-	if s.needsSettling(aps.config.Threshold, channel) {
+	policy := aps.policy(id)
+	if trigger, ok := s.needsSettlingByPolicy(policy, aps.config.Threshold, channel); ok {
 		// TODO: when do we settle into stake? Do we ever auto settle into stake now?
 		// if channel.channel.Stake != nil && channel.channel.StakeGoal != nil && channel.channel.Stake.Uint64() < channel.channel.StakeGoal.Uint64() {
 		// 	go func() {
@@ -284,51 +307,129 @@ func (aps *hermesPromiseSettler) handleHermesPromiseReceived(apep event.AppEvent
 		// 		log.Error().Err(err).Msgf("could not settle into stake for %q", apep.ProviderID)
 		// 	}()
 		// } else
-		aps.initiateSettling(channel)
+		return newReceivedPromise(channel, trigger, policy)
+	}
+	return receivedPromise{}, false
+}
+
+// policy returns settlement policy of the given provider or an empty one if none is set.
+func (aps *hermesPromiseSettler) policy(id identity.Identity) SettlementPolicy {
+	if aps.policies == nil {
+		return SettlementPolicy{}
+	}
+	policy, err := aps.policies.Get(id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		log.Error().Err(err).Msgf("Could not load settlement policy for %q", id)
+	}
+	return policy
+}
+
+// dueSettlements returns promises of providers whose scheduled settlement is due.
+func (aps *hermesPromiseSettler) dueSettlements(chainID int64, now time.Time) []receivedPromise {
+	var due []receivedPromise
+	for _, channel := range aps.channelProvider.List(chainID) {
+		aps.lock.RLock()
+		s, ok := aps.currentState[channel.Identity]
+		aps.lock.RUnlock()
+		if !ok || !s.registered || s.settleInProgress {
+			continue
+		}
+
+		policy := aps.policy(channel.Identity)
+		if !policy.scheduleDue(now) || !policy.amountReached(channel.UnsettledBalance()) {
+			continue
+		}
+
+		log.Info().Msgf("Scheduled %s settlement is due for provider %q", policy.Schedule, channel.Identity)
+		if p, ok := newReceivedPromise(channel, SettlementTriggerSchedule, policy); ok {
+			due = append(due, p)
+		}
 	}
+	return due
 }
 
-func (aps *hermesPromiseSettler) initiateSettling(channel HermesChannel) {
+func newReceivedPromise(channel HermesChannel, trigger SettlementTrigger, policy SettlementPolicy) (receivedPromise, bool) {
 	hexR, err := hex.DecodeString(channel.lastPromise.R)
 	if err != nil {
 		log.Error().Err(fmt.Errorf("could encode R: %w", err))
-		return
+		return receivedPromise{}, false
 	}
 	channel.lastPromise.Promise.R = hexR
 
-	aps.settleQueue <- receivedPromise{
+	return receivedPromise{
 		hermesID:    channel.HermesID,
 		provider:    channel.Identity,
 		promise:     channel.lastPromise.Promise,
 		beneficiary: channel.Beneficiary,
+		trigger:     trigger,
+		policy:      policy,
+	}, true
+}
+
+// settleInBackground starts settlement of the promise unless its channel is gone.
+func (aps *hermesPromiseSettler) settleInBackground(p receivedPromise) {
+	channel, found := aps.channelProvider.Get(p.promise.ChainID, p.provider, p.hermesID)
+	if !found {
+		return
 	}
+	go aps.settleByPolicy(p, channel.Channel.Settled)
 }
 
 func (aps *hermesPromiseSettler) listenForSettlementRequests() {
 	log.Info().Msg("Listening for settlement events")
 	defer log.Info().Msg("Stopped listening for settlement events")
 
+	var policyCheck <-chan time.Time
+	if aps.config.PolicyCheckInterval > 0 {
+		ticker := time.NewTicker(aps.config.PolicyCheckInterval)
+		defer ticker.Stop()
+		policyCheck = ticker.C
+	}
+
 	for {
 		select {
 		case <-aps.stop:
 			return
-		case p := <-aps.settleQueue:
-			channel, found := aps.channelProvider.Get(p.promise.ChainID, p.provider, p.hermesID)
-			if !found {
-				continue
+		case now := <-policyCheck:
+			// due settlements are started directly, this loop is the only reader of the queue.
+			for _, p := range aps.dueSettlements(aps.chainID(), now) {
+				aps.settleInBackground(p)
 			}
-			go aps.settle(
-				func(promise crypto.Promise) error {
-					return aps.transactor.SettleAndRebalance(p.hermesID.Hex(), p.provider.Address, promise)
-				},
-				p.provider,
-				p.hermesID,
-				p.promise,
-				p.beneficiary,
-				channel.Channel.Settled,
-			)
+		case p := <-aps.settleQueue:
+			aps.settleInBackground(p)
+		}
+	}
+}
+
+// ErrSettleFeeTooHigh indicates that transactor fee exceeds the ceiling set in settlement policy.
+var ErrSettleFeeTooHigh = errors.New("transactor fee exceeds settlement policy limit")
+
+// settleByPolicy settles automatically queued promise respecting provider's settlement policy.
+func (aps *hermesPromiseSettler) settleByPolicy(p receivedPromise, settled *big.Int) error {
+	if p.policy.MaxFee != nil && p.trigger.feeLimited() {
+		fees, err := aps.transactor.FetchSettleFees(p.promise.ChainID)
+		if err != nil {
+			log.Error().Err(err).Msg("Could not fetch settle fees")
+			return err
+		}
+		if !p.policy.feeAcceptable(fees.Fee) {
+			log.Info().Msgf("Postponing settlement for %q: transactor fee %v is above policy limit %v", p.provider, fees.Fee, p.policy.MaxFee)
+			return ErrSettleFeeTooHigh
+		}
+	}
+
+	settleFunc := func(promise crypto.Promise) error {
+		return aps.transactor.SettleAndRebalance(p.hermesID.Hex(), p.provider.Address, promise)
+	}
+	beneficiary := p.beneficiary
+	if p.policy.hasBeneficiary() {
+		beneficiary = p.policy.Beneficiary
+		settleFunc = func(promise crypto.Promise) error {
+			return aps.transactor.SettleWithBeneficiary(p.provider.Address, beneficiary.Hex(), p.hermesID.Hex(), promise)
 		}
 	}
+
+	return aps.settle(settleFunc, p.provider, p.hermesID, p.promise, beneficiary, settled, p.trigger)
 }
 
 // SettleIntoStake settles the promise but transfers the money to stake increase, not to beneficiary.
@@ -352,6 +453,7 @@ func (aps *hermesPromiseSettler) SettleIntoStake(chainID int64, providerID ident
 		channel.lastPromise.Promise,
 		channel.Beneficiary,
 		channel.Channel.Settled,
+		SettlementTriggerManual,
 	)
 }
 
@@ -380,6 +482,7 @@ func (aps *hermesPromiseSettler) ForceSettle(chainID int64, providerID identity.
 		channel.lastPromise.Promise,
 		channel.Beneficiary,
 		channel.Channel.Settled,
+		SettlementTriggerManual,
 	)
 }
 
@@ -405,6 +508,7 @@ func (aps *hermesPromiseSettler) SettleWithBeneficiary(chainID int64, providerID
 		channel.lastPromise.Promise,
 		beneficiary,
 		channel.Channel.Settled,
+		SettlementTriggerManual,
 	)
 }
 
@@ -439,7 +543,8 @@ func (aps *hermesPromiseSettler) settle(
 	promise crypto.Promise,
 	beneficiary common.Address,
 	settled *big.Int,
-) error {
+	trigger SettlementTrigger,
+) (err error) {
 	if aps.isSettling(provider) {
 		return errors.New("provider already has settlement in progress")
 	}
@@ -447,6 +552,18 @@ func (aps *hermesPromiseSettler) settle(
 	aps.setSettling(provider, true)
 	log.Info().Msgf("Marked provider %v as requesting settlement", provider)
 
+	defer func() {
+		if err != nil && aps.publisher != nil {
+			aps.publisher.Publish(event.AppTopicSettlementFailed, event.AppEventSettlementFailed{
+				ProviderID: provider,
+				HermesID:   hermesID,
+				ChainID:    promise.ChainID,
+				Trigger:    string(trigger),
+				Error:      err.Error(),
+			})
+		}
+	}()
+
 	updatedPromise, err := aps.updatePromiseWithLatestFee(hermesID, promise)
 	if err != nil {
 		aps.setSettling(provider, false)
@@ -526,6 +643,7 @@ func (aps *hermesPromiseSettler) settle(
 				Amount:         info.AmountSentToBeneficiary,
 				Fees:           info.Fees,
 				TotalSettled:   ch.Channel.Settled,
+				Trigger:        trigger,
 			}
 
 			err = aps.settlementHistoryStorage.Store(she)
@@ -533,6 +651,25 @@ func (aps *hermesPromiseSettler) settle(
 				log.Error().Err(err).Msg("Could not store settlement history")
 			}
 
+			if aps.policies != nil {
+				if err := aps.policies.MarkSettled(provider, she.Time); err != nil {
+					log.Error().Err(err).Msg("Could not update settlement policy")
+				}
+			}
+
+			if aps.publisher != nil {
+				aps.publisher.Publish(event.AppTopicSettlementComplete, event.AppEventSettlementComplete{
+					ProviderID:   provider,
+					HermesID:     hermesID,
+					ChainID:      promise.ChainID,
+					TxHash:       she.TxHash,
+					Amount:       she.Amount,
+					Fees:         she.Fees,
+					TotalSettled: she.TotalSettled,
+					Trigger:      string(trigger),
+				})
+			}
+
 			return
 		case <-time.After(aps.config.MaxWaitForSettlement):
 			log.Info().Msgf("Settle timeout for %v", provider)
@@ -600,6 +737,19 @@ func (aps *hermesPromiseSettler) handleNodeStop() {
 	})
 }
 
+// needsSettlingByPolicy checks if channel should be settled after receiving a promise and returns what triggered it.
+// Threshold is always respected so that channel balance never runs out, minimum amount
+// triggers settlement only for policies without schedule.
+func (ss settlementState) needsSettlingByPolicy(policy SettlementPolicy, threshold float64, channel HermesChannel) (SettlementTrigger, bool) {
+	if ss.needsSettling(threshold, channel) {
+		return SettlementTriggerThreshold, true
+	}
+	if policy.Schedule == SettlementScheduleNone && policy.MinAmount != nil && policy.MinAmount.Sign() > 0 && ss.registered && !ss.settleInProgress && policy.amountReached(channel.UnsettledBalance()) {
+		return SettlementTriggerAmount, true
+	}
+	return "", false
+}
+
 // settlementState earning calculations model
 type settlementState struct {
 	settleInProgress bool
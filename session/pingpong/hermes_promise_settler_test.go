@@ -20,7 +20,9 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/mysteriumnetwork/payments/client"
 	"github.com/mysteriumnetwork/payments/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPromiseSettler_loadInitialState(t *testing.T) {
@@ -45,7 +48,7 @@ func TestPromiseSettler_loadInitialState(t *testing.T) {
 	ks := identity.NewMockKeystore()
 
 	fac := &mockHermesCallerFactory{}
-	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, &mockHermesChannelProvider{}, &mockProviderChannelStatusProvider{}, mrsp, ks, &settlementHistoryStorageMock{}, cfg)
+	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, &mockHermesChannelProvider{}, &mockProviderChannelStatusProvider{}, mrsp, ks, &settlementHistoryStorageMock{}, &mockSettlementPolicyProvider{}, &mockPublisher{}, cfg)
 	settler.currentState[mockID] = settlementState{}
 
 	// check if existing gets skipped
@@ -106,7 +109,7 @@ func TestPromiseSettler_handleRegistrationEvent(t *testing.T) {
 	ks := identity.NewMockKeystore()
 	fac := &mockHermesCallerFactory{}
 
-	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, &mockHermesChannelProvider{}, &mockProviderChannelStatusProvider{}, mrsp, ks, &settlementHistoryStorageMock{}, cfg)
+	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, &mockHermesChannelProvider{}, &mockProviderChannelStatusProvider{}, mrsp, ks, &settlementHistoryStorageMock{}, &mockSettlementPolicyProvider{}, &mockPublisher{}, cfg)
 
 	statusesWithNoChangeExpected := []registry.RegistrationStatus{registry.Unregistered, registry.InProgress, registry.RegistrationError}
 	for _, v := range statusesWithNoChangeExpected {
@@ -143,7 +146,7 @@ func TestPromiseSettler_handleHermesPromiseReceived(t *testing.T) {
 	ks := identity.NewMockKeystore()
 	fac := &mockHermesCallerFactory{}
 
-	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, channelProvider, channelStatusProvider, mrsp, ks, &settlementHistoryStorageMock{}, cfg)
+	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, channelProvider, channelStatusProvider, mrsp, ks, &settlementHistoryStorageMock{}, &mockSettlementPolicyProvider{}, &mockPublisher{}, cfg)
 
 	// no receive on unknown provider
 	channelProvider.channelToReturn = NewHermesChannel("1", mockID, hermesID, mockProviderChannel, HermesPromise{})
@@ -226,7 +229,7 @@ func TestPromiseSettler_handleNodeStart(t *testing.T) {
 	}
 
 	fac := &mockHermesCallerFactory{}
-	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, &mockHermesChannelProvider{}, &mockProviderChannelStatusProvider{}, mrsp, ks, &settlementHistoryStorageMock{}, cfg)
+	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, &mockHermesChannelProvider{}, &mockProviderChannelStatusProvider{}, mrsp, ks, &settlementHistoryStorageMock{}, &mockSettlementPolicyProvider{}, &mockPublisher{}, cfg)
 
 	settler.handleNodeStart()
 
@@ -268,10 +271,52 @@ func TestPromiseSettler_RejectsIfFeesExceedSettlementAmount(t *testing.T) {
 	settled := big.NewInt(6000)
 
 	mockSettler := func(crypto.Promise) error { return nil }
-	err := promiseSettler.settle(mockSettler, identity.Identity{}, common.Address{}, mockPromise, common.Address{}, settled)
+	err := promiseSettler.settle(mockSettler, identity.Identity{}, common.Address{}, mockPromise, common.Address{}, settled, SettlementTriggerManual)
 	assert.Equal(t, "Settlement fees exceed earning amount. Please provide more service and try again. Current earnings: 29000, current fees: 30000", err.Error())
 }
 
+func TestPromiseSettler_PublishesFailedSettlement(t *testing.T) {
+	fac := &mockHermesCallerFactory{}
+	publisher := &mockPublisher{publicationChan: make(chan testEvent, 1)}
+
+	promiseSettler := hermesPromiseSettler{
+		currentState: make(map[identity.Identity]settlementState),
+		transactor: &mockTransactor{
+			feesToReturn: registry.FeesResponse{
+				Fee: big.NewInt(5000),
+			},
+		},
+		hermesCallerFactory: fac.Get,
+		hermesURLGetter:     &mockHermesURLGetter{},
+		bc: &mockProviderChannelStatusProvider{
+			calculatedFees: big.NewInt(25000),
+		},
+		publisher: publisher,
+	}
+
+	providerID := identity.FromAddress("0x0000000000000000000000000000000000000001")
+	hermesID := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	mockPromise := crypto.Promise{
+		ChainID: 5,
+		Fee:     big.NewInt(5000),
+		Amount:  big.NewInt(35000),
+	}
+
+	mockSettler := func(crypto.Promise) error { return nil }
+	err := promiseSettler.settle(mockSettler, providerID, hermesID, mockPromise, common.Address{}, big.NewInt(6000), SettlementTriggerThreshold)
+	assert.Error(t, err)
+
+	published := <-publisher.publicationChan
+	assert.Equal(t, event.AppTopicSettlementFailed, published.name)
+	assert.Equal(t, event.AppEventSettlementFailed{
+		ProviderID: providerID,
+		HermesID:   hermesID,
+		ChainID:    5,
+		Trigger:    string(SettlementTriggerThreshold),
+		Error:      err.Error(),
+	}, published.value)
+}
+
 func TestPromiseSettler_AcceptsIfFeesDoNotExceedSettlementAmount(t *testing.T) {
 	fac := &mockHermesCallerFactory{}
 	transactorFee := big.NewInt(5000)
@@ -308,10 +353,136 @@ func TestPromiseSettler_AcceptsIfFeesDoNotExceedSettlementAmount(t *testing.T) {
 	mockSettler := func(crypto.Promise) error { return nil }
 
 	go func() { bc.sinkToReturn <- &bindings.HermesImplementationPromiseSettled{} }()
-	err := promiseSettler.settle(mockSettler, identity.Identity{}, common.Address{}, mockPromise, common.Address{}, settled)
+	err := promiseSettler.settle(mockSettler, identity.Identity{}, common.Address{}, mockPromise, common.Address{}, settled, SettlementTriggerManual)
 	assert.NoError(t, err)
 }
 
+func TestPromiseSettler_settleScheduled(t *testing.T) {
+	channelProvider := &mockHermesChannelProvider{}
+	policies := &mockSettlementPolicyProvider{}
+	fac := &mockHermesCallerFactory{}
+	settler := NewHermesPromiseSettler(&mockTransactor{}, fac.Get, &mockHermesURLGetter{}, channelProvider, &mockProviderChannelStatusProvider{}, &mockRegistrationStatusProvider{}, identity.NewMockKeystore(), &settlementHistoryStorageMock{}, policies, &mockPublisher{}, cfg)
+	settler.currentState[mockID] = settlementState{registered: true}
+
+	now := time.Date(2020, 1, 8, 0, 0, 0, 0, time.UTC)
+	channelProvider.channelToReturn = NewHermesChannel("1", mockID, hermesID, client.ProviderChannel{Settled: big.NewInt(100), Stake: big.NewInt(0)}, HermesPromise{Promise: crypto.Promise{Amount: big.NewInt(200)}})
+
+	// no policy, nothing scheduled
+	assert.Empty(t, settler.dueSettlements(0, now))
+
+	// settled recently
+	policies.policy = SettlementPolicy{ID: "1", Schedule: SettlementScheduleWeekly, LastSettledAt: now.Add(-time.Hour)}
+	assert.Empty(t, settler.dueSettlements(0, now))
+
+	// due, but below minimum amount
+	policies.policy = SettlementPolicy{ID: "1", Schedule: SettlementScheduleWeekly, MinAmount: big.NewInt(101)}
+	assert.Empty(t, settler.dueSettlements(0, now))
+
+	// due
+	policies.policy = SettlementPolicy{ID: "1", Schedule: SettlementScheduleDaily, MinAmount: big.NewInt(100), LastSettledAt: now.Add(-25 * time.Hour)}
+	due := settler.dueSettlements(0, now)
+	require.Len(t, due, 1)
+	assert.Equal(t, mockID, due[0].provider)
+	assert.Equal(t, SettlementTriggerSchedule, due[0].trigger)
+	assertNoReceive(t, settler.settleQueue)
+}
+
+func TestPromiseSettler_settlesMoreDueChannelsThanQueueHolds(t *testing.T) {
+	channelProvider := &mockHermesChannelProvider{}
+	policies := &mockSettlementPolicyProvider{
+		policy: SettlementPolicy{ID: "1", Schedule: SettlementScheduleDaily},
+	}
+	fac := &mockHermesCallerFactory{}
+	config := cfg
+	config.PolicyCheckInterval = 10 * time.Millisecond
+	// settlements fail early, only starting them matters here
+	transactor := &mockTransactor{feesError: errors.New("fees unavailable")}
+	settler := NewHermesPromiseSettler(transactor, fac.Get, &mockHermesURLGetter{}, channelProvider, &mockProviderChannelStatusProvider{}, &mockRegistrationStatusProvider{}, identity.NewMockKeystore(), &settlementHistoryStorageMock{}, policies, &mockPublisher{}, config)
+
+	channelCount := cap(settler.settleQueue) + 3
+	for i := 0; i < channelCount; i++ {
+		provider := identity.FromAddress(fmt.Sprintf("0x%040d", i))
+		settler.currentState[provider] = settlementState{registered: true}
+		channelProvider.channels = append(channelProvider.channels, NewHermesChannel(strconv.Itoa(i), provider, hermesID, client.ProviderChannel{Settled: big.NewInt(0), Stake: big.NewInt(0)}, HermesPromise{Promise: crypto.Promise{Amount: big.NewInt(200)}}))
+	}
+
+	due := settler.dueSettlements(0, time.Now())
+	assert.Len(t, due, channelCount)
+	assertNoReceive(t, settler.settleQueue)
+
+	done := make(chan struct{})
+	go func() {
+		settler.listenForSettlementRequests()
+		close(done)
+	}()
+	// every due settlement looks up its channel before settling
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&channelProvider.gets) >= int32(channelCount)
+	}, 2*time.Second, 10*time.Millisecond)
+	close(settler.stop)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("settlement loop is blocked")
+	}
+}
+
+func TestPromiseSettler_settleByPolicyRespectsFeeCeiling(t *testing.T) {
+	fac := &mockHermesCallerFactory{}
+	promiseSettler := hermesPromiseSettler{
+		currentState: make(map[identity.Identity]settlementState),
+		transactor: &mockTransactor{
+			feesToReturn: registry.FeesResponse{
+				Fee: big.NewInt(5000),
+			},
+		},
+		hermesCallerFactory: fac.Get,
+		hermesURLGetter:     &mockHermesURLGetter{},
+		bc: &mockProviderChannelStatusProvider{
+			calculatedFees: big.NewInt(25000),
+		},
+	}
+
+	err := promiseSettler.settleByPolicy(receivedPromise{
+		provider: mockID,
+		promise:  crypto.Promise{Amount: big.NewInt(35000)},
+		trigger:  SettlementTriggerAmount,
+		policy:   SettlementPolicy{MaxFee: big.NewInt(4999)},
+	}, big.NewInt(0))
+	assert.Equal(t, ErrSettleFeeTooHigh, err)
+
+	err = promiseSettler.settleByPolicy(receivedPromise{
+		provider: mockID,
+		promise:  crypto.Promise{Amount: big.NewInt(35000)},
+		trigger:  SettlementTriggerSchedule,
+		policy:   SettlementPolicy{MaxFee: big.NewInt(4999)},
+	}, big.NewInt(0))
+	assert.Equal(t, ErrSettleFeeTooHigh, err)
+
+	err = promiseSettler.settleByPolicy(receivedPromise{
+		provider: mockID,
+		promise:  crypto.Promise{Fee: big.NewInt(5000), Amount: big.NewInt(35000)},
+		trigger:  SettlementTriggerThreshold,
+		policy:   SettlementPolicy{MaxFee: big.NewInt(4999)},
+	}, big.NewInt(6000))
+	assert.EqualError(t, err, "Settlement fees exceed earning amount. Please provide more service and try again. Current earnings: 29000, current fees: 30000")
+}
+
+func TestPromiseSettlerState_needsSettlingByPolicy(t *testing.T) {
+	state := settlementState{registered: true}
+	channel := NewHermesChannel("1", mockID, hermesID, client.ProviderChannel{Settled: big.NewInt(0), Stake: big.NewInt(1000)}, HermesPromise{Promise: crypto.Promise{Amount: big.NewInt(50)}})
+
+	_, ok := state.needsSettlingByPolicy(SettlementPolicy{}, 0.1, channel)
+	assert.False(t, ok)
+
+	trigger, ok := state.needsSettlingByPolicy(SettlementPolicy{MinAmount: big.NewInt(50)}, 0.1, channel)
+	assert.True(t, ok)
+	assert.Equal(t, SettlementTriggerAmount, trigger)
+
+	_, ok = state.needsSettlingByPolicy(SettlementPolicy{MinAmount: big.NewInt(50), Schedule: SettlementScheduleDaily}, 0.1, channel)
+	assert.False(t, ok)
+}
+
 func TestPromiseSettlerState_needsSettling(t *testing.T) {
 	s := settlementState{
 		registered: true,
@@ -396,10 +567,13 @@ var cfg = HermesPromiseSettlerConfig{
 
 type mockHermesChannelProvider struct {
 	channelToReturn    HermesChannel
+	channels           []HermesChannel
+	gets               int32
 	channelReturnError error
 }
 
 func (mhcp *mockHermesChannelProvider) Get(chainID int64, _ identity.Identity, _ common.Address) (HermesChannel, bool) {
+	atomic.AddInt32(&mhcp.gets, 1)
 	return mhcp.channelToReturn, true
 }
 
@@ -407,6 +581,30 @@ func (mhcp *mockHermesChannelProvider) Fetch(chainID int64, _ identity.Identity,
 	return mhcp.channelToReturn, mhcp.channelReturnError
 }
 
+func (mhcp *mockHermesChannelProvider) List(chainID int64) []HermesChannel {
+	if mhcp.channels != nil {
+		return mhcp.channels
+	}
+	return []HermesChannel{mhcp.channelToReturn}
+}
+
+type mockSettlementPolicyProvider struct {
+	policy  SettlementPolicy
+	settled time.Time
+}
+
+func (msp *mockSettlementPolicyProvider) Get(_ identity.Identity) (SettlementPolicy, error) {
+	if msp.policy.ID == "" {
+		return SettlementPolicy{}, ErrNotFound
+	}
+	return msp.policy, nil
+}
+
+func (msp *mockSettlementPolicyProvider) MarkSettled(_ identity.Identity, at time.Time) error {
+	msp.settled = at
+	return nil
+}
+
 type mockRegistrationStatus struct {
 	status registry.RegistrationStatus
 	err    error
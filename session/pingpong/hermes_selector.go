@@ -0,0 +1,135 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/market"
+)
+
+// ErrNoActiveHermes indicates that none of the Hermes accepted by provider is active.
+var ErrNoActiveHermes = errors.New("none of the accepted hermes is active")
+
+type hermesSelectorAddressProvider interface {
+	GetActiveHermes(chainID int64) (common.Address, error)
+	GetRegistryAddress(chainID int64) (common.Address, error)
+}
+
+type hermesBalanceGetter interface {
+	GetHermesBalance(chainID int64, id identity.Identity, hermesID common.Address) *big.Int
+}
+
+// HermesSelector picks Hermes consumer pays provider through.
+type HermesSelector struct {
+	statusChecker   hermesStatusChecker
+	addressProvider hermesSelectorAddressProvider
+	balanceGetter   hermesBalanceGetter
+}
+
+// NewHermesSelector returns a new instance of the HermesSelector.
+func NewHermesSelector(statusChecker hermesStatusChecker, addressProvider hermesSelectorAddressProvider, balanceGetter hermesBalanceGetter) *HermesSelector {
+	return &HermesSelector{
+		statusChecker:   statusChecker,
+		addressProvider: addressProvider,
+		balanceGetter:   balanceGetter,
+	}
+}
+
+// SelectHermes returns Hermes out of those accepted by the proposal, which consumer has enough balance with to pay for the service.
+// Our active Hermes is preferred, otherwise the funded Hermes with the lowest fee is picked keeping provider's order of preference on equal fees.
+// If consumer has no funded channel with any of them, our active Hermes or the cheapest accepted one is returned, failing balance validation later on.
+// Proposals which do not list accepted Hermes are paid through our active Hermes.
+func (hs *HermesSelector) SelectHermes(chainID int64, consumerID identity.Identity, proposal market.ServiceProposal) (common.Address, error) {
+	active, err := hs.addressProvider.GetActiveHermes(chainID)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("could not get active hermes: %w", err)
+	}
+	if len(proposal.AcceptedHermes) == 0 {
+		return active, nil
+	}
+
+	registry, err := hs.addressProvider.GetRegistryAddress(chainID)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("could not get registry address: %w", err)
+	}
+
+	var cheapest, cheapestFunded *HermesStatus
+	activeAccepted := false
+	for _, hex := range proposal.AcceptedHermes {
+		if !common.IsHexAddress(hex) {
+			continue
+		}
+		hermesID := common.HexToAddress(hex)
+
+		status, err := hs.statusChecker.GetHermesStatus(chainID, registry, hermesID)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Could not check hermes %s status, skipping", hex)
+			continue
+		}
+		if !status.IsActive {
+			continue
+		}
+		status.HermesID = hermesID
+
+		funded := hs.funded(chainID, consumerID, hermesID, proposal)
+		if hermesID == active {
+			if funded {
+				return active, nil
+			}
+			activeAccepted = true
+		}
+		if cheapest == nil || status.Fee < cheapest.Fee {
+			accepted := status
+			cheapest = &accepted
+		}
+		if funded && (cheapestFunded == nil || status.Fee < cheapestFunded.Fee) {
+			cheapestFunded = &status
+		}
+	}
+
+	switch {
+	case cheapestFunded != nil:
+		return cheapestFunded.HermesID, nil
+	case activeAccepted:
+		return active, nil
+	case cheapest != nil:
+		return cheapest.HermesID, nil
+	default:
+		return common.Address{}, ErrNoActiveHermes
+	}
+}
+
+// funded checks if consumer has enough balance with the Hermes to pay for the proposal.
+func (hs *HermesSelector) funded(chainID int64, consumerID identity.Identity, hermesID common.Address, proposal market.ServiceProposal) bool {
+	if proposal.PaymentMethodType == "" || proposal.PaymentMethod == nil {
+		return true
+	}
+	price := proposal.PaymentMethod.GetPrice()
+	if price.Amount == nil {
+		return true
+	}
+	balance := hs.balanceGetter.GetHermesBalance(chainID, consumerID, hermesID)
+	return balance != nil && balance.Cmp(price.Amount) >= 0
+}
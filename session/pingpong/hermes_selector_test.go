@@ -0,0 +1,123 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/market"
+	"github.com/mysteriumnetwork/node/money"
+)
+
+type mockHermesStatuses map[common.Address]HermesStatus
+
+func (m mockHermesStatuses) GetHermesStatus(chainID int64, registryAddress common.Address, hermesID common.Address) (HermesStatus, error) {
+	status, ok := m[hermesID]
+	if !ok {
+		return HermesStatus{}, errors.New("unknown hermes")
+	}
+	return status, nil
+}
+
+type mockHermesBalances map[common.Address]*big.Int
+
+func (m mockHermesBalances) GetHermesBalance(chainID int64, id identity.Identity, hermesID common.Address) *big.Int {
+	if balance, ok := m[hermesID]; ok {
+		return balance
+	}
+	return new(big.Int)
+}
+
+type mockSelectorAddressProvider struct {
+	active common.Address
+}
+
+func (m *mockSelectorAddressProvider) GetActiveHermes(chainID int64) (common.Address, error) {
+	return m.active, nil
+}
+
+func (m *mockSelectorAddressProvider) GetRegistryAddress(chainID int64) (common.Address, error) {
+	return common.Address{}, nil
+}
+
+func TestHermesSelector_SelectHermes(t *testing.T) {
+	hermes1 := common.HexToAddress("0x1")
+	hermes2 := common.HexToAddress("0x2")
+	hermes3 := common.HexToAddress("0x3")
+	hermes4 := common.HexToAddress("0x4")
+	statuses := mockHermesStatuses{
+		hermes1: {IsActive: false, Fee: 0},
+		hermes2: {IsActive: true, Fee: 2000},
+		hermes3: {IsActive: true, Fee: 1500},
+		hermes4: {IsActive: true, Fee: 1500},
+	}
+	selector := NewHermesSelector(statuses, &mockAddressProvider{}, mockHermesBalances{})
+
+	hermes, err := selector.SelectHermes(1, identity.FromAddress("0xconsumer"), market.ServiceProposal{})
+	assert.NoError(t, err)
+	assert.Equal(t, common.Address{}, hermes, "falls back to active hermes")
+
+	hermes, err = selector.SelectHermes(1, identity.FromAddress("0xconsumer"), market.ServiceProposal{AcceptedHermes: []string{
+		hermes1.Hex(), "0x5", hermes2.Hex(), hermes4.Hex(), hermes3.Hex(),
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, hermes4, hermes, "cheapest active hermes, provider order on ties")
+
+	_, err = selector.SelectHermes(1, identity.FromAddress("0xconsumer"), market.ServiceProposal{AcceptedHermes: []string{hermes1.Hex(), "0x5", "not an address"}})
+	assert.Equal(t, ErrNoActiveHermes, err)
+}
+
+func TestHermesSelector_SelectHermesSkipsUnfundedHermes(t *testing.T) {
+	active := common.HexToAddress("0x1")
+	cheaper := common.HexToAddress("0x2")
+	cheapest := common.HexToAddress("0x3")
+	statuses := mockHermesStatuses{
+		active:   {IsActive: true, Fee: 2000},
+		cheaper:  {IsActive: true, Fee: 1000},
+		cheapest: {IsActive: true, Fee: 500},
+	}
+	balances := mockHermesBalances{active: big.NewInt(100)}
+	selector := NewHermesSelector(statuses, &mockSelectorAddressProvider{active: active}, balances)
+	consumer := identity.FromAddress("0xconsumer")
+	proposal := market.ServiceProposal{
+		PaymentMethodType: "BYTES_TRANSFERRED_WITH_TIME",
+		PaymentMethod:     &mockPaymentMethod{price: money.Money{Amount: big.NewInt(50), Currency: money.CurrencyMyst}},
+		AcceptedHermes:    []string{cheapest.Hex(), active.Hex(), cheaper.Hex()},
+	}
+
+	hermes, err := selector.SelectHermes(1, consumer, proposal)
+	assert.NoError(t, err)
+	assert.Equal(t, active, hermes, "funded active hermes is preferred over cheaper unfunded ones")
+
+	balances[cheaper] = big.NewInt(100)
+	balances[active] = big.NewInt(10)
+	hermes, err = selector.SelectHermes(1, consumer, proposal)
+	assert.NoError(t, err)
+	assert.Equal(t, cheaper, hermes, "cheapest funded hermes when active one lacks balance")
+
+	balances[cheaper] = big.NewInt(0)
+	hermes, err = selector.SelectHermes(1, consumer, proposal)
+	assert.NoError(t, err)
+	assert.Equal(t, active, hermes, "active hermes when none is funded")
+}
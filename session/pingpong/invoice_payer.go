@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	consumer_session "github.com/mysteriumnetwork/node/consumer/session"
 	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
 	"github.com/mysteriumnetwork/node/datasize"
 	"github.com/mysteriumnetwork/node/eventbus"
@@ -66,6 +67,15 @@ type timeTracker interface {
 	Elapsed() time.Duration
 }
 
+type spendingGuard interface {
+	Pay(consumerID identity.Identity, sessionID string, sessionSpent, amount *big.Int) (time.Time, error)
+	Refund(consumerID identity.Identity, amount *big.Int, paidAt time.Time) error
+}
+
+type consumerLedger interface {
+	Store(entry ConsumerLedgerEntry) error
+}
+
 type channelAddressCalculator interface {
 	GetChannelAddress(id identity.Identity) (common.Address, error)
 }
@@ -76,8 +86,10 @@ type InvoicePayer struct {
 	once           sync.Once
 	channelAddress identity.Identity
 
-	lastInvoice crypto.Invoice
-	deps        InvoicePayerDeps
+	lastInvoice  crypto.Invoice
+	sessionSpent big.Int
+	unsentSpend  big.Int
+	deps         InvoicePayerDeps
 
 	dataTransferred     DataTransferred
 	dataTransferredLock sync.Mutex
@@ -102,6 +114,9 @@ type InvoicePayerDeps struct {
 	HermesAddress             common.Address
 	DataLeeway                datasize.BitSize
 	ChainID                   int64
+	SpendingGuard             spendingGuard
+	Ledger                    consumerLedger
+	IncidentRecorder          paymentIncidentRecorder
 }
 
 // NewInvoicePayer returns a new instance of exchange message tracker.
@@ -123,7 +138,7 @@ var ErrInvoiceMissmatch = errors.New("invoice mismatch")
 // Start starts the message exchange tracker. Blocks.
 func (ip *InvoicePayer) Start() error {
 	log.Debug().Msg("Starting...")
-	addr, err := ip.deps.AddressProvider.GetChannelAddress(ip.deps.ChainID, ip.deps.Identity)
+	addr, err := ip.hermesChannelAddress()
 	if err != nil {
 		return errors.Wrap(err, "could not generate channel address")
 	}
@@ -157,6 +172,20 @@ func (ip *InvoicePayer) Start() error {
 	}
 }
 
+// hermesChannelAddress calculates consumer channel address in the Hermes selected for the session,
+// which is not necessarily the active one.
+func (ip *InvoicePayer) hermesChannelAddress() (common.Address, error) {
+	registry, err := ip.deps.AddressProvider.GetRegistryAddress(ip.deps.ChainID)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "could not get registry address")
+	}
+	chimp, err := ip.deps.AddressProvider.GetChannelImplementation(ip.deps.ChainID)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "could not get channel implementation")
+	}
+	return ip.deps.AddressProvider.GetArbitraryChannelAddress(ip.deps.HermesAddress, registry, chimp, ip.deps.Identity)
+}
+
 func (ip *InvoicePayer) incrementGrandTotalPromised(amount big.Int) error {
 	res, err := ip.deps.ConsumerTotalsStorage.Get(ip.chainID(), ip.deps.Identity, ip.deps.HermesAddress)
 	if err != nil {
@@ -175,6 +204,7 @@ func (ip *InvoicePayer) incrementGrandTotalPromised(amount big.Int) error {
 
 func (ip *InvoicePayer) isInvoiceOK(invoice crypto.Invoice) error {
 	if !strings.EqualFold(invoice.Provider, ip.deps.Peer.Address) {
+		ip.recordIncident(IncidentWrongProvider, ErrWrongProvider, nil, invoice)
 		return ErrWrongProvider
 	}
 
@@ -190,12 +220,33 @@ func (ip *InvoicePayer) isInvoiceOK(invoice crypto.Invoice) error {
 
 	if invoice.AgreementTotal.Cmp(upperBound) == 1 {
 		log.Warn().Msg("Provider trying to overcharge")
+		ip.recordIncident(IncidentProviderOvercharge, ErrProviderOvercharge, upperBound, invoice)
 		return ErrProviderOvercharge
 	}
 
 	return nil
 }
 
+func (ip *InvoicePayer) recordIncident(kind PaymentIncidentKind, err error, expected *big.Int, invoice crypto.Invoice) {
+	if ip.deps.IncidentRecorder == nil {
+		return
+	}
+
+	ip.deps.IncidentRecorder.Record(PaymentIncident{
+		Kind:       kind,
+		Direction:  consumer_session.DirectionConsumed,
+		SessionID:  ip.deps.SessionID,
+		Offender:   ip.deps.Peer.Address,
+		ProviderID: ip.deps.Peer,
+		ConsumerID: ip.deps.Identity,
+		HermesID:   ip.deps.HermesAddress,
+		Error:      err.Error(),
+		Expected:   expected,
+		Actual:     invoice.AgreementTotal,
+		Invoice:    &invoice,
+	})
+}
+
 func estimateInvoiceTolerance(elapsed time.Duration, transferred DataTransferred) float64 {
 	if elapsed.Seconds() < 1 {
 		return 3
@@ -255,14 +306,44 @@ func (ip *InvoicePayer) issueExchangeMessage(invoice crypto.Invoice) error {
 		return errors.Wrap(err, "could not calculate amount to promise")
 	}
 
+	// Promises are cumulative, so amount of the undelivered ones is spent together with this one.
+	toSpend := new(big.Int).Add(&ip.unsentSpend, diff)
+	var paidAt time.Time
+	if ip.deps.SpendingGuard != nil {
+		if paidAt, err = ip.deps.SpendingGuard.Pay(ip.deps.Identity, ip.deps.SessionID, &ip.sessionSpent, toSpend); err != nil {
+			return err
+		}
+	}
+
 	msg, err := crypto.CreateExchangeMessage(ip.chainID(), invoice, amountToPromise, ip.channelAddress.Address, ip.deps.HermesAddress.Hex(), ip.deps.Ks, common.HexToAddress(ip.deps.Identity.Address))
 	if err != nil {
+		ip.refundSpending(toSpend, paidAt)
 		return errors.Wrap(err, "could not create exchange message")
 	}
 
+	if ip.deps.Ledger != nil {
+		err := ip.deps.Ledger.Store(ConsumerLedgerEntry{
+			SessionID:  ip.deps.SessionID,
+			ConsumerID: ip.deps.Identity,
+			HermesID:   ip.deps.HermesAddress,
+			Time:       time.Now().UTC(),
+			Invoice:    invoice,
+			Message:    *msg,
+			Promised:   diff,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to record paid invoice in ledger")
+		}
+	}
+
 	err = ip.deps.PeerExchangeMessageSender.Send(*msg)
 	if err != nil {
 		log.Warn().Err(err).Msg("Failed to send exchange message")
+		ip.refundSpending(toSpend, paidAt)
+		ip.unsentSpend.Set(toSpend)
+	} else {
+		ip.sessionSpent.Add(&ip.sessionSpent, toSpend)
+		ip.unsentSpend.SetInt64(0)
 	}
 
 	ip.deps.EventBus.Publish(event.AppTopicInvoicePaid, event.AppEventInvoicePaid{
@@ -276,6 +357,15 @@ func (ip *InvoicePayer) issueExchangeMessage(invoice crypto.Invoice) error {
 	return errors.Wrap(err, "could not increment grand total")
 }
 
+func (ip *InvoicePayer) refundSpending(amount *big.Int, paidAt time.Time) {
+	if ip.deps.SpendingGuard == nil {
+		return
+	}
+	if err := ip.deps.SpendingGuard.Refund(ip.deps.Identity, amount, paidAt); err != nil {
+		log.Error().Err(err).Msg("Failed to roll back recorded spending")
+	}
+}
+
 // Stop stops the message tracker.
 func (ip *InvoicePayer) Stop() {
 	ip.once.Do(func() {
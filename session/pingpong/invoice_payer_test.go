@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/mysteriumnetwork/node/core/connection"
 	"github.com/mysteriumnetwork/node/core/storage/boltdb"
 	"github.com/mysteriumnetwork/node/eventbus"
 	"github.com/mysteriumnetwork/node/identity"
@@ -968,3 +969,181 @@ func Test_estimateInvoiceTolerance(t *testing.T) {
 		})
 	}
 }
+
+type mockSpendingGuard struct {
+	err      error
+	paid     []*big.Int
+	refunded []*big.Int
+}
+
+func (m *mockSpendingGuard) Pay(_ identity.Identity, _ string, _, amount *big.Int) (time.Time, error) {
+	if m.err != nil {
+		return time.Time{}, m.err
+	}
+	m.paid = append(m.paid, new(big.Int).Set(amount))
+	return time.Now(), nil
+}
+
+func (m *mockSpendingGuard) Refund(_ identity.Identity, amount *big.Int, _ time.Time) error {
+	m.refunded = append(m.refunded, new(big.Int).Set(amount))
+	return nil
+}
+
+func TestInvoicePayer_issueExchangeMessage_respectsSpendingLimit(t *testing.T) {
+	sender := &MockPeerExchangeMessageSender{
+		chanToWriteTo: make(chan crypto.ExchangeMessage, 10),
+	}
+	emt := &InvoicePayer{
+		deps: InvoicePayerDeps{
+			PeerExchangeMessageSender: sender,
+			ConsumerTotalsStorage: &mockConsumerTotalsStorage{
+				res: big.NewInt(0),
+				bus: mocks.NewEventBus(),
+			},
+			EventBus:      mocks.NewEventBus(),
+			Identity:      identity.FromAddress("0x1"),
+			Peer:          identity.FromAddress("0x01"),
+			SpendingGuard: &mockSpendingGuard{err: connection.ErrSpendingLimitReached},
+		},
+		lastInvoice: crypto.Invoice{
+			AgreementID:    new(big.Int),
+			AgreementTotal: big.NewInt(10),
+			TransactorFee:  new(big.Int),
+		},
+	}
+
+	err := emt.issueExchangeMessage(crypto.Invoice{
+		AgreementTotal: big.NewInt(15),
+		AgreementID:    big.NewInt(0),
+		TransactorFee:  new(big.Int),
+	})
+	assert.Equal(t, connection.ErrSpendingLimitReached, err)
+	assert.Len(t, sender.chanToWriteTo, 0)
+}
+
+func TestInvoicePayer_issueExchangeMessage_refundsUndeliveredSpending(t *testing.T) {
+	ks := identity.NewMockKeystore()
+	acc, err := ks.NewAccount("")
+	assert.NoError(t, err)
+	assert.NoError(t, ks.Unlock(acc, ""))
+
+	sender := &MockPeerExchangeMessageSender{
+		mockError:     errors.New("send failed"),
+		chanToWriteTo: make(chan crypto.ExchangeMessage, 10),
+	}
+	guard := &mockSpendingGuard{}
+	emt := &InvoicePayer{
+		deps: InvoicePayerDeps{
+			PeerExchangeMessageSender: sender,
+			ConsumerTotalsStorage: &mockConsumerTotalsStorage{
+				res: big.NewInt(0),
+				bus: mocks.NewEventBus(),
+			},
+			EventBus:      mocks.NewEventBus(),
+			Ks:            ks,
+			Identity:      identity.FromAddress(acc.Address.Hex()),
+			Peer:          identity.FromAddress("0x01"),
+			SpendingGuard: guard,
+		},
+		lastInvoice: crypto.Invoice{
+			AgreementID:    new(big.Int),
+			AgreementTotal: big.NewInt(10),
+			TransactorFee:  new(big.Int),
+		},
+	}
+
+	invoice := crypto.Invoice{
+		AgreementTotal: big.NewInt(15),
+		AgreementID:    big.NewInt(0),
+		TransactorFee:  new(big.Int),
+		Hashlock:       "0x441Da57A51e42DAB7Daf55909Af93A9b00eEF23C",
+	}
+	assert.NoError(t, emt.issueExchangeMessage(invoice))
+	assert.Equal(t, []*big.Int{big.NewInt(5)}, guard.paid)
+	assert.Equal(t, []*big.Int{big.NewInt(5)}, guard.refunded)
+	assert.Equal(t, int64(0), emt.sessionSpent.Int64())
+
+	sender.mockError = nil
+	emt.lastInvoice = invoice
+	invoice.AgreementTotal = big.NewInt(20)
+	assert.NoError(t, emt.issueExchangeMessage(invoice))
+	assert.Equal(t, []*big.Int{big.NewInt(5), big.NewInt(10)}, guard.paid)
+	assert.Len(t, guard.refunded, 1)
+	assert.Equal(t, int64(10), emt.sessionSpent.Int64())
+}
+
+type mockPaymentIncidentRecorder struct {
+	incidents []PaymentIncident
+}
+
+func (m *mockPaymentIncidentRecorder) Record(incident PaymentIncident) {
+	m.incidents = append(m.incidents, incident)
+}
+
+func TestInvoicePayer_isInvoiceOK_recordsIncident(t *testing.T) {
+	recorder := &mockPaymentIncidentRecorder{}
+	ip := &InvoicePayer{
+		deps: InvoicePayerDeps{
+			TimeTracker: &mockTimeTracker{
+				timeToReturn: time.Minute,
+			},
+			Proposal: market.ServiceProposal{
+				PaymentMethod: &mockPaymentMethod{
+					price: money.New(big.NewInt(100000), money.CurrencyMyst),
+					rate:  market.PaymentRate{PerTime: time.Minute},
+				},
+			},
+			Peer:             identity.FromAddress("0x441Da57A51e42DAB7Daf55909Af93A9b00eEF23C"),
+			Identity:         identity.FromAddress("0x1"),
+			SessionID:        "session",
+			IncidentRecorder: recorder,
+		},
+	}
+
+	invoice := crypto.Invoice{
+		TransactorFee:  big.NewInt(0),
+		AgreementID:    big.NewInt(1),
+		AgreementTotal: big.NewInt(200000),
+		Provider:       "0x441Da57A51e42DAB7Daf55909Af93A9b00eEF23C",
+	}
+	assert.Equal(t, ErrProviderOvercharge, ip.isInvoiceOK(invoice))
+	assert.Len(t, recorder.incidents, 1)
+
+	incident := recorder.incidents[0]
+	assert.Equal(t, IncidentProviderOvercharge, incident.Kind)
+	assert.Equal(t, "Consumed", incident.Direction)
+	assert.Equal(t, "session", incident.SessionID)
+	assert.Equal(t, ip.deps.Peer.Address, incident.Offender)
+	assert.Equal(t, big.NewInt(200000), incident.Actual)
+	assert.Equal(t, 1, incident.Expected.Cmp(big.NewInt(100000)))
+	assert.Equal(t, invoice, *incident.Invoice)
+}
+
+type hermesRecordingAddressProvider struct {
+	mockAddressProvider
+	hermes common.Address
+}
+
+func (m *hermesRecordingAddressProvider) GetArbitraryChannelAddress(hermes, registry, channel common.Address, id identity.Identity) (common.Address, error) {
+	m.hermes = hermes
+	return m.addrToReturn, nil
+}
+
+func TestInvoicePayer_hermesChannelAddress_usesSelectedHermes(t *testing.T) {
+	selected := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	addressProvider := &hermesRecordingAddressProvider{
+		mockAddressProvider: mockAddressProvider{addrToReturn: common.HexToAddress("0x1")},
+	}
+	ip := &InvoicePayer{
+		deps: InvoicePayerDeps{
+			AddressProvider: addressProvider,
+			HermesAddress:   selected,
+			Identity:        identity.FromAddress("0x2"),
+		},
+	}
+
+	addr, err := ip.hermesChannelAddress()
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x1"), addr)
+	assert.Equal(t, selected, addressProvider.hermes)
+}
@@ -32,6 +32,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mysteriumnetwork/node/config"
+	consumer_session "github.com/mysteriumnetwork/node/consumer/session"
 	"github.com/mysteriumnetwork/node/eventbus"
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/market"
@@ -85,6 +86,10 @@ type providerInvoiceStorage interface {
 	GetR(providerID identity.Identity, agreementID *big.Int) (string, error)
 }
 
+type paymentIncidentRecorder interface {
+	Record(incident PaymentIncident)
+}
+
 type promiseHandler interface {
 	RequestPromise(r []byte, em crypto.ExchangeMessage, providerID identity.Identity, sessionID string) <-chan error
 }
@@ -159,6 +164,7 @@ type InvoiceTrackerDeps struct {
 	PromiseHandler             promiseHandler
 	MaxNotPaidInvoice          *big.Int
 	ChainID                    int64
+	IncidentRecorder           paymentIncidentRecorder
 }
 
 // NewInvoiceTracker creates a new instance of invoice tracker.
@@ -249,6 +255,12 @@ func (it *InvoiceTracker) handleExchangeMessage(em crypto.ExchangeMessage) error
 
 	err := it.validateExchangeMessage(em)
 	if err != nil {
+		switch errors.Cause(err) {
+		case ErrConsumerPromiseValidationFailed:
+			it.recordIncident(IncidentInvalidPromise, err, invoice.invoice.AgreementTotal, em.AgreementTotal, &invoice.invoice, &em)
+		case ErrExchangeValidationFailed:
+			it.recordIncident(IncidentInvalidExchangeMessage, err, invoice.invoice.AgreementTotal, em.AgreementTotal, &invoice.invoice, &em)
+		}
 		return err
 	}
 
@@ -455,13 +467,14 @@ func (it *InvoiceTracker) sendInvoice(isCritical bool) error {
 		return ErrInvoiceSendMaxFailCountReached
 	}
 
+	shouldBe := CalculatePaymentAmount(it.deps.TimeTracker.Elapsed(), it.getDataTransferred(), it.deps.Proposal.PaymentMethod)
+	lastEm := it.getLastExchangeMessage()
+
 	if it.getNotReceivedExchangeMessageCount() >= it.maxNotReceivedExchangeMessages {
+		it.recordIncident(IncidentUnderpayment, ErrExchangeWaitTimeout, shouldBe, lastEm.AgreementTotal, nil, &lastEm)
 		return ErrExchangeWaitTimeout
 	}
 
-	shouldBe := CalculatePaymentAmount(it.deps.TimeTracker.Elapsed(), it.getDataTransferred(), it.deps.Proposal.PaymentMethod)
-
-	lastEm := it.getLastExchangeMessage()
 	if lastEm.AgreementTotal.Cmp(big.NewInt(0)) == 0 && shouldBe.Cmp(big.NewInt(0)) == 1 {
 		// The first invoice should have minimal static value.
 		shouldBe = providerFirstInvoiceValue
@@ -503,7 +516,10 @@ func (it *InvoiceTracker) waitForInvoicePayment(hlock []byte) {
 
 		if inv.isCritical {
 			log.Info().Msgf("did not get paid for invoice with hashlock %v, invoice is critical. Aborting.", inv.invoice.Hashlock)
-			it.criticalInvoiceErrors <- fmt.Errorf("did not get paid for critical invoice with hashlock %v", inv.invoice.Hashlock)
+			err := fmt.Errorf("did not get paid for critical invoice with hashlock %v", inv.invoice.Hashlock)
+			lastEm := it.getLastExchangeMessage()
+			it.recordIncident(IncidentUnderpayment, err, inv.invoice.AgreementTotal, lastEm.AgreementTotal, &inv.invoice, &lastEm)
+			it.criticalInvoiceErrors <- err
 			return
 		}
 
@@ -630,6 +646,27 @@ func (it *InvoiceTracker) validateExchangeMessage(em crypto.ExchangeMessage) err
 	return nil
 }
 
+func (it *InvoiceTracker) recordIncident(kind PaymentIncidentKind, err error, expected, actual *big.Int, invoice *crypto.Invoice, em *crypto.ExchangeMessage) {
+	if it.deps.IncidentRecorder == nil {
+		return
+	}
+
+	it.deps.IncidentRecorder.Record(PaymentIncident{
+		Kind:       kind,
+		Direction:  consumer_session.DirectionProvided,
+		SessionID:  it.deps.SessionID,
+		Offender:   it.deps.Peer.Address,
+		ProviderID: it.deps.ProviderID,
+		ConsumerID: it.deps.Peer,
+		HermesID:   it.deps.ConsumersHermesID,
+		Error:      err.Error(),
+		Expected:   expected,
+		Actual:     actual,
+		Invoice:    invoice,
+		Message:    em,
+	})
+}
+
 // Stop stops the invoice tracker.
 func (it *InvoiceTracker) Stop() {
 	it.once.Do(func() {
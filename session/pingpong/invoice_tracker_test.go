@@ -876,3 +876,30 @@ type mockHermesStatusChecker struct {
 func (mhsc *mockHermesStatusChecker) GetHermesStatus(chainID int64, registryAddress common.Address, hermesID common.Address) (HermesStatus, error) {
 	return mhsc.statusToReturn, mhsc.errToReturn
 }
+
+func TestInvoiceTracker_handleExchangeMessage_recordsIncident(t *testing.T) {
+	invoice := crypto.CreateInvoice(big.NewInt(1), big.NewInt(10), new(big.Int), nil, 1)
+	em, _ := generateExchangeMessage(t, big.NewInt(10), invoice, "")
+
+	recorder := &mockPaymentIncidentRecorder{}
+	consumer := identity.FromAddress("0x441Da57A51e42DAB7Daf55909Af93A9b00eEF23C")
+	it := NewInvoiceTracker(InvoiceTrackerDeps{
+		Peer:             consumer,
+		ProviderID:       identity.FromAddress("0x1"),
+		SessionID:        "session",
+		IncidentRecorder: recorder,
+	})
+	it.markInvoiceSent(sentInvoice{invoice: invoice})
+
+	err := it.handleExchangeMessage(em)
+	assert.Equal(t, ErrExchangeValidationFailed, err)
+	assert.Len(t, recorder.incidents, 1)
+
+	incident := recorder.incidents[0]
+	assert.Equal(t, IncidentInvalidExchangeMessage, incident.Kind)
+	assert.Equal(t, "Provided", incident.Direction)
+	assert.Equal(t, consumer.Address, incident.Offender)
+	assert.Equal(t, consumer, incident.ConsumerID)
+	assert.Equal(t, invoice, *incident.Invoice)
+	assert.Equal(t, em, *incident.Message)
+}
@@ -0,0 +1,257 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/asdine/storm/v3/q"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mysteriumnetwork/payments/crypto"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/identity"
+)
+
+// PaymentIncidentKind describes what went wrong with the payment.
+type PaymentIncidentKind string
+
+const (
+	// IncidentProviderOvercharge is recorded by consumer when provider invoices more than was consumed.
+	IncidentProviderOvercharge = PaymentIncidentKind("provider_overcharge")
+	// IncidentWrongProvider is recorded by consumer when invoice is issued by another provider.
+	IncidentWrongProvider = PaymentIncidentKind("wrong_provider")
+	// IncidentInvalidPromise is recorded by provider when consumer promises an incorrect amount or channel.
+	IncidentInvalidPromise = PaymentIncidentKind("invalid_promise")
+	// IncidentInvalidExchangeMessage is recorded by provider when exchange message signature is not valid.
+	IncidentInvalidExchangeMessage = PaymentIncidentKind("invalid_exchange_message")
+	// IncidentUnderpayment is recorded by provider when consumer stops paying invoices.
+	IncidentUnderpayment = PaymentIncidentKind("underpayment")
+)
+
+// PaymentIncident is a record of a failed payment between consumer and provider.
+type PaymentIncident struct {
+	ID        int `storm:"id,increment"`
+	Time      time.Time
+	Kind      PaymentIncidentKind `storm:"index"`
+	Direction string
+	SessionID string `storm:"index"`
+	// Offender is a lowercase address of the peer which caused the incident.
+	Offender   string `storm:"index"`
+	ProviderID identity.Identity
+	ConsumerID identity.Identity
+	HermesID   common.Address
+	Error      string
+	Expected   *big.Int
+	Actual     *big.Int
+	Invoice    *crypto.Invoice
+	Message    *crypto.ExchangeMessage
+}
+
+// PaymentIncidentFilter defines fields for filtering payment incidents.
+type PaymentIncidentFilter struct {
+	SessionID *string
+	Offender  *identity.Identity
+	Kind      *PaymentIncidentKind
+	Since     *time.Time
+}
+
+// paymentIncidentUnblock marks the time incidents of the offender were forgiven at.
+type paymentIncidentUnblock struct {
+	Offender string `storm:"id"`
+	Time     time.Time
+}
+
+// PaymentIncidentStorage persists payment incidents.
+type PaymentIncidentStorage struct {
+	bolt *boltdb.Bolt
+}
+
+// NewPaymentIncidentStorage returns a new instance of the PaymentIncidentStorage.
+func NewPaymentIncidentStorage(bolt *boltdb.Bolt) *PaymentIncidentStorage {
+	return &PaymentIncidentStorage{
+		bolt: bolt,
+	}
+}
+
+const (
+	paymentIncidentBucket        = "payment-incidents"
+	paymentIncidentUnblockBucket = "payment-incident-unblocks"
+)
+
+// Store stores a given incident.
+func (pis *PaymentIncidentStorage) Store(incident PaymentIncident) error {
+	incident.Offender = strings.ToLower(incident.Offender)
+	return pis.bolt.DB().From(paymentIncidentBucket).Save(&incident)
+}
+
+// List retrieves stored incidents, latest first.
+func (pis *PaymentIncidentStorage) List(filter PaymentIncidentFilter) (result []PaymentIncident, err error) {
+	err = pis.bolt.DB().
+		From(paymentIncidentBucket).
+		Select(filter.matchers()...).
+		OrderBy("ID").
+		Reverse().
+		Find(&result)
+	if errors.Is(err, storm.ErrNotFound) {
+		return []PaymentIncident{}, nil
+	}
+
+	return result, err
+}
+
+// Count returns the number of incidents caused by the given peer.
+func (pis *PaymentIncidentStorage) Count(offender identity.Identity) (int, error) {
+	return pis.bolt.DB().
+		From(paymentIncidentBucket).
+		Select(q.Eq("Offender", strings.ToLower(offender.Address))).
+		Count(&PaymentIncident{})
+}
+
+// Unblock forgives all incidents caused by the given peer until now, so they no longer count towards blocking.
+func (pis *PaymentIncidentStorage) Unblock(offender identity.Identity) error {
+	return pis.bolt.DB().From(paymentIncidentUnblockBucket).Save(&paymentIncidentUnblock{
+		Offender: strings.ToLower(offender.Address),
+		Time:     time.Now().UTC(),
+	})
+}
+
+// UnblockedAt returns the time the given peer was last unblocked at, zero time if never.
+func (pis *PaymentIncidentStorage) UnblockedAt(offender identity.Identity) (time.Time, error) {
+	var unblock paymentIncidentUnblock
+	err := pis.bolt.DB().From(paymentIncidentUnblockBucket).One("Offender", strings.ToLower(offender.Address), &unblock)
+	if errors.Is(err, storm.ErrNotFound) {
+		return time.Time{}, nil
+	}
+	return unblock.Time, err
+}
+
+func (f PaymentIncidentFilter) matchers() []q.Matcher {
+	where := make([]q.Matcher, 0)
+	if f.SessionID != nil {
+		where = append(where, q.Eq("SessionID", *f.SessionID))
+	}
+	if f.Offender != nil {
+		where = append(where, q.Eq("Offender", strings.ToLower(f.Offender.Address)))
+	}
+	if f.Kind != nil {
+		where = append(where, q.Eq("Kind", *f.Kind))
+	}
+	if f.Since != nil {
+		where = append(where, q.Gte("Time", *f.Since))
+	}
+	return where
+}
+
+// weight returns how much the incident counts towards blocking the offender.
+// Exchange message timeouts are often caused by network issues rather than the peer, so they weigh less.
+func (incident PaymentIncident) weight() float64 {
+	if incident.Error == ErrExchangeWaitTimeout.Error() {
+		return timeoutIncidentWeight
+	}
+	return 1
+}
+
+const timeoutIncidentWeight = 0.25
+
+type paymentIncidentStorage interface {
+	Store(incident PaymentIncident) error
+	List(filter PaymentIncidentFilter) ([]PaymentIncident, error)
+	UnblockedAt(offender identity.Identity) (time.Time, error)
+}
+
+// PaymentIncidentRecorder records payment incidents and tells which peers caused too many of them.
+type PaymentIncidentRecorder struct {
+	storage        paymentIncidentStorage
+	blockThreshold int
+	blockWindow    time.Duration
+	now            func() time.Time
+}
+
+// NewPaymentIncidentRecorder returns a new instance of the PaymentIncidentRecorder.
+// Peers are blocked once incidents they caused during blockWindow add up to blockThreshold, zero disables blocking.
+// Older incidents count less and stop counting once they fall out of the window.
+func NewPaymentIncidentRecorder(storage paymentIncidentStorage, blockThreshold int, blockWindow time.Duration) *PaymentIncidentRecorder {
+	return &PaymentIncidentRecorder{
+		storage:        storage,
+		blockThreshold: blockThreshold,
+		blockWindow:    blockWindow,
+		now:            time.Now,
+	}
+}
+
+// Record stores the incident, storage failures are only logged as incidents are informational.
+func (pir *PaymentIncidentRecorder) Record(incident PaymentIncident) {
+	if incident.Time.IsZero() {
+		incident.Time = time.Now().UTC()
+	}
+	log.Warn().Msgf("Payment incident %q in session %s caused by %s: %s", incident.Kind, incident.SessionID, incident.Offender, incident.Error)
+
+	if err := pir.storage.Store(incident); err != nil {
+		log.Error().Err(err).Msg("Failed to store payment incident")
+	}
+}
+
+// IsIdentityBlocked returns true if the given identity caused too many payment incidents recently.
+func (pir *PaymentIncidentRecorder) IsIdentityBlocked(id identity.Identity) bool {
+	if pir.blockThreshold <= 0 || pir.blockWindow <= 0 {
+		return false
+	}
+
+	now := pir.now().UTC()
+	since := now.Add(-pir.blockWindow)
+	unblockedAt, err := pir.storage.UnblockedAt(id)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get payment incident unblock time")
+		return false
+	}
+	if unblockedAt.After(since) {
+		since = unblockedAt
+	}
+
+	incidents, err := pir.storage.List(PaymentIncidentFilter{Offender: &id, Since: &since})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list payment incidents")
+		return false
+	}
+	return pir.score(incidents, now) >= float64(pir.blockThreshold)
+}
+
+// score sums incident weights. Incidents count fully during the first half of the block window
+// and decay linearly to zero over the second half.
+func (pir *PaymentIncidentRecorder) score(incidents []PaymentIncident, now time.Time) float64 {
+	half := pir.blockWindow / 2
+	var score float64
+	for _, incident := range incidents {
+		age := now.Sub(incident.Time)
+		if age >= pir.blockWindow {
+			continue
+		}
+		decay := 1.0
+		if age > half {
+			decay = float64(pir.blockWindow-age) / float64(pir.blockWindow-half)
+		}
+		score += incident.weight() * decay
+	}
+	return score
+}
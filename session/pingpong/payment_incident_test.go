@@ -0,0 +1,172 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/identity"
+)
+
+func TestPaymentIncidentStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "paymentIncidentTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bolt, err := boltdb.NewStorage(dir)
+	assert.NoError(t, err)
+	defer bolt.Close()
+
+	storage := NewPaymentIncidentStorage(bolt)
+	offender := identity.FromAddress("0xAbC")
+
+	incidents, err := storage.List(PaymentIncidentFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, incidents, 0)
+
+	assert.NoError(t, storage.Store(PaymentIncident{Kind: IncidentProviderOvercharge, SessionID: "s1", Offender: "0xAbC", Expected: big.NewInt(10), Actual: big.NewInt(20)}))
+	assert.NoError(t, storage.Store(PaymentIncident{Kind: IncidentUnderpayment, SessionID: "s2", Offender: "0x2"}))
+	assert.NoError(t, storage.Store(PaymentIncident{Kind: IncidentWrongProvider, SessionID: "s1", Offender: "0xabc"}))
+
+	incidents, err = storage.List(PaymentIncidentFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, incidents, 3)
+	assert.Equal(t, 3, incidents[0].ID)
+
+	incidents, err = storage.List(PaymentIncidentFilter{Offender: &offender})
+	assert.NoError(t, err)
+	assert.Len(t, incidents, 2)
+	assert.Equal(t, "0xabc", incidents[1].Offender)
+	assert.Equal(t, big.NewInt(20), incidents[1].Actual)
+
+	kind := IncidentUnderpayment
+	incidents, err = storage.List(PaymentIncidentFilter{Kind: &kind})
+	assert.NoError(t, err)
+	assert.Len(t, incidents, 1)
+	assert.Equal(t, "s2", incidents[0].SessionID)
+
+	count, err := storage.Count(offender)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	t.Run("recorder blocks repeat offenders", func(t *testing.T) {
+		assert.False(t, NewPaymentIncidentRecorder(storage, 0, time.Hour).IsIdentityBlocked(offender))
+
+		recorder := NewPaymentIncidentRecorder(storage, 3, time.Hour)
+		assert.False(t, recorder.IsIdentityBlocked(offender))
+
+		for i := 0; i < 3; i++ {
+			recorder.Record(PaymentIncident{Kind: IncidentInvalidPromise, Offender: offender.Address})
+		}
+		assert.True(t, recorder.IsIdentityBlocked(offender))
+		assert.False(t, recorder.IsIdentityBlocked(identity.FromAddress("0x2")))
+
+		incidents, err := storage.List(PaymentIncidentFilter{})
+		assert.NoError(t, err)
+		assert.False(t, incidents[0].Time.IsZero())
+
+		assert.NoError(t, storage.Unblock(offender))
+		assert.False(t, recorder.IsIdentityBlocked(offender))
+
+		recorder.Record(PaymentIncident{Kind: IncidentInvalidPromise, Offender: offender.Address})
+		assert.False(t, recorder.IsIdentityBlocked(offender))
+	})
+}
+
+type mockPaymentIncidentStorage struct {
+	incidents   []PaymentIncident
+	unblockedAt time.Time
+}
+
+func (m *mockPaymentIncidentStorage) Store(incident PaymentIncident) error {
+	m.incidents = append(m.incidents, incident)
+	return nil
+}
+
+func (m *mockPaymentIncidentStorage) List(filter PaymentIncidentFilter) ([]PaymentIncident, error) {
+	var res []PaymentIncident
+	for _, incident := range m.incidents {
+		if filter.Since == nil || !incident.Time.Before(*filter.Since) {
+			res = append(res, incident)
+		}
+	}
+	return res, nil
+}
+
+func (m *mockPaymentIncidentStorage) UnblockedAt(_ identity.Identity) (time.Time, error) {
+	return m.unblockedAt, nil
+}
+
+func TestPaymentIncidentRecorder_IsIdentityBlocked(t *testing.T) {
+	now := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	offender := identity.FromAddress("0x1")
+	incident := func(age time.Duration, err error) PaymentIncident {
+		return PaymentIncident{Kind: IncidentUnderpayment, Offender: offender.Address, Time: now.Add(-age), Error: err.Error()}
+	}
+
+	tests := []struct {
+		name      string
+		incidents []PaymentIncident
+		blocked   bool
+	}{
+		{
+			name: "recent incidents count fully",
+			incidents: []PaymentIncident{
+				incident(time.Minute, ErrConsumerPromiseValidationFailed), incident(time.Hour, ErrConsumerPromiseValidationFailed),
+			},
+			blocked: true,
+		},
+		{
+			name: "incidents outside of window do not count",
+			incidents: []PaymentIncident{
+				incident(time.Minute, ErrConsumerPromiseValidationFailed), incident(25*time.Hour, ErrConsumerPromiseValidationFailed),
+			},
+			blocked: false,
+		},
+		{
+			name: "old incidents decay",
+			incidents: []PaymentIncident{
+				incident(time.Minute, ErrConsumerPromiseValidationFailed), incident(18*time.Hour, ErrConsumerPromiseValidationFailed),
+			},
+			blocked: false,
+		},
+		{
+			name: "exchange message timeouts weigh less",
+			incidents: []PaymentIncident{
+				incident(time.Minute, ErrExchangeWaitTimeout), incident(time.Minute, ErrExchangeWaitTimeout),
+				incident(time.Minute, ErrExchangeWaitTimeout), incident(time.Minute, ErrExchangeWaitTimeout),
+			},
+			blocked: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := NewPaymentIncidentRecorder(&mockPaymentIncidentStorage{incidents: tc.incidents}, 2, 24*time.Hour)
+			recorder.now = func() time.Time { return now }
+			assert.Equal(t, tc.blocked, recorder.IsIdentityBlocked(offender))
+		})
+	}
+}
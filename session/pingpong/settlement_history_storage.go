@@ -54,6 +54,7 @@ type SettlementHistoryEntry struct {
 	Amount         *big.Int
 	TotalSettled   *big.Int
 	Fees           *big.Int
+	Trigger        SettlementTrigger
 }
 
 const settlementHistoryBucket = "settlement-history"
@@ -81,10 +82,10 @@ func (shs *SettlementHistoryStorage) List(filter SettlementHistoryFilter) (resul
 		where = append(where, q.Lte("Time", filter.TimeTo.UTC()))
 	}
 	if filter.ProviderID != nil {
-		where = append(where, q.Eq("ProviderID", filter.ProviderID))
+		where = append(where, q.Eq("ProviderID", *filter.ProviderID))
 	}
 	if filter.HermesID != nil {
-		where = append(where, q.Eq("HermesID", filter.HermesID))
+		where = append(where, q.Eq("HermesID", *filter.HermesID))
 	}
 
 	sq := shs.bolt.DB().
@@ -93,4 +93,27 @@ func TestSettlementHistoryStorage(t *testing.T) {
 		assert.Len(t, entries, 2)
 		assert.EqualValues(t, []SettlementHistoryEntry{entry2, entry1}, entries)
 	})
+
+	t.Run("Filters by provider and hermes", func(t *testing.T) {
+		otherProvider := identity.FromAddress("0x1")
+		err := storage.Store(SettlementHistoryEntry{
+			TxHash:     common.BigToHash(big.NewInt(3)),
+			ProviderID: otherProvider,
+			HermesID:   common.HexToAddress("0x2"),
+			Time:       time.Date(2020, 1, 1, 3, 0, 0, 0, time.UTC),
+		})
+		assert.NoError(t, err)
+
+		entries, err := storage.List(SettlementHistoryFilter{ProviderID: &providerID})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []SettlementHistoryEntry{entry2, entry1}, entries)
+
+		entries, err = storage.List(SettlementHistoryFilter{HermesID: &hermesAddress})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []SettlementHistoryEntry{entry2, entry1}, entries)
+
+		entries, err = storage.List(SettlementHistoryFilter{ProviderID: &otherProvider})
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
 }
@@ -0,0 +1,202 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/identity"
+)
+
+// SettlementTrigger describes what caused the settlement.
+type SettlementTrigger string
+
+const (
+	// SettlementTriggerManual is a settlement requested by the user.
+	SettlementTriggerManual = SettlementTrigger("manual")
+	// SettlementTriggerThreshold is a settlement caused by channel balance running low.
+	SettlementTriggerThreshold = SettlementTrigger("threshold")
+	// SettlementTriggerAmount is a settlement caused by unsettled earnings reaching policy minimum.
+	SettlementTriggerAmount = SettlementTrigger("amount")
+	// SettlementTriggerSchedule is a settlement caused by policy schedule.
+	SettlementTriggerSchedule = SettlementTrigger("schedule")
+)
+
+// feeLimited checks if settlement caused by this trigger respects policy fee ceiling.
+func (t SettlementTrigger) feeLimited() bool {
+	return t != SettlementTriggerThreshold
+}
+
+// SettlementSchedule describes how often scheduled settlement happens.
+type SettlementSchedule string
+
+const (
+	// SettlementScheduleNone disables scheduled settlement.
+	SettlementScheduleNone = SettlementSchedule("")
+	// SettlementScheduleDaily settles once a day.
+	SettlementScheduleDaily = SettlementSchedule("daily")
+	// SettlementScheduleWeekly settles once a week.
+	SettlementScheduleWeekly = SettlementSchedule("weekly")
+)
+
+// Period returns time between scheduled settlements.
+func (s SettlementSchedule) Period() time.Duration {
+	switch s {
+	case SettlementScheduleDaily:
+		return 24 * time.Hour
+	case SettlementScheduleWeekly:
+		return 7 * 24 * time.Hour
+	}
+	return 0
+}
+
+// SettlementPolicy describes when and how provider earnings are settled automatically.
+type SettlementPolicy struct {
+	// ID is a lowercase provider identity address.
+	ID string `storm:"id"`
+	// Schedule adds settlement on a fixed schedule, threshold based settlement still happens
+	// whenever channel balance runs low.
+	Schedule SettlementSchedule
+	// MinAmount is the amount of unsettled earnings which is worth settling.
+	MinAmount *big.Int
+	// MaxFee is the highest transactor fee scheduled or amount triggered settlement is allowed to pay.
+	// Threshold settlement ignores it, as postponing it could exhaust channel balance.
+	MaxFee *big.Int
+	// Beneficiary, when set, receives settled earnings directly.
+	Beneficiary common.Address
+	// LastSettledAt is the time of last successful settlement.
+	LastSettledAt time.Time
+}
+
+// Validate checks if policy values are sane.
+func (p SettlementPolicy) Validate() error {
+	switch p.Schedule {
+	case SettlementScheduleNone, SettlementScheduleDaily, SettlementScheduleWeekly:
+	default:
+		return fmt.Errorf("unknown settlement schedule %q", p.Schedule)
+	}
+	if p.MinAmount != nil && p.MinAmount.Sign() < 0 {
+		return errors.New("minimum settlement amount can't be negative")
+	}
+	if p.MaxFee != nil && p.MaxFee.Sign() < 0 {
+		return errors.New("maximum settlement fee can't be negative")
+	}
+	return nil
+}
+
+// amountReached checks if unsettled earnings reached policy minimum.
+func (p SettlementPolicy) amountReached(unsettled *big.Int) bool {
+	if p.MinAmount == nil || p.MinAmount.Sign() == 0 {
+		return unsettled.Sign() > 0
+	}
+	return unsettled.Cmp(p.MinAmount) >= 0
+}
+
+// scheduleDue checks if scheduled settlement is due at the given time.
+func (p SettlementPolicy) scheduleDue(now time.Time) bool {
+	period := p.Schedule.Period()
+	if period == 0 {
+		return false
+	}
+	return !now.Before(p.LastSettledAt.Add(period))
+}
+
+// feeAcceptable checks if transactor fee does not exceed policy ceiling.
+func (p SettlementPolicy) feeAcceptable(fee *big.Int) bool {
+	if p.MaxFee == nil || fee == nil {
+		return true
+	}
+	return fee.Cmp(p.MaxFee) <= 0
+}
+
+// hasBeneficiary checks if earnings should be settled straight to beneficiary.
+func (p SettlementPolicy) hasBeneficiary() bool {
+	return p.Beneficiary != common.Address{}
+}
+
+// SettlementPolicyStorage stores settlement policies per provider identity.
+type SettlementPolicyStorage struct {
+	bolt *boltdb.Bolt
+}
+
+// NewSettlementPolicyStorage returns a new instance of the SettlementPolicyStorage.
+func NewSettlementPolicyStorage(bolt *boltdb.Bolt) *SettlementPolicyStorage {
+	return &SettlementPolicyStorage{
+		bolt: bolt,
+	}
+}
+
+const settlementPolicyBucket = "settlement-policies"
+
+func policyID(id identity.Identity) string {
+	return strings.ToLower(id.Address)
+}
+
+// Get returns settlement policy of the given identity.
+func (sps *SettlementPolicyStorage) Get(id identity.Identity) (SettlementPolicy, error) {
+	var policy SettlementPolicy
+	err := sps.bolt.DB().From(settlementPolicyBucket).One("ID", policyID(id), &policy)
+	if errors.Is(err, storm.ErrNotFound) {
+		return policy, ErrNotFound
+	}
+	return policy, err
+}
+
+// Store saves settlement policy of the given identity, keeping the last settlement time.
+func (sps *SettlementPolicyStorage) Store(id identity.Identity, policy SettlementPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	existing, err := sps.Get(id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	policy.ID = policyID(id)
+	policy.LastSettledAt = existing.LastSettledAt
+	return sps.bolt.DB().From(settlementPolicyBucket).Save(&policy)
+}
+
+// Delete removes settlement policy of the given identity.
+func (sps *SettlementPolicyStorage) Delete(id identity.Identity) error {
+	err := sps.bolt.DB().From(settlementPolicyBucket).DeleteStruct(&SettlementPolicy{ID: policyID(id)})
+	if errors.Is(err, storm.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// MarkSettled records the time of successful settlement for the identity with a policy.
+func (sps *SettlementPolicyStorage) MarkSettled(id identity.Identity, at time.Time) error {
+	policy, err := sps.Get(id)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	policy.LastSettledAt = at.UTC()
+	return sps.bolt.DB().From(settlementPolicyBucket).Save(&policy)
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettlementPolicyStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "settlementPolicyTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bolt, err := boltdb.NewStorage(dir)
+	assert.NoError(t, err)
+	defer bolt.Close()
+
+	storage := NewSettlementPolicyStorage(bolt)
+	providerID := identity.FromAddress("0x79bb2a1c5E0075005F084a66A44D5e930A88eC86")
+
+	_, err = storage.Get(providerID)
+	assert.Equal(t, ErrNotFound, err)
+
+	// marking without policy is a noop
+	assert.NoError(t, storage.MarkSettled(providerID, time.Now()))
+	_, err = storage.Get(providerID)
+	assert.Equal(t, ErrNotFound, err)
+
+	err = storage.Store(providerID, SettlementPolicy{Schedule: "hourly"})
+	assert.Error(t, err)
+
+	policy := SettlementPolicy{
+		Schedule:    SettlementScheduleWeekly,
+		MinAmount:   big.NewInt(100),
+		MaxFee:      big.NewInt(10),
+		Beneficiary: common.HexToAddress("0x4443189b9b945DD38E7bfB6167F9909451582eE5"),
+	}
+	assert.NoError(t, storage.Store(providerID, policy))
+
+	settledAt := time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+	assert.NoError(t, storage.MarkSettled(providerID, settledAt))
+
+	// updating policy keeps last settlement time
+	policy.Schedule = SettlementScheduleDaily
+	assert.NoError(t, storage.Store(providerID, policy))
+
+	stored, err := storage.Get(identity.FromAddress("0x79BB2A1C5E0075005F084A66A44D5E930A88EC86"))
+	assert.NoError(t, err)
+	assert.Equal(t, SettlementScheduleDaily, stored.Schedule)
+	assert.Equal(t, big.NewInt(100), stored.MinAmount)
+	assert.Equal(t, big.NewInt(10), stored.MaxFee)
+	assert.Equal(t, policy.Beneficiary, stored.Beneficiary)
+	assert.True(t, settledAt.Equal(stored.LastSettledAt))
+
+	assert.NoError(t, storage.Delete(providerID))
+	_, err = storage.Get(providerID)
+	assert.Equal(t, ErrNotFound, err)
+	assert.NoError(t, storage.Delete(providerID))
+}
@@ -0,0 +1,309 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/connection"
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/eventbus"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong/event"
+)
+
+// SpendingPeriod is a period consumer spending limit applies to.
+type SpendingPeriod string
+
+const (
+	// SpendingPeriodSession limits spending of a single session.
+	SpendingPeriodSession = SpendingPeriod("session")
+	// SpendingPeriodDay limits spending of a calendar day in UTC.
+	SpendingPeriodDay = SpendingPeriod("day")
+	// SpendingPeriodMonth limits spending of a calendar month in UTC.
+	SpendingPeriodMonth = SpendingPeriod("month")
+)
+
+// spendingAlertThresholds are the shares of limit in percents which are reported once crossed.
+var spendingAlertThresholds = []int{50, 80, 100}
+
+// SpendingLimits holds consumer spending limits, nil limit means unlimited.
+type SpendingLimits struct {
+	// ID is a lowercase consumer identity address.
+	ID         string `storm:"id"`
+	PerSession *big.Int
+	PerDay     *big.Int
+	PerMonth   *big.Int
+}
+
+// Validate checks if limit values are sane.
+func (l SpendingLimits) Validate() error {
+	for _, limit := range []*big.Int{l.PerSession, l.PerDay, l.PerMonth} {
+		if limit != nil && limit.Sign() < 0 {
+			return errors.New("spending limit can't be negative")
+		}
+	}
+	return nil
+}
+
+func (l SpendingLimits) limit(period SpendingPeriod) *big.Int {
+	switch period {
+	case SpendingPeriodSession:
+		return l.PerSession
+	case SpendingPeriodDay:
+		return l.PerDay
+	case SpendingPeriodMonth:
+		return l.PerMonth
+	}
+	return nil
+}
+
+// spendingRecord is amount spent by consumer during a single day or month.
+type spendingRecord struct {
+	ID     string `storm:"id"`
+	Amount *big.Int
+}
+
+// SpendingLimitStorage stores consumer spending limits and amounts spent per day and month.
+type SpendingLimitStorage struct {
+	bolt *boltdb.Bolt
+}
+
+// NewSpendingLimitStorage returns a new instance of the SpendingLimitStorage.
+func NewSpendingLimitStorage(bolt *boltdb.Bolt) *SpendingLimitStorage {
+	return &SpendingLimitStorage{
+		bolt: bolt,
+	}
+}
+
+const (
+	spendingLimitsBucket = "consumer-spending-limits"
+	spendingBucket       = "consumer-spending"
+)
+
+func spendingLimitsID(id identity.Identity) string {
+	return strings.ToLower(id.Address)
+}
+
+func spendingRecordID(id identity.Identity, period SpendingPeriod, at time.Time) string {
+	at = at.UTC()
+	key := at.Format("2006-01")
+	if period == SpendingPeriodDay {
+		key = at.Format("2006-01-02")
+	}
+	return spendingLimitsID(id) + "|" + string(period) + "|" + key
+}
+
+// Get returns spending limits of the given identity.
+func (sls *SpendingLimitStorage) Get(id identity.Identity) (SpendingLimits, error) {
+	var limits SpendingLimits
+	err := sls.bolt.DB().From(spendingLimitsBucket).One("ID", spendingLimitsID(id), &limits)
+	if errors.Is(err, storm.ErrNotFound) {
+		return limits, ErrNotFound
+	}
+	return limits, err
+}
+
+// Store saves spending limits of the given identity.
+func (sls *SpendingLimitStorage) Store(id identity.Identity, limits SpendingLimits) error {
+	if err := limits.Validate(); err != nil {
+		return err
+	}
+	limits.ID = spendingLimitsID(id)
+	return sls.bolt.DB().From(spendingLimitsBucket).Save(&limits)
+}
+
+// Delete removes spending limits of the given identity.
+func (sls *SpendingLimitStorage) Delete(id identity.Identity) error {
+	err := sls.bolt.DB().From(spendingLimitsBucket).DeleteStruct(&SpendingLimits{ID: spendingLimitsID(id)})
+	if errors.Is(err, storm.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// Spent returns amount spent by the identity during the day or month of the given time.
+func (sls *SpendingLimitStorage) Spent(id identity.Identity, period SpendingPeriod, at time.Time) (*big.Int, error) {
+	var record spendingRecord
+	err := sls.bolt.DB().From(spendingBucket).One("ID", spendingRecordID(id, period, at), &record)
+	if errors.Is(err, storm.ErrNotFound) || record.Amount == nil {
+		return new(big.Int), nil
+	}
+	return record.Amount, err
+}
+
+// AddSpent adds amount to the day and month spending of the identity.
+// Records older than the previous day and month are pruned, previous ones are kept for late refunds.
+func (sls *SpendingLimitStorage) AddSpent(id identity.Identity, amount *big.Int, at time.Time) error {
+	for _, period := range []SpendingPeriod{SpendingPeriodDay, SpendingPeriodMonth} {
+		spent, err := sls.Spent(id, period, at)
+		if err != nil {
+			return err
+		}
+		record := spendingRecord{
+			ID:     spendingRecordID(id, period, at),
+			Amount: new(big.Int).Add(spent, amount),
+		}
+		if err := sls.bolt.DB().From(spendingBucket).Save(&record); err != nil {
+			return err
+		}
+	}
+	return sls.pruneSpent(id, at)
+}
+
+func (sls *SpendingLimitStorage) pruneSpent(id identity.Identity, at time.Time) error {
+	at = at.UTC()
+	oldest := map[SpendingPeriod]string{
+		SpendingPeriodDay:   spendingRecordID(id, SpendingPeriodDay, at.AddDate(0, 0, -1)),
+		SpendingPeriodMonth: spendingRecordID(id, SpendingPeriodMonth, time.Date(at.Year(), at.Month()-1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	var records []spendingRecord
+	err := sls.bolt.DB().From(spendingBucket).Prefix("ID", spendingLimitsID(id)+"|", &records)
+	if errors.Is(err, storm.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		for period, oldestID := range oldest {
+			// Keys of the same period are formatted dates, so they sort chronologically.
+			if strings.HasPrefix(record.ID, spendingLimitsID(id)+"|"+string(period)+"|") && record.ID < oldestID {
+				if err := sls.bolt.DB().From(spendingBucket).DeleteStruct(&spendingRecord{ID: record.ID}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type spendingLimitStorage interface {
+	Get(id identity.Identity) (SpendingLimits, error)
+	Spent(id identity.Identity, period SpendingPeriod, at time.Time) (*big.Int, error)
+	AddSpent(id identity.Identity, amount *big.Int, at time.Time) error
+}
+
+// SpendingGuard enforces consumer spending limits and alerts when spending approaches them.
+type SpendingGuard struct {
+	storage   spendingLimitStorage
+	publisher eventbus.Publisher
+	now       func() time.Time
+	lock      sync.Mutex
+}
+
+// NewSpendingGuard returns a new instance of the SpendingGuard.
+func NewSpendingGuard(storage spendingLimitStorage, publisher eventbus.Publisher) *SpendingGuard {
+	return &SpendingGuard{
+		storage:   storage,
+		publisher: publisher,
+		now:       time.Now,
+	}
+}
+
+// Pay checks whether consumer is allowed to pay the given amount and records spending if it is.
+// sessionSpent is the amount already paid during the session. Returns connection.ErrSpendingLimitReached
+// if paying would exceed any of the limits. Recorded spending must be rolled back with Refund
+// using the returned payment time if the payment does not reach the provider.
+func (sg *SpendingGuard) Pay(consumerID identity.Identity, sessionID string, sessionSpent, amount *big.Int) (time.Time, error) {
+	sg.lock.Lock()
+	defer sg.lock.Unlock()
+
+	now := sg.now()
+	limits, err := sg.storage.Get(consumerID)
+	if errors.Is(err, ErrNotFound) {
+		return now, sg.storage.AddSpent(consumerID, amount, now)
+	}
+	if err != nil {
+		return now, err
+	}
+
+	spent := map[SpendingPeriod]*big.Int{SpendingPeriodSession: sessionSpent}
+	for _, period := range []SpendingPeriod{SpendingPeriodDay, SpendingPeriodMonth} {
+		if spent[period], err = sg.storage.Spent(consumerID, period, now); err != nil {
+			return now, err
+		}
+	}
+
+	for _, period := range []SpendingPeriod{SpendingPeriodSession, SpendingPeriodDay, SpendingPeriodMonth} {
+		limit := limits.limit(period)
+		if limit == nil {
+			continue
+		}
+		if new(big.Int).Add(spent[period], amount).Cmp(limit) > 0 {
+			log.Warn().Msgf("Consumer %s %s spending limit %v reached, already spent %v", consumerID.Address, period, limit, spent[period])
+			sg.alert(consumerID, sessionID, period, 100, spent[period], limit)
+			return now, connection.ErrSpendingLimitReached
+		}
+	}
+
+	if err := sg.storage.AddSpent(consumerID, amount, now); err != nil {
+		return now, err
+	}
+
+	for _, period := range []SpendingPeriod{SpendingPeriodSession, SpendingPeriodDay, SpendingPeriodMonth} {
+		before := spent[period]
+		limit := limits.limit(period)
+		if limit == nil || limit.Sign() == 0 {
+			continue
+		}
+		after := new(big.Int).Add(before, amount)
+		for _, threshold := range spendingAlertThresholds {
+			if crossed(before, after, limit, threshold) {
+				sg.alert(consumerID, sessionID, period, threshold, after, limit)
+			}
+		}
+	}
+	return now, nil
+}
+
+// Refund rolls back spending recorded by Pay at paidAt for a payment which was not delivered.
+// Spending is rolled back in the day and month it was recorded in, even if those have already ended.
+func (sg *SpendingGuard) Refund(consumerID identity.Identity, amount *big.Int, paidAt time.Time) error {
+	sg.lock.Lock()
+	defer sg.lock.Unlock()
+
+	return sg.storage.AddSpent(consumerID, new(big.Int).Neg(amount), paidAt)
+}
+
+func (sg *SpendingGuard) alert(consumerID identity.Identity, sessionID string, period SpendingPeriod, threshold int, spent, limit *big.Int) {
+	sg.publisher.Publish(event.AppTopicSpendingLimit, event.AppEventSpendingLimit{
+		ConsumerID: consumerID,
+		SessionID:  sessionID,
+		Period:     string(period),
+		Threshold:  threshold,
+		Spent:      new(big.Int).Set(spent),
+		Limit:      new(big.Int).Set(limit),
+	})
+}
+
+// crossed checks if spending went over threshold percent of the limit.
+func crossed(before, after, limit *big.Int, threshold int) bool {
+	mark := new(big.Int).Mul(limit, big.NewInt(int64(threshold)))
+	mark.Div(mark, big.NewInt(100))
+	return before.Cmp(mark) < 0 && after.Cmp(mark) >= 0
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pingpong
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mysteriumnetwork/node/core/connection"
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpendingLimitStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spendingLimitsTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bolt, err := boltdb.NewStorage(dir)
+	assert.NoError(t, err)
+	defer bolt.Close()
+
+	storage := NewSpendingLimitStorage(bolt)
+	consumerID := identity.FromAddress("0x79bb2a1c5E0075005F084a66A44D5e930A88eC86")
+
+	_, err = storage.Get(consumerID)
+	assert.Equal(t, ErrNotFound, err)
+
+	assert.Error(t, storage.Store(consumerID, SpendingLimits{PerDay: big.NewInt(-1)}))
+	assert.NoError(t, storage.Store(consumerID, SpendingLimits{PerDay: big.NewInt(100)}))
+	limits, err := storage.Get(consumerID)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), limits.PerDay)
+	assert.Nil(t, limits.PerMonth)
+
+	day1 := time.Date(2020, 1, 31, 23, 0, 0, 0, time.UTC)
+	day2 := time.Date(2020, 2, 1, 1, 0, 0, 0, time.UTC)
+	assert.NoError(t, storage.AddSpent(consumerID, big.NewInt(10), day1))
+	assert.NoError(t, storage.AddSpent(consumerID, big.NewInt(5), day1))
+	assert.NoError(t, storage.AddSpent(consumerID, big.NewInt(7), day2))
+
+	spent, err := storage.Spent(consumerID, SpendingPeriodDay, day1)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(15), spent)
+	spent, err = storage.Spent(consumerID, SpendingPeriodMonth, day1)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(15), spent)
+	spent, err = storage.Spent(consumerID, SpendingPeriodMonth, day2)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(7), spent)
+
+	// only records of the previous and current day and month are kept
+	day3 := time.Date(2020, 3, 2, 1, 0, 0, 0, time.UTC)
+	assert.NoError(t, storage.AddSpent(consumerID, big.NewInt(1), day3))
+	var records []spendingRecord
+	assert.NoError(t, bolt.GetAllFrom(spendingBucket, &records))
+	var ids []string
+	for _, r := range records {
+		ids = append(ids, r.ID)
+	}
+	assert.ElementsMatch(t, []string{
+		spendingRecordID(consumerID, SpendingPeriodDay, day3),
+		spendingRecordID(consumerID, SpendingPeriodMonth, day2),
+		spendingRecordID(consumerID, SpendingPeriodMonth, day3),
+	}, ids)
+
+	assert.NoError(t, storage.Delete(consumerID))
+	_, err = storage.Get(consumerID)
+	assert.Equal(t, ErrNotFound, err)
+}
+
+type mockSpendingLimitStorage struct {
+	limits  *SpendingLimits
+	spent   map[SpendingPeriod]*big.Int
+	spentAt []time.Time
+}
+
+func (m *mockSpendingLimitStorage) Get(_ identity.Identity) (SpendingLimits, error) {
+	if m.limits == nil {
+		return SpendingLimits{}, ErrNotFound
+	}
+	return *m.limits, nil
+}
+
+func (m *mockSpendingLimitStorage) Spent(_ identity.Identity, period SpendingPeriod, _ time.Time) (*big.Int, error) {
+	if v, ok := m.spent[period]; ok {
+		return v, nil
+	}
+	return new(big.Int), nil
+}
+
+func (m *mockSpendingLimitStorage) AddSpent(_ identity.Identity, amount *big.Int, at time.Time) error {
+	m.spentAt = append(m.spentAt, at)
+	for _, period := range []SpendingPeriod{SpendingPeriodDay, SpendingPeriodMonth} {
+		m.spent[period] = new(big.Int).Add(m.spent[period], amount)
+	}
+	return nil
+}
+
+func TestSpendingGuard_Pay(t *testing.T) {
+	storage := &mockSpendingLimitStorage{spent: map[SpendingPeriod]*big.Int{
+		SpendingPeriodDay:   big.NewInt(0),
+		SpendingPeriodMonth: big.NewInt(0),
+	}}
+	publisher := &mockPublisher{publicationChan: make(chan testEvent, 10)}
+	guard := NewSpendingGuard(storage, publisher)
+	consumerID := identity.FromAddress("0x1")
+
+	// no limits, spending is still recorded
+	_, err := guard.Pay(consumerID, "s1", big.NewInt(0), big.NewInt(10))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(10), storage.spent[SpendingPeriodDay])
+	assert.Len(t, publisher.publicationChan, 0)
+
+	storage.limits = &SpendingLimits{PerDay: big.NewInt(100), PerSession: big.NewInt(1000)}
+
+	// crossing 50% and 80% at once
+	_, err = guard.Pay(consumerID, "s1", big.NewInt(10), big.NewInt(75))
+	assert.NoError(t, err)
+	ev := <-publisher.publicationChan
+	assert.Equal(t, event.AppTopicSpendingLimit, ev.name)
+	assert.Equal(t, event.AppEventSpendingLimit{
+		ConsumerID: consumerID,
+		SessionID:  "s1",
+		Period:     "day",
+		Threshold:  50,
+		Spent:      big.NewInt(85),
+		Limit:      big.NewInt(100),
+	}, ev.value)
+	ev = <-publisher.publicationChan
+	assert.Equal(t, 80, ev.value.(event.AppEventSpendingLimit).Threshold)
+	assert.Len(t, publisher.publicationChan, 0)
+
+	// paying over the limit is refused
+	_, err = guard.Pay(consumerID, "s1", big.NewInt(85), big.NewInt(16))
+	assert.Equal(t, connection.ErrSpendingLimitReached, err)
+	assert.Equal(t, big.NewInt(85), storage.spent[SpendingPeriodDay])
+	ev = <-publisher.publicationChan
+	assert.Equal(t, 100, ev.value.(event.AppEventSpendingLimit).Threshold)
+
+	// reaching exactly the limit is allowed
+	paidAt := time.Date(2020, 1, 31, 23, 59, 59, 0, time.UTC)
+	guard.now = func() time.Time { return paidAt }
+	at, err := guard.Pay(consumerID, "s1", big.NewInt(85), big.NewInt(15))
+	assert.NoError(t, err)
+	assert.Equal(t, paidAt, at)
+	ev = <-publisher.publicationChan
+	assert.Equal(t, 100, ev.value.(event.AppEventSpendingLimit).Threshold)
+	// undelivered payment is rolled back in the period it was paid in, even after it has ended
+	guard.now = func() time.Time { return paidAt.Add(time.Minute) }
+	assert.NoError(t, guard.Refund(consumerID, big.NewInt(15), at))
+	assert.Equal(t, big.NewInt(85), storage.spent[SpendingPeriodDay])
+	assert.Equal(t, big.NewInt(85), storage.spent[SpendingPeriodMonth])
+	assert.Equal(t, paidAt, storage.spentAt[len(storage.spentAt)-1])
+}
@@ -25,5 +25,6 @@ const (
 	commandWgUp             = "wg-up"
 	commandWgDown           = "wg-down"
 	commandWgStats          = "wg-stats"
+	commandWgRebind         = "wg-rebind"
 	commandTequilapiSetPort = "ta-set-port"
 )
@@ -103,6 +103,14 @@ func (d *Daemon) dialog(conn io.ReadWriter) {
 			} else {
 				answer.ok(stats)
 			}
+		case commandWgRebind:
+			err := d.wgRebind(cmd...)
+			if err != nil {
+				log.Err(err).Msgf("%s failed", commandWgRebind)
+				answer.err(err)
+			} else {
+				answer.ok()
+			}
 		case commandKill:
 			if err := d.killMyst(); err != nil {
 				log.Err(err).Msgf("%s failed", commandKill)
@@ -170,6 +178,21 @@ func (d *Daemon) wgDown(args ...string) (err error) {
 	return nil
 }
 
+func (d *Daemon) wgRebind(args ...string) error {
+	flags := flag.NewFlagSet("", flag.ContinueOnError)
+	interfaceName := flags.String("iface", "", "")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *interfaceName == "" {
+		return errors.New("-iface is required")
+	}
+	if err := d.monitor.Rebind(*interfaceName); err != nil {
+		return fmt.Errorf("could not rebind wg interface %s: %w", *interfaceName, err)
+	}
+	return nil
+}
+
 func (d *Daemon) wgStats(args ...string) (string, error) {
 	flags := flag.NewFlagSet("", flag.ContinueOnError)
 	interfaceName := flags.String("iface", "", "")
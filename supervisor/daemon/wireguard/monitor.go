@@ -90,3 +90,16 @@ func (m *Monitor) Stats(interfaceName string) (*wgcfg.Stats, error) {
 	}
 	return stats, nil
 }
+
+// Rebind requests interface to rebind its UDP socket to the current local address.
+func (m *Monitor) Rebind(interfaceName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	iface, ok := m.interfaces[interfaceName]
+	if !ok {
+		return fmt.Errorf("interface %s not found", interfaceName)
+	}
+
+	return iface.Device.BindUpdate()
+}
@@ -18,16 +18,25 @@
 package client
 
 import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/tequilapi/contract"
-	"github.com/mysteriumnetwork/node/tequilapi/validation"
 )
 
 // NewClient returns a new instance of Client
@@ -40,11 +49,67 @@ func NewClient(ip string, port int) *Client {
 	}
 }
 
+// NewUnixSocketClient returns a new instance of Client talking to Tequilapi over Unix domain socket
+func NewUnixSocketClient(socketPath string) *Client {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socketPath)
+		},
+	}
+	return &Client{
+		http: newHTTPClientWithTransport("http://unix", "goclient-v0.1", transport),
+	}
+}
+
+// NewTLSClient returns a new instance of Client talking to Tequilapi over HTTPS
+func NewTLSClient(ip string, port int, tlsConfig *tls.Config) *Client {
+	transport := &http.Transport{TLSClientConfig: tlsConfig}
+	return &Client{
+		http: newHTTPClientWithTransport(
+			fmt.Sprintf("https://%s:%d", ip, port),
+			"goclient-v0.1",
+			transport,
+		),
+	}
+}
+
+// PinnedTLSConfig returns TLS configuration trusting only the server certificate with given SHA-256 fingerprint.
+// It is meant for Tequilapi serving a self-signed certificate.
+func PinnedTLSConfig(fingerprint string) *tls.Config {
+	expected := strings.ToLower(strings.NewReplacer(":", "", " ", "").Replace(fingerprint))
+	return &tls.Config{
+		// Chain verification is replaced by fingerprint pinning below.
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("server presented no certificate")
+			}
+			sum := sha256.Sum256(rawCerts[0])
+			if hex.EncodeToString(sum[:]) != expected {
+				return errors.New("server certificate fingerprint mismatch")
+			}
+			return nil
+		},
+	}
+}
+
 // Client is able perform remote requests to Tequilapi server
 type Client struct {
 	http httpClientInterface
 }
 
+// WithContext returns a copy of the client, which binds all requests to the given context.
+// Cancelling the context aborts the requests in flight.
+func (client *Client) WithContext(ctx context.Context) *Client {
+	return &Client{http: client.http.WithContext(ctx)}
+}
+
+// SetToken authorizes further requests with the given login JWT or API token.
+func (client *Client) SetToken(token string) {
+	client.http.SetToken(token)
+}
+
 // AuthAuthenticate authenticates user and issues auth token
 func (client *Client) AuthAuthenticate(request contract.AuthRequest) (res contract.AuthResponse, err error) {
 	response, err := client.http.Post("/auth/authenticate", request)
@@ -101,6 +166,159 @@ func (client *Client) AuthChangePassword(request contract.ChangePasswordRequest)
 	return nil
 }
 
+// APITokens lists named API tokens.
+func (client *Client) APITokens() (res contract.APITokenListResponse, err error) {
+	response, err := client.http.Get("/auth/tokens", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// CreateAPIToken creates named API token, returned secret can not be retrieved later.
+func (client *Client) CreateAPIToken(request contract.CreateAPITokenRequest) (res contract.CreateAPITokenResponse, err error) {
+	response, err := client.http.Post("/auth/tokens", request)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// RevokeAPIToken revokes named API token.
+func (client *Client) RevokeAPIToken(id string) error {
+	response, err := client.http.Delete("/auth/tokens/"+id, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("expected 202 got %v", response.StatusCode)
+	}
+	return nil
+}
+
+// Webhooks returns webhooks notified about node events.
+func (client *Client) Webhooks() (res contract.WebhookListResponse, err error) {
+	response, err := client.http.Get("/webhooks", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// CreateWebhook creates webhook, returned signing secret can not be retrieved later.
+func (client *Client) CreateWebhook(request contract.CreateWebhookRequest) (res contract.CreateWebhookResponse, err error) {
+	response, err := client.http.Post("/webhooks", request)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// DeleteWebhook deletes webhook.
+func (client *Client) DeleteWebhook(id string) error {
+	response, err := client.http.Delete("/webhooks/"+id, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("expected 202 got %v", response.StatusCode)
+	}
+	return nil
+}
+
+// TestWebhook sends ping notification to webhook.
+func (client *Client) TestWebhook(id string) (res contract.WebhookDeliveryDTO, err error) {
+	response, err := client.http.Post("/webhooks/"+id+"/test", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// WebhookDeliveries returns most recent notification deliveries to webhook, latest first.
+func (client *Client) WebhookDeliveries(id string) (res contract.WebhookDeliveryListResponse, err error) {
+	response, err := client.http.Get("/webhooks/"+id+"/deliveries", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// AuditLog returns privileged API operations matching the query, latest first.
+func (client *Client) AuditLog(query contract.AuditLogQuery) (res contract.AuditLogDTO, err error) {
+	params := url.Values{}
+	if query.From != nil {
+		params.Set("from", *query.From)
+	}
+	if query.To != nil {
+		params.Set("to", *query.To)
+	}
+	if query.Actor != nil {
+		params.Set("actor", *query.Actor)
+	}
+	if query.Path != nil {
+		params.Set("path", *query.Path)
+	}
+	if query.Limit != nil {
+		params.Set("limit", strconv.Itoa(*query.Limit))
+	}
+
+	response, err := client.http.Get("/audit", params)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// LogLevels returns global log level and its overrides for components.
+func (client *Client) LogLevels() (res contract.LogLevelsDTO, err error) {
+	response, err := client.http.Get("/logs/levels", url.Values{})
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// SetLogLevels changes global log level and overrides it for the given components.
+func (client *Client) SetLogLevels(request contract.LogLevelsRequest) (res contract.LogLevelsDTO, err error) {
+	response, err := client.http.Put("/logs/levels", request)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
 // ImportIdentity sends a request to import a given identity.
 func (client *Client) ImportIdentity(blob []byte, passphrase string, setDefault bool) (id contract.IdentityRefDTO, err error) {
 	response, err := client.http.Post("identities-import", contract.IdentityImportRequest{
@@ -117,6 +335,23 @@ func (client *Client) ImportIdentity(blob []byte, passphrase string, setDefault
 	return id, err
 }
 
+// ExportIdentity exports identity key or, if bundle is set, a backup bundle encrypted with the given passphrase.
+func (client *Client) ExportIdentity(address, currentPassphrase, passphrase string, bundle bool) ([]byte, error) {
+	response, err := client.http.Post("identities/"+address+"/export", contract.IdentityExportRequest{
+		Passphrase:        passphrase,
+		CurrentPassphrase: currentPassphrase,
+		Bundle:            bundle,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var res contract.IdentityExportResponse
+	err = parseResponseJSON(response, &res)
+	return res.Data, err
+}
+
 // GetIdentities returns a list of client identities
 func (client *Client) GetIdentities() (ids []contract.IdentityRefDTO, err error) {
 	response, err := client.http.Get("identities", url.Values{})
@@ -261,6 +496,34 @@ func (client *Client) ConnectionStatistics() (statistics contract.ConnectionStat
 	return statistics, err
 }
 
+// ConnectionSpeedTest runs speed test to the provider of current connection
+func (client *Client) ConnectionSpeedTest() (result contract.SpeedTestResultDTO, err error) {
+	response, err := client.http.Post("connection/speed-test", nil)
+	if err != nil {
+		return result, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &result)
+	return result, err
+}
+
+// SpeedTests returns stored speed test results, optionally filtered by provider
+func (client *Client) SpeedTests(providerID string) (results contract.SpeedTestListResponse, err error) {
+	params := url.Values{}
+	if providerID != "" {
+		params.Add("provider_id", providerID)
+	}
+	response, err := client.http.Get("speed-tests", params)
+	if err != nil {
+		return results, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &results)
+	return results, err
+}
+
 // ConnectionStatus returns connection status
 func (client *Client) ConnectionStatus() (status contract.ConnectionInfoDTO, err error) {
 	response, err := client.http.Get("connection", url.Values{})
@@ -354,6 +617,41 @@ func (client *Client) proposals(query url.Values) ([]contract.ProposalDTO, error
 	return proposals.Proposals, err
 }
 
+// QueryProposals returns a page of proposals matching the query, next pages are fetched by passing returned cursor.
+func (client *Client) QueryProposals(query contract.ProposalQuery) (res contract.ProposalQueryResponse, err error) {
+	params := url.Values{}
+	if query.Filter != "" {
+		params.Set("filter", query.Filter)
+	}
+	if query.SortBy != "" {
+		params.Set("sort_by", query.SortBy)
+	}
+	if query.Order != "" {
+		params.Set("order", query.Order)
+	}
+	if query.Fields != "" {
+		params.Set("fields", query.Fields)
+	}
+	if query.Limit > 0 {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+	if query.Cursor != "" {
+		params.Set("cursor", query.Cursor)
+	}
+	if query.MonitoringFailed {
+		params.Set("monitoring_failed", "true")
+	}
+
+	response, err := client.http.Get("proposals/query", params)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
 // ProposalsByPrice returns all available proposals within the given price range
 func (client *Client) ProposalsByPrice(lowerTime, upperTime, lowerGB, upperGB *big.Int) ([]contract.ProposalDTO, error) {
 	values := url.Values{}
@@ -380,6 +678,36 @@ func (client *Client) Unlock(identity, passphrase string) error {
 	return nil
 }
 
+// UnlockTimed unlocks identity for the given timeout, rounded to seconds.
+func (client *Client) UnlockTimed(identity, passphrase string, timeout time.Duration) error {
+	seconds := uint64(timeout.Seconds())
+	payload := contract.IdentityUnlockRequest{
+		Passphrase: &passphrase,
+		Timeout:    &seconds,
+	}
+
+	path := fmt.Sprintf("identities/%s/unlock", identity)
+	response, err := client.http.Put(path, payload)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return nil
+}
+
+// Lock locks identity and stops services it provides
+func (client *Client) Lock(identity string) error {
+	path := fmt.Sprintf("identities/%s/lock", identity)
+	response, err := client.http.Put(path, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return nil
+}
+
 // Payout registers payout address for identity
 func (client *Client) Payout(identity, ethAddress string) error {
 	path := fmt.Sprintf("identities/%s/payout", identity)
@@ -633,6 +961,190 @@ func (client *Client) SettleWithBeneficiary(address, beneficiary, hermesID strin
 	return nil
 }
 
+// SettlementPolicy returns automatic settlement policy of the given identity.
+func (client *Client) SettlementPolicy(address string) (res contract.SettlementPolicyDTO, err error) {
+	response, err := client.http.Get("identities/"+address+"/settlement-policy", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// SetSettlementPolicy sets automatic settlement policy of the given identity.
+func (client *Client) SetSettlementPolicy(address string, policy contract.SettlementPolicyDTO) (res contract.SettlementPolicyDTO, err error) {
+	response, err := client.http.Put("identities/"+address+"/settlement-policy", policy)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// DeleteSettlementPolicy removes automatic settlement policy of the given identity.
+func (client *Client) DeleteSettlementPolicy(address string) error {
+	response, err := client.http.Delete("identities/"+address+"/settlement-policy", nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("expected 202 got %v", response.StatusCode)
+	}
+	return nil
+}
+
+// SpendingLimits returns consumer spending limits of the given identity.
+func (client *Client) SpendingLimits(address string) (res contract.SpendingLimitsDTO, err error) {
+	response, err := client.http.Get("identities/"+address+"/spending-limits", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// SetSpendingLimits sets consumer spending limits of the given identity.
+func (client *Client) SetSpendingLimits(address string, limits contract.SpendingLimitsDTO) (res contract.SpendingLimitsDTO, err error) {
+	response, err := client.http.Put("identities/"+address+"/spending-limits", limits)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// DeleteSpendingLimits removes consumer spending limits of the given identity.
+func (client *Client) DeleteSpendingLimits(address string) error {
+	response, err := client.http.Delete("identities/"+address+"/spending-limits", nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("expected 202 got %v", response.StatusCode)
+	}
+	return nil
+}
+
+// PaymentLedger returns consumer payment ledger filtered by the given session and consumer, empty values are ignored.
+func (client *Client) PaymentLedger(sessionID, consumerID string) (res contract.PaymentLedgerDTO, err error) {
+	response, err := client.http.Get("payments/ledger", paymentLedgerParams(sessionID, consumerID))
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// VerifyPaymentLedger verifies consumer payment ledger filtered by the given session and consumer.
+func (client *Client) VerifyPaymentLedger(sessionID, consumerID string) (res contract.PaymentLedgerReportDTO, err error) {
+	response, err := client.http.Get("payments/ledger/verify", paymentLedgerParams(sessionID, consumerID))
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+func paymentLedgerParams(sessionID, consumerID string) url.Values {
+	params := url.Values{}
+	if sessionID != "" {
+		params.Set("session_id", sessionID)
+	}
+	if consumerID != "" {
+		params.Set("consumer_id", consumerID)
+	}
+	return params
+}
+
+// PaymentIncidents returns payment incidents, filters are optional.
+func (client *Client) PaymentIncidents(query contract.PaymentIncidentQuery) (res contract.PaymentIncidentListDTO, err error) {
+	response, err := client.http.Get("payments/incidents", paymentIncidentParams(query))
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// UnblockPaymentIncidentOffender forgives payment incidents caused by the given peer so far.
+func (client *Client) UnblockPaymentIncidentOffender(address string) error {
+	response, err := client.http.Put("payments/incidents/offenders/"+address+"/unblock", nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return nil
+}
+
+func paymentIncidentParams(query contract.PaymentIncidentQuery) url.Values {
+	params := url.Values{}
+	if query.SessionID != nil {
+		params.Set("session_id", *query.SessionID)
+	}
+	if query.Offender != nil {
+		params.Set("offender", *query.Offender)
+	}
+	if query.Kind != nil {
+		params.Set("kind", *query.Kind)
+	}
+	return params
+}
+
+// IdentityHermes returns identity balances and channels per Hermes.
+func (client *Client) IdentityHermes(address string) (res contract.IdentityHermesListDTO, err error) {
+	response, err := client.http.Get("identities/"+address+"/hermes", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// ForecastBalance returns how long consumer balance lasts at the current spending rate.
+func (client *Client) ForecastBalance(address string) (res contract.BalanceForecastDTO, err error) {
+	response, err := client.http.Get("identities/"+address+"/forecast/balance", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// ForecastEarnings returns projected provider earnings and the next automatic settlement.
+func (client *Client) ForecastEarnings(address string) (res contract.EarningsForecastDTO, err error) {
+	response, err := client.http.Get("identities/"+address+"/forecast/earnings", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
 // Beneficiary gets beneficiary address for the provided identity.
 func (client *Client) Beneficiary(address string) (res contract.IdentityBeneficiaryResponse, err error) {
 	response, err := client.http.Get("identities/"+address+"/beneficiary", nil)
@@ -648,35 +1160,51 @@ func (client *Client) Beneficiary(address string) (res contract.IdentityBenefici
 // SetMMNApiKey sets MMN's API key in config and registers node to MMN
 func (client *Client) SetMMNApiKey(data contract.MMNApiKeyRequest) error {
 	response, err := client.http.Post("mmn/api-key", data)
-
-	// non 200 status codes return a generic error and we can't use it, instead
-	// the response contains validation JSON which we can use to extract the error
-	if err != nil && response == nil {
+	if err != nil {
+		// Validation error of the key is more helpful than the generic one.
+		if apiErr, ok := AsAPIError(err); ok && apiErr.FieldError("api_key") != nil {
+			return errors.New(apiErr.FieldError("api_key").Message)
+		}
 		return err
 	}
-
 	defer response.Body.Close()
 
-	if response.StatusCode == 200 {
-		return nil
-	}
+	return nil
+}
 
-	// TODO this should probably be wrapped and moved into the validation package
-	type validationResponse struct {
-		Message string                              `json:"message"`
-		Errors  map[string][]*validation.FieldError `json:"errors"`
+// MMNApiKey returns MMN's API key
+func (client *Client) MMNApiKey() (res contract.MMNApiKeyRequest, err error) {
+	response, err := client.http.Get("mmn/api-key", nil)
+	if err != nil {
+		return res, err
 	}
-	res := validationResponse{}
+	defer response.Body.Close()
+
 	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// ClearMMNApiKey removes MMN's API key from config
+func (client *Client) ClearMMNApiKey() error {
+	response, err := client.http.Delete("mmn/api-key", nil)
 	if err != nil {
 		return err
 	}
+	defer response.Body.Close()
+
+	return nil
+}
 
-	if res.Errors != nil && res.Errors["api_key"] != nil && res.Errors["api_key"][0] != nil {
-		return errors.New((res.Errors["api_key"][0]).Message)
+// MMNNodeReport returns node report from MMN
+func (client *Client) MMNNodeReport() (string, error) {
+	response, err := client.http.Get("mmn/report", nil)
+	if err != nil {
+		return "", err
 	}
+	defer response.Body.Close()
 
-	return nil
+	report, err := ioutil.ReadAll(response.Body)
+	return string(report), err
 }
 
 // IdentityReferralCode returns a referral token for the given identity.
@@ -791,3 +1319,251 @@ func (client *Client) FetchConfig() (map[string]interface{}, error) {
 	config := data.(map[string]interface{})
 	return config, err
 }
+
+// DefaultConfig returns default configuration
+func (client *Client) DefaultConfig() (map[string]interface{}, error) {
+	return client.config("config/default")
+}
+
+// UserConfig returns configuration set by user
+func (client *Client) UserConfig() (map[string]interface{}, error) {
+	return client.config("config/user")
+}
+
+func (client *Client) config(path string) (map[string]interface{}, error) {
+	resp, err := client.http.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var res contract.ConfigPayload
+	err = parseResponseJSON(resp, &res)
+	return res.Data, err
+}
+
+// SetUserConfig changes configuration set by user, nil values remove the settings
+func (client *Client) SetUserConfig(data map[string]interface{}) (map[string]interface{}, error) {
+	resp, err := client.http.Post("config/user", contract.ConfigPayload{Data: data})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var res contract.ConfigPayload
+	err = parseResponseJSON(resp, &res)
+	return res.Data, err
+}
+
+// AccessPolicies returns access policies
+func (client *Client) AccessPolicies() (res contract.AccessPolicies, err error) {
+	response, err := client.http.Get("access-policies", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// ExchangeMyst returns price of MYST in the given currency
+func (client *Client) ExchangeMyst(currency string) (res contract.CurrencyExchangeDTO, err error) {
+	response, err := client.http.Get("exchange/myst/"+url.PathEscape(currency), nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// PayoutInfo returns payout info of the identity
+func (client *Client) PayoutInfo(identity string) (res contract.PayoutInfoResponse, err error) {
+	response, err := client.http.Get(fmt.Sprintf("identities/%s/payout", identity), nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// UpdateReferralCode registers referral code of the identity
+func (client *Client) UpdateReferralCode(identity, referralCode string) error {
+	response, err := client.http.Put(fmt.Sprintf("identities/%s/referral", identity), contract.ReferralInfoDTO{ReferralCode: referralCode})
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return nil
+}
+
+// UpdateEmail registers email of the identity
+func (client *Client) UpdateEmail(identity, email string) error {
+	response, err := client.http.Put(fmt.Sprintf("identities/%s/email", identity), contract.EmailInfoDTO{Email: email})
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return nil
+}
+
+// ReferralTokenAvailable checks whether the identity can obtain a referral token, error is returned if it can not.
+func (client *Client) ReferralTokenAvailable(identity string) error {
+	response, err := client.http.Get(fmt.Sprintf("identities/%s/referral-available", identity), nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return nil
+}
+
+// ProposalsQuality returns quality metrics of proposals
+func (client *Client) ProposalsQuality() (res contract.ProposalQualityResponse, err error) {
+	response, err := client.http.Get("proposals/quality", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// SessionsConnectivityStatus returns connectivity statuses of sessions
+func (client *Client) SessionsConnectivityStatus() (res contract.SessionConnectivityStatusCollection, err error) {
+	response, err := client.http.Get("sessions-connectivity-status", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// SessionStatsAggregated returns statistics of sessions matching the query
+func (client *Client) SessionStatsAggregated(query contract.SessionQuery) (res contract.SessionStatsAggregatedResponse, err error) {
+	response, err := client.http.Get("sessions/stats-aggregated", sessionQueryParams(query))
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// SessionStatsDaily returns daily statistics of sessions matching the query, last 30 days by default
+func (client *Client) SessionStatsDaily(query contract.SessionQuery) (res contract.SessionStatsDailyResponse, err error) {
+	response, err := client.http.Get("sessions/stats-daily", sessionQueryParams(query))
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+func sessionQueryParams(query contract.SessionQuery) url.Values {
+	params := url.Values{}
+	if query.DateFrom != nil {
+		params.Set("date_from", query.DateFrom.String())
+	}
+	if query.DateTo != nil {
+		params.Set("date_to", query.DateTo.String())
+	}
+	if query.Direction != nil {
+		params.Set("direction", *query.Direction)
+	}
+	if query.ConsumerID != nil {
+		params.Set("consumer_id", *query.ConsumerID)
+	}
+	if query.HermesID != nil {
+		params.Set("hermes_id", *query.HermesID)
+	}
+	if query.ProviderID != nil {
+		params.Set("provider_id", *query.ProviderID)
+	}
+	if query.ServiceType != nil {
+		params.Set("service_type", *query.ServiceType)
+	}
+	if query.Status != nil {
+		params.Set("status", *query.Status)
+	}
+	return params
+}
+
+// SettlementHistory returns settlements matching the query
+func (client *Client) SettlementHistory(query contract.SettlementListQuery) (res contract.SettlementListResponse, err error) {
+	params := url.Values{}
+	if query.PageSize > 0 {
+		params.Set("page_size", strconv.Itoa(query.PageSize))
+	}
+	if query.Page > 0 {
+		params.Set("page", strconv.Itoa(query.Page))
+	}
+	if query.DateFrom != nil {
+		params.Set("date_from", query.DateFrom.String())
+	}
+	if query.DateTo != nil {
+		params.Set("date_to", query.DateTo.String())
+	}
+	if query.ProviderID != nil {
+		params.Set("provider_id", *query.ProviderID)
+	}
+	if query.HermesID != nil {
+		params.Set("hermes_id", *query.HermesID)
+	}
+
+	response, err := client.http.Get("transactor/settle/history", params)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// ExportPaymentIncidents returns payment incidents matching the query as CSV
+func (client *Client) ExportPaymentIncidents(query contract.PaymentIncidentQuery) ([]byte, error) {
+	response, err := client.http.Get("payments/incidents/export", paymentIncidentParams(query))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return ioutil.ReadAll(response.Body)
+}
+
+// Terms returns terms of use agreed by the user
+func (client *Client) Terms() (res contract.TermsResponse, err error) {
+	response, err := client.http.Get("terms", nil)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
+
+// ReportIssue reports user issue along with the node logs
+func (client *Client) ReportIssue(report contract.ReportIssueRequest) (res contract.ReportIssueSuccess, err error) {
+	response, err := client.http.Post("feedback/issue", report)
+	if err != nil {
+		return res, err
+	}
+	defer response.Body.Close()
+
+	err = parseResponseJSON(response, &res)
+	return res, err
+}
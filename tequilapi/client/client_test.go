@@ -18,6 +18,8 @@
 package client
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -123,3 +125,22 @@ func (tc *trackingCloser) Close() error {
 }
 
 var _ io.ReadCloser = (*trackingCloser)(nil)
+
+func Test_PinnedTLSConfig_VerifiesServerFingerprint(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sum := sha256.Sum256(server.Certificate().Raw)
+	fingerprint := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	pinned := &http.Client{Transport: &http.Transport{TLSClientConfig: PinnedTLSConfig(fingerprint)}}
+	resp, err := pinned.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	mismatched := &http.Client{Transport: &http.Transport{TLSClientConfig: PinnedTLSConfig(strings.Repeat("00", 32))}}
+	_, err = mismatched.Get(server.URL)
+	assert.Error(t, err)
+}
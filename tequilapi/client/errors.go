@@ -0,0 +1,148 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/mysteriumnetwork/node/tequilapi/validation"
+)
+
+// APIError represents error response of Tequilapi.
+type APIError struct {
+	// Code is HTTP status code of the response.
+	Code int
+	// Status is HTTP status of the response, e.g. "422 Unprocessable Entity".
+	Status string
+	// URL of the request.
+	URL *url.URL
+	// Message is the error message returned by Tequilapi.
+	Message string
+	// Fields lists validation errors of request fields, keyed by field name.
+	Fields map[string][]validation.FieldError
+}
+
+// Error returns string equivalent for error.
+func (e *APIError) Error() string {
+	message := e.Message
+	if len(e.Fields) > 0 {
+		message = fmt.Sprintf("%s (%s)", message, e.fieldsString())
+	}
+	return fmt.Sprintf("server response invalid: %s (%s). Possible error: %s", e.Status, e.URL, message)
+}
+
+// FieldError returns the first validation error of the given request field, nil if there is none.
+func (e *APIError) FieldError(field string) *validation.FieldError {
+	if errs := e.Fields[field]; len(errs) > 0 {
+		return &errs[0]
+	}
+	return nil
+}
+
+func (e *APIError) fieldsString() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		for _, fe := range e.Fields[field] {
+			parts = append(parts, field+": "+fe.Message)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// AsAPIError returns Tequilapi error response if the given error or any error it wraps is one.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound checks whether the error is Tequilapi response of requested resource not being found.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized checks whether the error is Tequilapi response of request not being authenticated.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden checks whether the error is Tequilapi response of request not being allowed for the used API token.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsValidationError checks whether the error is Tequilapi response of request parameters being invalid.
+func IsValidationError(err error) bool {
+	return hasStatus(err, http.StatusUnprocessableEntity)
+}
+
+func hasStatus(err error, code int) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.Code == code
+}
+
+type errorBody struct {
+	Message string                             `json:"message"`
+	Errors  map[string][]validation.FieldError `json:"errors"`
+}
+
+func parseResponseError(response *http.Response) error {
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+
+	apiErr := &APIError{
+		Code:   response.StatusCode,
+		Status: response.Status,
+		URL:    response.Request.URL,
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	// Response body is kept for callers, which parse error responses of their own format.
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		apiErr.Message = err.Error()
+		return apiErr
+	}
+
+	// Sometimes we can get JSON message with single "message" field which represents error, sometimes validation errors of fields.
+	var parsedBody errorBody
+	if err := json.Unmarshal(body, &parsedBody); err != nil {
+		apiErr.Message = err.Error()
+	} else {
+		apiErr.Message = parsedBody.Message
+		apiErr.Fields = parsedBody.Errors
+	}
+	return apiErr
+}
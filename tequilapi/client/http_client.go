@@ -19,15 +19,16 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
-	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 
 	"github.com/mysteriumnetwork/node/requests"
@@ -35,6 +36,8 @@ import (
 
 type httpClientInterface interface {
 	SetToken(token string)
+	WithContext(ctx context.Context) httpClientInterface
+	Stream(path string, values url.Values) (*http.Response, error)
 	Get(path string, values url.Values) (*http.Response, error)
 	Post(path string, payload interface{}) (*http.Response, error)
 	Put(path string, payload interface{}) (*http.Response, error)
@@ -46,8 +49,15 @@ type httpRequestInterface interface {
 }
 
 func newHTTPClient(baseURL string, ua string) *httpClient {
+	return newHTTPClientWithTransport(baseURL, ua, requests.NewTransport(requests.NewDialer("0.0.0.0").DialContext))
+}
+
+func newHTTPClientWithTransport(baseURL string, ua string, transport *http.Transport) *httpClient {
 	return &httpClient{
-		http:    requests.NewHTTPClient("0.0.0.0", 100*time.Second),
+		http: requests.NewHTTPClientWithTransport(transport, 100*time.Second),
+		// Streams last as long as they are read, so they are limited by the context only.
+		stream:  requests.NewHTTPClientWithTransport(transport, 0),
+		ctx:     context.Background(),
 		baseURL: baseURL,
 		ua:      ua,
 	}
@@ -55,6 +65,8 @@ func newHTTPClient(baseURL string, ua string) *httpClient {
 
 type httpClient struct {
 	http      httpRequestInterface
+	stream    httpRequestInterface
+	ctx       context.Context
 	authToken string
 	baseURL   string
 	ua        string
@@ -64,17 +76,30 @@ func (client *httpClient) SetToken(token string) {
 	client.authToken = token
 }
 
+// WithContext returns a copy of the client, which binds requests to the given context.
+func (client *httpClient) WithContext(ctx context.Context) httpClientInterface {
+	c := *client
+	c.ctx = ctx
+	return &c
+}
+
 func (client *httpClient) Get(path string, values url.Values) (*http.Response, error) {
-	basePath := fmt.Sprintf("%v/%v", client.baseURL, path)
+	return client.executeRequest(client.http, "GET", client.fullPath(path, values), nil)
+}
+
+// Stream executes GET request of a streaming endpoint, reading the response is limited by the context only.
+func (client *httpClient) Stream(path string, values url.Values) (*http.Response, error) {
+	return client.executeRequest(client.stream, "GET", client.fullPath(path, values), nil)
+}
+
+func (client *httpClient) fullPath(path string, values url.Values) string {
+	basePath := fmt.Sprintf("%v/%v", client.baseURL, strings.TrimPrefix(path, "/"))
 
-	var fullPath string
 	params := values.Encode()
 	if params == "" {
-		fullPath = basePath
-	} else {
-		fullPath = fmt.Sprintf("%v?%v", basePath, params)
+		return basePath
 	}
-	return client.executeRequest("GET", fullPath, nil)
+	return fmt.Sprintf("%v?%v", basePath, params)
 }
 
 func (client *httpClient) Post(path string, payload interface{}) (*http.Response, error) {
@@ -96,11 +121,15 @@ func (client httpClient) doPayloadRequest(method, path string, payload interface
 		return nil, err
 	}
 
-	return client.executeRequest(method, client.baseURL+"/"+path, payloadJSON)
+	return client.executeRequest(client.http, method, client.fullPath(path, nil), payloadJSON)
 }
 
-func (client *httpClient) executeRequest(method, fullPath string, payloadJSON []byte) (*http.Response, error) {
-	request, err := http.NewRequest(method, fullPath, bytes.NewBuffer(payloadJSON))
+func (client *httpClient) executeRequest(doer httpRequestInterface, method, fullPath string, payloadJSON []byte) (*http.Response, error) {
+	ctx := client.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request, err := http.NewRequestWithContext(ctx, method, fullPath, bytes.NewBuffer(payloadJSON))
 	if err != nil {
 		log.Error().Err(err).Msg("")
 		return nil, err
@@ -112,7 +141,7 @@ func (client *httpClient) executeRequest(method, fullPath string, payloadJSON []
 		request.Header.Set("Authorization", "Bearer "+client.authToken)
 	}
 
-	response, err := client.http.Do(request)
+	response, err := doer.Do(request)
 	if err != nil {
 		log.Error().Err(err).Msg("")
 		return response, err
@@ -127,28 +156,6 @@ func (client *httpClient) executeRequest(method, fullPath string, payloadJSON []
 	return response, nil
 }
 
-type errorBody struct {
-	Message string `json:"message"`
-}
-
-func parseResponseError(response *http.Response) error {
-	if response.StatusCode < 200 || response.StatusCode >= 300 {
-		//sometimes we can get json message with single "message" field which represents error - try to get that
-		var parsedBody errorBody
-		var message string
-		err := parseResponseJSON(response, &parsedBody)
-		if err != nil {
-			message = err.Error()
-		} else {
-			message = parsedBody.Message
-		}
-		// TODO these errors are ugly long and hard to check against - consider return error structs or specific error constants
-		return errors.Errorf("server response invalid: %s (%s). Possible error: %s", response.Status, response.Request.URL, message)
-	}
-
-	return nil
-}
-
 func parseResponseJSON(response *http.Response, dto interface{}) error {
 	b := bytes.NewBuffer(make([]byte, 0))
 	reader := io.TeeReader(response.Body, b)
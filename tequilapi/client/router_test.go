@@ -0,0 +1,234 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+
+	stateEvent "github.com/mysteriumnetwork/node/core/state/event"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/logconfig"
+	natEvent "github.com/mysteriumnetwork/node/nat/event"
+	"github.com/mysteriumnetwork/node/requests"
+	"github.com/mysteriumnetwork/node/session/connectivity"
+	"github.com/mysteriumnetwork/node/tequilapi"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/endpoints"
+)
+
+// newRouterClient serves the router used by Tequilapi, having routes added by the setup, and returns client talking to it.
+func newRouterClient(setup func(router *httprouter.Router)) (*Client, func()) {
+	router := tequilapi.NewAPIRouter()
+	setup(router)
+	server := httptest.NewServer(router)
+	return &Client{http: newHTTPClient(server.URL, "test-agent")}, server.Close
+}
+
+type mockMystExchange struct {
+	rates map[string]float64
+}
+
+func (m *mockMystExchange) GetMystExchangeRate() (map[string]float64, error) {
+	return m.rates, nil
+}
+
+type mockStateProvider struct{}
+
+func (m *mockStateProvider) GetState() stateEvent.State {
+	return stateEvent.State{}
+}
+
+func Test_Router_Healthcheck(t *testing.T) {
+	client, cleanup := newRouterClient(func(router *httprouter.Router) {})
+	defer cleanup()
+
+	healthcheck, err := client.Healthcheck()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, healthcheck.Uptime)
+}
+
+func Test_Router_ContextCancelsRequest(t *testing.T) {
+	client, cleanup := newRouterClient(func(router *httprouter.Router) {})
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.WithContext(ctx).Healthcheck()
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	_, err = client.Healthcheck()
+	assert.NoError(t, err)
+}
+
+func Test_Router_TypedErrors(t *testing.T) {
+	client, cleanup := newRouterClient(func(router *httprouter.Router) {
+		endpoints.AddRoutesForCurrencyExchange(router, &mockMystExchange{rates: map[string]float64{"USD": 0.25}})
+		endpoints.AddRoutesForLogs(router, logconfig.LogControl{})
+	})
+	defer cleanup()
+
+	exchange, err := client.ExchangeMyst("usd")
+	assert.NoError(t, err)
+	assert.Equal(t, contract.CurrencyExchangeDTO{Amount: 0.25, Currency: "USD"}, exchange)
+
+	_, err = client.ExchangeMyst("xyz")
+	assert.True(t, IsNotFound(err))
+	apiErr, ok := AsAPIError(err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, apiErr.Code)
+	assert.Equal(t, "currency not supported", apiErr.Message)
+
+	_, err = client.SetLogLevels(contract.LogLevelsRequest{Level: "loud"})
+	assert.True(t, IsValidationError(err))
+	assert.False(t, IsNotFound(err))
+	apiErr, _ = AsAPIError(err)
+	assert.Equal(t, "invalid", apiErr.FieldError("level").Code)
+	assert.Nil(t, apiErr.FieldError("components"))
+	assert.Contains(t, err.Error(), "Possible error: validation_error (level: Unknown log level)")
+}
+
+func Test_Router_AccessPolicies(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"entries":[{"id":"mysterium","title":"Mysterium verified traffic","allow":[{"type":"identity","value":"0x1"}]}]}`))
+	}))
+	defer upstream.Close()
+
+	client, cleanup := newRouterClient(func(router *httprouter.Router) {
+		endpoints.AddRoutesForAccessPolicies(requests.NewHTTPClient("0.0.0.0", time.Second), router, upstream.URL)
+	})
+	defer cleanup()
+
+	policies, err := client.AccessPolicies()
+	assert.NoError(t, err)
+	assert.Equal(t, contract.AccessPolicies{Entries: []contract.AccessPolicy{{
+		ID:    "mysterium",
+		Title: "Mysterium verified traffic",
+		Allow: []contract.AccessRule{{Type: "identity", Value: "0x1"}},
+	}}}, policies)
+}
+
+func Test_Router_SessionsConnectivityStatus(t *testing.T) {
+	storage := connectivity.NewStatusStorage()
+	createdAt := time.Date(2020, 11, 1, 10, 0, 0, 0, time.UTC)
+	storage.AddStatusEntry(connectivity.StatusEntry{
+		PeerID:       identity.FromAddress("0x1"),
+		SessionID:    "session1",
+		StatusCode:   connectivity.StatusConnectionOk,
+		Message:      "ok",
+		CreatedAtUTC: createdAt,
+	})
+	client, cleanup := newRouterClient(func(router *httprouter.Router) {
+		endpoints.AddRoutesForConnectivityStatus(router, storage)
+	})
+	defer cleanup()
+
+	statuses, err := client.SessionsConnectivityStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, []*contract.SessionConnectivityStatus{{
+		PeerAddress:  "0x1",
+		SessionID:    "session1",
+		Code:         uint32(connectivity.StatusConnectionOk),
+		Message:      "ok",
+		CreatedAtUTC: createdAt,
+	}}, statuses.Entries)
+}
+
+func Test_Router_Events(t *testing.T) {
+	handler := endpoints.NewSSEHandler(&mockStateProvider{})
+	client, cleanup := newRouterClient(func(router *httprouter.Router) {
+		endpoints.AddRoutesForSSE(router, handler)
+	})
+	defer cleanup()
+
+	_, err := client.Events(context.Background(), 0, "unknown")
+	assert.Error(t, err)
+
+	stream, err := client.Events(context.Background(), 0, "nat")
+	assert.NoError(t, err)
+
+	handler.ConsumeNATEvent(natEvent.Event{ID: "1", Stage: "hole_punching", Successful: true})
+
+	event := <-stream.Events()
+	assert.Equal(t, "nat", event.Type)
+	assert.NotZero(t, event.ID)
+	var payload contract.NATEventDTO
+	assert.NoError(t, json.Unmarshal(event.Payload, &payload))
+	assert.Equal(t, "hole_punching", payload.Stage)
+
+	stream.Close()
+	_, open := <-stream.Events()
+	assert.False(t, open)
+	assert.NoError(t, stream.Err())
+}
+
+func Test_Router_Logs(t *testing.T) {
+	original, originalLevel := log.Logger, logconfig.CurrentLogOptions.LogLevel
+	defer func() {
+		log.Logger = original
+		logconfig.SetLogLevel(originalLevel)
+		logconfig.ResetComponentLogLevel("session/pingpong")
+	}()
+	logconfig.Bootstrap()
+
+	client, cleanup := newRouterClient(func(router *httprouter.Router) {
+		endpoints.AddRoutesForLogs(router, logconfig.LogControl{})
+	})
+	defer cleanup()
+
+	levels, err := client.SetLogLevels(contract.LogLevelsRequest{
+		Level:      "info",
+		Components: map[string]string{"session/pingpong": "trace"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, contract.LogLevelsDTO{Level: "info", Components: map[string]string{"session/pingpong": "trace"}}, levels)
+	assert.Equal(t, zerolog.InfoLevel, logconfig.CurrentLogOptions.LogLevel)
+
+	levels, err = client.LogLevels()
+	assert.NoError(t, err)
+	assert.Equal(t, "info", levels.Level)
+
+	level := "warn"
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamLogs(ctx, contract.LogStreamQuery{Level: &level})
+	assert.NoError(t, err)
+
+	log.Info().Msg("not streamed")
+	log.Warn().Msg("streamed")
+
+	var line map[string]interface{}
+	assert.NoError(t, json.Unmarshal(<-stream.Lines(), &line))
+	assert.Equal(t, "warn", line["level"])
+	assert.Equal(t, "streamed", line["message"])
+
+	cancel()
+	for range stream.Lines() {
+	}
+	stream.Close()
+}
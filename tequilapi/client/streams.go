@@ -0,0 +1,212 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+)
+
+// maxStreamLineSize limits size of a single streamed event.
+const maxStreamLineSize = 4 * 1024 * 1024
+
+// Event represents node event received from Tequilapi event stream.
+type Event struct {
+	// ID of the event, used to resume the stream after reconnecting.
+	ID uint64 `json:"-"`
+	// Type of the event, e.g. "state-change".
+	Type string `json:"type"`
+	// Payload of the event, its format depends on the type.
+	Payload json.RawMessage `json:"payload"`
+}
+
+// EventStream receives node events until it is closed, its context is cancelled or the connection drops.
+type EventStream struct {
+	stream
+	events chan Event
+}
+
+// Events returns channel of received events, which is closed when the stream ends.
+func (s *EventStream) Events() <-chan Event {
+	return s.events
+}
+
+// Events subscribes to node events of the given topics, all events are received if no topics are given.
+// Passing ID of the last received event resumes the stream after reconnecting, as long as node still keeps missed events.
+func (client *Client) Events(ctx context.Context, lastEventID uint64, topics ...string) (*EventStream, error) {
+	params := url.Values{}
+	if len(topics) > 0 {
+		params.Set("topics", strings.Join(topics, ","))
+	}
+	if lastEventID > 0 {
+		params.Set("last_event_id", strconv.FormatUint(lastEventID, 10))
+	}
+
+	response, err := client.http.WithContext(ctx).Stream("events", params)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &EventStream{
+		stream: newStream(response.Body),
+		events: make(chan Event),
+	}
+	go s.run(s.readEvents)
+	return s, nil
+}
+
+// readEvents parses server-sent events, only "id" and "data" fields are used by Tequilapi.
+func (s *EventStream) readEvents(scanner *bufio.Scanner) error {
+	defer close(s.events)
+
+	var id uint64
+	var data []byte
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		switch {
+		case len(line) == 0:
+			if len(data) == 0 {
+				continue
+			}
+			event := Event{ID: id}
+			if err := json.Unmarshal(data, &event); err != nil {
+				return err
+			}
+			data = data[:0]
+			select {
+			case s.events <- event:
+			case <-s.done:
+				return nil
+			}
+		case bytes.HasPrefix(line, []byte("id:")):
+			parsed, err := strconv.ParseUint(string(bytes.TrimSpace(line[3:])), 10, 64)
+			if err != nil {
+				return err
+			}
+			id = parsed
+		case bytes.HasPrefix(line, []byte("data:")):
+			data = append(data, bytes.TrimPrefix(line[5:], []byte(" "))...)
+		}
+	}
+	return scanner.Err()
+}
+
+// LogStream receives JSON encoded log events until it is closed, its context is cancelled or the connection drops.
+type LogStream struct {
+	stream
+	lines chan json.RawMessage
+}
+
+// Lines returns channel of received log events, which is closed when the stream ends.
+func (s *LogStream) Lines() <-chan json.RawMessage {
+	return s.lines
+}
+
+// StreamLogs streams log events of the node matching the query.
+func (client *Client) StreamLogs(ctx context.Context, query contract.LogStreamQuery) (*LogStream, error) {
+	params := url.Values{}
+	if query.Level != nil {
+		params.Set("level", *query.Level)
+	}
+	if query.Component != nil {
+		params.Set("component", *query.Component)
+	}
+
+	response, err := client.http.WithContext(ctx).Stream("logs/stream", params)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &LogStream{
+		stream: newStream(response.Body),
+		lines:  make(chan json.RawMessage),
+	}
+	go s.run(s.readLines)
+	return s, nil
+}
+
+func (s *LogStream) readLines(scanner *bufio.Scanner) error {
+	defer close(s.lines)
+
+	for scanner.Scan() {
+		line := json.RawMessage(append([]byte(nil), scanner.Bytes()...))
+		select {
+		case s.lines <- line:
+		case <-s.done:
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// stream reads response body of a streaming endpoint.
+type stream struct {
+	body     io.ReadCloser
+	done     chan struct{}
+	stopOnce sync.Once
+	finished chan struct{}
+	err      error
+}
+
+func newStream(body io.ReadCloser) stream {
+	return stream{
+		body:     body,
+		done:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+}
+
+func (s *stream) run(read func(scanner *bufio.Scanner) error) {
+	defer close(s.finished)
+	defer s.body.Close()
+
+	scanner := bufio.NewScanner(s.body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+	err := read(scanner)
+
+	select {
+	case <-s.done:
+		// Reading errors are caused by closing the stream.
+	default:
+		s.err = err
+	}
+}
+
+// Err returns error which ended the stream, nil if the stream was closed or ended by the node.
+// It should be checked after the stream channel is closed.
+func (s *stream) Err() error {
+	<-s.finished
+	return s.err
+}
+
+// Close stops the stream.
+func (s *stream) Close() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+		s.body.Close()
+	})
+	<-s.finished
+}
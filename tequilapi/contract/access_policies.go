@@ -0,0 +1,37 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package contract
+
+// AccessPolicies represents list of access policies.
+// swagger:model AccessPolicies
+type AccessPolicies struct {
+	Entries []AccessPolicy `json:"entries"`
+}
+
+// AccessPolicy represents access policy.
+type AccessPolicy struct {
+	ID          string       `json:"id"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Allow       []AccessRule `json:"allow"`
+}
+
+// AccessRule represents rule of access policy.
+type AccessRule struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
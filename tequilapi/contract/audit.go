@@ -0,0 +1,186 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mysteriumnetwork/node/core/audit"
+	"github.com/mysteriumnetwork/node/tequilapi/validation"
+)
+
+const defaultAuditLimit = 100
+
+// AuditLogQuery allows to filter audit log entries.
+// swagger:parameters auditLog
+type AuditLogQuery struct {
+	// Return entries recorded at or after the given time, in RFC3339 format.
+	// in: query
+	From *string `json:"from"`
+
+	// Return entries recorded at or before the given time, in RFC3339 format.
+	// in: query
+	To *string `json:"to"`
+
+	// Actor of the operation, e.g. "user:myst", "token:<id>" or "anonymous".
+	// in: query
+	Actor *string `json:"actor"`
+
+	// Return entries for API paths starting with the given prefix.
+	// in: query
+	Path *string `json:"path"`
+
+	// Maximum number of entries to return, 100 by default.
+	// in: query
+	Limit *int `json:"limit"`
+}
+
+// Bind creates query from API request.
+func (q *AuditLogQuery) Bind(request *http.Request) *validation.FieldErrorMap {
+	errs := validation.NewErrorMap()
+	qs := request.URL.Query()
+	if qStr := qs.Get("from"); qStr != "" {
+		q.From = &qStr
+		if _, err := time.Parse(time.RFC3339, qStr); err != nil {
+			errs.ForField("from").Invalid("Time must be in RFC3339 format")
+		}
+	}
+	if qStr := qs.Get("to"); qStr != "" {
+		q.To = &qStr
+		if _, err := time.Parse(time.RFC3339, qStr); err != nil {
+			errs.ForField("to").Invalid("Time must be in RFC3339 format")
+		}
+	}
+	if qStr := qs.Get("actor"); qStr != "" {
+		q.Actor = &qStr
+	}
+	if qStr := qs.Get("path"); qStr != "" {
+		q.Path = &qStr
+	}
+	if qStr := qs.Get("limit"); qStr != "" {
+		limit, err := strconv.Atoi(qStr)
+		if err != nil || limit <= 0 {
+			errs.ForField("limit").Invalid("Limit must be a positive number")
+		}
+		q.Limit = &limit
+	}
+	return errs
+}
+
+// ToFilter converts API query to audit log filter.
+func (q *AuditLogQuery) ToFilter() audit.Filter {
+	filter := audit.Filter{Limit: defaultAuditLimit}
+	if q.From != nil {
+		from, _ := time.Parse(time.RFC3339, *q.From)
+		filter.From = &from
+	}
+	if q.To != nil {
+		to, _ := time.Parse(time.RFC3339, *q.To)
+		filter.To = &to
+	}
+	if q.Actor != nil {
+		filter.Actor = *q.Actor
+	}
+	if q.Path != nil {
+		filter.PathPrefix = *q.Path
+	}
+	if q.Limit != nil {
+		filter.Limit = *q.Limit
+	}
+	return filter
+}
+
+// NewAuditLogDTO maps to API audit log.
+func NewAuditLogDTO(entries []audit.Entry, verifyErr error) AuditLogDTO {
+	dto := AuditLogDTO{
+		Entries: make([]AuditEntryDTO, len(entries)),
+		Intact:  verifyErr == nil,
+	}
+	if verifyErr != nil {
+		dto.IntegrityError = verifyErr.Error()
+	}
+	for i, e := range entries {
+		dto.Entries[i] = AuditEntryDTO{
+			Seq:          e.Seq,
+			Time:         e.Time.Format(time.RFC3339Nano),
+			Actor:        e.Actor,
+			RemoteAddr:   e.RemoteAddr,
+			ForwardedFor: e.ForwardedFor,
+			Method:       e.Method,
+			Path:         e.Path,
+			Query:        e.Query,
+			Body:         e.Body,
+			Status:       e.Status,
+			PrevHash:     e.PrevHash,
+			Hash:         e.Hash,
+		}
+	}
+	return dto
+}
+
+// AuditLogDTO holds audit log entries, latest first.
+// swagger:model AuditLogDTO
+type AuditLogDTO struct {
+	Entries []AuditEntryDTO `json:"entries"`
+
+	// false if entries were modified or removed from the log
+	Intact bool `json:"intact"`
+
+	// example: entry 12 was modified
+	IntegrityError string `json:"integrity_error,omitempty"`
+}
+
+// AuditEntryDTO represents audited API operation. Secrets in query and body are redacted.
+// swagger:model AuditEntryDTO
+type AuditEntryDTO struct {
+	// example: 12
+	Seq uint64 `json:"seq"`
+
+	// example: 2020-11-01T10:00:00.123Z
+	Time string `json:"time"`
+
+	// example: user:myst
+	Actor string `json:"actor"`
+
+	// example: 127.0.0.1:51234
+	RemoteAddr string `json:"remote_addr"`
+
+	// example: 192.168.1.5
+	ForwardedFor string `json:"forwarded_for,omitempty"`
+
+	// example: POST
+	Method string `json:"method"`
+
+	// example: /transactor/settle/sync
+	Path string `json:"path"`
+
+	Query map[string][]string `json:"query,omitempty"`
+
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// HTTP status code of the response
+	// example: 202
+	Status int `json:"status"`
+
+	PrevHash string `json:"prev_hash"`
+
+	Hash string `json:"hash"`
+}
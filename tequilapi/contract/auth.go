@@ -55,3 +55,77 @@ type ChangePasswordRequest struct {
 	OldPassword string `json:"old_password"`
 	NewPassword string `json:"new_password"`
 }
+
+// NewAPITokenDTO maps to API token.
+func NewAPITokenDTO(token auth.APIToken) APITokenDTO {
+	dto := APITokenDTO{
+		ID:        token.ID,
+		Name:      token.Name,
+		Scopes:    make([]string, len(token.Scopes)),
+		CreatedAt: token.CreatedAt.Format(time.RFC3339),
+	}
+	for i, s := range token.Scopes {
+		dto.Scopes[i] = string(s)
+	}
+	if token.ExpiresAt != nil {
+		dto.ExpiresAt = token.ExpiresAt.Format(time.RFC3339)
+	}
+	if token.RevokedAt != nil {
+		dto.RevokedAt = token.RevokedAt.Format(time.RFC3339)
+	}
+	return dto
+}
+
+// APITokenDTO describes named API token. Token secret is only returned on creation.
+// swagger:model APITokenDTO
+type APITokenDTO struct {
+	// example: 1f3a5c7e9b2d4f60
+	ID string `json:"id"`
+
+	// example: grafana
+	Name string `json:"name"`
+
+	// any of admin, read, connection, service, payments
+	// example: ["read"]
+	Scopes []string `json:"scopes"`
+
+	// example: 2020-10-01T11:04:43Z
+	CreatedAt string `json:"created_at"`
+
+	// example: 2021-10-01T11:04:43Z
+	ExpiresAt string `json:"expires_at,omitempty"`
+
+	// example: 2020-11-01T11:04:43Z
+	RevokedAt string `json:"revoked_at,omitempty"`
+}
+
+// APITokenListResponse lists API tokens.
+// swagger:model APITokenListResponse
+type APITokenListResponse struct {
+	Tokens []APITokenDTO `json:"tokens"`
+}
+
+// CreateAPITokenRequest request used to create named API token.
+// swagger:model CreateAPITokenRequest
+type CreateAPITokenRequest struct {
+	// example: grafana
+	Name string `json:"name"`
+
+	// any of admin, read, connection, service, payments
+	// example: ["read"]
+	Scopes []string `json:"scopes"`
+
+	// optional expiry time, token never expires if omitted
+	// example: 2021-10-01T11:04:43Z
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CreateAPITokenResponse returns created API token along with its secret.
+// swagger:model CreateAPITokenResponse
+type CreateAPITokenResponse struct {
+	APITokenDTO
+
+	// secret to be used as bearer token, it can not be retrieved later
+	// example: mtk_1f3a5c7e9b2d4f60_9c1e...
+	Token string `json:"token"`
+}
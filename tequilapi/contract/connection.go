@@ -19,6 +19,7 @@ package contract
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mysteriumnetwork/node/consumer/bandwidth"
@@ -26,6 +27,7 @@ import (
 	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
 	"github.com/mysteriumnetwork/node/core/quality"
 	"github.com/mysteriumnetwork/node/datasize"
+	"github.com/mysteriumnetwork/node/p2p"
 	"github.com/mysteriumnetwork/node/tequilapi/validation"
 	"github.com/mysteriumnetwork/payments/crypto"
 )
@@ -62,7 +64,7 @@ type ConnectionInfoDTO struct {
 	// example: 0x00
 	HermesID string `json:"hermes_id,omitempty"`
 
-	// example: {"id":1,"provider_id":"0x71ccbdee7f6afe85a5bc7106323518518cd23b94","servcie_type":"openvpn","service_definition":{"location_originate":{"asn":"","country":"CA"}}}
+	// example: {"id":1,"provider_id":"0x71ccbdee7f6afe85a5bc7106323518518cd23b94","service_type":"openvpn","service_definition":{"location_originate":{"asn":1,"country":"CA"}}}
 	Proposal *ProposalDTO `json:"proposal,omitempty"`
 
 	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
@@ -70,12 +72,12 @@ type ConnectionInfoDTO struct {
 }
 
 // NewConnectionDTO maps to API connection.
-func NewConnectionDTO(session connectionstate.Status, statistics connectionstate.Statistics, throughput bandwidth.Throughput, invoice crypto.Invoice) ConnectionDTO {
+func NewConnectionDTO(session connectionstate.Status, statistics connectionstate.Statistics, throughput bandwidth.Throughput, invoice crypto.Invoice, channelStats p2p.ChannelStats, quality connectionstate.Quality) ConnectionDTO {
 	dto := ConnectionDTO{
 		ConnectionInfoDTO: NewConnectionInfoDTO(session),
 	}
 	if !statistics.At.IsZero() {
-		statsDto := NewConnectionStatisticsDTO(session, statistics, throughput, invoice)
+		statsDto := NewConnectionStatisticsDTO(session, statistics, throughput, invoice, channelStats, quality)
 		dto.Statistics = &statsDto
 	}
 	return dto
@@ -89,12 +91,12 @@ type ConnectionDTO struct {
 }
 
 // NewConnectionStatisticsDTO maps to API connection stats.
-func NewConnectionStatisticsDTO(session connectionstate.Status, statistics connectionstate.Statistics, throughput bandwidth.Throughput, invoice crypto.Invoice) ConnectionStatisticsDTO {
+func NewConnectionStatisticsDTO(session connectionstate.Status, statistics connectionstate.Statistics, throughput bandwidth.Throughput, invoice crypto.Invoice, channelStats p2p.ChannelStats, quality connectionstate.Quality) ConnectionStatisticsDTO {
 	agreementTotal := new(big.Int)
 	if invoice.AgreementTotal != nil {
 		agreementTotal = invoice.AgreementTotal
 	}
-	return ConnectionStatisticsDTO{
+	dto := ConnectionStatisticsDTO{
 		Duration:           int(session.Duration().Seconds()),
 		BytesSent:          statistics.BytesSent,
 		BytesReceived:      statistics.BytesReceived,
@@ -102,6 +104,13 @@ func NewConnectionStatisticsDTO(session connectionstate.Status, statistics conne
 		ThroughputReceived: datasize.BitSize(throughput.Down).Bits(),
 		TokensSpent:        agreementTotal,
 	}
+	if channelStats.PacketsReceived > 0 {
+		dto.Channel = NewChannelStatsDTO(channelStats)
+	}
+	if !quality.At.IsZero() {
+		dto.Quality = NewConnectionQualityDTO(quality)
+	}
+	return dto
 }
 
 // ConnectionStatisticsDTO holds consumer connection statistics.
@@ -127,6 +136,62 @@ type ConnectionStatisticsDTO struct {
 
 	// example: 500000
 	TokensSpent *big.Int `json:"tokens_spent"`
+
+	// p2p communication channel statistics
+	Channel *ChannelStatsDTO `json:"channel,omitempty"`
+
+	// connection quality measured by probing through the tunnel
+	Quality *ConnectionQualityDTO `json:"quality,omitempty"`
+}
+
+// NewConnectionQualityDTO maps to API connection quality.
+func NewConnectionQualityDTO(quality connectionstate.Quality) *ConnectionQualityDTO {
+	targets := make([]ConnectionProbeDTO, len(quality.Targets))
+	for i, t := range quality.Targets {
+		targets[i] = ConnectionProbeDTO{
+			Target:  t.Target,
+			Latency: t.Latency.Milliseconds(),
+			Jitter:  t.Jitter.Milliseconds(),
+			Loss:    t.Loss,
+		}
+	}
+	return &ConnectionQualityDTO{
+		ProbedAt:   quality.At.Format(time.RFC3339),
+		Targets:    targets,
+		Throughput: quality.Throughput,
+	}
+}
+
+// ConnectionQualityDTO holds connection quality probing results.
+// swagger:model ConnectionQualityDTO
+type ConnectionQualityDTO struct {
+	// example: 2019-06-06T11:04:43Z
+	ProbedAt string `json:"probed_at"`
+
+	Targets []ConnectionProbeDTO `json:"targets"`
+
+	// Download speed in bits per second, 0 if throughput was not probed
+	// example: 1024
+	Throughput uint64 `json:"throughput"`
+}
+
+// ConnectionProbeDTO holds probing results of a single target.
+// swagger:model ConnectionProbeDTO
+type ConnectionProbeDTO struct {
+	// example: 1.1.1.1:443
+	Target string `json:"target"`
+
+	// average latency in milliseconds
+	// example: 40
+	Latency int64 `json:"latency"`
+
+	// average latency variation in milliseconds
+	// example: 5
+	Jitter int64 `json:"jitter"`
+
+	// ratio of lost probes, from 0 to 1
+	// example: 0.2
+	Loss float64 `json:"loss"`
 }
 
 // ConnectionCreateRequest request used to start a connection.
@@ -142,7 +207,7 @@ type ConnectionCreateRequest struct {
 	// example: 0x0000000000000000000000000000000000000002
 	ProviderID string `json:"provider_id"`
 
-	// hermes identity
+	// hermes identity, the cheapest active hermes accepted by provider is used if empty
 	// example: 0x0000000000000000000000000000000000000003
 	HermesID string `json:"hermes_id"`
 
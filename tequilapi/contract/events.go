@@ -0,0 +1,372 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/core/service/servicestate"
+	"github.com/mysteriumnetwork/node/identity/registry"
+	natEvent "github.com/mysteriumnetwork/node/nat/event"
+	sessionEvent "github.com/mysteriumnetwork/node/session/event"
+	pingpongEvent "github.com/mysteriumnetwork/node/session/pingpong/event"
+	"github.com/mysteriumnetwork/node/trace"
+)
+
+// NewConnectionStateEventDTO maps to API connection state event.
+func NewConnectionStateEventDTO(e connectionstate.AppEventConnectionState) ConnectionStateEventDTO {
+	dto := ConnectionStateEventDTO{
+		State:       string(e.State),
+		SessionID:   string(e.SessionInfo.SessionID),
+		ConsumerID:  e.SessionInfo.ConsumerID.Address,
+		ProviderID:  e.SessionInfo.Proposal.ProviderID,
+		ServiceType: e.SessionInfo.Proposal.ServiceType,
+	}
+	if !e.SessionInfo.StartedAt.IsZero() {
+		dto.StartedAt = e.SessionInfo.StartedAt.UTC().Format(time.RFC3339)
+	}
+	return dto
+}
+
+// ConnectionStateEventDTO is sent when consumer connection state changes.
+// swagger:model ConnectionStateEventDTO
+type ConnectionStateEventDTO struct {
+	// example: Connected
+	State string `json:"state"`
+
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	SessionID string `json:"session_id,omitempty"`
+
+	// example: 0x00a8fe1fe5a69d4bd79b1b9cd1b4f77e9bf2d9a3
+	ConsumerID string `json:"consumer_id,omitempty"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id,omitempty"`
+
+	// example: wireguard
+	ServiceType string `json:"service_type,omitempty"`
+
+	// example: 2019-06-06T11:04:43Z
+	StartedAt string `json:"started_at,omitempty"`
+}
+
+// NewSessionEventDTO maps to API provider session lifecycle event.
+func NewSessionEventDTO(e sessionEvent.AppEventSession) SessionEventDTO {
+	return SessionEventDTO{
+		Status:          string(e.Status),
+		SessionID:       e.Session.ID,
+		ServiceID:       e.Service.ID,
+		ServiceType:     e.Session.Proposal.ServiceType,
+		ConsumerID:      e.Session.ConsumerID.Address,
+		ConsumerCountry: e.Session.ConsumerLocation.Country,
+		HermesID:        e.Session.HermesID.Hex(),
+		StartedAt:       e.Session.StartedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// SessionEventDTO is sent when provider session is created, acknowledged or removed.
+// swagger:model SessionEventDTO
+type SessionEventDTO struct {
+	// example: CreatedStatus
+	Status string `json:"status"`
+
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	SessionID string `json:"session_id"`
+
+	// example: 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+	ServiceID string `json:"service_id"`
+
+	// example: wireguard
+	ServiceType string `json:"service_type"`
+
+	// example: 0x00a8fe1fe5a69d4bd79b1b9cd1b4f77e9bf2d9a3
+	ConsumerID string `json:"consumer_id"`
+
+	// example: US
+	ConsumerCountry string `json:"consumer_country"`
+
+	// example: 0x42a537D649d6853C0a866470f2d084DA0f73b5E4
+	HermesID string `json:"hermes_id"`
+
+	// example: 2019-06-06T11:04:43Z
+	StartedAt string `json:"started_at"`
+}
+
+// NewInvoicePaidEventDTO maps to API invoice paid event.
+func NewInvoicePaidEventDTO(e pingpongEvent.AppEventInvoicePaid) InvoicePaidEventDTO {
+	return InvoicePaidEventDTO{
+		ConsumerID:     e.ConsumerID.Address,
+		SessionID:      e.SessionID,
+		ProviderID:     e.Invoice.Provider,
+		AgreementID:    e.Invoice.AgreementID,
+		AgreementTotal: e.Invoice.AgreementTotal,
+		TransactorFee:  e.Invoice.TransactorFee,
+	}
+}
+
+// InvoicePaidEventDTO is sent when consumer pays provider invoice.
+// swagger:model InvoicePaidEventDTO
+type InvoicePaidEventDTO struct {
+	// example: 0x00a8fe1fe5a69d4bd79b1b9cd1b4f77e9bf2d9a3
+	ConsumerID string `json:"consumer_id"`
+
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	SessionID string `json:"session_id"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id"`
+
+	// example: 1234
+	AgreementID *big.Int `json:"agreement_id"`
+
+	// example: 500000000000000000
+	AgreementTotal *big.Int `json:"agreement_total"`
+
+	// example: 0
+	TransactorFee *big.Int `json:"transactor_fee"`
+}
+
+// NewPromiseEventDTO maps to API hermes promise event.
+func NewPromiseEventDTO(e pingpongEvent.AppEventHermesPromise) PromiseEventDTO {
+	return PromiseEventDTO{
+		ProviderID: e.ProviderID.Address,
+		HermesID:   e.HermesID.Hex(),
+		ChainID:    e.Promise.ChainID,
+		ChannelID:  "0x" + hex.EncodeToString(e.Promise.ChannelID),
+		Amount:     e.Promise.Amount,
+		Fee:        e.Promise.Fee,
+	}
+}
+
+// PromiseEventDTO is sent when provider receives promise from hermes.
+// swagger:model PromiseEventDTO
+type PromiseEventDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id"`
+
+	// example: 0x42a537D649d6853C0a866470f2d084DA0f73b5E4
+	HermesID string `json:"hermes_id"`
+
+	// example: 5
+	ChainID int64 `json:"chain_id"`
+
+	// example: 0x8dc9fa1bfa19e9b65e8e8fbdc11e8c9cb3f23e1e6b4ac0ce3e1a96bf7c7a3b0e
+	ChannelID string `json:"channel_id"`
+
+	// example: 500000000000000000
+	Amount *big.Int `json:"amount"`
+
+	// example: 0
+	Fee *big.Int `json:"fee"`
+}
+
+// NewSettlementEventDTO maps to API settlement event.
+func NewSettlementEventDTO(e pingpongEvent.AppEventSettlementComplete) SettlementEventDTO {
+	return SettlementEventDTO{
+		ProviderID:   e.ProviderID.Address,
+		HermesID:     e.HermesID.Hex(),
+		ChainID:      e.ChainID,
+		TxHash:       e.TxHash.Hex(),
+		Amount:       e.Amount,
+		Fees:         e.Fees,
+		TotalSettled: e.TotalSettled,
+		Trigger:      e.Trigger,
+	}
+}
+
+// SettlementEventDTO is sent when provider promises get settled.
+// swagger:model SettlementEventDTO
+type SettlementEventDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id"`
+
+	// example: 0x42a537D649d6853C0a866470f2d084DA0f73b5E4
+	HermesID string `json:"hermes_id"`
+
+	// example: 5
+	ChainID int64 `json:"chain_id"`
+
+	// example: 0x20c070a9be65355adbd2ba479e095e2e8ed7e692596548734984eab75d3fdfa5
+	TxHash string `json:"tx_hash"`
+
+	// example: 500000000000000000
+	Amount *big.Int `json:"amount"`
+
+	// example: 1000000000000000
+	Fees *big.Int `json:"fees"`
+
+	// example: 2000000000000000000
+	TotalSettled *big.Int `json:"total_settled"`
+
+	// example: threshold
+	Trigger string `json:"trigger"`
+}
+
+// NewSettlementFailedEventDTO maps to API failed settlement event.
+func NewSettlementFailedEventDTO(e pingpongEvent.AppEventSettlementFailed) SettlementFailedEventDTO {
+	return SettlementFailedEventDTO{
+		ProviderID: e.ProviderID.Address,
+		HermesID:   e.HermesID.Hex(),
+		ChainID:    e.ChainID,
+		Trigger:    e.Trigger,
+		Error:      e.Error,
+	}
+}
+
+// SettlementFailedEventDTO is sent when settlement of provider promises fails.
+// swagger:model SettlementFailedEventDTO
+type SettlementFailedEventDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id"`
+
+	// example: 0x42a537D649d6853C0a866470f2d084DA0f73b5E4
+	HermesID string `json:"hermes_id"`
+
+	// example: 5
+	ChainID int64 `json:"chain_id"`
+
+	// example: manual
+	Trigger string `json:"trigger"`
+
+	// example: settle timeout
+	Error string `json:"error"`
+}
+
+// NewBalanceEventDTO maps to API balance change event.
+func NewBalanceEventDTO(e pingpongEvent.AppEventBalanceChanged) BalanceEventDTO {
+	return BalanceEventDTO{
+		Identity: e.Identity.Address,
+		Previous: e.Previous,
+		Current:  e.Current,
+	}
+}
+
+// BalanceEventDTO is sent when consumer balance of identity changes.
+// swagger:model BalanceEventDTO
+type BalanceEventDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	Identity string `json:"identity"`
+
+	// example: 2000000000000000000
+	Previous *big.Int `json:"previous"`
+
+	// example: 1500000000000000000
+	Current *big.Int `json:"current"`
+}
+
+// NewServiceStatusEventDTO maps to API service status event.
+func NewServiceStatusEventDTO(e servicestate.AppEventServiceStatus) ServiceStatusEventDTO {
+	return ServiceStatusEventDTO{
+		ID:         e.ID,
+		ProviderID: e.ProviderID,
+		Type:       e.Type,
+		Status:     e.Status,
+	}
+}
+
+// ServiceStatusEventDTO is sent when status of provided service changes.
+// swagger:model ServiceStatusEventDTO
+type ServiceStatusEventDTO struct {
+	// example: 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+	ID string `json:"id"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id"`
+
+	// example: wireguard
+	Type string `json:"type"`
+
+	// example: NotRunning
+	Status string `json:"status"`
+}
+
+// NewRegistrationEventDTO maps to API identity registration event.
+func NewRegistrationEventDTO(e registry.AppEventIdentityRegistration) RegistrationEventDTO {
+	return RegistrationEventDTO{
+		Identity: e.ID.Address,
+		Status:   e.Status.String(),
+		ChainID:  e.ChainID,
+	}
+}
+
+// RegistrationEventDTO is sent when identity registration status changes.
+// swagger:model RegistrationEventDTO
+type RegistrationEventDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	Identity string `json:"identity"`
+
+	// example: Registered
+	Status string `json:"status"`
+
+	// example: 5
+	ChainID int64 `json:"chain_id"`
+}
+
+// NewNATEventDTO maps to API NAT traversal event.
+func NewNATEventDTO(e natEvent.Event) NATEventDTO {
+	dto := NATEventDTO{
+		ID:         e.ID,
+		Stage:      e.Stage,
+		Successful: e.Successful,
+	}
+	if e.Error != nil {
+		dto.Error = e.Error.Error()
+	}
+	return dto
+}
+
+// NATEventDTO is sent after NAT traversal stage completes.
+// swagger:model NATEventDTO
+type NATEventDTO struct {
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	ID string `json:"id"`
+
+	// example: hole_punching
+	Stage string `json:"stage"`
+
+	// example: true
+	Successful bool `json:"successful"`
+
+	// example: no UPnP or NAT-PMP router discovered
+	Error string `json:"error,omitempty"`
+}
+
+// NewTraceEventDTO maps to API trace event.
+func NewTraceEventDTO(e trace.Event) TraceEventDTO {
+	return TraceEventDTO{
+		ID:         e.ID,
+		Key:        e.Key,
+		DurationMS: e.Duration.Milliseconds(),
+	}
+}
+
+// TraceEventDTO is sent when a traced connection stage completes.
+// swagger:model TraceEventDTO
+type TraceEventDTO struct {
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	ID string `json:"id"`
+
+	// example: Consumer connection
+	Key string `json:"key"`
+
+	// example: 1500
+	DurationMS int64 `json:"duration_ms"`
+}
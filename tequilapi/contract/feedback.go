@@ -0,0 +1,38 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package contract
+
+// ReportIssueRequest params for issue report
+// swagger:model
+type ReportIssueRequest struct {
+	Email       string `json:"email"`
+	Description string `json:"description"`
+}
+
+// ReportIssueSuccess successful issue report
+// swagger:model
+type ReportIssueSuccess struct {
+	IssueID string `json:"issue_id"`
+}
+
+// ReportIssueError issue report error
+// swagger:model
+type ReportIssueError struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/mysteriumnetwork/node/core/forecast"
+)
+
+// BalanceForecastDTO describes how long consumer balance lasts at the current spending rate.
+// swagger:model BalanceForecastDTO
+type BalanceForecastDTO struct {
+	// current consumer balance
+	Balance *big.Int `json:"balance"`
+
+	// count of sessions forecast is based on
+	// example: 12
+	Sessions int `json:"sessions"`
+
+	// average spending per day
+	SpentPerDay *big.Int `json:"spent_per_day"`
+
+	// average spending per hour of connected time
+	SpentPerHour *big.Int `json:"spent_per_hour"`
+
+	// average spending per GiB of transferred data
+	SpentPerGiB *big.Int `json:"spent_per_gib"`
+
+	// connected time in seconds balance is enough for
+	// example: 36000
+	ConnectedTimeLeft uint64 `json:"connected_time_left"`
+
+	// amount of data in bytes balance is enough for
+	// example: 10737418240
+	DataLeft uint64 `json:"data_left"`
+
+	// date balance runs out at the current spending rate, empty when there was no spending
+	// example: 2020-12-01T12:00:00Z
+	DepletedAt string `json:"depleted_at,omitempty"`
+}
+
+// NewBalanceForecastDTO maps to API balance forecast.
+func NewBalanceForecastDTO(f forecast.BalanceForecast) BalanceForecastDTO {
+	dto := BalanceForecastDTO{
+		Balance:           f.Balance,
+		Sessions:          f.Sessions,
+		SpentPerDay:       f.SpentPerDay,
+		SpentPerHour:      f.SpentPerHour,
+		SpentPerGiB:       f.SpentPerGiB,
+		ConnectedTimeLeft: uint64(f.ConnectedTimeLeft.Seconds()),
+		DataLeft:          f.DataLeft,
+	}
+	if f.DepletedAt != nil {
+		dto.DepletedAt = f.DepletedAt.Format(time.RFC3339)
+	}
+	return dto
+}
+
+// EarningsForecastDTO describes projected provider earnings and the next automatic settlement.
+// swagger:model EarningsForecastDTO
+type EarningsForecastDTO struct {
+	// average earnings per day
+	EarnedPerDay *big.Int `json:"earned_per_day"`
+
+	// projected earnings in a week
+	Weekly *big.Int `json:"weekly"`
+
+	// projected earnings in a month
+	Monthly *big.Int `json:"monthly"`
+
+	// provider earnings which are not settled yet
+	Unsettled *big.Int `json:"unsettled"`
+
+	// earliest projected automatic settlement, empty when it can't be projected
+	NextSettlement *SettlementForecastDTO `json:"next_settlement,omitempty"`
+}
+
+// SettlementForecastDTO describes a projected automatic settlement.
+// swagger:model SettlementForecastDTO
+type SettlementForecastDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	HermesID string `json:"hermes_id"`
+
+	// what triggers the settlement
+	// example: threshold
+	Trigger string `json:"trigger"`
+
+	// projected amount of settled earnings
+	Amount *big.Int `json:"amount"`
+
+	// projected settlement date
+	// example: 2020-12-01T12:00:00Z
+	At string `json:"at"`
+}
+
+// NewEarningsForecastDTO maps to API earnings forecast.
+func NewEarningsForecastDTO(f forecast.EarningsForecast) EarningsForecastDTO {
+	dto := EarningsForecastDTO{
+		EarnedPerDay: f.EarnedPerDay,
+		Weekly:       f.Weekly,
+		Monthly:      f.Monthly,
+		Unsettled:    f.Unsettled,
+	}
+	if s := f.NextSettlement; s != nil {
+		dto.NextSettlement = &SettlementForecastDTO{
+			HermesID: s.HermesID.Hex(),
+			Trigger:  string(s.Trigger),
+			Amount:   s.Amount,
+			At:       s.At.Format(time.RFC3339),
+		}
+	}
+	return dto
+}
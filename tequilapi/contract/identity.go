@@ -91,6 +91,10 @@ func (r IdentityCreateRequest) Validate() *validation.FieldErrorMap {
 // swagger:model IdentityUnlockRequestDTO
 type IdentityUnlockRequest struct {
 	Passphrase *string `json:"passphrase"`
+
+	// Seconds identity stays unlocked for, identity stays unlocked until locked explicitly if not set
+	// example: 3600
+	Timeout *uint64 `json:"timeout,omitempty"`
 }
 
 // Validate validates fields in request
@@ -162,9 +166,37 @@ type IdentityBeneficiaryResponse struct {
 	Beneficiary string `json:"beneficiary"`
 }
 
+// IdentityExportRequest is received in identity export endpoint.
+// swagger:model IdentityExportRequestDTO
+type IdentityExportRequest struct {
+	// Passphrase exported key or bundle is encrypted with
+	Passphrase string `json:"passphrase"`
+	// Current identity passphrase
+	CurrentPassphrase string `json:"current_passphrase,omitempty"`
+	// Export backup bundle with identity state instead of a plain key
+	Bundle bool `json:"bundle"`
+}
+
+// Validate validates the export request.
+func (r *IdentityExportRequest) Validate() error {
+	if len(r.Passphrase) == 0 {
+		return errors.New("passphrase must be provided")
+	}
+
+	return nil
+}
+
+// IdentityExportResponse represents exported identity.
+// swagger:model IdentityExportResponseDTO
+type IdentityExportResponse struct {
+	// Keystore json or encrypted backup bundle, accepted by identity import endpoint
+	Data []byte `json:"data"`
+}
+
 // IdentityImportRequest is received in identity import endpoint.
-//swagger:model IdentityImportRequest
+// swagger:model IdentityImportRequest
 type IdentityImportRequest struct {
+	// Keystore json or encrypted backup bundle. Identity state is restored from bundles as well.
 	Data              []byte `json:"data"`
 	CurrentPassphrase string `json:"current_passphrase,omitempty"`
 
@@ -0,0 +1,59 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package contract
+
+import (
+	"math/big"
+)
+
+// IdentityHermesListDTO lists identity accounting per Hermes.
+// swagger:model IdentityHermesListDTO
+type IdentityHermesListDTO struct {
+	Hermes []IdentityHermesDTO `json:"hermes"`
+}
+
+// IdentityHermesDTO holds identity balances and channel state with a single Hermes.
+// swagger:model IdentityHermesDTO
+type IdentityHermesDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	HermesID string `json:"hermes_id"`
+
+	// whether Hermes is currently active in the registry
+	Active bool `json:"active"`
+
+	// Hermes fee in hundredths of a percent
+	// example: 2000
+	Fee uint16 `json:"fee"`
+
+	// provider channel with this Hermes, empty when identity has no channel
+	ChannelID string `json:"channel_id,omitempty"`
+
+	// lifetime provider earnings through this Hermes
+	Earnings *big.Int `json:"earnings"`
+
+	// provider earnings through this Hermes which are not settled yet
+	EarningsUnsettled *big.Int `json:"earnings_unsettled"`
+
+	// provider channel stake
+	Stake *big.Int `json:"stake"`
+
+	// amount already settled into provider channel
+	Settled *big.Int `json:"settled"`
+
+	// total amount consumer promised to pay through this Hermes
+	Promised *big.Int `json:"promised"`
+}
@@ -0,0 +1,229 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mysteriumnetwork/payments/crypto"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+// PaymentLedgerQuery allows to filter consumer payment ledger.
+// swagger:parameters paymentLedger verifyPaymentLedger
+type PaymentLedgerQuery struct {
+	// Session ID to filter the ledger by.
+	// in: query
+	SessionID *string `json:"session_id"`
+
+	// Consumer identity to filter the ledger by.
+	// in: query
+	ConsumerID *string `json:"consumer_id"`
+}
+
+// Bind creates query from API request.
+func (q *PaymentLedgerQuery) Bind(request *http.Request) {
+	qs := request.URL.Query()
+	if qStr := qs.Get("session_id"); qStr != "" {
+		q.SessionID = &qStr
+	}
+	if qStr := qs.Get("consumer_id"); qStr != "" {
+		q.ConsumerID = &qStr
+	}
+}
+
+// ToFilter converts API query to storage filter.
+func (q *PaymentLedgerQuery) ToFilter() pingpong.ConsumerLedgerFilter {
+	filter := pingpong.ConsumerLedgerFilter{
+		SessionID: q.SessionID,
+	}
+	if q.ConsumerID != nil {
+		id := identity.FromAddress(*q.ConsumerID)
+		filter.ConsumerID = &id
+	}
+	return filter
+}
+
+// NewPaymentLedgerDTO maps to API payment ledger.
+func NewPaymentLedgerDTO(entries []pingpong.ConsumerLedgerEntry) PaymentLedgerDTO {
+	dto := PaymentLedgerDTO{
+		Entries: make([]PaymentLedgerEntryDTO, len(entries)),
+	}
+	for i, e := range entries {
+		dto.Entries[i] = NewPaymentLedgerEntryDTO(e)
+	}
+	return dto
+}
+
+// PaymentLedgerDTO holds consumer payment ledger entries.
+// swagger:model PaymentLedgerDTO
+type PaymentLedgerDTO struct {
+	Entries []PaymentLedgerEntryDTO `json:"entries"`
+}
+
+// NewPaymentLedgerEntryDTO maps to API payment ledger entry.
+func NewPaymentLedgerEntryDTO(e pingpong.ConsumerLedgerEntry) PaymentLedgerEntryDTO {
+	return PaymentLedgerEntryDTO{
+		ID:               e.ID,
+		SessionID:        e.SessionID,
+		ConsumerID:       e.ConsumerID.Address,
+		HermesID:         e.HermesID.Hex(),
+		ProviderID:       e.Invoice.Provider,
+		ChainID:          e.Invoice.ChainID,
+		RecordedAt:       e.Time.Format(time.RFC3339),
+		AgreementID:      e.Invoice.AgreementID,
+		AgreementTotal:   e.Invoice.AgreementTotal,
+		TransactorFee:    e.Invoice.TransactorFee,
+		Hashlock:         e.Invoice.Hashlock,
+		Promised:         e.Promised,
+		Promise:          NewPromiseDTO(e.Message.Promise),
+		MessageSignature: e.Message.Signature,
+	}
+}
+
+// PaymentLedgerEntryDTO holds invoice received by consumer and exchange message signed to pay it.
+// swagger:model PaymentLedgerEntryDTO
+type PaymentLedgerEntryDTO struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	SessionID string `json:"session_id"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	ConsumerID string `json:"consumer_id"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	HermesID string `json:"hermes_id"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id"`
+
+	// example: 80001
+	ChainID int64 `json:"chain_id"`
+
+	// example: 2019-06-06T11:04:43.910035Z
+	RecordedAt string `json:"recorded_at"`
+
+	// example: 1
+	AgreementID *big.Int `json:"agreement_id"`
+
+	// example: 500000
+	AgreementTotal *big.Int `json:"agreement_total"`
+
+	// example: 0
+	TransactorFee *big.Int `json:"transactor_fee"`
+
+	// example: 0x528f7a73b0ef7b9d17b8b1fc5ba8bb1ad4f8b1b3f0e6ec80a2f3d4c0d1d5e5a6
+	Hashlock string `json:"hashlock"`
+
+	// amount the exchange message added to the promised total
+	// example: 100000
+	Promised *big.Int `json:"promised"`
+
+	Promise PromiseDTO `json:"promise"`
+
+	// example: 0x7e3d...
+	MessageSignature string `json:"message_signature"`
+}
+
+// NewPromiseDTO maps to API promise.
+func NewPromiseDTO(p crypto.Promise) PromiseDTO {
+	return PromiseDTO{
+		ChannelID: hexutil.Encode(p.ChannelID),
+		Amount:    p.Amount,
+		Fee:       p.Fee,
+		Hashlock:  hexutil.Encode(p.Hashlock),
+		Signature: hexutil.Encode(p.Signature),
+	}
+}
+
+// PromiseDTO represents payment promise object.
+// swagger:model PromiseDTO
+type PromiseDTO struct {
+	// example: 0x30bc5a8e43c3a5ff0ba5b8f1d4e7f0ffd7cc1e1bb0b4d58b7a3bd9d8a9e1ef8b
+	ChannelID string `json:"channel_id"`
+
+	// example: 500000
+	Amount *big.Int `json:"amount"`
+
+	// example: 0
+	Fee *big.Int `json:"fee"`
+
+	// example: 0x528f7a73b0ef7b9d17b8b1fc5ba8bb1ad4f8b1b3f0e6ec80a2f3d4c0d1d5e5a6
+	Hashlock string `json:"hashlock"`
+
+	// example: 0x7e3d...
+	Signature string `json:"signature"`
+}
+
+// NewPaymentLedgerReportDTO maps to API payment ledger report.
+func NewPaymentLedgerReportDTO(r pingpong.LedgerReport) PaymentLedgerReportDTO {
+	dto := PaymentLedgerReportDTO{
+		Entries:  r.Entries,
+		Invoiced: r.Invoiced,
+		Promised: r.Promised,
+		Valid:    r.Valid(),
+		Problems: make([]PaymentLedgerProblemDTO, len(r.Problems)),
+	}
+	for i, p := range r.Problems {
+		dto.Problems[i] = PaymentLedgerProblemDTO{
+			EntryID:   p.EntryID,
+			SessionID: p.SessionID,
+			Reason:    p.Reason,
+		}
+	}
+	return dto
+}
+
+// PaymentLedgerReportDTO is the result of payment ledger verification.
+// swagger:model PaymentLedgerReportDTO
+type PaymentLedgerReportDTO struct {
+	// number of verified entries
+	// example: 12
+	Entries int `json:"entries"`
+
+	// example: 1200000
+	Invoiced *big.Int `json:"invoiced"`
+
+	// example: 1200000
+	Promised *big.Int `json:"promised"`
+
+	// example: true
+	Valid bool `json:"valid"`
+
+	Problems []PaymentLedgerProblemDTO `json:"problems"`
+}
+
+// PaymentLedgerProblemDTO describes ledger entry which failed verification.
+// swagger:model PaymentLedgerProblemDTO
+type PaymentLedgerProblemDTO struct {
+	// example: 3
+	EntryID int `json:"entry_id"`
+
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	SessionID string `json:"session_id"`
+
+	// example: promise hashlock does not match invoice hashlock
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package contract
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mysteriumnetwork/node/tequilapi/validation"
+)
+
+var logLevels = []zerolog.Level{
+	zerolog.TraceLevel,
+	zerolog.DebugLevel,
+	zerolog.InfoLevel,
+	zerolog.WarnLevel,
+	zerolog.ErrorLevel,
+	zerolog.FatalLevel,
+	zerolog.PanicLevel,
+}
+
+// ParseLogLevel parses log level from its name, e.g. "debug".
+func ParseLogLevel(name string) (zerolog.Level, bool) {
+	for _, level := range logLevels {
+		if level.String() == strings.ToLower(strings.TrimSpace(name)) {
+			return level, true
+		}
+	}
+	return zerolog.NoLevel, false
+}
+
+// LogLevelsDTO represents global log level and its overrides for components.
+// swagger:model LogLevelsDTO
+type LogLevelsDTO struct {
+	// Global log level.
+	// example: info
+	Level string `json:"level"`
+
+	// Log levels of components (package paths, e.g. "session/pingpong"), overriding the global level for them and their subcomponents.
+	// example: {"session/pingpong": "trace"}
+	Components map[string]string `json:"components"`
+}
+
+// NewLogLevelsDTO maps to API log levels.
+func NewLogLevelsDTO(global zerolog.Level, components map[string]zerolog.Level) LogLevelsDTO {
+	dto := LogLevelsDTO{
+		Level:      global.String(),
+		Components: make(map[string]string, len(components)),
+	}
+	for component, level := range components {
+		dto.Components[component] = level.String()
+	}
+	return dto
+}
+
+// LogLevelsRequest request used to change log levels.
+// swagger:model LogLevelsRequest
+type LogLevelsRequest struct {
+	// Global log level, left unchanged if empty.
+	// example: info
+	Level string `json:"level,omitempty"`
+
+	// Log levels of components to override, empty level removes the override.
+	// example: {"session/pingpong": "trace", "p2p": ""}
+	Components map[string]string `json:"components,omitempty"`
+}
+
+// Validate validates fields in request
+func (r LogLevelsRequest) Validate() *validation.FieldErrorMap {
+	errs := validation.NewErrorMap()
+	if r.Level != "" {
+		if _, ok := ParseLogLevel(r.Level); !ok {
+			errs.ForField("level").Invalid("Unknown log level")
+		}
+	}
+	for component, level := range r.Components {
+		if strings.Trim(strings.TrimSpace(component), "/") == "" {
+			errs.ForField("components").Invalid("Component must not be empty")
+			continue
+		}
+		if level == "" {
+			continue
+		}
+		if _, ok := ParseLogLevel(level); !ok {
+			errs.ForField("components").Invalid("Unknown log level of component " + component)
+		}
+	}
+	return errs
+}
+
+// LogStreamQuery allows to filter streamed log events.
+// swagger:parameters logStream
+type LogStreamQuery struct {
+	// Minimum level of events, all levels by default.
+	// in: query
+	Level *string `json:"level"`
+
+	// Stream events of the given component (package path) and its subcomponents only, e.g. "session/pingpong".
+	// in: query
+	Component *string `json:"component"`
+}
+
+// Bind creates query from API request.
+func (q *LogStreamQuery) Bind(request *http.Request) *validation.FieldErrorMap {
+	errs := validation.NewErrorMap()
+	qs := request.URL.Query()
+	if qStr := qs.Get("level"); qStr != "" {
+		q.Level = &qStr
+		if _, ok := ParseLogLevel(qStr); !ok {
+			errs.ForField("level").Invalid("Unknown log level")
+		}
+	}
+	if qStr := qs.Get("component"); qStr != "" {
+		q.Component = &qStr
+	}
+	return errs
+}
+
+// MinLevel returns minimum level of streamed events.
+func (q *LogStreamQuery) MinLevel() zerolog.Level {
+	if q.Level == nil {
+		return zerolog.TraceLevel
+	}
+	level, _ := ParseLogLevel(*q.Level)
+	return level
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"time"
+
+	"github.com/mysteriumnetwork/node/p2p"
+)
+
+// NewChannelStatsDTO maps to API p2p channel stats.
+func NewChannelStatsDTO(stats p2p.ChannelStats) *ChannelStatsDTO {
+	dto := &ChannelStatsDTO{
+		RTT:             stats.RTT.Milliseconds(),
+		RTTVar:          stats.RTTVar.Milliseconds(),
+		LastRTT:         stats.LastRTT.Milliseconds(),
+		PacketsSent:     stats.PacketsSent,
+		PacketsReceived: stats.PacketsReceived,
+		BytesSent:       stats.BytesSent,
+		BytesReceived:   stats.BytesReceived,
+		RequestsSent:    stats.RequestsSent,
+		RequestsFailed:  stats.RequestsFailed,
+		PeerAddrChanges: stats.PeerAddrChanges,
+
+		NodeTransportRetransmits:  stats.NodeTransportRetransmits,
+		NodeTransportLostSegments: stats.NodeTransportLostSegments,
+	}
+	if !stats.PeerAddrChangedAt.IsZero() {
+		dto.PeerAddrChangedAt = stats.PeerAddrChangedAt.Format(time.RFC3339)
+	}
+	if !stats.LastReceivedAt.IsZero() {
+		dto.LastReceivedAt = stats.LastReceivedAt.Format(time.RFC3339)
+	}
+	return dto
+}
+
+// ChannelStatsDTO holds p2p channel transport metrics.
+// swagger:model ChannelStatsDTO
+type ChannelStatsDTO struct {
+	// smoothed round trip time of p2p messages in milliseconds
+	// example: 45
+	RTT int64 `json:"rtt"`
+
+	// round trip time variation of p2p messages in milliseconds
+	// example: 5
+	RTTVar int64 `json:"rttvar"`
+
+	// round trip time of the latest p2p message in milliseconds
+	// example: 50
+	LastRTT int64 `json:"last_rtt"`
+
+	// example: 120
+	PacketsSent uint64 `json:"packets_sent"`
+
+	// example: 118
+	PacketsReceived uint64 `json:"packets_received"`
+
+	// example: 10240
+	BytesSent uint64 `json:"bytes_sent"`
+
+	// example: 10100
+	BytesReceived uint64 `json:"bytes_received"`
+
+	// example: 30
+	RequestsSent uint64 `json:"requests_sent"`
+
+	// requests which failed or timed out waiting for peer reply
+	// example: 1
+	RequestsFailed uint64 `json:"requests_failed"`
+
+	// example: 1
+	PeerAddrChanges uint64 `json:"peer_addr_changes"`
+
+	// example: 2019-06-06T11:04:43Z
+	PeerAddrChangedAt string `json:"peer_addr_changed_at,omitempty"`
+
+	// example: 2019-06-06T11:04:43Z
+	LastReceivedAt string `json:"last_received_at,omitempty"`
+
+	// segments retransmitted by KCP since node start, shared by all p2p channels of the node
+	// example: 3
+	NodeTransportRetransmits uint64 `json:"node_transport_retransmits"`
+
+	// segments inferred as lost by KCP since node start, shared by all p2p channels of the node
+	// example: 1
+	NodeTransportLostSegments uint64 `json:"node_transport_lost_segments"`
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+// PaymentIncidentQuery allows to filter payment incidents.
+// swagger:parameters paymentIncidents exportPaymentIncidents
+type PaymentIncidentQuery struct {
+	// Session ID to filter the incidents by.
+	// in: query
+	SessionID *string `json:"session_id"`
+
+	// Identity of the peer which caused the incident.
+	// in: query
+	Offender *string `json:"offender"`
+
+	// Incident kind to filter by. Possible values are "provider_overcharge", "wrong_provider", "invalid_promise", "invalid_exchange_message", "underpayment".
+	// in: query
+	Kind *string `json:"kind"`
+}
+
+// Bind creates query from API request.
+func (q *PaymentIncidentQuery) Bind(request *http.Request) {
+	qs := request.URL.Query()
+	if qStr := qs.Get("session_id"); qStr != "" {
+		q.SessionID = &qStr
+	}
+	if qStr := qs.Get("offender"); qStr != "" {
+		q.Offender = &qStr
+	}
+	if qStr := qs.Get("kind"); qStr != "" {
+		q.Kind = &qStr
+	}
+}
+
+// ToFilter converts API query to storage filter.
+func (q *PaymentIncidentQuery) ToFilter() pingpong.PaymentIncidentFilter {
+	filter := pingpong.PaymentIncidentFilter{
+		SessionID: q.SessionID,
+	}
+	if q.Offender != nil {
+		id := identity.FromAddress(*q.Offender)
+		filter.Offender = &id
+	}
+	if q.Kind != nil {
+		kind := pingpong.PaymentIncidentKind(*q.Kind)
+		filter.Kind = &kind
+	}
+	return filter
+}
+
+// NewPaymentIncidentListDTO maps to API payment incident list.
+func NewPaymentIncidentListDTO(incidents []pingpong.PaymentIncident) PaymentIncidentListDTO {
+	dto := PaymentIncidentListDTO{
+		Incidents: make([]PaymentIncidentDTO, len(incidents)),
+	}
+	for i, incident := range incidents {
+		dto.Incidents[i] = NewPaymentIncidentDTO(incident)
+	}
+	return dto
+}
+
+// PaymentIncidentListDTO holds payment incidents.
+// swagger:model PaymentIncidentListDTO
+type PaymentIncidentListDTO struct {
+	Incidents []PaymentIncidentDTO `json:"incidents"`
+}
+
+// NewPaymentIncidentDTO maps to API payment incident.
+func NewPaymentIncidentDTO(incident pingpong.PaymentIncident) PaymentIncidentDTO {
+	dto := PaymentIncidentDTO{
+		ID:         incident.ID,
+		Kind:       string(incident.Kind),
+		Direction:  incident.Direction,
+		SessionID:  incident.SessionID,
+		Offender:   incident.Offender,
+		ProviderID: incident.ProviderID.Address,
+		ConsumerID: incident.ConsumerID.Address,
+		HermesID:   incident.HermesID.Hex(),
+		Error:      incident.Error,
+		Expected:   incident.Expected,
+		Actual:     incident.Actual,
+		CreatedAt:  incident.Time.Format(time.RFC3339),
+	}
+	if incident.Invoice != nil {
+		dto.Invoice = &InvoiceDTO{
+			AgreementID:    incident.Invoice.AgreementID,
+			AgreementTotal: incident.Invoice.AgreementTotal,
+			TransactorFee:  incident.Invoice.TransactorFee,
+			Hashlock:       incident.Invoice.Hashlock,
+			Provider:       incident.Invoice.Provider,
+			ChainID:        incident.Invoice.ChainID,
+		}
+	}
+	if incident.Message != nil {
+		dto.ExchangeMessage = &ExchangeMessageDTO{
+			AgreementID:    incident.Message.AgreementID,
+			AgreementTotal: incident.Message.AgreementTotal,
+			Provider:       incident.Message.Provider,
+			HermesID:       incident.Message.HermesID,
+			ChainID:        incident.Message.ChainID,
+			Signature:      incident.Message.Signature,
+			Promise:        NewPromiseDTO(incident.Message.Promise),
+		}
+	}
+	return dto
+}
+
+// PaymentIncidentDTO describes a failed payment between consumer and provider.
+// swagger:model PaymentIncidentDTO
+type PaymentIncidentDTO struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: provider_overcharge
+	Kind string `json:"kind"`
+
+	// Provided if incident was recorded by provider, Consumed if by consumer
+	// example: Consumed
+	Direction string `json:"direction"`
+
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	SessionID string `json:"session_id"`
+
+	// identity of the peer which caused the incident
+	// example: 0x0000000000000000000000000000000000000001
+	Offender string `json:"offender"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	ConsumerID string `json:"consumer_id"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	HermesID string `json:"hermes_id"`
+
+	// example: provider is overcharging
+	Error string `json:"error"`
+
+	// example: 500000
+	Expected *big.Int `json:"expected,omitempty"`
+
+	// example: 900000
+	Actual *big.Int `json:"actual,omitempty"`
+
+	// example: 2019-06-06T11:04:43.910035Z
+	CreatedAt string `json:"created_at"`
+
+	Invoice *InvoiceDTO `json:"invoice,omitempty"`
+
+	ExchangeMessage *ExchangeMessageDTO `json:"exchange_message,omitempty"`
+}
+
+// InvoiceDTO represents invoice sent by provider.
+// swagger:model InvoiceDTO
+type InvoiceDTO struct {
+	// example: 1
+	AgreementID *big.Int `json:"agreement_id"`
+
+	// example: 500000
+	AgreementTotal *big.Int `json:"agreement_total"`
+
+	// example: 0
+	TransactorFee *big.Int `json:"transactor_fee"`
+
+	// example: 528f7a73b0ef7b9d17b8b1fc5ba8bb1ad4f8b1b3f0e6ec80a2f3d4c0d1d5e5a6
+	Hashlock string `json:"hashlock"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	Provider string `json:"provider"`
+
+	// example: 80001
+	ChainID int64 `json:"chain_id"`
+}
+
+// ExchangeMessageDTO represents exchange message sent by consumer.
+// swagger:model ExchangeMessageDTO
+type ExchangeMessageDTO struct {
+	// example: 1
+	AgreementID *big.Int `json:"agreement_id"`
+
+	// example: 500000
+	AgreementTotal *big.Int `json:"agreement_total"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	Provider string `json:"provider"`
+
+	// example: 0x0000000000000000000000000000000000000001
+	HermesID string `json:"hermes_id"`
+
+	// example: 80001
+	ChainID int64 `json:"chain_id"`
+
+	// example: 7e3d...
+	Signature string `json:"signature"`
+
+	Promise PromiseDTO `json:"promise"`
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package contract
+
+// PayoutInfoDTO represents payout address of identity.
+// swagger:model PayoutInfoDTO
+type PayoutInfoDTO struct {
+	// in Ethereum address format
+	// required: true
+	// example: 0x000000000000000000000000000000000000000a
+	EthAddress string `json:"eth_address"`
+}
+
+// ReferralInfoDTO represents referral code of identity.
+// swagger:model ReferralInfoDTO
+type ReferralInfoDTO struct {
+	// required: true
+	// example: ABC123
+	ReferralCode string `json:"referral_code"`
+}
+
+// EmailInfoDTO represents email of identity.
+// swagger:model EmailInfoDTO
+type EmailInfoDTO struct {
+	// required: true
+	Email string `json:"email"`
+}
+
+// PayoutInfoResponse represents payout info of identity.
+// swagger:model PayoutInfoResponse
+type PayoutInfoResponse struct {
+	EthAddress   string `json:"eth_address"`
+	ReferralCode string `json:"referral_code"`
+	Email        string `json:"email"`
+}
@@ -34,6 +34,7 @@ func NewProposalDTO(p market.ServiceProposal) ProposalDTO {
 		ServiceDefinition: NewServiceDefinitionDTO(p.ServiceDefinition),
 		AccessPolicies:    p.AccessPolicies,
 		PaymentMethod:     NewPaymentMethodDTO(p.PaymentMethod),
+		AcceptedHermes:    p.AcceptedHermes,
 	}
 }
 
@@ -102,11 +103,18 @@ type ProposalDTO struct {
 	// Quality of the service
 	Quality *QualityMetricsDTO `json:"quality,omitempty"`
 
+	// Latest local speed test result of the service
+	SpeedTest *SpeedTestResultDTO `json:"speed_test,omitempty"`
+
 	// AccessPolicies
 	AccessPolicies *[]market.AccessPolicy `json:"access_policies,omitempty"`
 
 	// PaymentMethod
 	PaymentMethod PaymentMethodDTO `json:"payment_method"`
+
+	// Hermes provider accepts payments through, any Hermes is accepted when empty
+	// example: ["0x0000000000000000000000000000000000000001"]
+	AcceptedHermes []string `json:"accepted_hermes,omitempty"`
 }
 
 func (p ProposalDTO) String() string {
@@ -130,7 +138,7 @@ type ServiceLocationDTO struct {
 	City string `json:"city,omitempty"`
 
 	// Autonomous System Number
-	// example: 00001
+	// example: 1
 	ASN int `json:"asn"`
 	// example: Telia Lietuva, AB
 	ISP string `json:"isp,omitempty"`
@@ -0,0 +1,328 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mysteriumnetwork/node/core/discovery/proposal"
+	"github.com/mysteriumnetwork/node/core/discovery/reducer"
+	"github.com/mysteriumnetwork/node/market"
+	"github.com/mysteriumnetwork/node/money"
+	"github.com/mysteriumnetwork/node/tequilapi/validation"
+)
+
+// Proposal query sort keys.
+const (
+	ProposalSortQuality     = "quality"
+	ProposalSortPriceGiB    = "price_gib"
+	ProposalSortPriceMinute = "price_minute"
+	ProposalSortLatency     = "latency"
+	ProposalSortCountry     = "country"
+)
+
+const maxProposalQueryLimit = 1000
+
+var proposalSortKeys = []string{ProposalSortQuality, ProposalSortPriceGiB, ProposalSortPriceMinute, ProposalSortLatency, ProposalSortCountry}
+
+// proposalQueryFields copies projected fields of proposal query item.
+var proposalQueryFields = map[string]func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO){
+	"provider_id":      func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO) { dst.ProviderID = src.ProviderID },
+	"service_type":     func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO) { dst.ServiceType = src.ServiceType },
+	"location":         func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO) { dst.Location = src.Location },
+	"payment_method":   func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO) { dst.PaymentMethod = src.PaymentMethod },
+	"price_per_gib":    func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO) { dst.PricePerGiB = src.PricePerGiB },
+	"price_per_minute": func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO) { dst.PricePerMinute = src.PricePerMinute },
+	"quality":          func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO) { dst.Quality = src.Quality },
+	"speed_test":       func(dst *ProposalQueryItemDTO, src ProposalQueryItemDTO) { dst.SpeedTest = src.SpeedTest },
+}
+
+// NewProposalQuery creates proposal query with default values.
+func NewProposalQuery() ProposalQuery {
+	return ProposalQuery{
+		Limit: defaultPageSize,
+	}
+}
+
+// ProposalQuery allows to filter, sort and page proposals.
+// swagger:parameters queryProposals
+type ProposalQuery struct {
+	// Filter expression composed of and, or, not, eq and in conditions over provider_id, service_type, country and location_type fields,
+	// e.g. and(eq(service_type, wireguard), not(in(country, US, GB))). Values containing spaces, commas or parentheses should be double quoted.
+	// in: query
+	Filter string `json:"filter"`
+
+	// Sort key, one of "quality", "price_gib", "price_minute", "latency" or "country". Latency is taken from local speed tests.
+	// Proposals without the value go last. Ties and unsorted queries are ordered by provider ID and service type.
+	// in: query
+	SortBy string `json:"sort_by"`
+
+	// Sort order, "asc" or "desc". Quality is sorted descending and the other keys ascending by default.
+	// in: query
+	Order string `json:"order"`
+
+	// Comma separated list of item fields to return, all fields by default.
+	// Possible values are "provider_id", "service_type", "location", "payment_method", "price_per_gib", "price_per_minute", "quality" and "speed_test".
+	// in: query
+	Fields string `json:"fields"`
+
+	// Maximum number of items per page.
+	// in: query
+	// default: 50
+	Limit int `json:"limit"`
+
+	// Cursor of the page to return, as given in next_cursor of the previous page of the same query.
+	// The page starts after the last proposal of the previous page, so proposals appearing or disappearing in between do not shift it.
+	// in: query
+	Cursor string `json:"cursor"`
+
+	// If set to true, includes proposals failing quality monitoring.
+	// in: query
+	MonitoringFailed bool `json:"monitoring_failed"`
+
+	condition reducer.AndCondition
+	fields    []string
+	after     *ProposalQueryItemDTO
+}
+
+// Bind creates and validates query from API request.
+func (q *ProposalQuery) Bind(request *http.Request) *validation.FieldErrorMap {
+	errs := validation.NewErrorMap()
+
+	qs := request.URL.Query()
+	if qStr := qs.Get("filter"); qStr != "" {
+		q.Filter = qStr
+		condition, err := reducer.Parse(qStr)
+		if err != nil {
+			errs.ForField("filter").Invalid(err.Error())
+		} else {
+			q.condition = condition
+		}
+	}
+	if qStr := qs.Get("sort_by"); qStr != "" {
+		q.SortBy = qStr
+		if !containsString(proposalSortKeys, qStr) {
+			errs.ForField("sort_by").Invalid(fmt.Sprintf("unknown sort key %q", qStr))
+		}
+	}
+	if qStr := qs.Get("order"); qStr != "" {
+		q.Order = qStr
+		if qStr != "asc" && qStr != "desc" {
+			errs.ForField("order").Invalid(`order should be "asc" or "desc"`)
+		}
+	}
+	if qStr := qs.Get("fields"); qStr != "" {
+		q.Fields = qStr
+		for _, field := range strings.Split(qStr, ",") {
+			field = strings.TrimSpace(field)
+			if _, ok := proposalQueryFields[field]; !ok {
+				errs.ForField("fields").Invalid(fmt.Sprintf("unknown field %q", field))
+				continue
+			}
+			q.fields = append(q.fields, field)
+		}
+	}
+	if qStr := qs.Get("limit"); qStr != "" {
+		if qVal, err := parseInt(qStr); err != nil {
+			errs.ForField("limit").Add(err)
+		} else if *qVal < 1 || *qVal > maxProposalQueryLimit {
+			errs.ForField("limit").Invalid(fmt.Sprintf("limit should be between 1 and %d", maxProposalQueryLimit))
+		} else {
+			q.Limit = *qVal
+		}
+	}
+	if qStr := qs.Get("cursor"); qStr != "" {
+		q.Cursor = qStr
+		if after, err := parseProposalQueryCursor(qStr, q.SortBy); err != nil {
+			errs.ForField("cursor").Invalid(err.Error())
+		} else {
+			q.after = after
+		}
+	}
+	q.MonitoringFailed = qs.Get("monitoring_failed") == "true"
+
+	return errs
+}
+
+// ToFilter converts API query to proposal filter.
+func (q *ProposalQuery) ToFilter() *proposal.Filter {
+	return &proposal.Filter{
+		ExcludeUnsupported: true,
+		IncludeFailed:      q.MonitoringFailed,
+		Condition:          q.condition,
+	}
+}
+
+// Descending tells whether proposals should be sorted in descending order.
+func (q *ProposalQuery) Descending() bool {
+	if q.Order == "" {
+		return q.SortBy == ProposalSortQuality
+	}
+	return q.Order == "desc"
+}
+
+// After returns sort key of the last item of the previous page, nil when the first page is requested.
+// Only provider ID, service type and the field query is sorted by are set.
+func (q *ProposalQuery) After() *ProposalQueryItemDTO {
+	return q.after
+}
+
+// Includes tells whether the field is requested in query items.
+func (q *ProposalQuery) Includes(field string) bool {
+	return len(q.fields) == 0 || containsString(q.fields, field)
+}
+
+// Project leaves only fields requested by query in the item.
+func (q *ProposalQuery) Project(item ProposalQueryItemDTO) ProposalQueryItemDTO {
+	if len(q.fields) == 0 {
+		return item
+	}
+	var projected ProposalQueryItemDTO
+	for _, field := range q.fields {
+		proposalQueryFields[field](&projected, item)
+	}
+	return projected
+}
+
+// proposalQueryCursor points after the last item of a page.
+type proposalQueryCursor struct {
+	SortBy string               `json:"sort_by,omitempty"`
+	After  ProposalQueryItemDTO `json:"after"`
+}
+
+// NewProposalQueryCursor returns cursor pointing to the page following the given item of results sorted by the key.
+func NewProposalQueryCursor(sortBy string, last ProposalQueryItemDTO) string {
+	after := ProposalQueryItemDTO{
+		ProviderID:  last.ProviderID,
+		ServiceType: last.ServiceType,
+	}
+	switch sortBy {
+	case ProposalSortQuality:
+		if last.Quality != nil {
+			after.Quality = &QualityMetricsDTO{Quality: last.Quality.Quality}
+		}
+	case ProposalSortPriceGiB:
+		after.PricePerGiB = last.PricePerGiB
+	case ProposalSortPriceMinute:
+		after.PricePerMinute = last.PricePerMinute
+	case ProposalSortLatency:
+		if last.SpeedTest != nil {
+			after.SpeedTest = &SpeedTestResultDTO{Latency: last.SpeedTest.Latency}
+		}
+	case ProposalSortCountry:
+		if last.Location != nil {
+			after.Location = &ServiceLocationDTO{Country: last.Location.Country}
+		}
+	}
+
+	encoded, _ := json.Marshal(proposalQueryCursor{SortBy: sortBy, After: after})
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func parseProposalQueryCursor(cursor, sortBy string) (*ProposalQueryItemDTO, error) {
+	invalid := fmt.Errorf("invalid cursor %q", cursor)
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+	var c proposalQueryCursor
+	if err := json.Unmarshal(decoded, &c); err != nil || c.After.ProviderID == "" {
+		return nil, invalid
+	}
+	if c.SortBy != sortBy {
+		return nil, fmt.Errorf("cursor was issued for a different sort key %q", c.SortBy)
+	}
+	return &c.After, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// NewProposalQueryItemDTO maps to API proposal query item, quality and speed test are left empty.
+func NewProposalQueryItemDTO(p market.ServiceProposal) ProposalQueryItemDTO {
+	item := ProposalQueryItemDTO{
+		ProviderID:  p.ProviderID,
+		ServiceType: p.ServiceType,
+	}
+	if p.ServiceDefinition != nil {
+		location := NewServiceLocationsDTO(p.ServiceDefinition.GetLocation())
+		item.Location = &location
+	}
+	if p.PaymentMethod != nil {
+		paymentMethod := NewPaymentMethodDTO(p.PaymentMethod)
+		currency := p.PaymentMethod.GetPrice().Currency
+		pricePerGiB := money.New(reducer.PricePerGiB(p), currency)
+		pricePerMinute := money.New(reducer.PricePerMinute(p), currency)
+		item.PaymentMethod = &paymentMethod
+		item.PricePerGiB = &pricePerGiB
+		item.PricePerMinute = &pricePerMinute
+	}
+	return item
+}
+
+// ProposalQueryItemDTO holds proposal details, only fields requested by query are present.
+// swagger:model ProposalQueryItemDTO
+type ProposalQueryItemDTO struct {
+	// provider who offers service
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id,omitempty"`
+
+	// type of service provider offers
+	// example: wireguard
+	ServiceType string `json:"service_type,omitempty"`
+
+	Location *ServiceLocationDTO `json:"location,omitempty"`
+
+	PaymentMethod *PaymentMethodDTO `json:"payment_method,omitempty"`
+
+	// price of a GiB of traffic, zero when traffic is not charged
+	PricePerGiB *money.Money `json:"price_per_gib,omitempty"`
+
+	// price of a minute of service, zero when time is not charged
+	PricePerMinute *money.Money `json:"price_per_minute,omitempty"`
+
+	Quality *QualityMetricsDTO `json:"quality,omitempty"`
+
+	// Latest local speed test result of the service
+	SpeedTest *SpeedTestResultDTO `json:"speed_test,omitempty"`
+}
+
+// ProposalQueryResponse holds a page of queried proposals.
+// swagger:model ProposalQueryResponse
+type ProposalQueryResponse struct {
+	Items []ProposalQueryItemDTO `json:"items"`
+
+	// cursor of the next page, omitted on the last page
+	// example: eyJhZnRlciI6eyJwcm92aWRlcl9pZCI6IjB4MSIsInNlcnZpY2VfdHlwZSI6IndpcmVndWFyZCJ9fQ
+	NextCursor string `json:"next_cursor,omitempty"`
+
+	// number of proposals matching the query
+	// example: 240
+	TotalItems int `json:"total_items"`
+}
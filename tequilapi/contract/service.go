@@ -17,7 +17,13 @@
 
 package contract
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/mysteriumnetwork/payments/crypto"
+
+	"github.com/mysteriumnetwork/node/core/pricing"
+)
 
 // ServiceStartRequest request used to start a service.
 // swagger:model ServiceStartRequestDTO
@@ -33,6 +39,7 @@ type ServiceStartRequest struct {
 	Type string `json:"type"`
 
 	// PaymentMethod describes payment options that should be used for service creation.
+	// Prices are in currency set by payments.provider.price-currency when it's configured.
 	// required: false
 	PaymentMethod ServicePaymentMethod `json:"payment_method"`
 
@@ -86,9 +93,49 @@ type ServiceInfoDTO struct {
 
 	Proposal ProposalDTO `json:"proposal"`
 
+	// service price in fiat currency, empty when service is priced in MYST
+	Price *ServicePriceDTO `json:"price,omitempty"`
+
 	ConnectionStatistics ServiceStatisticsDTO `json:"connection_statistics"`
 }
 
+// ServicePriceDTO represents service price set in fiat currency together with its current MYST price.
+// swagger:model ServicePriceDTO
+type ServicePriceDTO struct {
+	// example: USD
+	Currency string `json:"currency"`
+
+	// smoothed price of MYST in currency
+	// example: 0.35
+	ExchangeRate float64 `json:"exchange_rate"`
+
+	// price per GiB in currency
+	// example: 0.05
+	PerGiB float64 `json:"per_gib"`
+
+	// price per minute in currency
+	// example: 0.0001
+	PerMinute float64 `json:"per_minute"`
+
+	// current price per GiB in MYST
+	MystPerGiB *big.Int `json:"myst_per_gib"`
+
+	// current price per minute in MYST
+	MystPerMinute *big.Int `json:"myst_per_minute"`
+}
+
+// NewServicePriceDTO maps to API service price.
+func NewServicePriceDTO(price pricing.Price) *ServicePriceDTO {
+	return &ServicePriceDTO{
+		Currency:      price.Currency,
+		ExchangeRate:  price.Rate,
+		PerGiB:        crypto.BigMystToFloat(price.PerGiB),
+		PerMinute:     crypto.BigMystToFloat(price.PerMinute),
+		MystPerGiB:    price.MystPerGiB,
+		MystPerMinute: price.MystPerMinute,
+	}
+}
+
 // ServiceStatisticsDTO shows the successful and attempted connection count
 type ServiceStatisticsDTO struct {
 	Attempted  int `json:"attempted"`
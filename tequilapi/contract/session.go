@@ -242,7 +242,7 @@ type SessionStatsDTO struct {
 
 // NewSessionDTO maps to API session.
 func NewSessionDTO(se session.History) SessionDTO {
-	return SessionDTO{
+	dto := SessionDTO{
 		ID:              string(se.SessionID),
 		Direction:       se.Direction,
 		ConsumerID:      se.ConsumerID.Address,
@@ -259,6 +259,10 @@ func NewSessionDTO(se session.History) SessionDTO {
 		Status:          se.Status,
 		NodeType:        se.NodeType,
 	}
+	if se.ChannelStats != nil {
+		dto.ChannelStats = NewChannelStatsDTO(*se.ChannelStats)
+	}
+	return dto
 }
 
 // SessionDTO represents the session object.
@@ -309,4 +313,7 @@ type SessionDTO struct {
 
 	// example: residential
 	NodeType string `json:"node_type"`
+
+	// p2p communication channel statistics, available for active sessions only
+	ChannelStats *ChannelStatsDTO `json:"channel_stats,omitempty"`
 }
@@ -0,0 +1,34 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package contract
+
+import "time"
+
+// SessionConnectivityStatusCollection represents list of session connectivity statuses.
+// swagger:model ConnectivityStatus
+type SessionConnectivityStatusCollection struct {
+	Entries []*SessionConnectivityStatus `json:"entries"`
+}
+
+// SessionConnectivityStatus represents connectivity status of session.
+type SessionConnectivityStatus struct {
+	PeerAddress  string    `json:"peer_address"`
+	SessionID    string    `json:"session_id"`
+	Code         uint32    `json:"code"`
+	Message      string    `json:"message"`
+	CreatedAtUTC time.Time `json:"created_at_utc"`
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"time"
+
+	"github.com/mysteriumnetwork/node/core/speedtest"
+)
+
+// NewSpeedTestResultDTO maps to API speed test result.
+func NewSpeedTestResultDTO(r speedtest.Result) SpeedTestResultDTO {
+	return SpeedTestResultDTO{
+		ProviderID:  r.ProviderID,
+		ServiceType: r.ServiceType,
+		SessionID:   r.SessionID,
+		Upload:      r.Upload,
+		Download:    r.Download,
+		Latency:     r.Latency.Milliseconds(),
+		TestedAt:    r.TestedAt.Format(time.RFC3339),
+	}
+}
+
+// NewSpeedTestListResponse maps to API speed test results list.
+func NewSpeedTestListResponse(results []speedtest.Result) SpeedTestListResponse {
+	res := SpeedTestListResponse{Results: []SpeedTestResultDTO{}}
+	for _, r := range results {
+		res.Results = append(res.Results, NewSpeedTestResultDTO(r))
+	}
+	return res
+}
+
+// SpeedTestResultDTO holds throughput measured between consumer and provider over the p2p channel.
+// swagger:model SpeedTestResultDTO
+type SpeedTestResultDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	ProviderID string `json:"provider_id"`
+
+	// example: wireguard
+	ServiceType string `json:"service_type"`
+
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	SessionID string `json:"session_id"`
+
+	// upload throughput in bits per second
+	// example: 20000000
+	Upload uint64 `json:"upload"`
+
+	// download throughput in bits per second
+	// example: 50000000
+	Download uint64 `json:"download"`
+
+	// round trip time in milliseconds
+	// example: 45
+	Latency int64 `json:"latency"`
+
+	// example: 2019-06-06T11:04:43Z
+	TestedAt string `json:"tested_at"`
+}
+
+// SpeedTestListResponse holds speed test results.
+// swagger:model SpeedTestListResponse
+type SpeedTestListResponse struct {
+	Results []SpeedTestResultDTO `json:"results"`
+}
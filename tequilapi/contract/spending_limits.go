@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+import (
+	"math/big"
+
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/session/pingpong/event"
+)
+
+// NewSpendingLimitsDTO maps to API spending limits.
+func NewSpendingLimitsDTO(limits pingpong.SpendingLimits, spentToday, spentThisMonth *big.Int) SpendingLimitsDTO {
+	return SpendingLimitsDTO{
+		PerSession:     limits.PerSession,
+		PerDay:         limits.PerDay,
+		PerMonth:       limits.PerMonth,
+		SpentToday:     spentToday,
+		SpentThisMonth: spentThisMonth,
+	}
+}
+
+// SpendingLimitsDTO holds consumer spending limits, missing limit means unlimited.
+// swagger:model SpendingLimitsDTO
+type SpendingLimitsDTO struct {
+	// example: 1000000000000000000
+	PerSession *big.Int `json:"per_session,omitempty"`
+
+	// example: 2000000000000000000
+	PerDay *big.Int `json:"per_day,omitempty"`
+
+	// example: 30000000000000000000
+	PerMonth *big.Int `json:"per_month,omitempty"`
+
+	// amount spent today (UTC), read only
+	// example: 500000000000000000
+	SpentToday *big.Int `json:"spent_today,omitempty"`
+
+	// amount spent this month (UTC), read only
+	// example: 5000000000000000000
+	SpentThisMonth *big.Int `json:"spent_this_month,omitempty"`
+}
+
+// ToLimits maps API spending limits to spending limits.
+func (dto SpendingLimitsDTO) ToLimits() pingpong.SpendingLimits {
+	return pingpong.SpendingLimits{
+		PerSession: dto.PerSession,
+		PerDay:     dto.PerDay,
+		PerMonth:   dto.PerMonth,
+	}
+}
+
+// NewSpendingLimitAlertDTO maps to API spending limit alert.
+func NewSpendingLimitAlertDTO(e event.AppEventSpendingLimit) SpendingLimitAlertDTO {
+	return SpendingLimitAlertDTO{
+		ConsumerID: e.ConsumerID.Address,
+		SessionID:  e.SessionID,
+		Period:     e.Period,
+		Threshold:  e.Threshold,
+		Spent:      e.Spent,
+		Limit:      e.Limit,
+	}
+}
+
+// SpendingLimitAlertDTO is sent when consumer spending crosses 50, 80 or 100% of a limit.
+// swagger:model SpendingLimitAlertDTO
+type SpendingLimitAlertDTO struct {
+	// example: 0x0000000000000000000000000000000000000001
+	ConsumerID string `json:"consumer_id"`
+
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	SessionID string `json:"session_id"`
+
+	// session, day or month
+	// example: day
+	Period string `json:"period"`
+
+	// crossed share of the limit in percents
+	// example: 80
+	Threshold int `json:"threshold"`
+
+	// example: 1600000000000000000
+	Spent *big.Int `json:"spent"`
+
+	// example: 2000000000000000000
+	Limit *big.Int `json:"limit"`
+}
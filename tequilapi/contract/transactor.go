@@ -156,6 +156,7 @@ func NewSettlementDTO(settlement pingpong.SettlementHistoryEntry) SettlementDTO
 		Amount:         settlement.Amount,
 		SettledAt:      settlement.Time.Format(time.RFC3339),
 		Fees:           settlement.Fees,
+		Trigger:        string(settlement.Trigger),
 	}
 }
 
@@ -185,6 +186,10 @@ type SettlementDTO struct {
 
 	// example: 500000
 	Fees *big.Int `json:"fees"`
+
+	// what caused the settlement: manual, threshold, amount or schedule
+	// example: schedule
+	Trigger string `json:"trigger,omitempty"`
 }
 
 // SettleRequest represents the request to settle hermes promises
@@ -219,3 +224,55 @@ type BeneficiaryTxStatus struct {
 	State beneficiary.SettleState `json:"state"`
 	Error string                  `json:"error"`
 }
+
+// NewSettlementPolicyDTO maps to API settlement policy.
+func NewSettlementPolicyDTO(policy pingpong.SettlementPolicy) SettlementPolicyDTO {
+	dto := SettlementPolicyDTO{
+		Schedule:  string(policy.Schedule),
+		MinAmount: policy.MinAmount,
+		MaxFee:    policy.MaxFee,
+	}
+	if policy.Beneficiary != (common.Address{}) {
+		dto.Beneficiary = policy.Beneficiary.Hex()
+	}
+	if !policy.LastSettledAt.IsZero() {
+		dto.LastSettledAt = policy.LastSettledAt.Format(time.RFC3339)
+	}
+	return dto
+}
+
+// SettlementPolicyDTO describes when provider earnings are settled automatically.
+// swagger:model SettlementPolicyDTO
+type SettlementPolicyDTO struct {
+	// settle on schedule instead of waiting for unsettled amount: daily, weekly or empty
+	// example: daily
+	Schedule string `json:"schedule"`
+
+	// minimum unsettled amount worth settling
+	// example: 1000000000000000000
+	MinAmount *big.Int `json:"min_amount,omitempty"`
+
+	// highest transactor fee scheduled or amount triggered settlement may pay, low balance settlement ignores it
+	// example: 50000000000000000
+	MaxFee *big.Int `json:"max_fee,omitempty"`
+
+	// settle straight to this beneficiary address instead of the default one
+	// example: 0x0000000000000000000000000000000000000001
+	Beneficiary string `json:"beneficiary,omitempty"`
+
+	// example: 2019-06-06T11:04:43Z
+	LastSettledAt string `json:"last_settled_at,omitempty"`
+}
+
+// ToPolicy maps API settlement policy to settlement policy.
+func (dto SettlementPolicyDTO) ToPolicy() pingpong.SettlementPolicy {
+	policy := pingpong.SettlementPolicy{
+		Schedule:  pingpong.SettlementSchedule(dto.Schedule),
+		MinAmount: dto.MinAmount,
+		MaxFee:    dto.MaxFee,
+	}
+	if dto.Beneficiary != "" {
+		policy.Beneficiary = common.HexToAddress(dto.Beneficiary)
+	}
+	return policy
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package contract
+
+// WebhookDTO describes webhook notified about node events. Signing secret is only returned on creation.
+// swagger:model WebhookDTO
+type WebhookDTO struct {
+	// example: 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+	ID string `json:"id"`
+
+	// example: https://example.com/mysterium/events
+	URL string `json:"url"`
+
+	// any of connection-state, balance, settlement, settlement-failed, registration, service-status, nat-failure
+	// example: ["settlement-failed","service-status"]
+	Topics []string `json:"topics"`
+
+	// example: 2020-10-01T11:04:43Z
+	CreatedAt string `json:"created_at"`
+}
+
+// WebhookListResponse lists webhooks.
+// swagger:model WebhookListResponse
+type WebhookListResponse struct {
+	Webhooks []WebhookDTO `json:"webhooks"`
+}
+
+// CreateWebhookRequest request used to create webhook.
+// swagger:model CreateWebhookRequest
+type CreateWebhookRequest struct {
+	// HTTP or HTTPS URL notifications are posted to
+	// example: https://example.com/mysterium/events
+	URL string `json:"url"`
+
+	// any of connection-state, balance, settlement, settlement-failed, registration, service-status, nat-failure
+	// example: ["settlement-failed","service-status"]
+	Topics []string `json:"topics"`
+
+	// optional secret signing notifications, generated if omitted
+	// example: 2b7e151628aed2a6abf7158809cf4f3c
+	Secret string `json:"secret,omitempty"`
+}
+
+// CreateWebhookResponse returns created webhook along with its signing secret.
+// swagger:model CreateWebhookResponse
+type CreateWebhookResponse struct {
+	WebhookDTO
+
+	// secret signing notifications, it can not be retrieved later
+	// example: 2b7e151628aed2a6abf7158809cf4f3c
+	Secret string `json:"secret"`
+}
+
+// WebhookDeliveryDTO describes delivery of a notification to webhook.
+// swagger:model WebhookDeliveryDTO
+type WebhookDeliveryDTO struct {
+	// example: 1b4e28ba-2fa1-11d2-883f-0016d3cca427
+	ID string `json:"id"`
+
+	// ID of the notification, same for all webhooks notified about the event
+	// example: 4cfb0324-daf6-4ad8-448b-e61fe0a1f918
+	NotificationID string `json:"notification_id"`
+
+	// example: settlement-failed
+	Topic string `json:"topic"`
+
+	// one of pending, delivered, failed
+	// example: failed
+	Status string `json:"status"`
+
+	// example: unexpected response status 503 Service Unavailable
+	Error string `json:"error,omitempty"`
+
+	// example: 2020-10-01T11:04:43Z
+	CreatedAt string `json:"created_at"`
+
+	// example: 2020-10-01T11:05:03Z
+	NextAttemptAt string `json:"next_attempt_at,omitempty"`
+
+	Attempts []WebhookDeliveryAttemptDTO `json:"attempts"`
+}
+
+// WebhookDeliveryAttemptDTO describes a single attempt to deliver notification.
+// swagger:model WebhookDeliveryAttemptDTO
+type WebhookDeliveryAttemptDTO struct {
+	// example: 2020-10-01T11:04:43Z
+	Time string `json:"time"`
+
+	// HTTP status of the response, omitted when no response was received
+	// example: 503
+	StatusCode int `json:"status_code,omitempty"`
+
+	// example: unexpected response status 503 Service Unavailable
+	Error string `json:"error,omitempty"`
+
+	// example: 120
+	DurationMS int64 `json:"duration_ms"`
+}
+
+// WebhookDeliveryListResponse lists most recent deliveries to webhook, latest first.
+// swagger:model WebhookDeliveryListResponse
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDeliveryDTO `json:"deliveries"`
+}
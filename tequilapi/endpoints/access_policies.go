@@ -22,26 +22,10 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/mysteriumnetwork/node/requests"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
 	"github.com/mysteriumnetwork/node/tequilapi/utils"
 )
 
-// swagger:model AccessPolicies
-type accessPolicyCollection struct {
-	Entries []accessPolicy `json:"entries"`
-}
-
-type accessPolicy struct {
-	ID          string       `json:"id"`
-	Title       string       `json:"title"`
-	Description string       `json:"description"`
-	Allow       []accessRule `json:"allow"`
-}
-
-type accessRule struct {
-	Type  string `json:"type"`
-	Value string `json:"value"`
-}
-
 type accessPoliciesEndpoint struct {
 	httpClient              *requests.HTTPClient
 	accessPolicyEndpointURL string
@@ -74,7 +58,7 @@ func (ape *accessPoliciesEndpoint) List(resp http.ResponseWriter, req *http.Requ
 		utils.SendError(resp, err, http.StatusInternalServerError)
 		return
 	}
-	r := accessPolicyCollection{}
+	r := contract.AccessPolicies{}
 	err = ape.httpClient.DoRequestAndParseResponse(req, &r)
 	if err != nil {
 		utils.SendError(resp, err, http.StatusInternalServerError)
@@ -19,26 +19,26 @@ var DocsAssets = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2021, 3, 17, 11, 47, 4, 852409961, time.UTC),
+			modTime: time.Date(2026, 10, 18, 18, 50, 55, 648186649, time.UTC),
 		},
 		"/index.html": &vfsgen۰CompressedFileInfo{
 			name:             "index.html",
-			modTime:          time.Date(2021, 2, 18, 6, 56, 37, 203817777, time.UTC),
+			modTime:          time.Date(2026, 10, 18, 16, 29, 20, 509645347, time.UTC),
 			uncompressedSize: 642,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x92\xc1\x8e\xd4\x30\x0c\x86\xef\xf3\x14\xde\x5c\xb8\x4c\x9b\x22\x90\x40\x43\x5b\x40\x2c\x07\x0e\x88\xd5\x6a\x2f\x1c\x33\x89\xdb\x66\x48\x9d\x10\xbb\x33\x8c\x80\x77\x47\x6d\x87\xd9\x5d\x5f\x62\x7f\xb6\x7f\x39\x4e\xea\x9b\xdb\x6f\x9f\x1e\xbe\xdf\x7d\x86\x41\xc6\xd0\x6e\xea\xff\x07\x1a\xd7\x6e\x00\x00\x6a\xf1\x12\xb0\x7d\xc0\x9f\x93\x0f\x06\x3e\xde\x7d\xa9\xf5\x8a\xd6\xf4\x4d\x51\x00\x21\x3a\x74\xd0\xc5\x0c\xc6\x99\x24\xfe\x88\xe0\x90\x7d\x4f\x50\x14\x97\xba\x11\xc5\x80\x1d\x4c\x66\x94\x46\x4d\xd2\x15\x6f\x95\x7e\x9a\x23\x33\x62\xa3\x8e\x1e\x4f\x29\x66\x51\x60\x23\x09\x92\x34\xea\xe4\x9d\x0c\x8d\xc3\xa3\xb7\x58\x2c\xc1\x16\x3c\x79\xf1\x26\x14\x6c\x4d\xc0\xe6\xa5\xba\x08\x05\x4f\x3f\x60\xc8\xd8\x35\x6a\x10\x49\xbc\xd3\xba\x8b\x24\x5c\xf6\x31\xf6\x01\x4d\xf2\x5c\xda\x38\x6a\xcb\xfc\xbe\x33\xa3\x0f\xe7\xe6\xeb\x9c\xc7\x9c\x8d\xec\x5e\x55\xd5\xf6\x75\x55\x6d\xdf\x54\xd5\x9f\xfb\xb8\x8f\x12\x9f\x22\x05\x19\x43\xa3\x58\xce\x01\x79\x40\x14\xd5\x6e\xae\x2b\x58\x9c\x7b\xbc\x8d\x16\x5c\x44\xa6\x17\x32\xdf\x95\x7a\x84\x38\x09\x66\x48\xa6\x47\x58\x5b\x97\xd2\xeb\x5a\x16\xb6\xfa\xb3\xed\xa3\x3b\xc3\xef\x6b\x38\xdb\x68\x72\xef\x69\x07\xd5\xbb\x67\x38\x19\xe7\x3c\xf5\xcf\xf8\xdf\x55\x53\x5f\x44\x6b\xbd\x3e\x63\x3d\xab\xb6\x9b\x3a\xa3\x8b\x16\x38\xa1\x2d\xa6\x1c\x1a\x55\x6a\x3e\x99\xbe\xc7\x5c\x1e\x38\x92\x6a\x6b\xbd\x54\xb4\x9b\x9a\x6d\xf6\x49\x80\xb3\x7d\x5c\xa4\x75\x54\x1e\xd8\x61\xf0\xc7\x5c\x12\x8a\xa6\x34\xae\x0d\x1f\x08\x7f\x89\xde\x4f\xe4\x02\xf2\x8a\x4a\x16\x43\xce\x84\x48\x58\x1e\x58\xb5\xf3\x50\x8b\xe6\x3c\xd5\x65\x1c\xbd\xfc\xb5\x7f\x01\x00\x00\xff\xff\xf9\x93\x7d\x58\x82\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\x41\x8f\xd4\x3c\x0c\x86\xef\xfd\x15\xde\x5c\xbe\xcb\xb4\xe9\x27\x90\x40\x43\x1a\x40\x2c\x07\x0e\x88\xd5\x6a\x2f\x1c\x33\x89\xdb\x66\x48\x9d\x90\xb8\xb3\x8c\x80\xff\x8e\xda\x0e\xc3\xae\x12\x29\xf6\x63\xfb\x55\xf2\x46\xdd\xdc\x7e\xf9\xf0\xf0\xf5\xee\x23\x8c\x3c\x05\x5d\xa9\xbf\x07\x1a\xa7\x2b\x00\x00\xc5\x9e\x03\xea\x07\xfc\x3e\xfb\x60\xe0\xfd\xdd\x27\x25\x37\xb4\x95\x6f\xea\x1a\x08\xd1\xa1\x83\x3e\x66\x30\xce\x24\xf6\x27\x04\x87\xc5\x0f\x04\x75\x7d\xe9\x9b\x90\x0d\xd8\xd1\xe4\x82\xdc\x89\x99\xfb\xfa\xb5\x90\x4f\x6b\x64\x26\xec\xc4\xc9\xe3\x63\x8a\x99\x05\xd8\x48\x8c\xc4\x9d\x78\xf4\x8e\xc7\xce\xe1\xc9\x5b\xac\xd7\x64\x07\x9e\x3c\x7b\x13\xea\x62\x4d\xc0\xee\x7f\x71\x11\x0a\x9e\xbe\xc1\x98\xb1\xef\xc4\xc8\x9c\xca\x5e\xca\x3e\x12\x97\x66\x88\x71\x08\x68\x92\x2f\x8d\x8d\x93\xb4\xa5\xbc\xed\xcd\xe4\xc3\xb9\xfb\xbc\xd4\x31\x67\xc3\xfb\x17\x6d\xbb\x7b\xd9\xb6\xbb\x57\x6d\xfb\xeb\x3e\x1e\x22\xc7\xa7\x48\x40\xc6\xd0\x89\xc2\xe7\x80\x65\x44\x64\xa1\xab\xab\x05\x6b\x70\x8f\xb7\xd1\x82\x8b\x58\xe8\x3f\x5e\xde\x4a\x03\x42\x9c\x19\x33\x24\x33\x20\x6c\xa3\x6b\xeb\xd5\x96\x95\x6d\xf1\xb2\x0e\xd1\x9d\xe1\xe7\x35\x5d\xf6\x64\xf2\xe0\x69\x0f\xed\x9b\x67\x38\x19\xe7\x3c\x0d\xcf\xf8\xef\x35\x52\xf2\x22\xaa\xe4\xf6\x8d\x6a\x51\xd5\x95\xca\xe8\xa2\x85\x92\xd0\xd6\x73\x0e\x9d\x68\x64\x4c\x48\x26\xf9\xe6\x58\x22\x09\xad\xe4\xda\xa1\x2b\x55\x6c\xf6\x89\xa1\x64\xfb\xcf\x48\xeb\xa8\x39\x16\x87\xc1\x9f\x72\x43\xc8\x92\xd2\xb4\x0d\xbc\x23\xfc\xc1\xf2\x30\x93\x0b\x58\x36\xd4\x14\x36\xe4\x4c\x88\x84\xcd\xb1\x08\x0d\x4a\x6e\x9a\xba\x52\xf2\x72\x1d\x39\xf2\x14\xf4\x9f\x01\x00\x17\xa2\x3b\x29\x82\x02\x00\x00"),
 		},
-		"/swagger.json": &vfsgen۰CompressedFileInfo{
-			name:             "swagger.json",
-			modTime:          time.Date(2021, 4, 7, 8, 29, 53, 17197368, time.UTC),
-			uncompressedSize: 116611,
+		"/openapi.json": &vfsgen۰CompressedFileInfo{
+			name:             "openapi.json",
+			modTime:          time.Date(2026, 10, 18, 19, 12, 6, 388262186, time.UTC),
+			uncompressedSize: 214766,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\x1b\xb9\x11\xe0\xf7\xfd\x15\x28\xdd\x55\x65\xf7\xca\xa2\x48\x3d\x2d\xdf\x27\xad\x64\xef\x32\x59\xc9\x8a\x24\xef\x5d\xe5\x9c\x62\x81\x33\x18\x12\xd1\x70\x30\x0b\x60\x24\x73\x53\xfb\xdf\xaf\xf0\x1a\xce\x03\xf3\x1e\x92\x92\xcc\x54\x25\xb1\x4d\xa0\xa7\xd1\x2f\x34\x1a\xdd\x8d\xff\xfe\x00\xc0\x9e\x43\x02\x16\x2d\x10\xdb\xfb\x00\xfe\xdf\x0f\x00\x00\xb0\x07\xc3\xd0\xc7\x0e\xe4\x98\x04\x07\xff\x61\x24\xd8\xfb\x01\x80\x7f\xbf\x13\x63\x43\x4a\xdc\xc8\xa9\x37\x96\x3d\xc3\xd9\x0c\xd1\xbd\x0f\x60\xef\x70\x30\xdc\x93\xff\x86\x03\x8f\xec\x7d\x00\xff\x55\x73\x5d\xc4\x1c\x8a\x43\x31\x57\x8c\x7a\x98\x23\x10\x46\x34\x24\x0c\x01\xe2\x01\x3e\xc7\x0c\xb8\xc4\x89\x16\x28\xe0\xf2\x03\x00\x33\xc0\x09\x08\x29\x79\xc2\x2e\x02\x2e\x7a\x42\x3e\x09\x11\x65\x00\x06\x00\x07\x0c\xcf\xe6\x5c\xcc\x9c\x93\x67\xc0\xc9\xd7\x00\x07\x1c\x51\xe8\x70\xf0\x8c\xf9\x1c\x5c\x2f\x19\x47\x14\x47\x0b\x70\x43\x5c\x04\x9e\x30\x04\x0f\xe8\x8f\x08\xfb\x10\x5c\xdc\x8e\x07\x5f\x83\x07\xf1\x41\x36\x27\x91\xef\x02\x17\x2d\x48\xc0\x38\x85\x1c\x01\xe8\xfb\x80\x0b\xdc\x08\x63\x78\xea\x23\x31\x1c\x38\xd0\xf7\x99\x02\xac\x96\x31\x45\x2e\x08\x21\x85\x0b\xc4\x15\x46\x2e\xa0\x88\x85\x24\x60\x88\x0d\xe4\xea\x01\xd8\xe3\x98\xfb\x48\xae\x75\xf5\x65\xf3\xdb\x13\xa2\x4c\x53\xc2\x45\x4f\x82\x8e\x7f\x49\x9a\xcd\x09\xe3\xe2\x1f\x47\x87\x67\x83\xe1\x60\x38\x18\x7d\x38\x1e\x9e\x68\x7a\x86\x90\xcf\xd9\x8a\xa0\x07\xd0\x71\x10\x63\xfb\x21\xf1\xb1\x83\xd1\xea\x17\x00\xf6\x66\x88\x27\xfe\x9a\xa7\xfe\x1d\xe2\x11\x0d\x18\xf0\x31\x93\x44\x54\xa0\x40\x0c\xea\xdd\x6a\x26\x8b\x16\x0b\x48\x97\xc9\x59\x25\xa3\x05\x87\x24\xfb\xc6\xae\x98\x71\x21\x47\xde\x5a\x06\xc6\xe4\x4a\xe1\x09\xc0\xde\xe1\x70\x98\xf9\xa7\x3c\xfa\xbf\x55\xa2\xad\x50\x77\xe6\x68\x01\x73\xd0\x00\xd8\xfb\x9f\x14\x79\x02\xd0\xff\x38\x70\x91\x87\x03\x2c\x00\xb3\x83\x0c\xb6\xa9\x49\x7f\x25\xfe\xf6\x57\xf2\x3b\x7b\x27\x35\x10\x1e\x0b\xe1\x0c\xa0\x0f\x18\xa2\x4f\x88\x02\x44\x29\xa1\x5d\xd1\xfd\x28\x80\x5c\x23\xc6\xe0\x0c\x5d\x3d\x7c\x2e\xc1\xf7\x87\xec\x9f\xd4\xff\xeb\x75\xec\x1d\xc0\x88\xcf\xe5\xff\xa0\x80\x0b\xfd\x46\x49\x59\x0a\x95\x48\x16\x0b\xd3\x45\x62\x22\x03\x11\x43\x54\x2a\x04\x66\x2c\x42\x0c\x08\xb0\x80\x93\x47\x14\x24\xd9\xcf\xe1\x6c\x65\x57\xf4\xbf\x25\xe0\x60\x65\x5b\xd4\x7f\xfe\x6d\x97\xc6\xe4\x67\xcb\x44\xb0\x60\xd8\x4a\x7d\x33\x78\x64\x38\x19\xc0\x85\x54\xe2\x29\x71\x97\x59\x8e\xe1\xa0\xe8\x97\xc6\xa2\x17\xf1\xf9\x1d\xfa\x23\x42\x8c\xd7\xe1\xe3\xbf\xfb\xd2\xa4\x34\xcd\x01\x8b\x1c\x07\x21\x17\xb9\x7b\x85\xe2\x7e\x5c\x03\xea\xcf\xc4\x5d\x0a\xfb\xc8\x70\x30\xdb\xb4\xac\x67\x90\x1d\x35\x25\x81\x07\xb1\x8f\xdc\x2d\xe1\xfb\x56\x6c\x89\x4f\x66\x52\x37\x3a\x1b\x11\x86\x38\x03\x0e\x21\x8f\x18\xa9\xfd\x57\x5a\x15\x77\x7d\x56\xe5\x37\x89\x79\xb1\x39\xc9\xfd\xbe\xb3\x23\x3b\x3b\xb2\xb3\x23\xeb\xb3\x23\x24\x4a\x1a\x8e\x3d\x17\xf9\x28\xe5\x9f\xe4\x17\x76\xe9\x23\x48\x95\xe3\x91\xe0\x87\x32\x22\xfd\x5b\x0b\x81\x5f\xa9\xb9\xc8\x0c\xe8\xe6\xf8\x92\xd9\x0c\xb9\x80\x44\x5c\xa9\x18\x63\x5e\xe4\xfb\xcb\xbd\xc6\x84\x0d\x21\x63\xcf\x84\xba\x29\x1b\x1d\x95\x9b\xe8\xcb\x39\x0c\x66\xc6\x38\xc7\x00\xfa\x25\xa8\xfa\x86\x15\x7a\x86\xb2\x8e\x1c\x79\x6b\x19\xf8\xb2\x2c\xf2\x65\x0a\xcf\x6d\xd8\x66\xf3\x6d\xa0\x48\xe6\x16\x8b\xce\xdb\x36\xd0\x5f\x02\x21\xfa\x84\xe2\x3f\x37\x69\x97\xab\xd4\xd1\x21\x81\x87\x67\x6d\x0e\xef\x2e\xf2\x60\xe4\x73\xa0\x20\x44\x4a\x39\xaa\xf4\xf1\x32\x35\xb8\x4a\x1d\xcd\xa7\x9c\x88\x52\x14\x64\x3e\x05\x9e\xa0\x1f\x95\x1e\xff\x67\x88\xab\xef\xf5\x66\x00\x2f\x15\x22\xfe\x12\x40\x87\xe3\x27\x54\xb8\xf8\x56\x5c\x55\xc0\x6e\xe1\xd2\x27\xd0\xfd\x8e\xf6\x5a\xb5\xee\x03\x2d\x50\x2f\x5c\x16\x2b\x3f\x95\x17\xc2\x2b\x35\xa5\x67\x59\xbc\xb2\x21\x92\x57\x8a\x56\xbc\xfd\xce\x25\x51\xb8\x18\x6d\xc4\xd0\xd8\x29\xe9\xa2\x6c\xd0\x2e\x96\x7f\x2f\x2f\x90\x5f\x18\xa2\x3d\x4b\xa3\x00\x29\x8e\xcb\x3b\x71\xec\x24\x8e\xef\x62\x6f\xb8\x2a\x62\xf1\x89\x50\xf0\x88\x96\x0c\x84\x14\x31\x21\x04\x38\x50\x37\x07\x8a\x4a\xef\x00\xe6\xe0\x19\xfb\xbe\x64\x0a\xa1\x80\xa2\x05\x79\x42\x72\x48\x42\x5c\x34\x83\xc0\x8f\xd8\x93\x3f\x3d\xa2\x25\xc0\x0c\x04\x91\xef\xff\x34\x00\xc6\xe5\x86\x14\x81\x10\x51\x86\x19\x47\x2e\xe0\x44\x0e\xd5\x00\x3c\xec\xa3\x41\xbf\xe2\x7d\x8f\xb8\xb9\xd4\x50\x72\xde\x48\xbe\x19\xa2\x76\xf9\xae\xeb\x98\x67\x08\x9d\x16\x68\x41\xf2\x03\xbb\x54\x6f\xc6\xa1\xaf\xab\x0b\xfd\x3b\xf2\x5f\xca\xb8\xb0\xd6\xb5\xbc\x7e\xbd\xce\x6d\x33\x01\x72\x34\xb2\x8d\x77\x19\xc6\x21\x8f\x18\x20\x5e\xd2\x2f\x36\xf0\xaa\x15\xd1\x8c\xac\xbd\xc9\xc4\x53\xf4\x97\xcb\x4e\xc5\xf1\xd8\xfb\xdc\xd0\x4e\xb2\x97\x03\xd7\x8a\x83\xab\xd5\x8f\x03\x8f\xbc\xf5\x80\xd6\x6a\x2f\xa9\x8a\xac\xa8\x5b\x79\x0a\x48\x88\xd2\x0c\x5f\xdd\x80\xd3\x1e\x25\xeb\x9e\x43\xca\x19\x08\xd0\x73\x81\xe4\x16\x4a\xd5\x25\x45\x2d\xef\xd1\x72\x41\x89\xf8\xf6\x1c\x07\x40\xd8\x65\xf0\xa3\x4e\x4e\xa0\x13\xec\xbe\x8b\x97\x2d\xff\x22\x38\x8a\x1d\x34\xe1\xcb\x10\xfd\x04\x28\xfa\x23\xc2\x14\xb9\xc0\x23\x14\x38\x02\x23\x1c\xcc\x8a\x57\x03\x36\x18\xec\xc9\x10\x4a\x87\x7b\x6a\x0a\x4e\xcd\x8d\xa2\x3a\xd2\x71\x99\xb2\x18\x94\x77\x8f\x77\xb4\x56\xdc\x5a\x51\x24\xe8\x4a\x9e\x22\xc6\xb7\x16\x3e\x3a\xaf\x43\x54\xcf\xc7\x0e\x1f\x80\x04\x79\xa1\x4f\x11\x74\x97\x00\x7d\xc3\x8c\x77\x36\x8f\x6d\xb1\x3f\x3c\xac\x11\x04\x8c\xf5\xed\x09\xfa\xd8\x55\xce\x54\x2f\x26\xf2\xf7\x18\x9e\xc4\xbf\x09\xe2\xe7\xb5\xc8\x6e\x88\xfd\x0c\x19\x70\x60\xe0\x20\x7f\x77\xb3\xd2\x6e\x23\xaa\x71\x7b\x72\xcf\x49\xc8\xd6\xeb\xd8\xe8\x4f\x34\xdc\x79\x24\xe3\x6b\xfa\x33\xd5\xfa\x90\x10\x2b\x81\x4e\x58\x7e\x55\xd9\xc4\x3a\xdc\x90\xe4\x06\xbe\x55\xc3\xf0\x2a\x85\xb5\xc8\x53\x3f\xc0\x61\x97\x90\x50\x18\x4d\x7d\xec\x80\xf1\x2d\x80\xae\x4b\x11\x63\x6b\xf0\xd4\xed\xc0\xad\x41\x71\xb3\x9b\xde\xf6\xe6\xa1\xdf\x96\x2c\xb0\x15\x13\xc7\xb7\x6f\x4b\xd4\x32\xf8\x1e\x55\x9f\x79\x94\xd7\x09\xa2\x00\x3e\x41\xec\xc3\xa9\x8f\x5e\xa4\x62\xf8\x44\xdf\xae\xb6\x51\x8f\x95\xa9\x32\x60\xd6\xa1\x19\x96\xcf\xd4\x55\x91\xdf\x2c\xe3\xbb\xdd\x21\x95\x2f\xb9\x15\x5f\x0d\x92\xdf\xa9\x04\x32\x0e\x39\x66\x1c\x3b\xad\xb2\x90\x57\xb3\x01\x9c\x92\x88\x6f\x25\xac\xa2\xd1\xaf\x1b\x5a\xc9\x0f\xef\x4b\x26\xad\xc8\xb4\x62\xf5\xa5\x05\xe3\xb7\x65\xd2\xab\x44\x14\x7d\x53\x39\x0f\x07\x8b\x25\xe3\x07\xff\x55\x72\xe5\x2c\xff\x6a\x23\xa5\x7c\x8e\x80\x00\x03\x42\x2a\x54\x52\x47\xfb\x67\xf8\x09\x05\xc0\x00\x06\x3f\xba\x10\x03\xcc\x80\x8b\x42\x8a\x1c\xc8\x91\xfb\x53\x95\xe8\x7e\xd4\x38\xd6\x16\xdc\x3a\x88\x94\xc8\xb1\xf9\xde\xf5\x32\x75\xdc\xae\x1d\xc8\xe1\xcb\x50\x46\x52\x18\xa7\x38\x98\x65\x79\x6d\xbd\xaf\x77\x96\x80\x13\xf0\x3c\xc7\xce\x5c\x61\x8e\xa5\xfa\x3d\x21\x5b\x68\xc2\x44\x6a\x2c\x4b\x01\xab\x68\x4d\x08\xf9\x3c\xfb\x8b\x89\x0b\xed\x7d\x00\x9c\x46\xc8\x2a\x28\xbd\x05\xe3\xaf\x53\x1c\x28\xa4\x3e\x68\xa5\xb8\x1a\x8e\x61\xd5\xf7\xa5\xb4\x1e\x42\xee\x14\x3a\x8f\x07\x32\xd7\xb7\x51\x16\xf1\x1d\x0a\x09\xe5\xfa\xbe\x4a\x4d\xaf\xd0\xbe\x4f\xfa\x63\x35\xb4\xaf\x0c\x74\x46\xc7\xa8\x1c\x3c\xce\x8e\x6a\x19\x2b\x55\x9f\x56\x1f\x2d\x0a\x94\x6d\x26\xbe\x79\xb7\x5a\xd7\x36\x32\xd9\xc6\x9a\x02\x02\x89\xee\x11\xa0\xc4\x62\xee\x55\x46\xdc\x0b\x0d\x6a\x26\x10\x95\x5a\x56\x3f\x2e\x58\x1d\xb7\x78\x20\x04\x2c\x60\xb0\x34\xb8\x32\xf0\xe3\x02\x7e\x1b\x80\xd1\xc1\x02\x07\x11\x47\x3f\x6d\x0b\xf9\xad\x19\xb2\x06\x08\x57\x59\xb2\x39\x82\x3e\x9f\x3b\x73\xe4\x3c\xb6\xf1\x38\xd4\x74\x20\xe7\x03\x1c\x78\x84\x2e\x54\xd8\x56\x7b\xc9\x3e\x46\x01\xaf\xf4\x8c\xd5\xa8\xba\xce\x45\x9d\xcf\x64\x8c\x9d\x42\xf3\x52\xae\xb2\x2f\x4d\xff\xb5\x60\xe9\x5d\x99\xfb\xeb\x0a\xd7\xef\x6b\x53\xc5\x2e\x0a\x38\xe6\x1d\xeb\x44\x13\x50\x2a\xc4\x6e\xac\x46\x2e\xeb\x0b\x9e\x15\x74\x46\xd4\x04\x1e\x63\xeb\xc0\x5e\xea\x49\xad\x38\xb4\x62\xdc\x6f\x29\x44\xef\x34\x72\x6f\x5a\xde\xea\x67\x4e\xa9\xbb\xd1\x98\xe5\x4b\x55\xe9\xc5\x09\x45\xf2\x32\xf8\x11\x2d\xe5\x5f\x00\x0a\x1c\xba\x0c\x39\x72\x55\xe9\x57\x08\x19\x0b\xe7\x14\xb2\x4a\xa7\xae\xb6\xf0\x19\x4c\x02\xf4\x1c\x63\x53\x16\x02\x90\xc3\xc7\x96\x81\x5d\x6f\xc1\x57\x97\xe0\xab\x55\x96\x5d\x74\x5b\x90\x05\x9b\x73\x03\x0d\x05\xd6\x78\xc9\x5d\x43\x01\x8c\xf4\x28\xae\x74\x76\x07\x0d\xbc\x3b\xe4\xad\xe1\x7e\xfb\x6e\xbb\xf7\xdb\xaf\xf5\x86\xf8\x55\x5a\xc2\xa2\x9d\x77\x1f\x2f\x84\x6b\xd9\xe8\x44\x3b\x5e\xa8\x63\x27\xd4\x21\x86\xd8\x66\xaa\xd4\x4c\x61\x0f\xb0\x8c\xa7\x40\x30\xf5\xc9\x54\xf6\xd2\x40\xdf\xb8\x0e\xb6\x38\x30\x00\x3e\x14\xf6\x65\x2a\xb3\x4e\x65\xee\xa8\xc2\x42\x4c\x13\x07\xdf\xca\xd4\xd1\xc4\x7e\x5b\x65\x4e\x8b\x90\x1d\x94\xd8\x54\x85\xcd\x5a\x6d\x6a\x66\xe1\xd0\x8e\x18\xd8\xbc\xfd\x54\xf4\xda\xc6\x49\xfa\x4b\xe0\x13\xe7\x11\xb9\x59\x79\x7a\x25\x66\x74\xcb\x57\x87\x6f\xc8\x26\x1d\xe8\xbb\x96\x94\x51\xaa\x48\x57\x7c\xa0\x18\xc9\xe6\x3c\x14\x71\x8a\x91\x4e\x6a\xf7\x21\xe3\x4a\xd5\x8c\x4c\xbd\x93\xff\xee\x61\xca\x78\xe2\xdf\x8c\x2b\x83\xd2\x29\xe6\xb0\xd0\x09\xeb\xe5\x78\xb1\x58\xc6\xb7\x4a\x75\x1c\x3d\x35\x74\xe7\xe9\xc5\x9e\x9e\x22\xc8\x96\x5c\xbd\x57\x6e\xad\x76\x4e\xdf\x77\xec\xf4\x1d\xfc\x17\xbb\x8d\xee\x1b\x6f\x75\xc7\xb3\x58\xd6\x5d\xc4\x21\xf6\xfb\x8b\xb8\xfc\x82\x6a\xd9\xc0\x19\xea\x64\xff\x1a\x5d\x13\xce\xd1\x37\x93\xb5\x94\x88\xc3\x14\xda\x3c\x9c\xd3\xfb\x17\x72\x29\x38\x4e\xd8\x27\xb9\x33\x6e\xc9\x40\xbd\x3d\x0d\x3a\x98\xa2\x00\x79\xd8\xc1\x4a\x88\x9b\x6a\x13\x03\x89\xf9\xb1\xa8\x89\xcd\x36\x7d\x52\xa9\xad\x64\x29\x72\x27\x71\xab\xd2\xbe\x9c\x7a\x5b\x10\x2b\xd1\x4a\xcb\x88\x9d\x46\x96\xac\xe3\xe7\x04\x75\x7b\x57\xca\x04\x70\x13\xe2\xfd\xbe\x95\x14\x2d\x20\xf6\x9b\x1c\x25\xee\xd0\x0c\x33\xe9\x7e\xc8\xa9\x52\x23\xd7\x70\x06\x48\x7d\x24\x84\x4b\x12\xf1\x09\x47\x8c\x0f\x66\xa4\x44\xd7\xa2\xd0\x85\x1c\x7d\x94\x6b\x5a\xb7\xbe\xc5\x3b\x87\x0c\x7d\xbb\xc9\x38\xf8\x9a\x75\xee\x5d\x97\x33\x8c\x24\xe8\x4f\x00\xb3\xf8\x04\xb3\x9d\x73\x8a\x64\x52\x75\x69\x52\xff\xf6\x45\x7e\x18\x28\x49\xe9\xda\xed\x6c\xeb\x65\x50\x6f\xd0\x22\x85\x70\xb9\x40\x01\xdf\x27\xd4\x6d\xd6\x52\xe2\x17\x59\x03\xef\xfb\x40\xce\x54\xbe\x42\x2e\x08\xeb\x51\xb2\x50\x45\xff\xd8\x7f\x5a\x72\xcc\x4c\xa5\x62\x95\xed\xfa\x2c\xd1\xa9\xe3\xa9\x67\x50\xa8\xe7\xb8\x4b\xf0\xeb\x77\x12\x62\xa3\x25\x30\x53\x11\x67\x4e\xc0\x4c\xf6\x3b\xc8\x20\x00\x5e\x85\xb7\x70\x41\x29\x5c\x0a\x7f\x47\xe2\x0f\xc8\xf4\x3f\xc8\x69\x52\xbd\x63\xa8\x07\x05\x9c\xcc\x34\xb1\x4a\x8e\x16\x59\xbc\xf4\x4f\x76\xbd\x90\x7c\xb4\xdf\x1e\xa7\xf5\xe2\xcd\x69\x75\xfd\xdb\xe4\x07\xf8\x88\x58\x22\x05\xd6\x85\x1c\xca\xc8\x22\x37\x61\x4a\x15\x6e\xd4\x11\x46\x6d\x10\x34\x87\x4d\xcf\x8e\x8c\xfa\x56\x5e\x8a\xd4\xd3\x5f\x75\x3f\xaa\x3e\x55\x79\xad\xfc\x39\x3b\x6a\x83\x2a\x6b\x28\x14\xe4\x70\x05\x5b\xf5\x38\xee\x4c\x58\x54\x32\x35\xcb\xca\x52\x5c\xd7\xeb\x6f\x68\xc5\xdc\xfc\xc5\xcd\xe7\x84\x61\xea\x69\x11\xdf\x41\x6e\x4a\x03\x1f\xe1\xe0\xbf\xf2\xff\x26\x0d\xa3\x76\xca\x5d\xd0\xfa\x63\x77\x16\x84\x4d\xd2\x56\xc7\x05\x0e\x59\x4c\xc9\x26\xfc\x87\x2a\xe3\x63\xbc\x85\x2d\x3a\x0b\xdb\x37\x3b\x66\x21\x38\xe0\x68\x96\x47\xc4\xaa\x83\x79\xac\x0c\xbe\x46\x80\x5e\xa8\x93\xb3\x33\x21\x6b\x31\x21\x99\x46\xc5\xf5\x23\x1f\x6a\x6e\x2a\x28\xb9\xc6\x10\x88\xfe\x9a\x7c\x9c\xa5\x2a\xf4\x71\x2b\xc7\x8e\x33\x43\x77\xf1\x8f\x44\xfc\x83\xcf\x27\x9a\x71\x2f\x20\x0a\xb2\xe2\xd7\xa6\xc3\x20\xb7\x2b\xa9\x02\x54\xcb\xda\xeb\x8f\x87\xec\x6e\x50\x5f\x8e\x85\xa5\xc8\x43\x94\x42\xbf\xb9\x53\x06\xcc\x54\xf5\x60\x84\x34\xb0\xab\xe3\x62\xec\x9e\x61\x0f\x40\xe0\xc0\x45\x08\xf1\xcc\xd2\xb7\x23\xe3\x57\xb1\x0c\xd4\x12\x5b\x7a\x67\x30\xdf\xa2\x87\x95\x43\x12\xbc\x8a\x78\xcc\x83\x64\x18\xb5\xf8\x17\x6f\x40\xde\xdf\x35\x77\x15\x62\x99\x73\x88\x8b\xd6\xed\x29\xc4\x1f\xab\xe5\x2b\x18\x29\xdf\x79\x0b\xc5\xde\x82\xa1\xe8\x44\xb0\xef\x05\xf8\x0b\x49\x9e\x6d\xda\x63\xb8\x4b\x4a\xd7\x1b\xf2\x19\x5e\xa5\x29\xaa\xb7\xf5\xee\xaf\x9a\x90\x34\xd8\x84\x7f\x47\x14\x7b\x58\xbf\x1b\xf2\x37\x06\x90\x8f\x67\x78\x8a\x7d\x13\x12\x91\xe1\x0f\xd9\x42\xd9\x91\x4f\x41\xc2\x00\xe8\x77\x26\xa7\x3e\x32\x6d\x9b\xcc\xbe\x5c\x65\xe6\x2c\x7b\x2d\x00\x7b\x1a\x6f\xf9\xcd\x1a\x4f\x90\x20\xe7\x91\x01\xdd\x8f\x59\x75\x3e\x86\x01\x20\x53\x0e\x71\x90\xf3\x26\xca\xc2\xba\xd9\x8a\xc8\xdd\xa6\x5f\xaf\xd5\x6c\xbe\x18\xfb\x3b\x50\x31\x65\xff\x1a\xd5\x86\xac\xb6\xea\xd8\x89\x25\x41\xf2\xe9\x53\xc4\x9f\x09\x7d\x04\x6c\x01\xa9\x6c\xa6\xc3\x29\x74\x64\x0b\x03\x1c\xcc\xc0\x03\x85\x01\x83\x0e\x27\x95\x9d\x65\x5b\xf8\x0e\x35\xae\x29\xcd\xe0\x8d\x25\x19\xc6\x9a\x62\x22\x2d\x7c\xb5\xef\xbc\x18\xaf\x01\xfa\xbe\x72\x16\x92\xcf\xcb\x02\x22\x7f\xcf\x98\xb5\x8d\x27\x81\x1b\x8e\x6d\x29\x0b\xfc\x6d\xc6\x18\xde\xac\x31\xa3\x8d\x7b\xd2\xc5\xb9\x9a\x49\x00\xa6\xdb\x7a\x3e\x57\xf3\x9d\xd8\xa3\x57\xc7\x77\x06\x02\xc2\x13\x82\x01\xf6\x4d\x0f\x69\x26\x34\x1e\x2b\x0d\x52\x37\x89\xa9\x72\x8b\x44\x51\x41\x02\xef\xbe\x6c\x62\x2e\xdf\xd3\xb2\xb8\x12\x2b\x89\x53\xba\x97\xc7\x6d\x97\xfc\x59\xb2\x0e\xd5\xb4\x7e\x1d\xc9\xd8\x2b\x66\xec\x12\x3f\x85\xca\x47\xb2\x42\xa7\xc9\xfd\xc7\x17\x86\x58\xa2\xa4\x5f\x6c\xc6\x2e\x92\xf5\xfe\x2b\x55\x29\x3d\xdd\x77\x53\x4b\x55\x52\x54\xcb\x51\x51\x6b\xdb\xbc\x9b\xf2\xba\x62\x1b\x85\xd5\x6c\x8a\x7c\xb2\x50\xf9\x45\x94\xb2\x29\xce\xaf\xc5\x87\xa9\xbe\x2d\x88\x99\x1b\xe9\x92\xb6\x37\xf3\xfe\x62\x75\x33\xd3\x4f\x84\x4e\xb1\xeb\xe6\x4f\xa4\x3b\x4f\x2b\xf7\xe7\x02\xb3\xdb\xa5\xd3\x2f\xa1\x78\x86\xc5\xd2\x6a\xf7\xf9\x8d\x3b\xef\x56\x9f\xfb\x0a\x3e\x51\x95\x77\x22\x86\xf7\xde\xdf\xf7\x73\xd9\x42\x5b\x31\xf0\x7b\xec\xee\xbb\x58\x04\x07\x30\xc4\xfb\x8f\x68\xd9\x28\x28\x21\x5f\x6c\xbf\xbe\xbe\xf9\x1b\x03\x17\xb7\x63\xb1\x75\x55\x89\xd9\xf5\xf5\x4d\xa5\x84\x95\x43\xcd\x3d\x40\xc6\x2f\x42\xfc\x0f\xd4\x47\xdd\x36\x0c\xf1\xe4\x11\x2d\x81\x87\x91\xbf\xa5\x28\xfd\xf5\xf5\x8d\x5a\xce\x36\xf2\x0d\x2b\x83\x71\xbb\x3b\xf2\x97\xfa\x92\x87\x7e\x07\x3d\xa5\x35\x2a\xe3\xd0\xc9\x3d\xcd\xd7\x56\x2f\x9b\x7c\x23\x1b\x1e\x17\x53\xf3\x7a\xda\xad\x29\x6f\x0a\x0f\xf5\xe2\x62\x89\x8b\xf5\x2a\x45\x20\x6f\xa6\x03\xe2\xa2\x7d\xd5\x15\xb5\x8d\x63\x20\xa6\xeb\xa6\xaa\x8a\x75\x82\xf1\x3d\xc8\x46\x5d\xf8\x79\xc7\xe0\x86\xb8\x48\xb5\xe1\xec\x4d\x32\x6e\x6c\x48\xbc\x75\xc1\x68\x2e\x13\xa6\x1b\x4b\xef\x1b\x78\x25\xe0\xbc\x14\xac\xd3\x36\x6c\x70\x4f\x7e\x6b\x82\x15\x40\x7e\xa0\xc3\x96\x0d\x04\xeb\xe6\xe2\xc1\x44\x72\x69\xa2\x8f\xbd\x6c\x1a\xf4\x18\x90\xe7\x00\x88\x11\x9c\xc2\x27\x44\x99\x58\x7b\x2e\x30\x6a\x93\xbc\x9b\x8b\x87\xea\xd7\xa6\xe6\xe4\x99\x81\xd5\xf7\x4b\x84\xee\xe6\xe2\x41\x85\x0c\x05\x85\x7a\xb3\x3c\xab\x95\xff\xf8\x75\x2f\x20\x7c\x22\xb8\xc2\xe6\xc8\xfd\xba\x77\xf0\x75\x8f\x29\x07\xcb\x8b\x7c\xf9\x57\x0f\x62\x5f\xfc\xf2\x93\x2a\x17\xd0\xf7\x3e\xfe\x52\x49\x01\xc0\x9e\x81\x85\x19\x58\x8d\xee\x2a\x1d\xa9\x85\xb7\x17\x8d\x54\x21\xc5\xbe\xee\x86\xdf\xb0\xe5\x6c\x5c\x77\x19\x12\xc6\xf0\xd4\x47\x60\x05\x07\xf0\x39\xe4\xf2\x36\xde\xb4\xb3\xf3\x08\x35\x15\x5d\x95\xc2\xd2\xac\xe6\xd2\xf6\xf9\xa2\xaf\x15\x15\x60\x5e\xae\x28\xd0\x97\x34\x6d\xaf\x42\x31\x13\xc4\xcc\x0c\x78\xd3\xc5\x88\x65\x52\xae\x54\xb4\x91\x88\x8f\x03\xc7\x8f\x5c\xc4\xc0\x02\x07\x78\x11\x2d\x00\x5c\x90\x28\x90\x1d\x88\xe5\xeb\x18\x71\xf8\xf4\x79\x8e\x02\xc0\x49\x18\xe2\x60\x06\xa2\x50\xf5\xc9\x8d\x66\x33\x24\x9f\x01\x57\xb3\x7a\x14\xfb\x74\x69\xa4\x59\x58\xb1\x9c\xff\x82\xf8\xad\x9a\x22\xbf\xf1\x39\x3f\xa1\xeb\x7d\x6f\x16\x76\x4f\x55\x2b\x36\xac\xbf\x23\xf9\xa5\x24\x24\x0c\xfa\x9d\x3a\x81\xc7\x40\x80\x87\x7d\x75\xdf\x3a\x5d\xc6\x8f\xf6\xa6\x8b\xa3\xac\x62\x79\xab\x01\xd4\x3e\x46\xac\xd0\x2e\x96\x48\x81\xdd\xad\x6d\xdc\x5a\x6e\x6a\xb0\xab\x29\xa1\xd6\x6c\x43\x10\x24\xc3\x44\x89\x27\x8d\xed\xd1\xa2\x3f\x22\x94\xec\x20\x54\xaf\x66\xad\x16\xaa\xc2\xd7\xd2\xa5\x85\x40\x4c\x94\x7d\x62\x65\xe2\x9d\xc2\x79\x00\x6e\xcd\x7e\xa7\xde\xd6\x07\x90\x22\xf0\x55\xd0\x37\x78\x0a\x83\xaf\x7b\xef\xc0\xd7\xbd\x67\x4c\xd1\x2c\x82\xd4\xfd\xba\x27\x4d\x91\xf0\x66\x48\x98\xf7\x3e\xcc\x7a\x93\xaf\x36\x6f\x63\xc1\x50\x7a\x56\x20\x24\x3e\x76\x96\x00\xcb\x1e\xb0\x4a\x5a\x53\x6b\x67\x60\x5a\x78\x25\xa5\x40\x4c\x14\x88\x2d\xf1\x2d\xbd\x0c\x46\x22\xea\xa0\xce\x4b\x51\x60\x7a\x5c\xce\x94\x10\x1f\xc1\xdc\xdd\x4e\x56\x65\x3c\xc0\x10\x17\xd8\x73\x1a\xa1\x77\xc0\x43\xdc\x99\xeb\xf2\xfd\x3f\x22\x28\x93\x42\x17\x88\x53\xec\x28\x6f\x2b\x20\x2e\x62\x03\xf0\x09\xfa\x0c\x09\x03\xe3\x22\x0f\x46\x3e\x2f\xec\xdb\x2b\xe1\x4d\x34\xa4\x4d\x33\x6b\xec\xe9\x0c\x95\x67\xec\xfb\x86\x3d\x49\xd6\xa8\x50\x88\xb9\x96\x90\x8a\x58\xb8\x12\x33\x6a\x2b\xda\xd3\x74\x25\x8e\xf0\x44\x68\x71\x3b\xe5\x78\x31\x7a\x60\xdd\xf5\x58\xf7\x86\x5e\x9e\x7a\x28\x34\xd6\x6d\x5e\x7a\x88\xb7\x9c\xef\xb0\x12\x36\x26\xe4\x81\xd1\xba\x5e\xbc\x8a\xac\x35\xa8\xed\x4e\xa4\xe8\x6b\x50\x6a\xec\x63\x94\x7d\x3e\xe3\x73\x58\x46\xf4\x22\xa1\xc5\x18\xb4\xe2\xb8\x21\xd0\x3f\x15\xd8\x1a\x92\x5a\xc5\x79\xbd\xbd\x37\x72\x23\xf5\x95\xa7\x5c\xe3\x2a\x3b\x2f\xe6\x3f\x8d\x02\xd9\xd2\xde\x80\x06\x44\xf5\x67\x11\xd6\xa6\xb2\x27\x8b\x86\x5d\xc9\x6c\x43\xe0\x18\xff\x62\xde\x26\xd0\x8d\x09\xd6\x37\x9f\xb3\x6b\xee\xca\x68\x1b\xce\x4d\x98\x1c\x5f\x31\x55\x5e\xf5\xde\x1a\xcf\x97\x71\x48\xb9\xee\x5d\xa1\xdb\x56\xc7\xce\xa6\x7c\x0d\x9d\x45\x8b\x4c\x3f\xaa\x2e\x0c\xbc\x4f\x7c\xce\x29\x7b\x86\x4f\x8f\x90\xe3\x7b\xb8\x0b\x4e\x5c\x63\xae\xd2\x9e\x34\x0d\xc6\x57\x99\xb4\x27\x49\x93\x0c\x35\xb6\x73\x7f\x7c\x9f\xa0\xc2\x5a\xd2\x9e\x46\x35\xf6\x30\xcc\xb1\x6c\xf9\x6e\xe4\x82\x65\x78\xd2\x65\x65\xd5\xe5\x6b\xe9\x1b\xeb\x57\x91\x17\x7e\x3c\xac\x7e\xbc\xef\x92\x04\x9e\x8f\x1d\x3e\x00\x26\x95\x04\x33\x00\x7d\x8a\xa0\xbb\x34\x76\x65\x5b\xe8\xef\xd2\x02\xb6\xe6\x92\x99\x9d\xe4\xe0\x03\x76\x5b\x6c\xce\x32\x14\x68\xf6\x66\x59\x6d\x21\xec\x99\xd6\x06\xe4\xc6\x2a\xbc\x8e\xbd\x79\x9c\x7b\x7e\xb0\xb6\x95\xff\x05\xf5\x77\x5b\x6c\xd4\x49\xb5\x5e\x97\x49\xb8\xbd\x3d\x41\xd8\xd6\x68\x1d\xd7\xc6\x3a\x20\x1c\x78\x24\x0a\x3a\xe7\xbb\x6f\x2a\x53\xc5\xb6\x3d\x90\xb0\x3f\x77\x81\x84\x8d\xbc\x85\xf4\xa7\x3b\xe5\xfb\x1a\x96\x08\xa0\x00\xeb\x65\x96\x26\xfd\x56\xb3\xf9\x86\xc4\x64\xca\x75\xa9\x58\x33\x9b\xdf\x9e\xa5\x64\xac\xe9\xed\x4d\xf6\xcc\x6a\x60\x80\x39\x66\x9c\xd0\x65\x2a\x20\xae\xa2\x28\x2a\xb0\x51\x29\xce\x12\x4e\xed\xb3\x6a\xf6\xbb\xa5\x92\x2d\x87\x8a\x23\x41\x97\xa0\x78\x41\x77\x34\x65\x19\xf5\x80\xd3\xe3\x7c\x68\x49\x86\xec\xf6\x3e\x80\x93\x61\xe6\xa7\x6f\xfb\x33\xb2\x6f\x9c\xe0\x5b\x38\x43\xf7\xf8\xcf\x9c\x93\x9c\x95\xfe\x68\x31\x45\x54\x96\x25\x71\xb4\x60\x20\x44\x14\x84\x70\x56\x1c\x48\x13\x3f\x4e\x98\x05\x70\x87\x20\x5a\x57\x4a\x8c\xaa\x08\x51\x41\x04\x31\x24\x13\x02\x56\xd4\x98\x16\xc7\xe0\x42\x0b\xd8\xde\xe3\x88\x2b\x0a\xb8\x90\xe7\x3e\x97\x5a\xe6\x15\xe4\xe8\x13\x25\x8b\x8a\xa5\x7e\x5a\x2d\x31\x96\x78\xdd\x36\x11\x33\x20\xbe\x02\x7e\x0c\xc8\x33\xd8\x3f\x1a\xba\xef\x12\x21\xe2\x9f\x06\xe0\x93\x44\x86\xab\x1a\x9a\xbb\x4f\x97\x47\x47\x47\xe7\x00\x0d\x66\x03\x70\x38\x3c\x1c\xee\x0f\xcf\xf6\x87\xa3\x42\x72\x09\xc8\x13\xcf\x82\xdf\xb6\x69\xf6\x40\x5a\x50\x2c\x0a\x38\xf6\x33\x24\x6b\x4c\xad\xa3\x61\x39\xb5\x78\x0e\xb3\xde\x69\x95\xa6\x06\xa6\xc8\xb1\xb9\x64\x19\x82\xc4\xe3\x32\x2a\x13\x53\x67\xba\x2c\xba\xff\xd2\x21\x0e\x57\x5d\x80\x5d\xaa\x48\x86\xfb\x75\xaf\x98\x10\x45\x38\xad\x97\x14\x1a\x33\x3a\xbe\xaa\xa0\x85\x19\xb8\x2a\xb2\x2b\xa6\x49\xd1\x1a\x4d\x40\x67\x13\xf7\x61\xa9\x55\xfe\x8a\xe8\x02\xb1\xca\x35\xaa\x61\x60\x7c\xd5\x62\x6d\x73\x39\x77\xe3\x2b\xbb\x8d\xc3\x48\x55\x96\x7f\x75\xc3\xde\x9a\x7f\x9b\xbc\x87\x4e\xad\x52\x7b\xc3\x0f\x96\x1b\xad\x02\xbf\x59\xde\x55\x37\x5f\xe1\x46\x6f\x9e\xd3\x4b\xcc\xe6\x18\x5a\x57\xa7\x52\xf8\x1a\x5b\xa3\x1b\xf4\x6c\x0c\xd1\x22\x14\xa7\xaa\x32\x4b\x94\xcb\x76\x2c\x5d\xbd\xd5\xeb\xec\x25\xbe\x1d\xfb\xd8\x9d\xcf\xcd\xb1\x13\xdb\xf4\x9a\x6d\x17\x8c\xda\xfe\x11\x6b\xdf\x21\x41\x20\xb6\xc6\x27\xcc\x97\xfb\xcd\x13\x88\x0b\x8e\x5d\x20\x09\xd6\x92\xe1\x5b\x7c\x70\xaa\x9a\x99\x39\x43\x5d\x26\x46\xe7\x94\xbc\x1f\x4d\xb1\x20\xd4\xfd\x3a\xc8\x82\x77\x77\x66\xca\x04\x70\xb6\x0f\x67\x33\x8a\x66\x32\xa2\xd1\x82\x93\xab\xd9\x72\xb1\x98\x71\xec\xb0\xd4\x89\x7a\xd3\x27\x69\xb9\xaa\xea\x73\xb4\x20\x23\xbb\x58\xad\xbd\xc3\x91\x7a\x77\x8a\xda\x9d\xa2\x76\xa7\xa8\x3c\x21\x76\xa7\xa8\xdd\x29\xaa\xf9\xca\x76\xa7\xa8\xdd\x29\x6a\x77\x8a\xd2\xbf\x94\x9e\xa2\x32\x2e\xcc\xee\x40\xf5\xfa\x0e\x54\xda\x07\x77\x21\xf6\x5b\xe5\x5c\x26\xdc\x6f\x09\xe3\x35\x3a\xe1\x57\x72\xf1\x3b\xff\x7b\xe7\x7f\xef\xfc\xef\x9d\xff\xbd\xf3\xbf\x77\xfe\xf7\x06\x56\xb9\xf3\xbf\x77\xfe\x77\x3c\xbc\x8b\xff\xbd\x4b\xa7\x7d\x25\x0e\x37\xe7\x3e\x3a\x30\x69\x56\x2d\x5c\x6d\x05\x41\xd6\xd6\x5b\x92\xb5\xac\xce\x70\xd9\x84\x7c\xbb\x3b\x3d\x78\x97\xe0\x95\x1a\xb0\x4b\xf0\xda\xba\x9b\xdf\xf4\x68\x64\x24\x39\x7b\x3a\xda\x1d\x83\xd2\xd4\xc9\x9e\x84\x5e\xdf\xb1\xe7\xfb\xf0\x15\x5f\xa2\xaf\xdf\xbf\x93\x54\x6b\xdb\x02\xed\xdc\xa5\xe4\xde\xd6\x38\x4c\xf9\x2a\x2a\xbd\xde\x82\x8b\xc4\x49\x98\x74\x8c\x2a\x4b\x47\x57\x15\x1f\x8e\x8f\x85\xbc\x70\x44\x17\x38\xa8\xf5\xf4\xc6\xa5\x9c\x51\xb3\xec\x43\x81\x2f\xf1\x9e\xf4\x5b\x63\x5c\x3a\xe5\x3d\x56\x7e\xe8\x4a\x4b\xd9\xbd\x23\xe4\xc8\x7d\x27\x6b\x5a\xc2\x4c\xeb\xac\x4a\xca\x0a\xba\xb4\xc8\x93\x91\x6f\xaa\xcb\x5e\x49\xe0\x19\xf3\xb9\x34\x26\xaa\x91\x99\x22\x35\xab\xd9\x03\xf6\x41\x7e\xbe\x8a\xd4\xbf\x20\x0d\xb5\x84\xce\x33\xc4\x1f\xb2\x43\xba\xbd\x0d\x2a\x97\xd1\x4f\x43\x28\x09\x6b\xcd\xd5\xd2\x0f\xf0\x51\x37\x3a\x51\x51\x74\xf9\x10\x8d\x7c\x00\x8f\xca\xd6\x76\x04\xa8\x87\x34\x35\x7f\xe0\x8c\x22\x65\x4a\x15\xa7\x2a\x4b\xec\xea\xb1\xea\x8b\xf5\x1b\x25\x7c\x53\x48\xe5\x58\xd7\xb2\x84\xfa\xce\x94\x48\xcb\xd5\x67\xd6\x5c\xb4\xc3\xad\xb7\x3c\x5a\xf3\x7e\xf3\xad\xb5\x3f\xff\x63\xf7\x76\xd5\xda\xf1\xad\xb4\xaf\xf1\x2b\x78\x07\x1e\x6a\xd6\xcb\xc2\x78\x3d\x62\x9e\x7a\xb2\x52\x5d\x92\xd9\x1f\xd6\xb3\x9c\xf2\xe5\x07\x8b\x35\xef\x13\x42\xbd\x36\x22\xad\x85\x67\x2b\x76\x18\x4c\xbf\x4b\xb1\xd1\xe1\x21\xc8\x96\x81\xd3\xc8\x07\xfa\x44\xa8\x23\xbb\x9f\x24\xdc\x66\xf3\x36\xb9\x72\xea\x41\x48\xc9\x02\x33\xc4\x06\xe0\x8a\x20\xf5\xc0\xd9\x33\xc4\x6a\x98\xa3\xa2\xa7\x98\x04\x83\x02\x39\xf3\xd4\x07\xd2\x67\x47\xdd\x66\x47\x82\xcd\x3c\x85\x1e\x77\xb2\x13\xbb\x92\xc2\xa0\x44\x3c\x95\x6b\x7e\x21\x97\xdd\x7d\x63\x50\x08\x1a\x7b\x06\x6c\x86\x7d\x53\xdd\x32\x04\x22\x5b\x7a\x1e\x28\x43\x05\xe3\x3b\xbe\xe9\x46\xe5\x79\x55\x5a\xa7\x26\x49\xd9\x9e\xaa\xb7\xbf\x4c\x10\x25\xa5\x1e\x98\x19\xcd\x42\xdb\xd4\xab\xfb\x9d\x5a\xed\xd4\xaa\x2f\xb5\xe2\xf0\x11\x1d\xb8\xc8\xa1\x08\x32\xd4\x48\xb3\xae\xf4\x24\x99\x96\xf3\x28\x7b\x7e\x20\x3e\x57\x0a\xe4\xcc\x21\x0e\xc0\x13\x86\x52\xd4\x17\xf1\xb3\xc3\xab\x2f\x57\x9e\x59\x0c\xf8\xca\x63\x4b\x06\x8f\x32\xf5\xc9\xfe\xde\x52\x73\x0c\xb9\xf4\xc2\x0b\x1c\xed\xcd\x28\x8f\x59\xbd\x5c\xdb\x36\x8e\x2a\xbb\xa7\x77\x5f\x8b\x96\xe3\x40\x89\x4a\x4f\xfe\xa8\x0c\x1e\x29\x0d\x30\x90\x4b\x77\x56\xd7\xb8\xa9\xd2\x42\x34\xdb\x40\x6d\xdf\x6a\xb4\xa9\x0e\xaa\xcc\xc2\x58\x83\xdd\x39\xad\xbb\xdd\x75\x5d\x7a\xb7\x15\xb5\x5b\x8b\x43\xbb\x39\x7d\xdc\x39\xbb\x3b\x75\x6c\xa5\x8e\x3f\xe8\xb5\xec\x25\xc0\xc6\xdf\xdf\xbb\x90\x1d\xcf\x6f\x89\x8f\xb3\x8f\xd0\x98\x1b\xdc\xcc\xed\xc1\x5e\x48\x85\x98\x72\x9c\xa1\xfc\x1e\x0a\x64\x90\x3e\xcb\x8e\xe2\xc7\x54\x0a\x1e\x52\x29\x20\x04\x5c\x21\x5a\x7c\x09\x9d\xbe\x58\xfe\xa8\x31\xca\x53\x26\x5e\x4c\x6a\x7c\xf2\x13\x97\xc4\xf7\x33\xe9\xbd\x6a\x70\x08\x9d\x47\x38\x93\xe3\x67\x98\xcf\xa3\xe9\xc0\x21\x8b\x83\xd8\xb3\x0f\x10\x7f\x26\xf4\x51\xbe\x37\x77\xc0\xd1\x1f\x11\xf6\x61\x88\x0f\x50\xe0\x86\x04\x07\x5c\x23\x63\x6c\xe4\x45\x72\x51\x09\xda\x67\x1f\xb4\x49\x0c\x03\x14\x85\x14\x31\x99\xe1\x90\xe8\x7c\xef\x90\x80\x53\xe2\xeb\xf7\x77\xb2\x8d\x9e\x1b\x33\x33\xd5\x14\x31\x05\xc1\x72\xa1\x9f\xa6\xe2\xf8\x2a\x41\xf0\xa4\x2d\x55\xdd\xf4\xdb\x82\xbd\x57\xd3\xab\x78\xd9\x84\x3d\x0b\x48\x1f\x11\xcf\x70\x24\xe2\x73\x73\x7a\xa8\xa1\x0c\x1c\x73\x5f\xfe\x90\x98\x17\x9b\x15\xf9\xfa\x12\x27\x00\x46\x7c\x8e\x02\x8e\x1d\x79\x8b\x43\xc0\xc5\xed\x78\x50\xcd\x82\x10\x32\xf6\x4c\x68\x7b\x46\xdc\x1a\x00\x56\x76\x44\x4c\x18\xab\x45\x7b\x86\x7c\x31\x00\xfa\x64\xc9\x4a\x63\xa4\x40\x43\xc7\xca\x1e\x7d\x07\xd9\x9c\x3f\x6a\x22\x30\x7b\x07\x80\x1e\x47\x14\xac\x9e\x17\x4b\xb2\x4a\x57\x11\xd4\x63\x16\xfa\x16\x62\x8a\xd8\x04\xf2\xd6\xf4\xfc\xa8\x40\x5c\xa4\x4f\x7a\x7b\xe8\x1b\x14\x8e\x89\x18\x71\x38\x1c\x9d\xef\x0f\x4f\xf7\x87\xa7\x0f\xa3\xd1\x87\xe1\xf1\x87\xe3\xa3\xc1\xf9\x68\x38\x3c\x3a\xf9\x97\x9d\xc7\x9c\x3c\xa2\xa0\x35\x42\x0f\x72\x76\x11\x32\x68\xf9\xf7\xf9\xf4\x17\x07\x7f\xc6\x7f\x1f\x7f\xf9\x73\x3c\xba\xc1\x63\x36\x0e\xee\x4e\x9c\xcb\xf1\xe9\xf8\x31\xfc\xbf\xbf\x5f\xfe\xfd\x7c\x80\x96\x7f\x1f\x39\x87\xbf\x2f\xa7\x8b\x4f\xfc\x5f\xf7\xe3\xd3\xf1\x62\x74\xe2\x1c\xfd\x13\xff\x76\xf9\x77\x1f\xfd\x7a\x81\x3f\xff\xe7\xe3\xe1\xf5\xd5\xc7\xe7\x9b\xab\x8b\xd1\xcd\x9f\x3f\x9f\x0f\xe6\x41\x70\xfe\x89\xb0\xa9\xbf\xfc\x3f\xfc\xdb\xc8\x43\xf7\x51\xf8\xed\xe8\x7a\xfe\xaf\x7f\xb9\x8f\xd3\xcb\xe8\xfa\x01\xe2\xeb\xd3\x6f\xfe\xc9\xf1\xef\xcf\xff\xdc\xa8\xdc\xfd\x8c\x02\xe4\x61\x07\x43\xba\x7c\xf8\x76\x9f\x6b\xcc\x51\x29\x7e\x96\xf9\xda\x8d\x54\x2e\xe4\x74\xf5\x7b\x1c\x1b\x12\x02\xa8\x52\xbb\xeb\x08\xa0\x74\x36\x5a\xcb\x9e\x9c\x6d\x37\xdb\x1c\xf2\x9c\x91\x28\x73\xe9\xee\xe5\x84\x8d\x32\x27\xc2\xbe\x6b\x7a\xc5\x36\xe2\x4a\x62\x22\x98\x13\xdf\xd5\xbd\x7c\x55\x57\xdd\xa9\xf8\xb5\x06\xe9\xa7\x14\x06\xce\xbc\x35\xed\x7f\x56\xd3\x8b\xf4\xec\x6b\x34\x1c\x1e\x39\x51\x20\x1f\x80\x94\x7f\x41\x76\x46\x49\x74\x27\x81\xcc\x0c\x6e\x8f\x8c\x00\xa2\xb2\x8b\x0b\x31\x72\xd1\xd3\xbe\xfc\x98\x1d\x0f\x87\x2c\x16\xb8\xbd\x19\xbc\x54\xd3\xdb\x91\x63\xfd\xb2\x76\x39\x87\xc1\x0c\x99\xad\xb5\x8d\xa7\x60\x85\x90\xf3\x19\x1c\x39\x4a\x3e\xc5\x6a\x1c\x81\x1a\xa2\x18\xa0\xe7\x49\x67\xbf\xe1\x06\x3d\x97\xbb\x0e\xc4\x77\xbb\x7f\xe5\xb3\xef\xbe\x45\x07\x45\xb7\x12\xd2\x2f\xe6\x65\x0c\x52\xbe\x42\x2f\x31\x56\x1b\xa0\xf8\x03\xa6\xd5\x51\xee\x91\xc1\xc6\xce\xbc\x1b\xe4\x4e\x65\x05\x31\xf4\x9b\x7b\x85\x8c\x9d\x23\x8f\xd8\xf7\x27\xec\x19\xf3\xbc\xb5\xcb\xac\x4c\x8c\x04\x6a\xa4\x46\x5f\xf8\x5c\x9c\x62\x47\x3e\xf7\x20\x4c\x44\x14\x18\x27\x8b\x04\xfe\x12\xf0\x39\x25\xd1\x6c\x0e\x7e\xbf\xbd\x49\xb3\xb4\xec\xed\xac\x6c\x91\x28\x83\x53\x1f\xfd\x03\xfb\xfe\xbd\x42\xb2\xc0\x88\x70\x1a\xa1\xba\x67\xc2\x34\x8f\x7a\x39\x0b\x96\x0a\x0e\x26\xc1\x25\x45\x90\x27\x23\x23\x8d\xac\x8b\x1d\x4c\xce\xbe\xc8\xb7\x25\x00\x8c\xfb\x69\x25\x33\x24\xf6\xcc\x23\x1d\xa9\xc8\x52\x41\x31\x68\x2a\x6f\x5c\xff\xeb\xbf\x2b\x45\x52\x7f\x76\x92\x7f\x19\xb4\x44\x3c\xf3\xba\x55\xb0\x01\xad\xf0\x2c\x17\x53\x27\x5b\x22\x6b\x17\xbd\x1a\x7b\x96\xb5\x26\x37\xb9\x6f\x0d\xbf\x0d\xeb\xfd\x67\x64\x5f\xd4\x2a\x83\xbd\x7c\x49\x3a\xf2\xd9\x71\x41\xd6\x94\xfc\x56\xcb\x39\xb2\x2f\x27\x29\x34\xe5\x0b\x0a\xb3\xa5\x0d\x2d\x97\x54\x50\x4b\xd1\x6a\x51\x87\x05\xae\x72\xb2\x16\xb5\x7c\x55\xc9\x77\x26\xfb\x7b\x57\xb2\x94\x1a\xab\xc2\x2b\x03\xb9\x2c\xda\x52\x50\xbf\x9b\x24\x97\x81\xd2\xd0\x94\x66\x4d\xd3\x46\x6c\x6a\x7b\x33\xba\x3a\x1a\x78\x91\xef\xc7\x0f\x35\x25\x0c\xa7\x7e\x01\xa3\xce\x29\xad\xc4\x34\xf5\x6d\x6c\x1a\x9a\x91\x7e\x4d\x43\xa1\xd2\xab\xb0\x64\x2d\x6b\x6f\x1e\x63\x2b\xb4\xf3\xba\xf0\xa7\xcb\x62\x74\xa5\x71\xc9\x6a\x8e\x1d\x6f\x3a\x3c\x3a\x3c\xde\x77\xa1\x77\xba\x7f\x0c\xdd\xf7\xfb\xc7\xc7\xef\xa7\xfb\xe8\x74\xe4\xa1\x21\x1c\x79\xe7\xa3\xf7\xc5\x07\x67\xd5\x2a\xa6\xd1\xee\xa6\x0b\x9f\xd5\xcc\xe2\xb5\x67\x43\x10\x0d\xd7\x9d\xaf\x18\x4f\x2e\x5a\xa3\x92\xbc\x10\xd9\x9c\xeb\x2c\xf8\xd1\xe2\x2c\x9f\x9b\xad\xb5\x36\x84\x94\x63\xb8\x53\xdc\x9d\xe2\x36\x51\xdc\xb7\xab\x5b\x69\xe3\xd2\x4e\xc3\x52\x30\xb4\x9e\xd9\xf4\x6b\x65\x02\xeb\x84\xd1\x96\x1c\xb1\x09\x45\x0e\xc2\x4f\xa8\x50\x32\x6c\x45\xe7\x89\x82\xe2\x28\x5f\x73\x9e\x09\x70\x89\xaf\xdc\x99\x8f\x14\x30\x69\x34\x3c\x3c\xb6\x8a\x86\xc2\x91\xa1\xa0\x30\xbe\xd5\x0b\x7e\xf7\xe9\x72\xa3\x7a\xb8\xb9\x91\xca\x1d\xa8\x3e\xf3\xc4\xe6\x4f\xcf\x00\x38\x00\x0c\x39\x24\x70\x99\xdd\x9d\xac\x58\x54\xd5\x9a\xae\x0c\x66\x05\x4b\x3a\x1d\x5a\x17\xa4\x43\x02\x61\xc4\x0b\xa5\x22\x9b\x89\x49\x9e\x03\x9f\x40\x17\xb0\x10\xa9\x32\xef\x29\xe6\xaa\x3b\x82\x5a\x5f\xab\xe5\x55\xf2\xec\x21\x46\xb4\xb5\x60\x25\xd6\x6a\x93\xae\xcc\x3a\xbf\x84\x5b\x5d\x65\x2b\xf1\x94\x77\x51\x6c\xc2\x42\xcb\xf2\xec\xfb\xc8\x38\x59\xc0\xba\x41\x1b\x99\xe8\x02\xfe\x72\xd3\x21\xf4\x1e\x5a\xde\xb9\xbc\xc7\xdc\x88\xc2\xef\x6d\x26\x51\xe2\x52\xd6\xe5\x96\x26\x49\x98\x21\xc9\x04\x09\x08\x58\x14\x86\x84\x72\xe4\xea\xd2\x5e\x67\x99\x8f\xa3\x66\xb6\xf4\x16\x89\x04\x24\x40\x4b\x3b\xc2\x1f\xbf\xa9\x48\x7e\xe3\xcd\x36\x3f\x5f\xa6\x7b\xc8\x08\x01\x20\x1e\x80\x3a\xa7\xcc\x2c\xab\xc6\x0e\x0b\x17\x24\x2a\xde\xb9\x02\xcb\xad\x4f\xa2\x53\x07\x89\xa6\x3e\x2a\x31\x0f\x17\x0a\xb8\x55\xf7\x9d\x3c\xf7\xca\x18\x90\x07\x1e\xb3\x7f\x93\xf6\x60\x15\x0d\x2f\x16\xba\x78\x0c\x90\xca\x89\x18\xb8\xba\xb9\x37\x09\x60\x0c\xf9\xb1\x23\x44\x21\x47\xb3\xa5\x2e\x4a\x53\x9e\xd2\x1a\x04\xd1\x21\x54\xfc\x8f\xd9\xe1\x33\xeb\xb1\x65\xc8\x97\x2c\xcd\x32\x3c\x9b\x7c\x54\x51\x00\xd0\xd8\x68\xda\x25\xb4\xce\xf6\x90\x90\xb6\xbe\x72\x97\xd6\x2f\x61\x1f\x17\x10\xfb\x0d\x0e\xbb\xf6\xb8\x3c\x12\x50\xea\x87\xde\xd5\xf0\xd6\x99\x02\xc9\x8f\x55\xee\x1a\xc8\x2c\x70\x9d\x7b\xc3\xc7\x4c\xea\x43\x56\x90\xe5\xef\x99\x5d\x01\x07\x33\x1f\xa9\x0c\x4d\xe1\x40\xc1\x00\xc8\x51\xb9\x07\xec\x1b\x4b\xf0\x42\xa5\x6a\xb6\xa6\xaf\x4e\xf5\xec\x4b\x0c\x3d\x84\xdc\x29\x74\x1e\x0f\x9c\x44\x43\x90\x14\xe1\x12\xb9\xa5\x1d\xbc\x9d\xbe\x96\x5d\x74\x6a\x56\x9c\x5a\xd4\x24\x4e\x2c\x7e\x89\x05\xf6\x22\x81\x11\xc7\xbe\x4d\xfa\x6c\x29\x69\x36\x29\x4c\x64\xa0\x69\x69\x04\xbf\x3e\x3c\xdc\x6a\x41\x0c\xe1\x52\xf8\xf5\xed\x85\x4f\x82\x59\x9b\xcb\x99\x49\x12\x2a\x77\x2f\x15\x26\x9b\x90\x62\x53\x58\x5f\x4c\x7a\x3d\x22\xbb\x79\xad\xea\x13\xd2\x5d\x06\x9a\xdf\xaf\x27\x77\xca\x8e\x9b\x97\x2e\x7d\x6d\x1f\x5d\x18\x9d\x56\xc6\x00\xed\x5f\x56\x35\x6b\xf6\x18\x42\xc3\x45\xac\x0a\x24\x5e\xe6\x49\xef\x13\x46\xbe\xab\xac\x1f\x0c\xcb\x04\x27\x39\x2e\xbd\x83\x2c\x60\x28\xbc\x70\x4f\x8c\x00\x82\xbc\xf2\x56\xce\x21\x54\xe5\x96\xba\x38\x98\xc5\xcf\x70\x29\xb5\xd4\xb5\x20\x90\xab\x49\xd5\xe2\xd6\x61\xd9\xab\xde\xa6\xe9\x85\xff\x8a\xa0\xcf\xe7\x97\x73\xe4\x3c\x36\x3d\x90\xa4\xa7\xea\xa0\xdf\xc5\xed\x18\xcc\xe5\x0f\x8e\xf8\xa1\x4e\x94\x4f\x66\xa9\x61\xe1\x12\xd4\x12\x8d\x54\x6e\x9f\x55\xd8\x42\x4a\x1c\xc4\xda\xaa\x4c\x55\xd4\xe3\x56\x43\x2f\x0c\x77\x1c\x1f\x9f\x5b\xd1\x8a\x42\x8e\xbb\x24\x2f\xa9\xe9\x45\xfb\xe1\xe1\xc9\xfc\xe4\x68\x71\x74\x34\x38\x39\x1e\x1e\x9f\x1f\x8d\xce\x46\x05\x5a\xfd\x84\x28\xb3\x28\x74\x6d\x3c\x7e\xd7\xf3\x0b\x2f\x0d\x06\xc3\xc1\xe9\x46\x95\x77\x7c\xdb\x54\x74\xe5\x0c\xa0\x74\x7b\x8a\x18\x18\xdf\x82\x05\xe2\xd0\x85\x1c\xd6\x90\x58\x1c\x56\x65\x28\x44\x53\x1f\x3b\x02\x2a\x74\x5d\x9a\x13\x96\xfa\x87\x8f\xdb\x42\x2a\x8f\x0e\xcf\x06\x82\xd2\xa3\xcd\x52\x5a\x67\x5c\x24\x92\x97\x8d\x03\xd3\x98\x05\xc5\xa0\xb2\xdb\x72\x9c\xf0\x91\xcc\x89\xd6\x94\xad\x63\x60\x56\xb3\xda\x67\xc1\x26\x60\xd4\xf5\x35\x4b\x56\xb8\xc6\x0c\x07\xf3\xd5\x4e\x39\x63\x56\x20\xe9\x8c\x31\xb1\x77\x05\xe8\x79\xd5\x5f\x54\x78\x3c\x99\xac\xb1\xb2\x42\x96\x70\x4e\xd3\x6d\x15\x9a\x31\xe4\x76\x05\xa2\x29\x3f\x36\x95\x6b\x12\x7f\x4f\x75\x10\xec\xca\x8a\x14\x94\x3c\x2f\x4c\x9f\xc2\x98\x1f\x14\x2d\xd0\x62\x8a\x04\x1d\xeb\xd8\xb4\xf6\x21\x92\x0b\x6d\xe3\xec\x7b\xf1\x76\x59\x9d\x22\xda\x06\x78\xdd\x96\xbb\x89\x72\x03\xc3\x3f\xe1\x11\x09\x9f\xa4\x4e\x22\x66\x93\x44\xcb\x29\xf4\x61\x90\x2f\xbb\x6b\xe8\xd0\x3b\x73\x18\x04\xc8\x9f\x98\x0d\xae\x75\x1c\x57\xc1\x29\x95\x21\x04\x69\x80\x83\x59\xcd\xac\x99\x42\x94\x0d\x98\x09\x27\xbc\x6e\xe6\x42\x83\x90\x62\xc6\x07\x48\xf0\x11\x7c\xe4\x73\x44\x51\xb4\x30\x9b\x16\xd0\xde\x66\x3b\xaf\xe0\xc2\xe6\x53\xf4\x98\x58\x9a\x3c\xf6\x4d\x3a\xa6\x3e\xdc\x25\x60\x65\x6f\xa3\x32\x19\x16\xed\x0e\xca\x9b\xf3\x79\xc6\x8b\x90\x50\xde\xa6\xbe\xc3\x0a\x01\x60\x06\xcc\x25\xb6\x90\x91\x95\xbc\xc8\x51\xc0\x84\x36\xeb\xa4\x22\x29\x2b\x37\xe9\xc1\xd6\x6a\x83\x69\x35\xb9\x09\x76\x09\x47\xb9\x7b\x50\xa9\xf6\xb3\x01\x11\x0e\xf8\xfb\x9a\x71\xa6\x2b\x81\x9a\x15\x69\x53\x0a\xd3\x91\x44\xba\x18\xa6\x8c\x3c\x0c\xf1\xc9\x2a\xb9\xb6\xcc\x4a\xa8\xab\x22\xe8\x0f\xc0\x95\x1a\x9f\x4c\xf8\xfd\xfc\x8f\x81\xdd\x44\x54\x57\x3e\xdc\x23\xae\x01\x6e\x45\x57\xee\x90\xd7\x76\x27\x54\x53\x93\x47\x80\x28\xc0\x7f\x44\x28\xe9\xd8\x78\x88\xa2\xc0\x41\xbd\x6e\x8b\x6f\xcd\xa0\x6f\x92\xd9\xaa\xb5\x95\xdd\xc1\xcd\xb6\xc4\xb0\x4f\xca\x1e\xf9\x12\xcc\x5e\x6d\x20\xc2\xd9\xa5\x00\x07\x61\xc4\x41\xa2\xd9\x49\xeb\x28\x6d\xc9\xc1\x30\x9b\x49\x00\x9d\x39\x02\x24\xe2\x49\x86\x03\x53\x40\xd0\x92\xed\xf6\x33\x65\x6a\x23\xf6\x10\xa5\xd0\x9f\x58\xab\xb6\xb3\x8d\xb2\xc5\x98\x0f\xc0\xcc\x01\x72\xce\x3b\x80\x3d\x49\x4f\x49\xb9\x39\x64\x80\x04\xa8\x25\xba\x77\x1a\xb2\x2a\x02\xdf\xc4\x2e\x9e\xf5\xe6\x33\x32\xb3\x01\x77\x3e\xe9\xbe\x14\x04\x3a\x4a\xe5\x3b\x3d\x33\x29\xe4\x29\xb9\x56\x4e\x96\x8c\x17\x07\x08\xb9\xa6\xd1\xb7\x27\x2f\x29\xd5\x9a\x71\x30\x03\xc4\xd3\x19\x1e\xb9\xc2\x97\xc6\xa2\x9f\x68\x47\x57\x2e\x56\xa6\xeb\x32\xa7\xc2\x04\x7b\x09\x37\x85\x25\x7a\xda\x09\x9b\x18\xc2\xa5\x7a\x65\x85\x2d\x20\xe5\x20\x26\x6c\xcb\x1d\xec\xce\xd2\x30\xaf\xff\x9c\xdc\x76\x22\x98\x66\xeb\x06\xe4\xf0\x4b\xe0\x13\xe7\xb1\x63\x04\x21\x05\x24\x1f\x40\x88\x39\x1b\xc9\x71\xf5\xc2\x06\xdb\x3d\xde\xa7\x56\xb4\x4e\x36\x04\x65\x69\x31\x9f\x4d\x8f\x2e\x06\xa0\xff\x0c\x97\x0c\xc8\xd4\x17\xe9\xbd\x23\x0a\x20\x9d\x45\x4a\x31\x7e\xfc\x5f\xe3\x80\xff\x04\x28\x14\xde\xc3\xd7\x80\xcf\x61\x00\xc6\x81\x71\xf9\xde\x49\xfd\x47\xd0\x99\x1b\x8f\x27\xfe\x0d\x68\xf7\x86\x7d\x0d\x30\x67\x80\x3c\x07\x66\x88\x80\x68\xbe\x34\x00\x0f\x04\x7c\xdd\x73\x48\xb8\x94\x25\x61\xab\xf9\xef\xbe\x06\x30\x00\xe8\x1b\x66\xb2\xd8\xf6\x47\x15\xba\xf3\x97\x00\xfa\x3e\x71\x20\x47\xee\x4f\x72\xf0\x22\x62\x1c\x4c\x65\x3b\x33\xc0\xc9\xd7\x00\xca\x08\x9f\x42\x21\x62\x62\xaa\xd8\x4b\xc6\x01\x1f\xdc\x23\x0e\x16\x88\xcf\x89\xfb\xbf\x01\x9b\x0b\x30\xcf\xc0\x21\x21\x16\x28\x12\x4f\x0c\x51\x2e\x6c\x40\x78\x22\xe1\x4e\x2c\x70\x01\x97\xc0\x47\x50\x96\x9c\xaa\x6b\xb0\x41\xb5\x19\x5b\x35\x6d\x51\xab\xca\xa4\x6e\xcc\x02\xe4\x82\x45\xe4\x73\xbc\x1f\x52\xe4\x60\xa6\x52\x98\xe5\xa9\x62\xf0\x35\x78\x98\x23\xf0\x27\xa2\x44\xaf\x44\x08\x3b\xcc\xc1\x59\xa5\xd2\x25\x9e\x7c\xca\x89\xd2\x02\xf2\xf9\xc1\x14\xcf\xd2\xf2\xf1\x1b\x66\x5c\xcb\x24\x46\xac\x55\x37\x1a\x3b\x08\x1d\x13\x32\xd7\x86\x38\x1e\x50\x2b\xa2\x67\x06\xaf\xeb\xfa\x3f\xe3\xed\xd7\x3b\x9f\xad\x16\xb9\x51\x67\x55\xd0\xd7\x94\xa1\xb4\xe7\x50\x0e\x42\x86\x41\xa6\x2c\xa6\x0e\x7f\xe2\xb1\xeb\x62\x8f\xb5\xea\xa6\x94\x37\xf1\xf2\x36\xcb\x1a\xa2\xca\xfe\x9b\xee\x6b\x89\x79\xe9\xab\x3c\x5f\xff\xd0\xe4\x4e\x0f\xb2\x2a\x07\xfb\x22\xe2\x24\x20\x0b\x12\x31\xc0\xc4\x3a\x17\xc0\x96\x1f\xdb\xd3\x25\xf3\xc5\xfd\x4d\x61\x6d\xc4\xe1\xe8\xcc\x7e\xc1\xec\x08\x97\xb0\x7c\x11\x37\xc4\x45\xe0\xb2\x43\x5d\x7b\x6e\x6a\xe2\x9c\xfa\x3b\xf6\x03\x5c\x14\xe1\x13\xbc\xc7\x41\x75\x25\xc3\x65\x3c\xae\x25\x86\xf6\xf9\x09\x34\x3f\x7e\x29\xc2\x30\x0a\x78\xe5\x51\x50\x51\x50\x0f\x6d\x8b\xa2\x65\x76\x02\xc1\xdf\x1e\x0a\x62\xce\x55\xf7\xce\x6b\xbd\x70\x1e\x1c\x0e\x8e\x06\xc7\x05\x98\xb1\x4a\xd4\x64\x2b\x4c\xc4\x81\x79\xdd\x39\x7e\xa0\x50\x7e\xbb\x25\xba\xf7\xc5\xf8\x3e\x20\x1f\x43\xf0\x1b\x46\x3c\x7a\x82\xef\xc0\xc5\xcf\x05\x41\x41\xe2\xd6\x2a\xcf\xff\x22\x0e\xd0\xf2\x45\xea\x1f\xaf\x3e\xde\xde\x7d\xbc\xbc\x78\xf8\x78\xf5\x53\x4b\xbc\x85\x0c\x95\x16\xd2\x53\xc4\xd4\xf6\x0d\xfd\xe2\x56\x38\x0d\xd1\x16\x86\x70\xe2\x20\xc1\x87\x77\x20\xf1\x81\x77\x00\x71\x67\xd0\x76\x29\xe2\x03\x2d\x96\xb2\xfe\x5d\xe5\xfa\xfa\xe6\x22\xc4\xff\x40\xcb\x36\x11\xfb\xec\xe4\x5c\xb3\x94\x05\x0c\xe0\x0c\x81\xeb\xeb\x9b\xbf\xa9\x9c\xa7\x47\x54\xab\xde\x22\xc4\x93\x47\xd4\x3e\x0d\x41\x21\xb5\x59\x3a\xca\x4a\x96\xe2\xd3\x8f\xfc\xdd\x74\x2a\x8a\xdf\xcb\x73\x96\x4a\xea\x84\xc7\xaf\x53\xf8\x5b\xc7\x2a\x7a\x29\x01\x28\x2a\x38\x29\xa8\xbc\x5f\x47\x75\x89\xa5\x26\xe8\xe6\xe2\x41\x05\x22\xca\x83\x4a\xc9\x61\x32\x04\xc4\x92\xb7\xc3\xba\x39\xdd\xcd\xc5\x03\xe0\x14\x3e\x21\xca\xa0\x6f\x3a\x58\x02\x42\x81\x07\xb1\x1f\xd1\x0e\x19\xec\x6b\xed\x24\xb8\xfe\x18\x4e\x9f\xda\xf0\x99\xba\xab\xf8\x63\x49\x48\x20\x31\x4c\xeb\x06\x11\xff\x14\xdb\x11\xdd\x67\xa0\x3d\x4f\x7c\x3c\x9b\xf3\x00\x07\xb3\x89\xc6\xbf\x88\x8a\xd5\x91\xb6\xdf\x0c\xa8\x1b\x0d\xa9\xc0\x94\x7b\xd0\x67\xc8\xca\x47\x41\xcb\xc9\x5a\x6b\xc9\xae\x97\x8c\x5f\xa4\xed\x48\x06\xbb\xa3\xc1\xc8\x5e\x66\x1a\xc2\xe5\xa4\x73\xb9\xd9\x2d\x5c\x5e\x66\xab\x05\x41\xce\xaf\xbc\xdb\x86\x2c\x56\xd6\x19\xa4\xc6\xad\x47\x1a\x8b\xb3\x87\xba\xd6\x18\x17\xf7\x73\x18\xd9\x3d\x51\x13\x1a\x6f\x5d\xf0\x65\x6d\x2a\xd5\x63\x4f\xa8\x97\xa0\x2c\xa3\x81\x9d\x7a\x42\x57\xd6\x8a\xda\x2d\x5c\xb6\xc7\x6c\x23\x5a\xfc\xf3\xc3\x65\x51\x26\x9b\xbc\xdf\xe8\x9c\xf1\x74\xab\xe0\xf4\x76\xe9\x5b\x20\x66\x06\xdd\x88\xb6\x2f\xed\xd3\xa8\x7e\xb9\xfb\xad\x10\xcd\x39\xe7\xe1\x87\x83\x03\x87\xe0\x60\x06\x39\x92\xe6\x0c\x07\x4f\x04\x3b\xe8\xe0\xf8\xfc\xf8\xdc\xf1\x86\x70\xdf\x73\x9c\xe9\xfe\xb1\xe3\x1c\xee\x9f\x1f\x1d\x1f\xee\x9f\x41\x6f\xf4\xfe\x7c\xe8\x38\xa7\xa7\x05\xe7\xc9\x90\x62\x07\xad\x59\x12\xc5\x27\x5a\xca\xa2\xc4\xae\xbb\x34\x0a\x30\xed\xe5\x51\x27\x0f\xad\x97\x4a\xba\x7d\x45\x3b\x3a\x19\x0c\x3b\x53\x4a\x63\xd1\x9e\x56\xeb\x6d\xe0\x13\x22\x59\xdd\xb3\xd1\xcd\xff\x16\xce\x10\x9c\xfa\x8d\xd3\xee\x13\xf3\xe2\x76\x58\x33\xfd\x84\x7c\x41\xca\x69\xf1\xdd\x5f\xbe\xbc\x33\x9b\x94\x90\x78\x40\x5d\x0c\x07\x44\xa5\x23\xc8\x38\x76\x41\x72\x53\xd7\xf2\x9c\x74\x39\xe8\xbb\x34\xbe\x13\x86\xff\xac\x42\x5a\x35\xbb\x96\xf7\x2d\x02\x4d\xd9\x46\x45\xcc\x5d\x1f\xbe\xf7\x02\x29\x2b\xce\x32\x55\x75\x62\x0b\xfb\xdb\x48\x2d\x47\xaf\x93\xb8\x0f\xe2\x03\x63\x89\x4d\x09\xba\x82\x58\x75\xd0\xf5\x21\xdb\x98\x58\x48\xcc\x6f\x25\x62\x9b\x55\x53\xb9\x83\xea\x2c\xe7\xe6\xca\x9a\x99\x9d\xce\x20\x89\xff\x48\x42\x14\x20\xd7\x64\x60\x00\x9d\x9b\x0d\xa6\x88\x3f\xa3\x44\xba\x88\xf5\x91\xa9\x1e\xb2\xa3\x12\x89\x4c\x60\x5f\xbe\x74\xf9\x0c\x7d\x1f\xf1\x8e\xc1\xe8\xca\xfc\xa8\x97\x98\x17\xbe\x91\xae\x7c\xc7\x87\xf0\xe4\xe8\xec\xea\xf4\xf8\xdc\x3d\x7d\x7f\x72\x74\x39\x84\xef\x4f\x4f\x8f\xcf\x86\xde\xa1\x3b\x7c\x7f\x7c\x75\x31\xf4\xce\x8e\xa6\x27\x1f\x8b\x82\xf5\x55\x39\x3f\x5f\x92\x09\x97\x1e\x56\xb6\x30\x23\x5c\x6d\x23\xf6\x65\xcb\x7a\xef\x39\x27\xde\x19\x1c\x9d\x9d\x1f\xbb\xce\xd1\xb9\x73\xfa\xfe\xe8\xcc\xf5\x46\xc3\xd3\xd1\xd1\xd4\x75\xbd\x11\x72\xce\xdf\x8f\x86\x27\xc3\x23\x78\x32\x3c\x1a\x9e\x8a\x45\x9f\x79\x67\xc3\xc3\xe3\x93\x33\x78\x76\x74\x5e\x90\xf6\x4b\x9e\x83\x6e\x5d\x1a\x3f\x0b\x00\x7d\x37\x72\xde\x98\xe9\xb9\x96\x59\x1a\x2d\x2d\x4f\x3c\x39\xf6\x15\x94\x10\xa8\xd4\x8f\x06\xdd\x32\xa5\xcb\x5c\x4f\xb5\xae\x57\x61\xd2\x2c\x2b\x69\xed\x87\x47\x34\xfe\x77\x90\xa3\xc2\x32\x61\xdb\xf5\x49\x6d\xa1\x90\x77\x1e\x5b\x60\xa7\x0c\xe6\x7c\xce\xf5\x48\xb7\x3c\x3d\x9b\x1d\x9d\xdc\x30\x42\xec\x3f\x2d\x39\x5e\x31\x54\xc5\x83\x3a\xbf\x2b\xb0\xc0\x01\x5e\x44\x8b\x75\xc5\x35\x34\x74\xbb\x8b\x1f\xcd\x66\x88\xf1\xe2\x3e\x91\xcd\xeb\x12\x2c\xe8\xda\x10\x4e\xfc\x5c\x92\x5b\x71\x1f\xe3\xb7\x05\xa9\x31\x7a\xd0\xc2\x04\xe8\xa9\x19\x03\xe0\xc9\xd0\x61\xe0\xd4\x4b\x45\x0a\x11\x9d\xc8\x46\x99\xeb\x8a\x12\xde\x22\x2a\xdb\x64\x16\x38\xfe\x88\x4e\x4c\x37\xcb\xf5\x21\x70\xaf\xbf\xb0\x61\xf6\x92\x88\x77\xef\x17\xc5\xe7\x71\x58\x4b\xff\x6b\x8d\xae\x51\x89\x49\x15\x95\x13\x7d\x17\x4c\x7c\xe4\xf3\xde\xc2\x67\xb0\x76\xd6\x69\x18\x13\xbb\x38\x3f\xb0\x7b\xef\xaa\x64\xd6\x56\x23\x75\x5d\xcd\xd3\xba\x6a\x5e\x14\x30\x89\x66\x0d\xb6\x6b\xf5\x84\xe4\x24\xcc\x3f\x02\x6a\xe3\x6f\xe6\xc5\x50\x2b\x4f\xbb\x26\xb3\x5d\x34\x7f\xec\x33\x83\x56\x3b\x5f\x58\x1e\xfe\x4d\xaa\x8a\x4e\xba\x65\x88\x62\xe8\xeb\xf4\x2f\xe1\x1b\x1b\x4a\x27\xe6\x9a\x49\xed\x5a\xaf\xb6\xbf\x15\x39\xb1\x5b\x40\x1d\x0c\x56\x7e\x5b\x23\x17\x6a\xe5\x3f\xda\x21\x37\x7f\xb6\xe3\x79\x4e\x00\xf1\x3c\x44\x63\x11\x6d\x69\x06\xfa\x7c\xc0\xa3\xa0\x16\xf6\x8f\x08\xfa\x96\xdb\x24\x3b\xc5\xfe\xa9\x06\x5f\x23\x4e\x4b\x3b\xf5\xeb\x47\x41\x56\x53\xeb\x81\xd7\x99\x53\x57\xf1\x3f\x55\x7e\xa1\x46\x82\x90\xcc\xd2\x48\x88\x70\xcc\x25\xc5\xa1\x96\x9c\xe9\xf3\xb1\x90\x5e\xf7\x4b\x6d\x0a\xff\x99\x63\x6b\x7d\x03\xab\xe7\x6a\x23\xab\x05\x44\x9c\x88\x04\xd3\x75\xcf\x65\xb9\xfa\x1a\x76\x76\x41\x02\xcc\x89\x20\xe6\xc4\x83\xd8\x2f\x76\x5e\xab\x6f\xf1\xaf\x63\x50\x9f\x14\xa4\xa6\xea\xda\x46\xed\x9a\xe8\x4c\x4f\x87\x00\xc3\xba\xc6\x82\xdf\x4a\x78\xb7\x28\x9c\xad\x92\xd4\x0b\x60\x68\x61\x85\xbe\xbf\x4a\x51\xcf\x8a\x6e\x0d\x71\xad\xe0\x6c\x5f\x09\xeb\x39\x16\x97\x6e\xf3\x79\x81\x58\x3f\xab\xf2\x96\xbe\x09\x93\x72\xb3\xcd\xe1\xca\x38\x6a\x59\xa3\xe2\x51\xb2\x00\xc6\xee\x7c\xa6\xd0\xf1\x5f\xa4\x71\xd9\xb4\xe2\xaf\x9f\xcf\xa6\xe6\xb5\xf3\x09\x2b\x2e\xe5\x75\x88\x8b\xea\x9f\xb1\xd2\xd3\xda\x5f\x9f\x2a\x28\x97\x02\x48\xd1\x76\x7c\xf1\xf3\xe5\xe8\xf0\xa8\xf6\x61\x88\x26\x28\xb3\xce\xe3\x50\xaa\xea\xb8\x95\x49\xb4\x42\x48\x57\x90\xc5\xaf\x7f\xab\x8a\xdb\x64\x05\x75\x0d\x3d\xeb\xe3\x4d\xed\xcd\x0a\x75\x48\x28\x1f\x33\x16\xa1\xaa\x46\xc9\xd9\xa1\x00\x8b\x3f\x0a\xe2\xc9\x16\x25\x72\x76\xeb\x60\x61\x5f\xbd\x69\x0b\xbe\x5b\xfe\x75\xfd\xab\xbd\x4b\x71\x35\x0f\x6d\x9c\xcc\x35\x6a\x4e\x73\x34\xff\xb7\x92\x4d\xad\xdf\x6e\xb9\x75\x55\x2d\x66\x76\x75\x86\x6b\x7e\xb0\x6a\xc9\xa0\x3a\x23\x24\xc5\xa4\xbd\x80\xa4\x3f\xd9\x4e\xbd\xae\x12\x30\xac\x9b\xd6\x26\x9a\x9f\xaf\x8b\x4d\xf7\x2a\xc9\xbb\x1e\x9b\xf4\x60\x93\x19\xee\x45\x7e\x4f\x6c\x92\x50\xba\x1c\x28\x24\x7a\x6b\x6b\xb4\x5f\x40\x46\xed\xe5\x67\x82\x44\xc5\x94\xb4\x8e\xcf\x16\x10\xab\xb8\x99\xea\x7d\x40\x7c\xa5\x0b\xe6\x64\x2d\xdf\xd7\xed\x92\x62\xdb\xa3\xa9\xd4\x2c\xa9\x67\x8b\xc6\x57\x9b\x0d\x6a\x5b\xe3\x1c\x4d\x76\x7c\x1b\x00\x13\x13\x0d\x91\x83\x3d\xec\x24\x42\x76\x75\x63\xa2\xa6\xaa\x74\x42\x28\x96\x19\x53\x35\xaf\x22\x35\x36\xc9\x2a\xd7\x2d\x50\xb3\xcd\x03\x8a\xe9\xa9\xa9\xdc\x93\x28\x08\x70\x30\x8b\xa9\xd8\x2c\x71\x6c\xf5\x1c\xc9\xa4\xe9\x93\x98\x1a\xa5\x1a\xef\x61\x76\x7b\xf3\xa3\xc8\x4b\x3e\x9d\xc2\xb3\xe9\xfb\xd1\x70\xff\xdc\x85\xee\xfe\x68\xe4\x8e\xf6\xdf\x0f\xa7\xc7\xfb\xc3\xa1\x33\x3c\xf6\xdc\xe3\xa3\xa1\x53\xf0\x86\x60\xc1\xbb\xd6\x19\x2b\xa3\x47\x81\x67\xcc\xe7\xe0\x79\x8e\x9d\x79\x4c\xe3\x67\xc8\x94\x0d\x41\xee\x00\x7c\x7c\x42\x74\x19\xff\x34\x87\xc2\x8b\xd5\xd1\x69\x53\x8b\x2e\x9b\x32\x20\xd7\x5c\xea\x16\xa4\x55\x59\x9c\xa6\x4c\xf6\x43\xe6\x4e\x38\x43\x91\x8c\x81\x91\x3b\xc9\x07\x30\x1a\x1d\x1e\xe5\xfd\x30\x4e\x1c\xe2\xcb\xeb\x34\x37\x4c\xd9\x1e\x2b\xc1\x7a\x7e\x62\xf2\x6d\xbe\x2f\xbd\xd6\xbc\xd6\x3b\xa5\xe5\xf5\xb3\x27\xb6\xff\xa4\x75\x3e\x47\xe3\x25\x85\x9f\xcd\x56\x80\x19\x2f\x3b\xca\xa6\xf7\xf3\x9c\x3d\x4e\x4e\x4f\x9f\x63\x8d\xea\x67\xcc\x33\x03\x24\x90\xee\x89\x40\x32\x61\x9f\xf3\x4e\x42\xb9\xd5\xcd\x34\xdd\xdf\x00\xa1\x5a\x76\x86\xc8\x4f\xcf\x5c\x89\xae\xbd\x43\xc4\xbd\xea\x10\x71\xb3\x95\x0e\x11\xf6\x6c\x7c\x5b\x77\x88\x7e\xfa\x3e\x5c\x2c\x04\x8f\x5d\x58\x90\x99\x53\xd8\xf9\x61\x7b\x3d\x1d\x7a\x6b\xd5\x70\xf3\x5b\xed\x86\x08\x2f\xa2\xab\xc1\xba\x5b\x14\x6c\xcc\x34\xa4\x6e\xa7\xdb\x18\x87\x14\x80\x38\xaf\x69\xd5\x56\x32\x7f\x76\xab\x9b\xe6\x38\x99\x4d\x3b\x26\x11\x2b\x30\x0b\x1c\x44\x75\x0f\x19\x9b\x6e\x8a\xbc\xf2\xc3\x69\xdb\xc6\xf6\x16\x10\xb9\x36\x0b\x92\xee\x00\x5a\x6e\x54\xed\x61\xf6\xa4\x93\x97\xf5\x55\xf4\x5f\xab\x83\xee\x15\xc9\x2f\xa5\x5b\x64\x9d\x9c\x93\x7a\x27\x01\x23\x7a\xc6\x7d\xff\x2e\x5c\xfe\x16\x69\x2a\x56\x7b\xf0\xfd\x9c\x00\xbe\x17\x37\x3c\x73\x39\x9f\xb4\x1a\xbd\x5c\x3f\x55\x99\x39\xfb\x0b\xf9\xf6\x18\x61\xfa\x31\x7c\x36\x27\xcf\x2a\x3a\x98\x88\xbc\xca\xbe\x28\x9c\xa3\x45\x28\x1f\x22\x5e\xbd\xc0\xee\x74\xec\x95\x62\x60\xb6\x4c\x74\xad\xf4\x3a\x63\xf8\xf6\xf3\x68\xbc\xc2\x35\x7d\xff\x7e\xf5\x81\xcd\x6e\x77\xf2\xad\xeb\xe6\x5b\x9c\x99\x96\x0d\x14\xeb\xc7\xb3\x81\x9a\x5a\xa7\x1a\x6b\xc9\x11\x2b\x7c\xf5\xbe\xaf\x34\x66\x99\x44\xdd\xfa\xc9\x7a\x85\xa3\xed\xb5\xfa\x5e\xf1\x6b\xf5\xd8\xbc\x79\x62\x79\xd2\xfd\x44\xa0\x00\xb5\x3d\x19\xc4\x88\x74\x08\x50\x1a\x1c\xd6\x98\xf8\x28\x5f\x73\x42\xee\x04\x76\x38\xb5\x29\x10\x17\xc5\xa7\xb6\xc3\xe1\xe8\x7c\x7f\x78\xba\x3f\x3c\x7d\x18\x8d\x3e\x0c\x8f\x3f\x1c\x1f\x0d\xce\x47\xc3\xe1\xd1\xc9\xbf\xec\x58\xb9\x98\xea\x77\xac\x5b\xdf\x07\xc6\x10\x8a\x90\xd2\xc4\x2d\xb0\x70\x6e\x64\x7f\x0f\x33\xb3\x13\x98\x61\x00\x07\xc0\x94\x1e\xb4\x89\x02\x54\x6a\xc4\x95\xc1\xa7\x48\x21\x0e\x87\xd6\x75\x6c\xa4\x4e\xb1\x9b\x08\xae\x27\x82\x7f\xec\x78\xd3\xe1\xd1\xe1\xf1\xbe\x0b\xbd\xd3\xfd\x63\xe8\xbe\xdf\x3f\x3e\x7e\x3f\xdd\x47\xa7\x23\x0f\x0d\xe1\xc8\x3b\x1f\x15\x44\xf0\xb7\x76\xa8\xb6\xf9\xb0\x5d\xcd\x98\x71\x4d\xab\xcc\xd8\x97\xfb\x8d\x24\x92\xae\x4b\x86\x7a\x4f\x08\xad\xef\xc7\x6e\x2c\x4c\x7f\x49\xc4\x9f\x0a\x7d\x32\x99\x9e\xd4\xaa\x46\x7a\x63\x7e\x55\x55\x40\xbc\xca\xc1\x4a\x45\xc4\xe5\x7a\x10\x8b\x5d\x2c\x79\x38\x8e\xdd\x2f\x28\x8e\x3e\x90\x81\xff\xb0\x5a\x57\x95\xd6\xce\x0b\x7d\xe5\xbb\x26\xbc\xca\xc4\xf0\xb2\x9b\xf8\xe2\xce\x0b\xbb\x66\x1c\x7d\xe1\xbb\x6b\xc6\xf1\x56\x9b\x71\x68\x7d\x13\xe6\x94\x5d\xcc\x66\x14\xcd\x84\x7f\xda\xc5\xf0\x14\x80\x8a\x6d\x10\x8c\x7f\x32\xe6\x48\xde\xe1\xcb\xee\x1d\x7a\x68\x7d\x5b\x24\x27\xd6\x0d\x8a\xad\xd0\xdb\x7c\xc2\x49\xfa\xdb\x6d\xc9\x5a\x72\x6a\x4e\x92\x35\x8e\xb5\xd4\x4a\x3c\x29\xe9\x94\xd5\x51\xa6\xa5\x23\x55\x72\x09\x35\x31\x07\xbf\x75\xbd\x4b\x2e\x11\xb8\x8c\x3f\x62\x77\x44\xa2\xc5\x64\x33\x91\x84\xfb\x68\x91\x0e\x26\x54\xe0\xb3\xce\xa8\x81\xc1\x45\x06\x0e\x0a\xf1\x28\x3a\xd9\xf5\x88\x45\x7c\x58\x2b\x44\xe2\x15\x38\x6b\x4a\x39\x21\xf6\xdb\x55\x28\x15\x42\xc9\x39\x6e\xc6\x50\xae\xc1\x73\xb3\x5d\x81\x40\xd7\xc5\xea\x31\xc6\xdb\xe2\x94\xf1\xa6\xf6\xb6\xad\x3b\xf7\x6a\x6c\x3d\xe7\x7e\xc1\x23\xe3\xb9\xd8\x78\x62\x68\xd6\xb0\x9b\x7b\x3f\x4e\x00\x93\xe3\x74\x9b\x2a\x10\x52\xb2\xc0\x0c\x75\x68\x49\xd2\x63\xa0\xc3\xee\xc8\xae\xab\xa2\xd2\xce\xbd\xc4\x03\x9f\x2b\x72\xae\xf5\x06\x44\x7c\x47\x08\x17\xaa\x64\xae\x1c\x95\xdb\xb3\xe5\x3f\xca\x0a\x63\xc9\x58\x99\xd9\x98\x7c\xc8\x9f\x53\x18\x30\x98\x8e\xc9\x15\x91\xae\xf9\xfa\x1c\x42\xd1\xc1\x34\xdb\x49\x2c\xbd\xba\x05\x0a\x5a\xdc\x5e\x27\x66\xe6\xfd\x14\xf3\x63\xfd\x00\x7f\x2f\x5d\xee\x4b\x7a\xb6\xb5\x6a\xbb\xb6\xb6\xe8\xf2\x7a\x5e\x0f\x5f\x17\xba\x1e\xaa\x9b\x06\xb0\xdd\xbe\x70\xdd\x56\xf9\x6a\x42\x7a\x42\xb9\x3a\xdd\x4c\x28\xe5\xed\xf5\x66\x82\x7f\x9b\xcc\x21\x9b\xb7\x2f\x03\xfc\xf6\xab\x98\x5e\x4c\xb4\xc3\xa1\x33\x3c\x1b\xc2\xf3\x29\x3a\x3d\x39\x3a\x39\x81\xee\xd4\x3d\x9c\xc2\xe3\xb3\x73\x34\x3c\x3f\x41\x87\xe8\x3d\x72\xcf\xd0\xe9\xf9\xe1\xc9\xf9\xe9\xc9\xf1\xfb\xb3\xa3\xe3\xf3\xf7\xc7\x08\x4e\xcf\x4e\xdc\x23\xcf\xf5\xe0\xc9\x16\xfc\x02\x61\x00\x3b\x84\xf6\x6c\x20\x12\x4e\x62\x6c\x62\x5f\x72\x80\x2f\xb9\xbd\x74\x76\x0a\x77\x31\xbe\x5d\x8c\x6f\x17\xe3\x2b\xb7\x3c\x0f\x88\x2e\x58\x9b\x27\x9f\x92\x13\xb5\xd7\x06\x30\x93\x15\x7d\x32\x47\x67\xba\x04\x5c\x0c\x01\x71\x3d\x61\x1d\xa7\x6e\x46\x11\x72\xe3\xd0\x4f\x91\x9d\xa9\x6e\xc0\x70\x21\x01\x99\xe8\x4e\xd1\x36\x51\xfc\x42\x8b\x46\xc4\x6c\xf1\x5d\x11\xb1\xbf\x7c\xde\x00\x91\x27\x44\x59\x97\x6b\x7c\x85\xc6\xef\x1a\x4a\xe1\xae\x39\x18\x0e\x0e\xcf\xb6\x21\x7f\x2d\x36\xbc\xd4\xcc\x84\x04\x52\xf9\x02\xf7\x4e\x02\xdf\x86\x04\x26\x0f\x41\x6a\x9f\xee\x8c\x89\x7a\x16\x81\xbf\x40\x65\xf8\x1d\xfa\xd8\x95\x11\x4f\xd9\xcf\xa0\xe6\x19\xbb\x59\xbf\x08\xbb\xe7\xf5\x09\x23\xdf\x95\x1f\xbd\x86\xa1\x9d\xfc\xf6\xd6\x0f\xf5\xc9\x6e\xda\x3d\x14\xd1\x5b\xa2\x0b\x16\xd9\xa6\x10\x15\x71\x9d\xa7\x34\xc9\xb2\x1f\xe9\xc2\xa3\x88\x63\x3f\x53\x04\x0f\x93\x5d\x15\x3b\xb0\x46\x26\x9e\xaf\xcb\x8b\x56\x48\xde\x45\xb5\x5b\xed\x5e\x48\x6c\xac\x4c\xdf\x48\x4b\x89\x6e\x89\x4b\x76\x3f\x4f\xef\x12\x2d\x0f\x97\x72\xf6\x7a\x34\xbf\xa0\xbd\x42\x82\x69\x1d\xe4\xaa\x53\xda\x4e\xa6\x81\x5b\x82\x9a\x32\xf5\xbd\x35\xdc\xdf\xe5\xec\x8d\x52\xd3\x21\x81\x87\x67\xb7\x70\xe9\x13\x58\xab\xda\xa9\xb0\xa5\x0a\xe4\xb0\xff\x4b\x91\x0c\x84\x7a\x5a\x7a\x25\x50\x29\x30\x9e\x19\xf8\x16\xac\xc1\x4a\xc8\xf6\xc3\xac\xfd\x4a\x0c\xf1\xb1\x3c\x07\xec\xc5\x84\xcf\xb5\xe7\x79\x97\x85\x6a\x52\xb9\xac\xf0\x74\xb5\xcb\xc9\xc9\xe1\x61\x16\xd0\x0f\x45\x7f\xfb\x6b\xa3\xb2\xa2\xaf\xcf\x2e\x75\x4d\xc1\x13\xe6\xcb\xfb\x6c\xfe\x59\x63\xb9\x29\xeb\x3f\x56\xe3\x62\xf4\xe8\xb0\xf4\xe2\xda\x2d\xd0\xd3\x55\x12\xf2\x24\xe2\x4e\x13\x85\x4d\xf4\x95\x83\x1c\xed\x73\x9c\x7d\x63\xda\x9e\xa9\xfc\xa5\xe8\x01\xa9\xbe\x9c\x05\x2b\xf0\x10\x21\xda\xfd\x59\x39\x84\xe8\x45\xba\x87\x77\x36\x6e\x2a\xe5\xa2\x4b\x74\x57\x5f\x35\x6e\xac\x55\xcf\x0f\xe2\xbf\x7f\xfd\xff\x00\x00\x00\xff\xff\xe9\xa8\xc0\x4b\x83\xc7\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xe1\x73\xdb\xb6\x92\xff\xae\xbf\x62\x87\x77\x33\xd7\xce\xc8\x92\x93\xf4\xcd\xcd\xcb\x37\xd7\x49\x5b\xcf\xa5\xa9\xc7\x76\x7b\x1f\x2e\x6f\x32\x10\xb9\x92\x50\x53\x00\x0b\x80\xb2\xf5\x3a\xfa\xdf\x6f\x16\x20\x24\x8a\x22\x25\xd2\xa2\x23\xc5\xe1\xbc\xce\x4b\xa8\x00\x0b\x60\xb1\xfb\xdb\x05\x16\x58\xfc\xdd\x03\x08\x64\x82\x82\x25\x3c\x78\x0b\xc1\x9b\xc1\xf9\xe0\x4d\xd0\xa7\x5f\xb9\x18\xcb\xe0\x2d\x50\x09\x80\xc0\x70\x13\x23\x95\xb8\xc3\xbf\x52\x1e\x33\xb8\xb8\xbe\xb2\xe5\x00\x82\x08\x75\xa8\x78\x62\xb8\x14\xb6\xc4\x14\x21\x49\x55\x22\x35\x82\x1c\x83\x99\x72\x0d\x91\x0c\xd3\x19\x0a\xc3\xa8\x10\x70\x0d\x46\x42\xa2\xe4\x9c\x47\x08\x11\xce\x31\x96\x09\x2a\x0d\x4c\x00\x17\x9a\x4f\xa6\x86\x6a\x4e\xe5\x03\x18\xf9\x49\x70\x61\x50\xb1\xd0\xc0\x03\x37\x53\xf8\x75\xa1\x0d\x2a\x9e\xce\xe0\xa3\x8c\x10\xe6\x9c\x41\xae\x4f\x83\x4f\xe2\x8e\x1a\xd4\x53\x99\xc6\x11\x44\x38\x93\x42\x1b\xc5\x0c\x02\x8b\x63\x30\xd4\x37\xa9\x35\x1f\xc5\x48\x43\x80\x90\xc5\xb1\x76\x84\xdd\x30\x46\x18\x41\xc2\x14\x9b\xa1\x71\x3d\x8a\x40\xa1\x4e\xa4\xd0\xa8\x07\x7e\xc4\x73\x54\x3a\x1b\x6d\x84\xf3\xa0\x07\xb0\xa4\x7f\x09\x34\x2a\xfa\xa7\xe0\x2d\xfc\x9f\x2d\xe8\x98\x07\x10\xa4\x2a\x26\xd6\x4c\x8d\x49\xde\x0e\x87\xaf\x5e\xff\xf7\xe0\x7c\x70\x3e\x78\xf5\xf6\x87\xf3\x7f\x9c\x53\x75\x80\x65\x0f\xe0\x5f\x96\x48\xc2\xcc\x54\xaf\x39\x3f\x5c\xfd\x15\x20\x98\xa0\xc9\x7d\xba\xb9\x53\x96\xa9\x57\x11\x35\x10\xc9\x50\x5f\x89\x08\x1f\xb3\x9e\xd2\x7f\x81\x61\x93\x75\x97\xb2\xdf\xde\xc9\x50\x07\xab\x1f\xfe\x95\x2b\xad\xd3\xd9\x8c\xa9\x05\x51\xbb\xc1\x88\x2b\x0c\x8d\x9d\x2e\xe2\xd6\xc6\x34\xe6\x9b\x28\x88\xc0\x46\x45\xcb\x73\x36\x41\x50\x28\x22\x54\x5c\x4c\xb6\x69\x81\xe5\x5c\x04\x29\x95\x80\x21\x0d\x63\x98\xa7\xbf\x9a\x82\x8d\xd1\x03\x04\x6f\xce\x5f\x15\x7e\xaa\xee\x0d\x8d\x22\x23\x9d\x2b\xbf\xec\x15\xff\xe6\xfe\x5c\x66\x73\x3d\x64\x61\x88\x5a\x9f\x25\x32\xe6\x21\x47\xdd\x64\x3a\x2e\x6c\xd5\x6b\x5f\xb3\x8a\xcb\x26\x55\x42\x83\x6b\x07\x92\x92\xd2\x5b\x03\x72\x35\x62\xae\xad\xa2\xec\xa8\x59\xc9\xb8\xd7\xe7\xe7\x7b\x19\xf7\x61\x2f\x7d\xfa\x2f\x08\xa5\x30\x28\x36\x39\x91\xfd\x13\x4b\x92\x98\x87\x56\x5c\x86\x7f\x6a\x29\x4a\xca\x10\x33\xc2\x29\xce\x58\xe9\xbf\x01\x04\xff\xa9\x70\x4c\x7c\xfa\x8f\x61\x28\x67\x89\x14\x28\x8c\x1e\xba\x2a\x7a\x58\xe0\xf0\x56\xfd\x65\x6f\xd7\x77\xfe\x2b\x9b\xef\xac\xd1\x7f\xd4\x60\xcf\x15\xe1\x92\x60\xb1\x13\x5e\x05\xa8\x94\x54\xa7\xc4\x9c\xf7\xd4\xa1\x5f\x51\x6b\x36\xc1\x77\x77\xbf\x05\xbd\x42\xed\x26\xdc\xe9\x15\xff\x56\xd4\x92\x34\xe2\xa6\x89\x6e\xd8\x0a\x1f\xe4\x64\x1f\x52\x5d\x50\xb9\x1a\x50\x95\x29\x11\x95\x86\x78\x93\x6c\x85\xfa\x24\x8a\xcf\x79\x8c\x13\x8c\x2c\x20\xad\xfa\xa7\xfb\x10\x33\x83\xda\xc0\x98\x2b\x6d\xfa\xc0\x62\x29\x26\xce\x48\x10\x98\x29\xd4\x69\x6c\x15\x23\x96\x13\x20\xeb\x34\x51\xdc\x2c\x20\x9c\x62\x78\x9f\x6f\x77\x6d\x47\x0a\x83\x5a\x73\x85\xfe\x17\x08\x36\xb3\xb6\x75\xac\xe4\x2c\x57\x9f\xfe\x0b\xb8\xed\xf2\x5f\x29\xaa\x45\xd0\xdf\x29\x8e\x0e\x47\x00\x85\x51\x1c\x35\x28\x0c\xa5\x8a\x30\x02\x66\x40\x2a\x60\x63\x83\xca\xda\xbf\x09\x9f\xa3\x00\xc3\x67\xd8\x07\x2e\xe0\xe6\xa7\xcb\x37\x6f\xde\xfc\x13\xc6\x52\xcd\x98\x19\x14\x1b\xa9\x14\xbf\xc0\x2c\x12\xdb\x6b\x6d\x08\xd3\x37\x85\xab\x52\xb1\x2a\x46\x6e\xe4\x73\x8d\x7b\x84\x63\xa9\xf0\x64\x07\xce\x42\x23\xd5\xd3\xc7\x7e\x41\xd5\x49\x12\x69\x7c\x2b\x01\xee\x03\x0e\x26\x03\xf8\x14\xa4\x1a\xd5\xdb\xd9\x42\x9b\x4f\x41\x1f\x3e\x05\x46\xde\xa3\x78\xfb\x29\x3d\x3f\x7f\x13\xf2\xc8\xfe\x89\x9f\x02\xe2\xd2\xa7\x80\x09\x29\x16\x33\x99\xea\x4f\xc1\x91\x58\x41\x7e\xcf\xd3\x39\x51\x90\x82\xb1\x54\x56\xa9\x89\xa8\x06\x6d\x98\x32\x3c\xaf\xc3\x4e\x09\x12\x85\x63\xfe\x78\xa4\xf1\xc6\x7c\xc6\xcd\xd3\x07\xfc\x2b\x7b\xe4\xb3\x74\x06\x22\x9d\x8d\xd0\xca\x80\x1f\xbb\x91\xa0\x2c\x37\xfa\xf0\xea\xfc\x1c\x46\x0b\x88\x70\xcc\xd2\xf8\x29\x32\x6e\xd1\x0d\xd5\x8e\x91\x96\x22\xf3\x41\x6e\xc7\x85\xc7\x70\x3f\xa2\x53\xb2\xa9\x17\x99\xd9\x3a\xd4\x9e\xe6\x87\x14\xfc\xf0\xfa\xf5\x5e\xae\x5c\xaf\x17\x25\x73\x16\xf3\xc8\x6a\xfa\xe9\xf9\x1c\x7f\xac\xfa\x66\xbd\x8f\x56\xd9\xd4\x39\x65\x8d\x9d\x32\x33\xb5\xff\x87\xc2\xd0\xa0\x30\xd7\xe3\x20\x91\x7a\xb7\x87\x76\x91\xaf\xb8\xd7\x4b\x5b\x95\x25\x79\x2d\x05\x85\x9c\xbb\x56\x45\xba\x30\x99\xf9\x62\x1a\xc8\x9a\xd9\x05\x39\xd7\x3a\x45\xf2\xf5\x08\xcc\xc9\xa6\xe5\x49\x28\xfc\x2b\x45\x6d\x7e\x94\xd1\x62\x63\x74\x3b\xa6\xbd\xce\xa4\xef\x9a\xf2\xdd\x13\x4e\x83\xb8\x71\x9d\x2a\x4e\xf6\xb2\x57\xf5\xb5\xec\x95\xa8\xc0\xa1\xb0\xba\x62\x26\x21\x87\x4e\xc3\x10\x31\xc2\x28\xdf\xa9\x5c\x63\x04\x4b\x35\xa8\x12\x9f\x69\xc3\x44\x93\x79\x7d\xd1\xca\x56\x60\xcd\xab\xa6\x0c\x1f\x33\x1e\x63\xf4\x4d\x70\xa7\x03\xea\xa7\x00\x75\x2c\x27\x5c\x34\x42\xe8\x0f\xb6\x46\xbb\xd0\xbc\x45\xb3\x1e\x26\x6b\x34\x1a\x42\x29\xef\x39\xba\x95\xb2\x05\xe9\xa8\x03\xe9\x0e\xa4\x3b\x90\xee\x40\xfa\x25\x81\xb4\x4c\xf3\x63\x0d\x22\x8c\xd1\xe0\x3e\x9c\xa6\x4a\xad\x03\x75\x81\x68\x61\xf2\x2e\x63\x64\x14\xc2\xda\x94\x70\x87\xd1\x41\x5b\x80\xf5\x41\x4e\x68\x03\x55\xa6\xc6\x81\x95\xd6\xe3\x34\x8e\x17\x41\x63\xc6\x26\x4c\xeb\x07\xa9\xa2\x5c\x8b\x41\x92\x9a\x9d\x7c\x0d\xa7\x4c\x4c\xf0\xda\xd7\x6c\x97\xbf\x97\x96\x38\x24\x25\xd4\x8b\x8c\xb6\x25\x33\x63\x58\x56\xfe\xe4\xcc\xdd\xe5\x06\xe3\x4e\xc1\xf0\xf9\xbe\x80\x9b\xd3\xa8\x5a\x9a\x3a\xeb\xd7\x9e\xf5\xfb\x5d\x10\xa8\x49\xc5\xff\xfd\x72\x8d\x5e\xaf\xf8\xb7\x32\xf4\xb1\x1e\xaa\x6e\x12\xbf\xa2\xe0\xeb\xc5\xf5\xd5\x9d\xab\xd8\x2e\xf4\x50\xe0\x55\xdb\xfd\x6b\xb3\x45\xbe\x30\x83\xae\x28\x45\x90\xa2\x5c\x05\xe0\x22\x8c\xd3\xc8\xba\x7b\x8f\x09\x57\x14\x06\xb2\x27\x19\xe6\xf2\x9e\xd0\x5a\xa0\x1e\x80\xed\x3a\x68\x0c\x15\xf9\xee\x4c\x21\x08\xa4\x95\x98\xdb\x3c\xc6\x68\x10\xb4\xa5\xdc\xa5\x23\x39\xba\x90\xf9\xd9\x23\x16\xde\x64\xc3\x3b\x48\xd2\x3a\xf7\xaa\x99\x7b\xd5\xaf\xbd\xd0\x0d\x15\x32\x83\x7e\xc2\x5a\xd6\xb6\x4b\x4b\x3c\xa7\x6f\x79\xfa\x85\x19\xf3\x65\x0b\x0a\x07\x36\x98\x83\x91\x3f\xef\xe2\xc2\x4b\x3a\x94\x09\x29\xda\x4d\xa6\x50\x99\xae\xf9\xc3\x49\x23\x24\x8f\x21\x02\xa6\x81\xc1\x08\x99\xa2\x00\x2d\x8d\xcf\xea\x6a\xc8\x04\x08\x69\x60\x84\x14\xcd\x51\x1c\xe7\x18\xd9\xb8\xb4\x1a\x9c\xb6\x6b\xb1\x31\x55\xcf\xef\x5a\xd4\x58\xe2\xad\x66\xc9\x89\xd1\x49\x59\xba\x22\xbb\xda\x86\xa1\xce\x41\xaa\x76\x90\xba\xa8\x5b\x17\x75\x7b\xae\xa8\x9b\x35\x0b\x7a\xf8\x37\x8f\x96\x0d\x37\x0b\x9c\x93\xe6\x11\xa1\x65\x5b\x77\x63\x89\xd7\xb3\x75\xbe\x6c\xc1\xd6\xf5\x21\xb3\x39\xb4\xe0\xe5\x62\x02\xdc\x58\xe7\x51\xe1\x9f\x18\x1a\xf2\x34\xc7\x06\xd5\x03\x53\x91\x3e\xe4\x30\x14\xdf\x82\x69\xbb\x39\x5e\x7a\x4c\xa4\xd0\xf1\x55\x57\xe1\xea\x5d\xb1\x28\xf5\x9d\xfc\xe1\xe0\x2d\x18\x95\x62\xbf\x57\x4f\xc2\xea\x9f\xfc\x28\x9d\x81\x1d\xf6\x6b\x3f\x06\xad\x87\x93\xf9\xef\x41\xa5\xae\xfe\x70\xfe\x43\x03\x72\xe4\x5e\x8c\x65\x2a\xa2\x97\xaa\xa9\x1d\x8e\x1d\x82\x63\xa1\x14\x63\x3e\x69\xb2\x26\x9e\xa0\xb9\x74\x95\xf6\xa0\x96\x2b\x95\xaa\xba\xa0\x45\xfe\xb3\x86\x30\x55\x0a\x85\x81\x30\x5f\x1b\xe6\x2c\x4e\x37\xce\x08\x55\x9d\xf7\xcc\x4e\x41\x6d\x56\x0f\xea\x69\xe9\x7e\xd1\xb9\x74\x9d\x8b\x17\xc0\x42\xc3\xe7\x58\xd9\xcc\xd1\x65\xc8\x75\xec\x9a\x2d\x62\xc9\x36\x02\x4b\x9b\xd2\x50\xfe\xbd\xac\xc4\x9e\x4e\xbf\x9e\xa4\x5f\xc3\x4c\x2c\x1b\xea\xd9\x3b\x57\xeb\x39\xd5\x6d\xaf\xbe\x1c\x45\xcf\xde\x95\x51\xdf\x06\x81\x4e\xcb\x3a\x2d\xcb\x6b\x19\xc5\x66\x1a\xaa\xd8\xef\x1a\xd5\x97\x30\x67\xd4\xb5\x6a\x65\xa9\x50\xb2\x7a\x95\x0f\xd2\x34\x1a\x3f\x68\xec\x54\xad\x53\xb5\x2a\x55\xab\xbf\x83\xab\x51\x3d\x97\x3e\xdd\xda\xf0\x85\x0d\x6f\x38\xc5\x6a\xa4\x50\x3f\x49\x05\xf7\xb8\xd0\x90\x28\xd4\xa4\x51\x5c\xd8\x1b\x02\x89\x43\xee\x3e\x2d\x6f\x1f\x78\x4c\x33\x6c\x2f\xd2\x28\x9c\xc9\xb9\xbb\x50\x92\x6b\x27\xd3\x0a\xf8\x8e\xbb\xbb\x18\xf7\xb8\xa0\x6b\xae\x22\x8d\xe3\xef\x07\xe0\x03\xc4\xb4\x4c\xa6\x8b\xae\x5c\xe7\xf6\x8a\x33\x02\x63\x1e\x63\xdd\x9d\xdd\xc2\x10\x36\xf5\x93\x06\x33\x2c\x51\xd2\x4a\x31\xac\x23\x84\xbb\x44\xf0\x00\xe5\x5c\xf6\xaa\xbe\x96\xbd\x12\xa5\x6c\x01\xcf\xaa\xc4\xa2\x03\xb1\x6f\x16\xc4\xb6\xfc\x05\x81\xa1\xe1\x1b\xc3\xa8\xb3\x6f\xb7\xae\x78\xc9\x44\x88\x71\x0d\x90\xf3\x0d\xed\x45\x38\x23\x13\x0d\xeb\x06\xf2\xa4\x0b\x73\x99\x15\xcd\xbc\x83\xf2\x2a\x07\xed\x46\xad\xbb\x0d\xd4\x56\xb2\x7b\x3b\xea\x9f\x75\xe8\x8d\x63\x1e\x9a\x01\x7c\x94\xb9\x21\x02\x3e\x72\x6d\xf4\x4b\x95\xc2\x4e\x47\x9b\xe9\x68\xbf\xae\xdf\xbe\x96\xa0\x5b\xc3\x4c\xaa\x5b\xd4\xc2\x95\xeb\xbd\xaa\x02\x7a\xab\x8d\x0a\x77\xdd\x15\xa4\x5b\x7a\x4f\x56\xcd\xfd\x32\xb2\x35\xe2\xa3\x0b\xc5\x9a\xbb\x57\x62\x2c\x3b\xa5\x39\x92\xd2\xec\x3d\x47\xb9\x9a\x26\x17\x8e\x6e\x51\x69\x6e\xe9\xea\xad\x06\x81\x0f\x15\x12\x5f\x98\xb2\x4b\x29\x74\x3a\xa3\x0b\xad\x09\x6e\xea\xda\x3a\x65\x8c\x7a\x9a\x9b\x9c\x8b\xe5\x72\x01\x23\x3a\x15\xf8\x5d\x98\x35\xf7\x99\x47\xfd\x15\x79\xfb\x41\x22\xc3\x43\xfc\x4c\x81\x9f\xef\xc1\x87\x8d\xe8\x9a\xbc\x3b\x46\x40\x91\xaf\xca\x51\xed\x10\xab\x3a\x42\xb5\x4b\xa4\xea\x2a\x9c\x9b\xc9\xec\xfc\x45\x89\x6c\x2d\x7b\x55\x5f\xcb\x5e\x89\xc6\x1d\x76\x04\x63\xdd\x2d\x82\x4c\x75\x6a\x67\x30\x9e\x0f\xa5\x6a\x1d\xbf\x60\x91\x0f\xa9\xbe\x54\x6c\x3a\xc0\x2b\xcc\x89\x0e\x8b\x15\xb2\x68\xf1\x2d\xb9\x86\xdd\x19\x95\x5a\x67\x54\x7e\xf8\x67\x2d\x91\xf2\x82\xf4\xc0\x34\x84\x76\x91\xd6\x5d\xf6\xe9\x2e\xfb\x94\x5e\xf6\x59\x9b\xf5\x21\x4f\x1a\x06\x0e\xd6\x92\x76\x75\xdd\xa2\x2b\xe5\xd7\x12\x57\xd7\xc0\xa2\x48\xa1\xce\x43\x60\xd5\xc2\xc3\x2f\x37\x92\x74\x14\xf3\xb0\xa2\xee\x41\xab\x8e\xeb\x1d\x94\x8f\x2e\x32\x57\xd7\x9d\x1a\x3d\xa7\x1a\x15\xb8\xf3\x66\x2f\x77\x6e\x9d\x5b\x0d\xa9\x60\x73\xc6\x63\x36\x8a\xf1\xa5\xf2\xa6\x57\xfc\xb5\x1a\x62\x62\x99\x9d\xdf\x7b\x2a\xd0\x7c\xf0\x04\xda\x87\x9b\x75\x37\x21\x2e\x69\xa5\x0a\x77\xb6\x6b\xb5\x07\x39\x97\xbb\x89\x1f\x5d\x8a\xfc\x6c\x74\xda\x75\x12\xda\xa5\x13\xc4\xe8\x8c\x32\xfa\xe5\x7a\x5d\xe3\xd2\xc9\x8a\xc2\x2d\x11\xb8\xdb\x5c\x9f\x1d\xac\x5e\x29\x6d\x0b\x12\x61\x30\x05\xca\x85\x49\xfd\x15\x99\x4e\x15\x6a\x7b\xfb\x43\x84\x8b\x3e\xa4\x09\x05\x89\xec\x35\x91\x48\x3e\x08\xfb\x61\xa6\x4a\xa6\x93\x69\x92\x1a\x1f\x58\xf4\xdb\x19\xe5\xbb\x8e\x20\xe7\x59\x42\xc0\xe4\x75\x62\x6f\x5d\x0a\x8c\x07\x70\xa7\xd8\x78\xcc\x43\x88\x24\x6a\x7b\xff\x64\x42\xc4\x2c\x69\x1b\xad\xfc\xe3\xfa\x23\x98\x54\x08\x8c\x6d\xf3\x42\x42\x96\x5c\x35\x47\x79\xc4\x34\xc6\x5c\x20\x45\x40\x67\xae\xf3\x2d\x5e\x26\xbb\x5d\xf1\x2c\xcb\xc4\x78\x4a\x12\xbe\x92\x94\x1b\xdb\xb5\x2f\xbe\x6e\xee\x62\x28\x5d\x0c\xa5\x2a\x86\x52\x8d\x8f\x94\x84\x59\x1b\x1e\xea\x26\xfe\xc7\x9a\xc0\xed\xba\x7e\x8b\xf8\xb8\xed\x48\xe8\xd2\x76\x2a\x1c\x90\x75\x61\x60\x23\x4a\x4f\xb0\x0d\x81\xad\x41\xd2\xe5\x9e\x3e\x1e\x5d\xb0\x2e\x4b\xe6\xaa\x53\xbf\x63\xaa\x5f\x84\xa3\x74\x32\x4c\x12\x25\xc7\x8d\x32\xbc\xdb\x1a\xfb\xd4\xec\x1d\x11\xaf\xad\x61\x2a\x15\x94\x0d\x98\xc2\x1f\x63\x1e\x53\x5c\x23\x62\x86\xe5\xdb\x28\x4c\x24\xad\xe1\xb0\xaa\x9e\x3f\x3f\xe5\xd2\xea\xd2\xf5\x73\x77\x2b\x68\xb4\xb0\xfe\x83\xed\x3f\xcc\xb9\x4e\x59\xcc\xff\xcd\xb2\xc8\x8e\x8c\x29\x17\x71\x84\x8f\xe4\xab\x50\x09\x1e\xa3\x26\xf7\x21\xcb\x0b\xff\x30\x45\xe1\x7f\xa7\x9f\xad\x63\x42\x77\x6c\x5b\x53\xe1\xeb\xcd\xc1\x3f\x75\x2a\xff\xce\x3a\xb9\x6c\x3c\xa7\xaf\xbb\x49\x2d\x9b\xd4\xa6\xd7\xc6\x32\x7a\x4f\xbe\x3b\xf6\x91\xcd\xb2\x67\x2b\x3c\x0f\x30\x4b\xa7\x3c\x45\x96\xf4\x61\x22\x95\x4c\x0d\x17\xd8\x5f\x75\x5d\x2a\x30\x8a\x85\x78\xf2\x57\xcd\x9e\x55\x0b\x70\x4e\x16\xa7\x89\xd8\xeb\x74\x44\xac\x1f\xe1\x7b\x57\x75\x8f\xfc\x67\xa5\x4a\x07\x9d\x53\x80\x5b\x4f\xd5\x26\x42\x16\xf4\x46\x08\x6e\xd1\x2f\x0c\xfb\xd6\x28\x64\x33\x9d\x2f\x4d\xb7\xf2\xad\x9c\xaa\x33\x7b\x2a\xd4\xd1\x18\xc0\xfb\x39\xaa\x85\x2b\x03\x21\x53\x36\xe1\x32\x13\x70\xf5\x0e\x1e\xa6\x3c\x9c\x52\x58\x81\xae\xec\x53\x16\x20\x8c\x60\xc4\xc2\x7b\xfb\x44\xc9\x07\xa6\xcd\x99\x1d\xc1\xd9\xd5\x3b\x98\x22\xa3\x77\x2e\xa4\x82\x98\x69\xf3\xd9\x52\xfb\xcc\x23\xb0\x79\x9f\xd7\xcf\x8f\xd0\x00\x68\x89\x33\x73\xc7\x4d\xb5\xed\x65\x96\xc9\x9d\xd2\xbb\x3b\x9b\x2e\x26\x7d\xea\xac\xcd\x51\xcf\x34\xcc\xb8\x6d\xd9\x8f\x42\x51\x3d\x3a\xbe\x7a\x8f\xc9\xea\x6c\xeb\x88\x2e\xfd\x21\x45\x1d\x93\x98\x2d\x60\x94\x8e\xc7\xa8\x06\xf0\x9b\x99\xa2\x7a\xe0\x7a\xa3\x39\x1b\xaf\xcd\x9e\x48\xa1\x9f\xbd\x1b\x45\xfe\x15\x82\x16\x2c\xd1\x53\x69\x06\x87\xe8\xac\x91\x49\x89\xbf\x54\x3f\x15\xf6\xa5\x9c\xcd\x18\x68\xa4\x76\x8d\x1f\x3b\x50\xe0\x3e\x4b\x86\x1d\x22\x9f\x63\xdf\xbd\x00\x63\x7f\xa5\x83\xb8\x76\x5a\xf9\x18\xe4\x8c\x1b\x83\xd1\x00\xfe\x47\xc8\x07\x91\x2f\x40\x23\x3c\xa3\x95\xf1\x04\xfb\xb4\x54\x17\x94\x50\xe5\xcc\xa6\x7a\xe8\xe7\xdc\xc8\x33\xcb\x8a\x3e\x68\xd4\xf4\x2a\x0c\x59\xb2\xb9\xe4\x21\x9e\x25\x2c\x3b\x55\x30\xe3\x9a\x28\xa0\x31\x31\xd2\xfb\x29\x74\x85\x76\xc2\xed\xbb\x34\xb6\x82\x60\xc6\xae\xa6\x2d\x8c\x0c\x82\xf6\x91\xa2\xbf\x7f\x12\x36\x24\xf1\xe9\x73\x71\xf5\xce\xa3\x27\x11\xf4\xbc\x5f\xcd\xc9\x4a\xa0\xcb\xde\x3a\x38\xcd\x74\xe4\x0e\x1c\x68\x54\x19\x8e\x54\x31\xb6\x56\xb0\xff\x77\x71\xef\xc4\x8c\x64\xde\x87\xfd\x5f\x6e\x10\xb2\x57\xfc\x5b\xa9\xd5\xb0\x2b\x50\x3c\xc0\x76\xbc\xee\x8c\x47\x67\x3c\x3a\xe3\xd1\x19\x8f\xce\x78\x7c\x4b\xc6\xe3\xd1\xe9\xd7\x90\x9e\xb8\x19\xfe\xed\xc0\x25\x5c\x34\x5a\x7b\xbf\xcf\x68\xd0\xb3\x82\x7b\x8d\x48\x56\x36\x28\x95\x93\x92\x45\x38\x41\x1e\xf5\x0d\x12\xc5\x43\xf4\x00\xea\x92\x83\xf9\xde\xe6\x1b\x2d\x4c\x77\x13\x32\xf0\x5d\xc4\x38\x2d\xae\x23\x4c\x14\xd2\x83\x09\xd1\xf7\x87\xc0\x6a\x49\xf7\x9a\x2d\xa4\x2f\x7d\xc7\x8c\xcc\xac\x9b\x1d\x02\xb7\x01\xe5\x39\x96\x9d\x01\xfd\x0a\x17\xcc\xbf\x6e\x4c\x4b\xe5\xcc\x1e\x5d\x2d\xfd\x6c\x78\x71\x3f\x54\x35\xbb\xcd\xdf\x43\x36\x7f\xc7\x88\x11\x6d\x0d\x0c\x6d\x92\xfc\x5c\x77\xf7\x07\xa5\x15\x26\x52\x99\x2b\x5b\x6f\x0f\x5c\xfd\x94\xb5\x12\x94\x8a\xfd\x06\x5c\x11\xcd\xec\xde\x2c\x2f\x92\x2e\x4c\xe4\xee\xc2\xf5\x4f\xe3\x3b\x3a\x8e\x82\x37\x65\x39\x4a\x3b\xa4\xa1\x8e\x2c\xec\x92\x84\xdd\x72\x70\xb3\x66\xf0\xf3\x27\x2f\xac\xa1\x37\x19\x7f\x12\x59\x86\x98\xc7\x54\x98\x1c\xa3\x6e\x5d\xae\xe6\x22\xa3\x36\x99\xb3\xfd\x9d\xff\x6a\xee\x0c\x9d\xe8\xb1\xf9\x1c\x5b\x2c\xa4\xb4\xc8\x94\xd7\xfb\xe3\xff\x77\x52\xc2\x8c\x89\x85\xe7\x8c\x86\xef\x66\xec\x71\x00\xaf\x86\x33\x2e\x52\x83\xdf\x7f\x1b\xac\x7a\x11\x16\xa9\x55\xf6\xf4\x8a\xbf\x16\x4c\xd2\x14\x59\x6c\xa6\xee\x09\xd3\x06\xee\xb3\xab\x76\x59\x7c\xf9\xb4\xcc\x1c\x5d\xc6\x9c\x74\x72\xbf\x31\x72\x4e\x2f\xbd\x3f\x4e\xc1\x26\x0a\x10\x66\x01\x7c\x47\xa0\xdf\xab\x9a\x51\x5f\xd5\x75\xca\xbd\xc7\x5a\x8b\xce\x41\x08\xfd\x4b\x45\x6b\xa7\x24\x4a\xbf\xac\xa7\xa9\xf3\xfc\x8e\xe9\xf9\xf1\x88\x92\x75\x9a\x86\xef\x88\x53\xae\xf9\xab\x75\xcd\x3d\x8a\x96\x95\x5c\xd4\x57\xb5\x52\xd2\x15\xca\xe5\x1f\x1d\x2f\xaf\xd4\xca\x7b\xe3\xa5\xa4\x8f\x2e\x26\x1f\x36\x26\xa1\x4b\x18\xff\x15\x24\x8c\x5f\x69\x42\x5b\x1a\xb3\x4a\xff\x8e\x0f\xc0\x4b\x88\x57\x64\x8b\xf7\x45\x6d\x90\x4f\x1b\x49\x87\x79\xb9\xcb\xd4\x43\x1f\x80\x22\x54\x8b\x84\x42\x97\x36\xcc\x4a\xfb\xff\xc9\x54\x31\x8d\x07\x5e\x74\x5e\xdf\x73\x5e\x93\xdc\x75\x97\xb9\x64\x48\x3b\xe4\xa9\x8e\x34\xed\x92\xa5\xdd\x92\xe4\xe7\xe4\x0b\xde\x63\xae\xa1\x7f\x59\xa7\x4e\x31\x93\xbc\x67\xd8\x0d\x8e\x0f\xd5\xbc\x27\xad\xc5\xfc\x6a\xf9\x85\xa2\x51\x77\x2d\xb7\xf9\xb5\xdc\xce\x3d\x7c\xb2\x7b\x78\xc6\x67\xb4\xfa\x6b\xb4\x37\xe8\xaa\x78\x1c\xa8\x69\xf4\xc8\xeb\xdb\x67\xf6\xae\x2c\x61\x7a\xa6\xc4\xed\x70\x7b\x3b\xb1\x11\xb8\x2d\xce\x6b\x45\x9d\x2c\x4b\x5f\x96\x38\x9e\x13\xd1\x51\x2c\x47\xe4\x52\x1a\x7c\x34\xb9\x48\x38\x5d\x77\x51\xab\x57\x52\x8c\x04\x37\x3e\x4a\xc8\x47\x9b\xa7\x83\xb6\x8c\x63\x81\x3c\x2b\x1f\xdf\x0e\x29\xac\x23\x83\xbb\x24\x70\xb7\xfc\xf9\xf9\x74\x93\xe0\x51\xb6\x40\x64\xd9\xab\xfa\x5a\xf6\x4a\x74\xf3\x30\x2b\xf8\xbb\x88\x65\x48\xef\x48\x15\xe6\xb4\xb3\x87\x25\xf6\xf0\x65\x03\x5a\x07\xf7\xed\xc0\xfd\x30\x3b\x19\x93\xeb\xf2\xfe\xd7\x2f\x5d\x15\x2f\xe7\x35\xf1\xbe\xfe\xb6\xc0\x6c\x91\x85\x32\x0d\xf0\x92\x36\x0a\x13\x7b\x67\x4f\x33\xd9\x43\x47\xee\xb5\xaa\xf5\x39\x0e\x0b\xb0\x9e\x44\xdf\xfe\x3e\xe6\x4a\xaf\xc9\xf6\xc1\xaf\x44\x70\x33\x93\x2b\xab\x5c\x69\x7d\xab\xab\x21\x37\x21\x19\xb6\x94\xc8\xe7\xb2\x57\xf5\xb5\xec\x95\x68\x6d\x67\x08\xbe\x98\x21\x78\xa9\x20\xd7\x2d\x8c\xba\x85\xd1\x17\x5c\x18\x6d\x3d\xa9\xb5\x6f\xf3\x7c\x82\xed\x9b\xc8\x9f\xb1\x96\x4d\xbc\x76\x37\xf0\xd7\xf0\x18\xa1\x61\x3c\xd6\x87\x1c\xcc\xe2\xd1\x93\x8f\x64\x4d\xf1\xd1\xe7\xe8\xc9\x6d\xb3\xe7\x07\xf0\xb5\x1e\xc3\xf2\x13\xb7\x7e\x2a\xf3\x94\x14\xe5\xb9\x0c\x50\x87\x22\x87\xa1\xc8\x70\x84\x02\xc7\x3c\xe4\xee\x1c\x54\xed\x70\x5c\xae\xda\xc5\x76\x32\xad\x83\x80\x65\x0b\x31\x72\x6d\x95\xe4\xd7\xaa\x00\x1c\x5d\x56\xcd\x6e\xf7\x6f\xee\xc5\x74\x38\xd4\x32\x0e\xfd\x98\x63\xfb\x49\x43\x51\xae\xa3\x3e\x92\xd9\x41\xd3\x97\x85\xa6\x7e\xed\x7d\x5d\x77\xf7\xe3\x7f\xb9\x99\xe6\xa6\xad\x35\xc8\xb9\xb5\xd4\xdd\x1d\x9d\x0a\xfa\x85\x89\x73\xcf\x99\x6c\x62\x0c\x05\x36\x1d\x21\x64\x4a\x70\x31\xb1\x57\x2f\xb9\x19\xc0\xdd\x94\xdb\xab\xfc\x4c\x2f\x44\x08\x33\x34\x53\x19\x0d\x8e\x84\x3c\x9e\x29\x2b\xf8\xb1\xfb\x15\x74\x9b\x06\xd5\xc9\xc3\xcf\xfe\x95\x55\x36\x03\xd9\xde\x08\xb0\x30\x44\x8a\x2a\x07\xa5\xad\x36\xb7\x91\x67\x59\xba\xfb\xa7\x99\xca\xbb\xc7\xdb\xad\x6c\xf9\xad\xec\x53\xe5\xda\xa0\x8b\xf8\x42\xb3\x75\x2a\x96\xcd\xe6\x0a\xec\xf2\x04\x56\x1b\x55\xbb\x29\x9d\x8e\x01\xfd\xaa\xc5\x78\xbf\x61\x68\x3c\xb1\x47\xb7\x14\x3f\x96\x88\xf9\x16\x91\x65\x6f\xd7\x77\xfe\xab\xb8\xcf\xb4\xff\x51\xdf\x5c\x07\x6c\x2e\xb7\x09\x82\xc0\x79\x76\xd5\x53\x45\x18\x0d\x82\x5e\x59\x5b\x35\x41\x00\x67\x8c\xc7\xb9\x4e\xec\xdd\x9b\x4e\x93\x88\x19\x7c\x6f\xab\xb5\xa7\xef\x4e\xc2\x35\xd8\xee\x24\x6c\x21\x53\xf3\x99\x12\xc1\x0d\x26\x32\x27\x0d\xdb\xe2\xb4\x51\xcf\xaa\xf1\xa9\x28\xb0\x3d\x0c\x14\xe5\x0f\x03\x9d\x8a\xfe\x1e\xb0\xbd\x6e\xd9\xfc\x3d\xd9\xfc\x55\xe7\xfb\xbd\x1a\x9a\x5a\x47\x4f\x77\x69\xe9\x1e\x6f\x8e\x7a\x55\x91\xe0\x7f\x59\x83\x3d\xcb\xb6\xe0\xcd\x6a\x05\x38\x0d\xd9\xf9\x78\xd2\x57\x7b\x07\xa2\x4d\xc7\xb9\x5b\x56\x34\x5b\x56\xec\x01\xf2\xc7\xc6\xa7\x4a\xf0\xf1\x99\x4e\x95\xbc\x7f\x6c\x7e\xaa\xc4\xd7\xf1\x65\xe9\x0c\xa5\x8d\x1e\x32\x9b\x2e\x21\x4d\x60\x94\x8a\x28\x46\x60\xb1\x96\x30\x95\x31\xdd\xba\x5f\x97\xde\xbc\x39\x9f\xf3\x2f\xfa\xf0\x0b\xaa\x19\x6a\x7f\xe1\x5e\xe7\x56\x34\x74\xe9\x1e\xa6\x9c\xa0\x7a\x31\x80\x1f\xa5\x59\x25\x6a\x70\x27\x46\x7c\xaa\x86\x54\x6f\xb4\x95\x1d\x27\x41\x11\x25\x92\x0b\x33\x38\x92\xa9\x39\xf1\xcd\x96\xfa\x66\xc6\x87\x6c\xdd\xd4\x38\xa9\xb4\x19\x19\x36\x86\x52\xa9\xd9\x75\xf4\x7a\x97\x56\xef\xd6\x69\xaf\x1e\x4e\x3e\x4f\x24\x3c\xeb\x3a\x83\x51\xe5\xa4\x1f\x13\x05\x8b\x1c\x7b\x86\x4d\xa8\x86\x01\xda\x97\x6d\x29\x3a\x3b\xda\xa6\x1d\x1d\x4b\x4a\xb2\xa0\xcd\x70\xc4\x62\x7a\x48\xa6\xc9\x9e\x88\xaf\xfb\x63\x56\xb5\x9e\x45\xad\xbf\x1f\xe2\xdf\x34\x83\xac\x6f\xe0\x1b\xcc\x4d\x5e\xd5\x92\x7b\x2a\x1f\x5c\x32\xa0\x2d\x22\xb4\x41\xa2\x6d\x6a\xef\x08\x28\xcf\xad\x4b\x76\xe6\xed\xa2\xcf\xdb\x72\xe4\x75\xd5\x0b\x8e\x2c\x54\xcf\xe5\xd1\x15\x31\x13\xe4\x9f\xb2\xae\x75\x48\x75\x92\x48\xe5\xf7\xe5\x9f\x02\x55\xef\x7d\xdd\xb6\xb1\x6a\xf5\x46\xc1\x2a\x6a\xe0\x9b\xcc\x37\x55\x01\x56\x89\x92\x7f\xba\x3c\xb4\xab\xda\xe4\x1a\x12\x0e\x09\x3a\x4a\xce\x52\x23\xe9\xe6\x6f\x98\x77\xe4\x3b\xa0\x7a\x5e\xa0\xf2\xa2\x72\x92\x48\xe5\x3b\xd7\x41\xd5\x29\x42\xd5\xd4\x2e\xc1\x9b\xe0\x93\xd7\x1b\xb7\x78\x6f\x1d\x9d\x3c\x79\x8a\xa4\x49\xca\x76\x2e\x26\x90\xa0\x82\xed\xe6\x2a\x10\x2a\x73\x9e\x74\xdf\x3f\xb0\xe2\x16\xaf\xd9\x26\x43\x04\x46\x1a\x16\xeb\x22\x26\xb9\x58\x2c\x6d\xdf\x2f\xc0\x65\x0b\xdd\x6e\xb0\xc3\xaa\x16\xb0\xea\xaa\xee\xfc\x1e\x5d\x2d\x7d\x4f\x5d\xcf\x3e\xf0\x0e\xba\x4e\x0b\xba\xe8\x1c\x7c\xae\xd3\x7b\xe3\x63\x54\xde\xcf\x69\x6b\xb0\xf5\x41\x86\xf7\x6b\xd0\xca\x93\x2d\x4c\xe5\x0d\xce\x24\x25\xfb\x8f\xd0\xdf\x3b\xf7\x95\x68\x3f\xd5\xa6\xd7\x84\x19\xce\xa4\x3f\xd8\x61\x64\xe2\xb2\xa1\xf3\x10\x57\x3e\xdb\x2a\xfb\xbf\xaf\x3b\x80\x8f\xd2\x80\x4e\x93\x6c\xd7\x29\x17\x67\xa3\xab\x21\x53\x8c\x6d\x15\x46\xef\x12\x79\x51\xe2\x13\x81\xaa\x9f\x5d\xfa\xbb\x47\x4c\x34\xf5\x40\x43\xea\x6f\x16\x48\x01\xdc\x68\x90\x0f\xe2\x58\x1b\xa8\x5f\x41\xac\xee\x80\x23\x23\xab\xd1\x39\x7e\x07\x95\x10\x51\x67\x33\x8d\x04\x90\x30\x34\x7b\xcc\x61\x2d\x0a\xa3\x45\x25\xcf\x5e\x0a\x62\x34\x0e\xd9\xaf\x38\x4f\x8f\x99\x8c\x29\x23\xff\x37\xc1\x9a\xce\xd4\x1c\x68\x6a\x12\xb6\xa0\x88\xd4\x99\x54\x11\xaa\x26\xce\xf2\x04\xcd\x6f\x54\x47\xef\x33\x38\xb6\x54\x50\x8a\x35\x39\x6b\x43\x77\x20\x58\x1c\x83\xed\x87\xae\x3c\x55\x51\x98\xda\x9f\xd1\xe8\x62\xb5\xad\x2b\xe2\xd6\x00\xd1\x32\x3e\xe1\xf1\x7c\x61\xb2\x27\x63\x78\x88\xc7\x36\x01\xd4\x5b\x67\xab\x8c\x84\x09\x9a\x6c\x14\x27\x6f\x07\xf6\xab\xdc\x85\x52\x6c\x41\xee\xbd\x1d\x11\xc8\x11\xed\xac\x7c\x69\x17\xd8\x8f\x9f\x51\x67\x82\x7e\x59\x11\x6e\x70\x56\x1c\x60\x5d\xad\xb5\x72\xed\x23\x4d\x9b\xdc\xdd\xe6\x71\xf9\x2f\xcb\x5e\xd5\x57\x87\x72\x0d\x51\xae\x5f\xfb\x04\x82\xbd\x95\x8b\x76\xf6\xda\x81\x2e\x97\x53\x08\x64\x91\x62\x61\x82\xee\xd8\x3d\xea\x5c\xae\x6d\x7a\x13\x29\x4b\xb5\x9f\xdd\x75\x76\x3d\xcb\xae\x29\x67\xc0\x9c\x69\x10\x17\xa5\x08\x36\x38\x29\x08\xf3\xfd\x17\x5b\xbc\xf8\x2a\x0e\x05\xdc\x64\xfd\xb3\x6f\xb6\x6d\xcd\xc7\xf6\x90\x2a\xb5\xa9\x8e\x2e\xed\xd2\xa4\x5a\xb8\x73\xfc\xc4\x1d\xbf\xe5\xb0\xfd\x94\x80\x66\x0f\x30\x2f\x7b\xbb\xbe\x3b\x10\x3e\x00\x84\x1b\xb8\x9a\xc3\xbf\xed\x1f\x9f\x9b\x5f\xbf\x6d\x11\xb9\x7f\xf6\x3e\x57\x9e\x5c\x61\x4a\x9d\x8b\x99\x41\x5a\xb9\x83\x49\x20\x9e\xc1\x74\x04\xa1\x9c\x8d\xe4\x57\xe4\x73\x1e\x0d\xad\xfb\xfb\x07\xec\x45\xe4\xc9\xc3\xfe\x2d\x9b\x95\xb6\x07\x77\x8c\xc7\x5f\x3a\xb8\xed\xe0\xb6\x21\xdc\xca\xd4\x34\x04\xd7\x6b\x7b\x0d\x82\xce\xb5\xef\x43\x58\x8f\x28\x41\xa9\xa4\x97\x84\xbf\x5c\x7f\x80\x17\x68\x57\xc4\xba\xb2\xd2\x59\xa4\x88\x5e\xa9\x1a\xa3\x52\x2c\x86\x50\x46\x68\xdd\x66\x7b\x2f\x60\x75\x51\x09\xa3\xca\x3d\x83\x6e\x77\xb7\xd6\xee\xee\x7e\x75\xbb\x2e\x9d\xc1\xa3\x2b\xd9\x5a\x66\xdb\x47\xa1\x3a\x5b\xaf\x39\xb6\xbc\xfc\xdd\xd7\x5e\xf1\x6f\x2b\x7e\xd5\xbc\xc5\xf5\x2c\x08\xe3\xaf\x64\xd5\xc5\x98\x42\x79\x1f\x8f\xee\x10\xa4\x0e\x82\x1c\x72\x97\xcb\x4c\x3f\x67\xcc\x3e\xb9\x1b\x5d\x6b\xc1\x2c\xd1\x91\x65\x0d\x2e\x2d\x9f\x01\x67\x73\xf6\x2d\xdf\xa5\xe6\xc1\xb4\x6f\xed\x6e\x57\x97\x3a\xac\x4b\x1d\xf6\x05\x52\x87\x0d\xbd\x5f\xda\xd0\xcf\xbe\xc9\xaa\xdd\xc9\x7b\x14\xfb\x0c\xa1\x2f\x1c\x94\x02\x72\xce\x10\xda\xbd\x8a\x95\xa7\x6c\x8a\xb4\x0b\xf3\x6a\x4b\xb3\xb5\x67\x6d\xcb\x5b\x2f\x7a\xbd\x49\xed\xad\x21\xf0\x31\x30\x08\xd9\x2c\x61\x7c\x42\x47\x2e\xb8\x36\xfa\xd8\x26\x72\x7b\x2f\x63\x6b\xcc\x47\x35\x94\x07\x98\x00\x2b\x17\xe0\x49\x04\x9d\xfe\x3e\x5d\x7f\xfd\x40\x6b\xfa\xa7\x5e\xd9\x9e\xc9\x43\x5d\x69\x5b\x6d\x1f\x75\x55\xc3\xae\x7c\x3b\x17\xf5\x99\x5d\x54\xcf\xee\xcf\xc4\xee\x93\x73\x52\xf3\xd2\x59\xa2\x2a\xcb\x1a\x9c\x5a\xb6\x85\x51\xbe\x2f\x9d\xa3\x7a\x88\xa3\xda\x79\x60\x2d\x79\x60\x67\x6c\xce\x78\xcc\x46\x71\xa3\xeb\x93\xbe\xb6\x35\xb8\x17\x2b\x0a\x7b\x80\xdf\x8b\x7e\x50\x8a\x3f\x39\xe0\xb7\x8f\xe4\x69\xf2\x9e\xc8\xa7\xb2\xaf\xdc\x86\x14\x71\x19\x19\xc6\xc5\x96\xf3\x95\x9b\xd2\xa2\x08\xfc\x81\x8a\x8f\xe9\x9c\x00\xd1\xf8\x2f\x0d\x18\xf3\x09\x1f\xf1\xd8\xc7\x9f\x88\x7c\xa2\x50\x23\x5d\xd1\x94\x63\x8a\x58\x65\xb3\x3e\x8a\x11\x92\x74\x14\xf3\x70\xe5\xbf\x1d\xdb\x70\xbc\x60\xc7\xad\xe4\xf1\xda\x4e\xdf\x5b\xd6\xf7\x2c\x35\x59\x93\xb4\x23\xde\x9d\xf2\x22\xb8\x4f\xc1\x57\xe5\x4a\x25\xa4\xd4\xb3\xe3\x25\xa4\x0b\xf3\xba\x5d\x98\x2e\x3f\xd3\xab\xef\xa8\x78\x3a\x83\x8f\x68\x1e\xa4\xba\x07\x3d\x63\xca\xd0\xcb\xf6\x46\xb1\xd0\xe8\x2c\x17\xc8\x5d\x96\xb4\x4c\xaa\x63\xeb\xef\x09\x27\x8a\xab\xeb\xf9\xd1\x59\x53\xfb\x5e\xcf\x9a\x7f\xc0\x40\xda\xb1\xb2\x7c\x96\xb1\x1d\x9a\x56\x47\xcf\x76\x69\xd9\x6e\x1d\xf3\xdc\xf6\x42\x93\x1d\xf9\x29\x51\xb7\x65\x0d\x2e\x2d\xdb\x02\xb8\x6e\x73\xf2\xf0\xcd\xc9\xce\x06\xb4\x62\x03\x1c\xc0\x37\xf1\xf6\x3c\xec\xde\xe4\xeb\xb7\x65\x0a\xb6\xb2\x6d\xe7\x7b\xb9\x9d\x66\xb2\x32\xdb\x76\x49\xb5\x92\x64\xa1\x7d\x72\x2a\x57\x4d\x65\x77\x64\xd6\x0a\x09\x67\xfe\x66\x95\xa6\xeb\x96\x9c\x88\xb1\xd8\x1d\x6d\xf4\xd8\xbc\x11\xef\x02\x55\xc1\x93\xee\x6e\x68\x0b\x77\x43\x5d\xb2\xda\x53\x7f\x50\x60\x2d\x00\x3e\x92\xde\xc1\xde\x29\xc1\xde\x3a\xf1\xc4\x59\x22\x63\x1e\xe6\x9d\x9c\x20\xc2\x18\x0d\xee\x84\x3f\x57\xe4\x76\x45\xe5\xda\x11\x69\x0b\x00\xfd\x45\xd0\x5c\x7e\x8c\x64\xab\x85\x8a\xcb\xa3\x5b\x75\xfa\x30\x66\x71\x4c\x7e\x2f\xa5\xe2\xa3\xe3\xd8\x66\xaa\x50\x53\x12\xbe\x2c\x6f\xd0\xba\xca\x91\xe0\xaa\xe8\x0f\x9f\x3c\x4e\xed\x0f\x89\xde\x16\xa7\x01\x94\x9d\xa0\x28\xe8\xb4\xfa\xe9\x5a\xdd\xaf\xeb\x9f\x4c\xd0\x3c\xa3\x76\xba\x24\x39\x5b\x9a\x96\x6f\xa1\xe2\x34\x5e\x69\xee\x9b\x4c\x40\xe4\x18\xcc\x14\x61\xc2\xff\x9f\xbd\xa7\xeb\x6d\x5b\xc7\xf2\xdd\xbf\x82\xf0\xcb\xb4\x80\xe3\xe4\xde\xde\xd9\xc5\xec\x5b\x37\xdd\xf6\x66\x27\x49\x3b\xd7\xe9\x0c\x16\x30\x10\xd0\x12\x2d\x73\x2a\x93\xaa\x48\x3b\xf5\x05\xfc\xdf\x17\x87\x1f\x92\x2c\xeb\xd3\x92\x6b\x27\xe1\x5b\xe4\x88\x47\xe4\xe1\xf9\xe6\xe1\x39\x59\xeb\xc4\x71\x64\x33\x8e\xbc\x6a\xcf\x91\xe7\xc4\x36\x79\x62\xed\xca\x3a\xad\x73\xef\xf6\xb0\x93\xdc\x16\x27\xce\x95\x74\xae\xa4\x72\x25\xed\x42\x6b\x0f\x80\xc5\x11\xa5\xef\x84\xc8\x76\xa2\x57\x0d\x70\x72\xb7\x9b\xdc\x6d\x1a\x0d\xdc\x13\x23\x3b\x0b\x28\xe5\x8d\x26\x9c\x51\xc5\x17\xd5\x5c\x91\xa7\xc5\x02\xce\xd8\x36\xc0\xc8\xf6\x68\x9a\xc8\x24\x00\x9c\x93\x24\x69\x80\xb3\x5d\x3c\xed\x3f\x97\xcb\x5a\x17\xca\x74\xa1\xcc\xde\x43\x99\x22\x22\x0c\xca\xca\x5f\x84\x74\x49\xa5\x38\xcc\xa3\x37\x30\x6e\x35\x88\xbe\x74\x56\xe2\x9b\x1b\xf0\x28\xdc\x83\x5f\xe2\xcd\x27\xf5\x7e\x73\x43\x9d\xb3\xd0\xc5\x59\x68\xe0\xbe\xe7\xd0\xed\x9c\xf7\x9f\xec\xbc\x1f\x8b\x11\x8d\xeb\xbe\xbb\xbd\x59\xf8\x25\x8e\x7b\x3b\x46\x44\x92\x07\x44\x2e\x48\xac\x6b\x46\xe2\x25\x94\x31\xd4\x9f\x95\x48\x72\x1f\xdb\x5c\x13\x2a\xd0\x92\x33\xb9\x70\x9c\xdb\x8c\x73\xeb\x99\x6c\x52\xba\xb5\x27\x67\x2f\x3b\x35\xad\x5d\xba\x32\x98\x13\x3f\x07\x8a\x9f\x26\xde\xeb\xce\x4e\xf5\x26\x7e\x94\x2b\x9a\x13\x20\x59\xe0\x79\x52\x26\xb2\xad\xe0\x19\xa3\x6b\xce\x18\xd1\x0d\xf9\xa8\x40\x3e\x15\x9e\xfe\x41\x15\x6d\xf4\xe0\xc6\xef\x46\x7f\x19\x02\x3c\x31\xc1\xde\x82\x9c\x4d\xdf\xcf\x73\x11\x3f\x8d\xbd\xdd\xd2\xad\xac\xe0\xa4\x26\x7c\x54\xc5\x45\x1d\x65\xcc\xb6\x01\x36\xb6\x47\x12\xc6\xe7\xe8\xe7\xd6\xe1\x6b\x17\x47\xfb\xcf\xd9\x27\xe7\xe5\x3a\x2f\xf7\xd8\x5e\x6e\xeb\x5e\xbf\x01\x49\xba\xc4\xfd\xda\x9b\x26\x83\x82\x3f\x56\xe7\x64\x81\x16\xa7\xe2\x24\xaf\x22\x9f\x48\x4c\x43\x71\x22\x7d\xf3\x0a\x4a\xa6\x9f\x79\x62\xcc\xdc\x49\x93\x73\x92\x26\xba\x9e\x77\x66\xda\xb5\x47\x3b\x7a\x84\xdd\xd0\xde\xe4\xc9\x57\x05\xb6\x51\xee\xf7\x57\xe8\x35\x18\x25\x7d\x07\x21\xa1\xc5\x14\x4c\x4f\x46\x17\x5c\xa8\x1b\xa3\x9b\x26\x35\xcf\x11\x8e\x09\xe2\x2c\xdc\x20\x0f\x2e\x9c\xa8\x5e\x10\xd0\x52\x52\xdf\x08\x81\x52\x02\x88\xca\x51\xf6\xf3\xd0\x45\x3f\x60\xea\xfe\x9e\x72\xe9\xe9\x92\xa8\x9a\x03\xb9\x5a\xdb\x63\x77\xc7\xf0\x08\x77\x0c\xd3\x7d\x78\xbb\x9b\x8c\xa9\xc9\x34\xdb\xf8\x73\x67\xb5\xa5\x0c\xdf\x84\xdd\xab\x98\xbd\x99\x1c\xd4\xd4\xfe\x53\xf2\xd0\xeb\x43\x9d\x09\x85\xd8\xe2\xfe\xd9\xd9\x1c\x60\xd1\x9a\xbb\x00\xaa\xeb\xaa\x32\xdd\xa0\x0f\x6c\x19\x5b\xbc\xc2\x12\xf4\xef\x6a\x51\xf8\x91\xc7\x33\xea\xfb\x84\xbd\x0a\x8c\x38\x25\xde\x52\x89\x87\xdc\x6b\x9d\xaf\xaf\x8a\xcc\xd3\x80\xb2\x5b\x3b\xb8\x46\x73\x27\xef\x15\x0a\xed\x82\x90\x3a\x57\xe0\x71\x88\xc2\x82\x2f\x94\x04\xd5\xf7\xc6\x88\x61\x33\xa9\x56\x4f\x31\x9f\xab\x40\x9f\x9c\x5c\x2c\x76\x7b\x66\xa4\x7a\xd1\x32\xd1\xc5\x53\xd1\x8a\xa5\x97\x7c\x5f\x2d\x1b\x05\xe2\x32\x24\x6b\x12\x8a\x96\x9c\x74\xcb\x83\x5b\x3d\xae\x96\x89\x02\xd1\x98\x81\x42\x1e\xa0\x70\x0f\x6c\x09\xe7\x04\x21\x9f\xe1\x30\x1d\xa3\x8e\x93\xd4\xb9\xf0\x9a\xc4\xb1\xba\xfd\x02\x66\x50\x8a\xe2\xde\x18\xeb\xb6\x68\x9a\x67\xc0\x50\x66\x4b\xfa\xa7\x9a\x51\x53\xd7\x48\xf4\x4d\x19\xd7\x0b\xcc\x02\xd2\x90\x32\xec\xcb\x85\x94\x91\x52\x05\x95\xb9\xc2\x44\x29\x32\xc1\x9c\xf6\xbe\xe1\x80\x20\x38\x39\x14\x6f\x47\x08\x7a\xeb\x85\x2a\x39\x8e\x41\xc9\x14\x75\x60\x20\x24\x86\x36\x52\xc3\x66\x26\x7c\x19\x4d\x34\xa1\x88\x2a\x7a\x68\x48\x0d\xc6\xc4\xce\x53\xc4\x76\x50\xf6\x94\xfe\xbd\xed\x9f\x61\x10\x9e\x43\x85\x14\xc0\x27\xf4\x1d\x0c\xce\x4a\xf4\xf6\xc6\x41\x3d\x78\x0c\x2f\x55\x25\xb9\xc2\x7a\x8d\x0a\xeb\x0d\xf2\xbf\x16\x69\x6e\x21\x63\x82\x97\x6d\x34\x77\xc8\x83\x89\x1e\xd4\x87\x70\xd6\xa0\x94\x70\xae\x12\xcb\x99\xd7\x10\x59\x03\xc7\x21\xac\x9a\x97\x6c\x54\xa8\xe9\x29\xa6\x52\x12\x36\x42\x9c\x11\xf4\xbf\x93\xcf\xf7\xa6\x2e\xb9\xea\x67\x1a\x52\x46\xb2\x42\xd8\x0b\x29\xc4\xa0\xd2\x33\x5d\x31\x46\x9f\x21\x56\x65\x00\x43\x34\x04\x78\x48\xbd\xbb\x8a\x63\x78\x39\xd5\x1d\xea\x7b\x1a\x6b\x1d\xc3\x51\x0a\x5e\x71\x44\xea\xfb\x8a\xc4\xbb\xa1\x96\x7d\x94\xdc\x51\x46\x97\xab\xa5\x51\x4f\x7c\x6e\xa6\x3f\x52\xfd\xa7\xcc\x5c\x67\x1b\xe4\x93\x39\x5e\x85\x32\x3b\xd3\xbe\x02\x51\xa3\xfa\x35\x26\x54\x7e\xf8\x3a\xf5\xd6\xdb\xcd\xd9\x39\xae\x4f\xa0\xef\xea\xdc\xb7\x89\x19\x27\x56\xb3\xe4\x1d\xa1\x02\x92\x23\x44\xc6\xc1\x18\x4d\x87\xa6\xc5\xff\x65\x44\x59\x10\x71\x16\x4c\x87\xc7\x40\x51\x21\xf9\x77\xd2\x83\x06\x1d\x7c\x9e\xe1\x85\x61\xd9\xae\x9c\x48\x3a\xfe\xb8\x60\xfe\x73\x90\x90\x27\x0d\xa0\x1c\x0d\x49\x3f\xd3\xfb\x5b\x2e\xd9\x25\x8e\xe8\xc5\x37\xb2\x69\x99\x29\xec\x85\x04\xc7\xef\x23\xfa\x77\xb2\xa9\x53\x24\x77\x77\xf7\xc3\x42\x46\xca\x1a\xf9\x00\x4e\xa0\xbb\xbb\xfb\xbf\x08\xf4\xfe\xcb\x4d\xda\xea\xd5\xe3\x6c\x4e\x83\xec\x37\x72\x9b\xda\x66\x68\x27\xd6\xdd\xfd\x82\xcb\x85\xfd\xb9\xb9\xb0\xfd\xd1\x5a\x6c\xa2\x07\x3b\xfb\x99\x05\x9c\xdb\xa4\xda\xf7\xfb\xa3\xaa\x73\xda\xfa\xbb\xbb\x7b\x8d\xf3\x12\xf7\x31\xbb\xa3\x45\xcf\x5b\xc7\x19\x3d\x70\x46\x6d\x29\x32\xd1\x27\x6b\x08\x22\x2b\xe8\x3c\xb7\x45\xd5\x2f\x37\x3f\xd1\xc4\x11\x7d\x04\x89\x3a\xa7\x24\xdc\x3d\xe2\x2d\xdd\xe0\x26\xdb\x5b\xb5\xb9\xdd\x28\x7f\x3b\x28\x7b\xda\x0e\x0a\x28\xfe\xc8\x15\x00\x9d\x07\x5d\xe6\x41\x3b\xa1\xd3\x4e\xe8\xec\xdb\x86\x31\x81\x76\xf9\x6d\xc2\x0b\x01\x91\xf7\xdc\x27\x7f\xe8\x81\x3d\x88\x24\x1b\xeb\x57\xb1\x57\x3d\x1f\xdd\x0e\x0f\xe4\xd9\x68\x50\xb6\x95\x4d\x87\x75\xe2\xcd\xfb\x22\xe0\x8e\x02\xfb\xa2\x40\x86\xe5\x01\x99\x9e\xf7\xef\x1f\x74\x79\x2c\x98\x5e\x0d\x01\xde\xbf\x7f\xa8\x25\xc0\xc9\x82\x3f\x09\x74\xff\xfe\xa1\xbe\xe6\x5a\xfa\x12\xb2\x46\x23\x44\xa1\x42\x2c\x24\xfa\xc6\xf8\x13\x53\x60\x64\x8c\xd7\x24\x16\x38\x2c\x00\xd8\x8d\x1c\xd3\xcf\xbf\x99\x0e\x19\x97\x8f\x73\xca\xa8\x58\x10\x7f\x3a\xbc\x9c\x0e\x85\xae\x25\x3b\x5f\x85\xea\x71\x8e\x69\x08\xff\xd1\xe1\x16\x5b\xaa\x12\xe2\x68\x40\x73\x50\x0e\xce\xc0\xa2\x02\xa5\x6f\x9f\x13\x2d\xee\xec\xf4\xde\xe8\xed\xa0\xea\x79\x3b\x28\xfa\xbb\x84\x10\x77\x5a\x8d\x5e\xe8\xa0\xa2\x47\x49\x2b\xb2\xb4\xad\x46\xc5\x75\x3a\xbc\x86\x3a\x9b\x77\x1d\x85\x98\x61\xc4\x85\xa0\x50\x9d\x39\x9d\x9f\x3a\xe4\x32\x93\xaf\xa2\xdb\xa4\xef\x7d\x11\x0c\xb9\xc0\x52\x55\x99\x9e\xa9\x8a\xd3\x7e\x29\xd0\x57\xde\xe2\x5d\xb5\x78\xaf\x8a\x2c\xee\x92\x58\xf9\x2f\xdb\x41\xd9\x93\x21\x48\xa7\x4f\x0e\xd3\x27\xbb\x6c\xac\x25\x5e\x2b\x1e\xfe\x44\xe4\x17\x0d\x43\xb1\xe6\x67\x03\xa1\x37\x36\x36\x13\xb4\x3c\xb0\x0f\x7e\x6f\x77\xbd\x70\x05\x59\x0e\x4b\x73\x98\xa0\xaf\xd8\x02\x1b\x2d\x37\x42\xa6\xc9\xa0\x4f\x0b\xc2\x90\xe4\x11\x44\xcb\xd1\x2a\x52\x12\x5f\xac\x82\x80\x08\xc8\x3e\xd4\xa3\xfa\xe3\xe5\x02\x1c\x19\x86\x3e\x27\xca\x2b\xda\xc9\x3d\x20\xdb\x41\xd5\xb3\xe3\xcd\xbe\x79\x53\x5c\x52\xe6\xa9\x64\xe5\x56\x8c\x69\x46\xdf\x24\x63\x6b\x58\xd2\xec\xbd\xa8\xe5\x4a\xeb\x46\x98\x0f\x20\x5a\xf4\x85\x12\xd7\x03\xd2\x4d\xbc\x05\x8e\x03\x22\xe0\xfc\xd2\x27\xb1\x81\x22\x14\xff\x51\xa6\x8e\x6b\xa0\xae\xee\x92\x42\x36\x7f\x4c\x3c\x60\x7c\x95\x93\x3f\xe3\x72\x91\x5e\x7a\x85\xd7\x4d\xf9\xdd\x78\x84\x42\x2c\x89\x90\x68\x4e\x63\x21\xbb\x1c\x60\x9a\x23\xb4\xd2\x86\xd8\x8d\x4e\xf7\x34\x0c\x74\xf3\x01\xae\x08\xcc\x69\x68\x53\x3b\x12\x44\xa1\xd9\xe6\x44\x87\x97\x7c\x3e\x27\xac\xa0\x15\x79\xf3\xd5\x25\x49\xe1\xe6\xd8\x32\x22\xc4\x76\x9a\xf0\xb0\xb2\x85\xb2\x4b\x3d\xd1\x32\xbf\x51\xd6\x61\x03\x2d\xcb\x20\x00\x93\xd9\xc3\xd9\x66\x8c\xbe\x58\x6b\x70\x8d\xc3\x15\xd1\x47\xe7\xd3\xa1\x25\xc4\xc7\x94\xbe\xa7\xc3\x11\x9a\x0e\x9f\x62\xce\x82\x47\xfb\x6f\xfd\x9b\x21\xf2\x47\x43\xe4\xbb\x3f\x92\x1f\x3a\x01\xe8\x71\xa9\xf5\xbf\xfe\x6f\x96\x53\x9e\xcb\xa9\xae\x91\x27\x29\xd5\x9f\x93\x18\xff\xb2\x2b\x1c\x6f\x69\xe1\x6d\x8b\x5d\x1c\xed\x3f\x97\x92\xa5\xd3\x75\xdd\x75\xdd\x25\xf9\xd1\x36\xd0\xa6\x47\xe4\xf6\xb6\x3f\xc5\xf7\x3f\x0a\xfc\x61\x8a\x6f\x6f\x0c\x24\xfb\x60\x74\x3d\xf9\x27\x9a\xd3\x90\x38\x9d\xe5\x74\x96\xd3\x59\xe7\xa7\xb3\xe0\x42\xa1\xe2\x51\x1e\x2f\xb1\x1c\x96\x6d\xe9\x4f\x91\xf7\x92\xfc\x90\x97\x9e\x58\x17\xfc\xef\x59\xcb\x79\xcb\xdf\xc9\xcd\xe3\x59\xfb\xab\xc7\x6a\x48\x4e\xf0\x7f\xde\x97\x1b\xdd\xe4\xff\x57\xfd\x99\x7d\x61\x8e\x0a\x44\x54\x7e\xf7\x3f\xf2\x18\xd2\xea\x8b\x34\x81\x91\x41\xb3\x4d\x26\x0f\x50\x89\x28\xc1\xd1\x1c\xc7\x23\x24\x38\xa2\xe6\x86\x24\x0a\x39\x0b\x80\xaf\x61\x26\x30\x88\xa8\xd1\x46\xb2\x2d\xc7\xc8\xae\x5e\x40\xd8\x7b\x03\xf4\xbb\xa0\x70\xa9\x77\x33\xee\xa2\x63\x3a\xdc\x33\xb6\xdb\x80\xe8\x99\xd6\xf4\x29\x95\x17\xf5\x67\xc8\xc9\xda\x0c\x05\xba\xdc\xa7\x4e\xb9\x4f\x25\xa2\x22\x24\x7e\x40\xe2\x36\x86\xa0\x19\x7a\xab\x07\xf6\xc5\xff\xd6\x96\x4b\x62\x10\xe6\x33\x28\xdc\xfb\x4e\x89\x15\x48\xd6\x24\x06\x9e\x5c\x73\xea\x11\x14\x13\x8f\xd0\x35\x30\xf1\x26\x85\xb9\x5b\x1f\xd0\xaa\x56\x64\x54\xab\x2e\x46\xa0\x6a\x0f\x44\x78\x83\xe8\xf9\x06\x3b\x34\x4e\x4e\x67\x35\x5a\x84\x76\x5a\xdd\xb5\xdd\x95\x44\x76\xfd\xc4\x45\x16\x92\x63\x2f\xe6\xcd\x1e\xbd\x9e\x5c\xae\x98\x99\x69\x86\xed\x2a\x58\x9c\xd8\xed\x51\xec\x5e\xae\xa1\x2d\xee\xa6\x8d\xf4\xd5\x23\x76\xb6\xb4\x37\x19\x9c\x34\xe9\x6d\x2f\x84\x4d\xa3\x60\x90\xa0\x58\xae\x62\xa2\x6e\x5e\x24\x01\xe6\xbc\xa4\x15\xa6\x0e\x2b\x96\x36\x1a\x9d\x1c\x0a\xa1\x25\x96\xde\xc2\x8a\x71\x31\x46\xff\x82\xa3\x24\xb8\x8a\x91\x88\xf1\x47\xea\x83\xbd\x06\x16\x1f\x53\x56\x9d\x09\x50\x1b\x50\xf0\x3f\x1c\x0a\x8e\x80\x15\xb0\x3a\x8d\xa2\xd9\xe0\x76\x10\xab\x8f\x73\x89\xc3\xb1\x93\xf0\x4e\xc2\x37\x96\xf0\x9a\x3d\xb4\x28\x32\x59\x58\xe7\x24\xc7\x76\x64\x82\xce\x87\x73\xc2\xfe\xa4\xc2\x3e\xe6\x11\x17\xb8\x5d\xa1\x83\x90\x0a\xf9\x25\x19\x58\x27\xd9\xcd\x8b\xc3\x42\x62\x2f\xb0\xae\xa3\x22\xc8\x25\xf6\x34\xcc\x04\xa4\x78\x32\xc6\xb0\xae\xb6\xa9\x6d\x08\x0b\x51\xbf\x8b\x10\xb5\x60\x3a\xc9\x19\xea\x9b\x79\xaa\x98\x5b\x3a\xe1\xfc\xb0\x1e\xa4\xca\xa8\x7e\x49\x40\xfa\xd4\x23\x8f\x0a\xe6\xc1\x6b\x02\x25\x60\x20\x21\x80\x94\x84\x57\xcd\xe2\xca\x22\x8f\x3c\x22\x6c\x1d\x31\x13\x6d\xa4\x31\x09\x56\x38\xf6\xa7\x43\xa5\x71\x21\x49\x8f\x47\xd3\xe1\x69\x10\x83\x55\x3a\xe0\xa3\x6e\xbe\xd2\x69\xc3\x01\x11\x1a\x9a\x6d\xe5\x42\xb3\x71\xd9\x2c\x9e\x04\x9a\x6d\xce\x61\xb9\x82\xaf\x62\x8f\xf4\xb9\x64\x0d\xf1\xec\x96\x3d\x27\xd2\x5b\x3c\x7e\x5f\xe1\x30\x5f\xfe\xad\xd5\x7a\x21\x1d\x94\x40\xd5\x7e\x5d\x83\x1a\x29\xb0\x2a\x3b\x90\x20\x03\x1c\x2d\xa1\xea\xa6\x27\x54\x82\x20\xe4\x5d\x8b\x31\xfa\x88\x43\x41\xba\xdd\x63\x9e\x71\x1e\x12\xcc\xba\x61\xc1\xd6\x3f\xea\x28\x05\x6e\xe6\xc6\xcc\x7d\xa2\x61\x68\xf7\x39\xbb\xc7\x6a\xdd\x49\xb5\x25\x25\x29\x8e\x61\x4c\xb5\x59\xb1\x07\x76\x7c\xbc\xf9\x79\x8b\x36\x1f\x3c\xd1\xba\x05\x8f\xe5\xe3\xac\xdb\x72\x0d\xa5\x4f\x87\x22\x22\x90\xf4\x3c\xca\x2c\x17\xc4\x3a\xa4\xe9\x80\x23\x33\xdb\xa8\x55\x87\xe1\x06\x2d\x09\x16\x2b\xf8\xcd\xe7\x4f\x2c\xe4\xd8\x87\x4a\xf5\xc4\x87\xc4\x1f\x70\x86\x88\x9f\x01\x11\x70\x95\x12\x3e\x46\x13\x78\x05\xc1\xff\xa1\xa4\xcc\x2a\x84\x2c\xdc\x58\x1d\xce\x41\x5a\x9f\x2a\x53\x69\x2d\xe4\x63\x60\xb3\xd0\x4a\xe9\x64\x92\xdf\xe6\x6d\x94\x73\xb2\x35\x6f\xb3\xa6\x9c\xed\x48\xbd\x8b\x9e\x3c\x8a\xf6\x9f\x4b\xc9\xd1\xd9\xe3\x07\xdb\xe3\x97\x56\x3b\xb5\xb0\xcb\x93\xc1\xff\xd8\xd3\x6c\x3d\x9b\xe6\x79\xf5\x96\xfd\x52\x63\x53\xbd\x0a\x48\x2f\x1c\x57\x0e\xff\xe4\x34\x65\x51\x6f\x76\xaa\x17\xce\x1b\xe4\x7f\xad\xa0\x2d\x10\xf9\x2d\x28\x4b\xe9\x08\x3b\x67\xd1\x1b\x5d\xfd\x63\x45\x62\xb8\x4e\x11\x15\x41\x2e\xa1\x23\x8c\x22\x38\x07\xd9\xa1\x24\x15\x8f\x83\x34\x6e\xa3\x88\xc9\x0f\xa8\xca\x0c\x51\xab\x51\xf6\x12\x8f\x48\x0a\xcb\x1a\xca\x18\x21\x06\xe7\xfa\x21\xfd\x53\xe9\x22\xea\x11\x9d\x49\xca\xa0\xe7\x72\x9c\x28\x6d\x74\x03\x17\x1c\x90\x87\xe3\x78\x93\x27\x2a\xe5\xb3\x28\x85\x87\xc4\x9e\xe6\x52\x27\xb7\xaa\xa2\x8f\xcf\x55\xd1\x67\x46\x92\xba\xd1\xff\xe6\x94\xa5\x87\xbe\xba\x80\xcf\xb8\x8b\x9b\xaa\xd7\x7e\xb8\x8e\xff\x98\xc7\x9d\x0a\x4e\x72\x88\x7b\xf2\x39\x2c\x73\x84\x78\x0c\x18\x93\x23\x44\xbe\x9b\x74\x5d\x08\x78\xfa\xd4\xa4\xb3\xc3\x89\x65\xc6\x59\x1e\x59\xe7\x50\x19\x98\x23\x8b\xce\x04\x63\x89\xed\xa9\x6f\x39\x8b\xd1\x94\xa9\x42\x36\x98\xf9\x6f\xc8\xf7\x37\xbb\x83\x13\x37\xf1\xad\x9a\xc2\x1b\xca\xde\x18\x78\x23\xf4\x75\x32\x42\x9f\xfe\xfb\xed\xdb\xb7\x63\xf4\x4f\xed\x67\x82\xba\xc0\x94\x01\x49\x88\x08\x7b\x90\x6c\xec\xf1\xe5\x12\x0b\xd8\xd6\x08\x43\xfd\xa3\x05\x81\xfc\x62\xb1\xe0\x2b\xe8\x76\x4f\x90\xcf\x57\x90\x22\xf3\x7d\xc5\x73\x35\xb9\xfb\xb2\x2c\x46\xf5\x5b\xd8\xd9\x4e\x9b\xc0\xad\xcb\x6f\x64\xa3\xab\x46\xf1\x39\x9a\x0e\x0d\xbd\x82\xc1\x06\x79\xaa\x80\xd1\x80\xce\xb2\x8f\x4b\xca\x56\xd2\x24\xed\x18\xea\x9f\x0e\x01\x51\xd3\xa1\x41\xf1\x74\x38\x46\xb7\x86\x2f\xa8\x40\x12\x7f\x23\x4c\x5f\xee\xcc\x53\xbe\x18\x4f\x59\x22\x27\xd4\x11\x26\x94\x3c\x07\x87\x48\xe5\x1e\xa5\x66\xde\x03\x35\x11\xf6\x15\x33\x6c\xf9\xdd\x08\x83\x9c\x31\x99\x84\x4c\x6e\x3e\x28\xca\x31\x64\x71\x4a\x37\x42\xcd\xae\xe3\x26\x29\x18\xb0\x07\x58\x78\x16\xdb\xb0\x99\x80\x6a\xa3\x14\xe0\x90\xc0\xe0\x06\xfe\x63\xfa\xe5\x00\x0e\x00\x9f\x5c\x1d\x12\x43\x85\x6c\x84\x93\x7f\x9e\xbc\x38\x96\xe6\xe4\xc3\x71\x73\x0d\x5c\x8a\x04\x81\x18\x1d\x5c\xc3\xb1\x56\x03\x95\x64\x69\xc4\x04\xf8\xdb\xb1\x52\x06\xba\x2e\x98\xf9\x35\xb3\xf4\x29\xab\xc9\x78\xa3\xca\x7f\x99\x0e\xb3\x22\xc6\xd0\xbf\x91\x4b\x86\x3f\x74\xb8\xfa\x71\x49\xe4\x82\x9b\x31\x9a\x67\x22\x12\xe7\xd9\x08\x7e\xca\xb2\x52\xc2\x78\x26\xac\xa5\x98\xe4\x11\x98\xe4\x38\xd9\x70\x0d\x76\x47\xf5\x5a\x3a\x7c\x73\xee\xf0\x0f\x75\xa3\x8a\xad\x96\x33\xb8\x8b\xa5\xb7\x45\xa8\x8a\x74\xa0\x94\x0f\x58\x15\x65\x92\xec\x9e\xda\x99\x17\xcc\x5e\x0e\xff\x0b\xfd\xf5\xaa\xd3\x9a\xbd\x55\x2c\x78\x7c\xf8\xa2\xaf\xd5\xf8\x24\xb4\x09\xb6\x47\x96\x00\xed\x19\x1f\x65\x88\x91\x1f\xf2\xd1\xdb\x7d\x3d\x26\x6b\xca\x57\x22\xb1\x59\xe0\x47\x81\x97\xa0\x67\x48\xbc\x19\x4f\xd9\x83\x05\xaa\xaa\xa7\x66\x0b\x81\x82\x9c\x4c\x6c\x9c\x42\x80\xca\xbc\x48\xad\x20\x1c\x45\x04\x83\x74\x03\x69\xe2\x53\x91\x3e\x53\xb8\xa8\x2a\x9f\x08\x61\xd6\x10\x11\x0b\x3a\x97\x88\x9e\x4a\x4c\x2c\x39\xa3\x92\x03\x9c\x47\x7d\x89\xfa\xf0\xfd\x49\x43\x13\x3a\x08\x67\x02\x05\x59\x67\x05\x3e\x01\x68\x30\x1c\x89\xd2\xaf\x1f\x25\xf4\x56\x68\xf5\x76\x72\x68\xbe\xe4\x2d\xde\xf3\x74\x65\x48\xbc\xe9\x3f\x84\xe0\x4a\xb9\xb8\x52\x2e\xc7\x28\xe5\x62\x74\xbf\x68\xe3\x06\x9b\x1a\xf9\x10\x34\x4b\x08\xbd\xc6\x17\x36\x43\x86\x85\x42\x21\xe3\x0a\xdb\x98\x45\x32\xad\xd1\xa0\x6c\x1b\x33\xb3\xb0\xee\x16\x5c\x25\xb1\xa6\x52\xbc\x62\xda\xf1\x31\x90\x10\x67\x49\xa1\xee\x71\xef\x31\x96\xfc\xd7\xce\x89\x9c\x8a\xb6\x6b\x0f\xc8\x76\x50\xf5\xbc\x1d\x14\xfd\xbd\x1d\xe4\x58\xb0\x49\x49\x32\x35\x97\x09\x68\xf9\xbe\x68\x46\x01\x13\x16\xf5\x15\x14\x63\x9a\xed\xc5\xd6\xc8\x50\x23\x58\x80\x18\x79\xb2\xa3\x41\x81\xda\x84\x1a\x71\x58\xcd\xb2\x8c\x14\x4e\xdb\x30\x99\x2f\xdf\x7c\xc8\xb5\x61\x52\x33\xc9\xcd\x21\xf3\xd9\x0a\xca\x69\x42\x37\x55\x54\xd3\x88\x66\x14\x6a\x4d\xa9\xb3\x02\x49\xb4\x1d\x94\x3d\xa5\x7f\x6f\x9b\xb1\xda\x2f\x7b\x93\xcc\xe3\xf5\x86\x51\x49\x21\x7d\x2c\xd9\x2b\x91\xa3\xa2\x73\xe1\xb4\x1b\x36\xe7\x5d\xe5\x76\xfb\x22\xf1\xaf\xac\x51\xea\x6f\x57\x7f\xab\xc5\xc9\x35\x67\xf3\x90\x7a\x72\x8c\xcc\xce\x40\x2c\x01\x87\x31\xc1\xfe\xc6\x8a\xec\xd7\x81\x2c\x67\x3d\x3a\xeb\xf1\x00\xeb\xb1\x75\xfb\xa4\x2f\x10\x16\x02\xcd\x0a\x17\xbb\xd0\x9c\x42\x7d\x28\x5d\x2f\x6a\x03\x85\xa2\xfe\x22\x21\xc6\xec\x71\xb6\x26\x2a\xa0\x27\x39\xba\xfb\xbf\xc9\xc3\x4b\x45\xdf\x20\xff\x57\x89\xf1\xad\x6e\xfc\xb5\xac\xb2\x6d\xc6\x4e\x24\x8f\xfa\x33\xa4\x78\x94\x68\xd7\x2c\xd0\x06\x9a\x98\x47\x5d\x0e\x6d\xda\xdc\xab\x7b\x86\xf7\xe3\xac\xf6\x01\x04\x23\x6a\xb0\x57\x71\x47\xee\xb7\xab\xdf\x6a\x61\xde\xf3\x04\xfb\xe4\x07\x15\x52\xbc\x54\x26\x72\x12\xba\x4a\x42\x0f\xf2\x7f\x6d\x47\x4d\xdd\x78\x43\x3e\x9f\x88\xec\x4b\x80\x80\xe1\x1b\x2f\x95\x90\x42\x78\x06\x47\x5e\xe6\x1b\xd9\x0f\x14\xf3\xc6\x27\x92\xf1\xe2\x29\x9b\x73\x34\xe7\xb1\x35\x68\x49\x7a\xde\x55\xe6\xc5\xbf\x2a\x89\x53\xcf\x03\x06\xab\xa6\x9d\xbc\xca\x17\x4b\xb6\xe6\x9c\x58\xe1\x78\x1e\xd3\x6f\x8d\x71\x04\x19\x09\x73\xbe\x62\xfe\x39\x21\xe6\xe7\x9a\x21\xaa\xea\x49\xab\x18\xa0\x19\x03\xf1\xaf\x7a\xe9\xa1\x5e\x1d\x16\x52\x79\x46\x7a\xd8\xd4\x16\x3b\x1d\x7b\x63\x3f\x0b\xbf\x24\x1b\xc6\x06\xfd\xf2\x43\x4d\x26\x8c\x4e\x30\xd1\x27\x54\xf9\xc3\x94\xd6\x92\x23\xc2\x01\x79\x14\xf4\x4f\x52\x2c\x40\x9a\x1c\xd6\xdc\x3f\xa7\x93\x43\x58\xef\xe1\x4b\xfd\x62\x4e\x0a\x4d\x4a\x12\x44\x60\xf5\x69\xe9\x6c\x73\x9c\xd5\xfe\xd2\x69\xb1\x3e\x96\xe4\x11\x52\x3a\x0e\x5f\xb1\x49\x20\x82\x95\x26\xe4\x08\x10\x91\x5c\x50\x81\xe0\x03\xe8\x0d\xe3\x4f\xe8\xe2\xdd\x95\x3f\xca\xe4\xbf\xbf\x1d\xa3\x8f\x4a\x44\x83\x63\x44\x19\xfa\xe3\xe3\xf5\xbb\x77\xef\xfe\xa6\xbb\x5a\xfd\x7a\xf5\xeb\xd5\xc5\xd5\x7f\x5e\x5c\xfd\x32\x1e\x1e\xaa\x7f\x76\xc7\x21\x34\xd4\x1a\xc1\x2e\x7b\xd8\x1d\x71\x92\xf7\x8b\x36\xdb\xd9\x2d\x8b\xb7\xd6\x28\x7b\x77\x75\xae\x28\xa3\x31\xf1\x8a\x14\x72\x73\xa4\x7d\xb0\x20\x72\x2c\x96\x60\xb0\xbc\xee\x91\x09\x86\x9b\x74\x0e\x73\xff\xd3\x9f\x0e\x5f\xf2\x2d\xd6\x2c\x5a\x4e\xb3\xcc\x05\x89\x97\x44\x74\x5a\xe4\xef\x0a\xc4\xfe\x3d\xe4\xd3\x2f\xce\x9e\x72\x74\x5a\x5e\x72\x48\x73\xb6\x7b\xd8\xcf\xad\x41\x6b\x7d\x02\x94\xf3\x5b\x62\xbe\xfb\x40\xdb\xc5\xa9\xf1\xed\x85\xd2\x3d\x79\xb2\xf2\x68\x19\x41\x08\xec\x48\x02\xa9\xd0\x14\xed\xe5\x20\xd8\x2e\xf2\x9c\x7c\x09\x63\x80\xf7\x76\x00\xec\x8e\x16\xdc\xd1\x42\xe7\xa3\x85\x41\xfe\xd7\x12\xa7\xf4\xc2\xb4\xf2\xa5\x6b\x2a\x37\x17\x46\x30\xb5\x70\x54\xaf\x33\xc3\x27\x7b\x4d\x55\xca\xdd\x4f\x94\xfd\x2e\x12\x7b\x23\x9b\x79\xa2\x75\x50\x7a\x91\x39\x05\xdf\x38\xaf\x0c\x94\x82\x3d\xd8\x83\xb1\x1d\x54\x3d\x6f\x07\x45\x7f\xd7\x90\x8e\xea\x10\x24\x2e\x70\x10\xc4\x24\x50\x11\xf7\x16\x74\x63\x80\xc0\x74\xc5\xfb\x14\xc2\xb1\x62\x1d\x6a\xaa\x0d\xe8\x2b\x5d\x8c\xda\x69\x2a\x24\xdc\xbe\xc9\x28\x9e\x63\x84\x3c\x9c\x57\xec\xbc\x62\xe7\x15\x3b\xaf\xd8\x79\xc5\xce\x2b\x76\x5e\xb1\xf3\x8a\x5f\x92\x57\x9c\x33\xf0\x9c\x83\xec\x1c\xe4\xe7\xe5\x20\x1b\x2f\xc7\xc7\x34\x6c\x55\xcc\xc0\x8c\x57\xf4\xff\x41\x0d\x3e\x23\xdf\x46\xad\xc6\x79\x38\xce\xc3\x71\x1e\x8e\xf3\x70\x9c\x87\xe3\x3c\x1c\xe7\xe1\x38\x0f\xc7\x79\x38\x87\x7b\x38\xbd\xda\xea\xce\xa5\x71\x2e\xcd\x51\x5c\x1a\xa8\x0d\x73\x01\xb5\x61\x44\x2b\x4f\x06\x86\x3d\x10\x21\x1b\x65\xa3\xda\x97\x87\x85\xc2\xa0\xc8\x8f\xd9\x2b\x68\x96\xfd\x46\x89\x2f\x03\xbd\xa6\x88\x5f\x30\x76\x04\xd7\x8d\x7b\x69\xd2\xdb\x8b\xda\x2d\xac\xe0\x6a\xa6\x5a\x5e\x3c\xfc\x19\xc8\xe3\xaa\x4d\x3b\x39\x53\x4d\xb2\x14\xdb\x7f\xc8\xc9\x09\x9e\xb6\x82\x07\x6e\xd1\xa5\x93\xac\xaf\x63\x90\x59\x7d\x93\x1b\x78\xd7\xaa\xae\xe1\xb0\x90\xdc\x33\x12\x07\x40\x09\x53\x04\x31\x0b\xb2\xf4\xfe\x9d\x7e\x15\x49\x12\x2f\x29\xcb\x5f\xee\xa8\x62\xa4\x7a\xfd\x6d\x6e\xfd\xab\x4a\xe6\x91\x84\x72\xc5\x42\xf2\x28\xca\xf1\x73\x2d\x66\x61\x6a\xad\x84\x79\x40\xe4\x83\x1a\x53\x83\x52\xfd\x52\x1d\x46\x3f\x41\x4d\xa4\x3c\xb8\xbd\x95\x42\xf8\x09\x61\x86\xf8\xec\xdf\xc4\x93\xaa\x5e\x9f\xb2\x5c\xf5\xd5\x55\x03\x01\x52\x39\xe6\x34\x68\x88\xe0\x7a\x06\x54\x0b\x30\x9f\x3c\x27\xbe\x53\xf3\xea\x45\x26\x0d\xf2\xbf\x6e\x47\x8d\x19\x6c\x15\x41\xe4\xa7\x47\x4a\xf8\xaa\x00\x9a\xad\xc4\x41\x4c\xc8\xb2\x9a\xcb\x1e\xf0\x37\x53\xc4\x5e\xc7\x18\x7d\x2c\xb1\xaa\x61\x27\x55\xb1\x56\xc9\xd1\xaa\x10\xa4\xa1\x93\xf1\x61\xd5\x43\xfe\x30\x77\xcd\xe0\x68\x1f\xe7\x3f\x92\x01\x59\x41\x24\x4d\x48\xa4\x8a\x40\x1a\x91\x87\x12\x0e\x79\xea\xd8\x0e\xca\x9e\xd2\xbf\xb7\x7d\xf1\xcf\xe7\xbf\x67\x3f\xef\x0a\x66\xd4\x15\xcc\x70\x46\x41\x4b\xa3\x40\xc6\x98\x09\xec\x49\x1e\x5f\xce\x09\x69\xa5\xc4\x3e\x12\x22\x3e\x3c\x7c\x1e\x16\x8b\x22\xeb\x23\x28\xa8\xa3\x41\xd9\x66\x64\x5f\x43\x0a\xb3\xfa\xc8\xe3\x21\x99\xd7\xb0\x2f\x5e\x6a\xf4\x89\x93\x6f\xbe\xc5\xea\xde\xc0\xed\xa0\xea\xd9\xb1\xc4\x31\x58\x42\x10\x29\x43\x72\x89\xc5\x86\x79\xad\x4c\xe7\x89\x1a\xf8\x5e\x8d\x2b\xe6\x8f\x39\x8f\x3d\xa3\x7a\xf5\x57\x94\x5a\xd5\x85\x1f\xa1\x03\xa1\x6e\x23\x93\x69\x12\x6d\x7d\x54\xd0\xce\x3a\x97\x28\xb3\x41\xf9\x0d\xfd\xa8\xa1\xe3\x2c\x6c\x0b\x4f\x0f\x4e\xbe\x33\x46\x1f\x38\x81\x76\xd3\x12\x3d\x61\xaa\x5f\x83\x1d\x08\x09\x00\x3b\x50\xc3\xeb\xcf\x5a\xd5\xa3\x0a\x84\x65\x00\x55\x10\x53\x13\x52\xaa\x22\xa4\x6a\x32\xd2\xdb\x62\x14\x7b\x01\x1d\x6d\x07\x65\x4f\xdb\x41\x01\x77\x75\x73\x3e\x72\x38\xb2\x3e\x48\x76\x4e\x8e\x93\x7b\xe6\x64\x7b\xdb\xba\x85\x9a\x4b\x39\xa8\x49\xe4\x2d\xa3\x54\xea\x8c\x75\xab\xfa\x32\x2c\x6a\xa7\x37\x1a\x94\xed\x6f\xb3\x41\xee\xba\xb7\xbb\xee\x7d\xb4\xeb\xde\x96\xf0\xf2\x99\x1f\x2e\xc5\x23\x49\xf1\x48\x51\x94\xcf\xf2\x78\xc6\x29\x1d\xaf\xe4\x04\xfc\xf9\x66\x31\x14\x2a\x9c\x4e\x2e\x53\x23\x6d\x73\x72\x93\x62\x92\xcc\xee\x48\xb7\x3f\x5d\xa0\xc5\x05\x5a\x7a\x0c\xb4\x18\x5b\xf4\x40\xa7\x72\xf2\x0c\x7c\x4a\x05\x70\x16\x72\xef\x5b\xaa\x01\x77\xe6\x44\x85\xf5\x31\x89\xf3\x30\x9d\x87\xf9\x22\x3c\x4c\x68\x95\x75\xe9\x13\x2f\x26\x58\x90\x56\x8c\x6d\x07\x4d\x00\x44\x8f\x1e\xe6\x07\x03\x57\x65\xa7\xef\x42\xce\x6d\x6a\xee\x4d\xe8\x23\x40\xe4\x42\xb3\xb0\xb7\xc0\x94\xa1\x35\xc5\x4a\xb0\x2c\x37\x42\x92\x98\xae\x96\x28\x5d\xfb\x81\x2c\x6c\x97\x6d\xbe\x69\x06\x9e\x03\x17\x5b\x74\xa8\x0d\x31\xcc\x9c\xa7\xa3\xed\xa0\xec\x69\x3b\x28\xe0\xae\x2a\x4e\xae\x67\xba\x2f\x78\x03\x95\x26\xa1\xc0\x21\x8a\x49\x40\x61\x0f\x88\xef\xce\x86\xdc\xd9\xd0\xcf\x39\x1b\x52\x1c\x7a\x49\x99\x66\x8b\x43\x02\xe2\x00\xe0\xc6\x8c\x6f\x1f\x17\x57\xd9\x0a\x6a\x12\xc8\x4e\xa2\x9d\x5d\xb3\x23\xa2\x1a\x18\x36\x45\x1f\xac\x32\x76\x7c\x1b\x43\x57\x22\xd3\xd9\x34\xce\xa6\x79\x39\x36\x8d\x65\x80\xcb\x6e\x5c\x3f\x79\x71\x4c\xef\x3c\x1c\xe7\xe1\xbc\x74\x0f\xe7\x89\xcc\x16\x9c\x7f\x13\x6d\x8e\xcc\xa0\x52\xf1\xbf\xec\xb8\x1a\x77\x26\x79\xaf\xce\x99\x81\xd8\x9e\x40\x4f\x05\x60\x73\xdb\xb7\xfb\x22\x1c\x6c\xd3\x39\x25\xbe\x29\xd6\x0e\xad\xd1\x10\x59\x03\x49\x8f\xd1\x84\x06\xa6\xa3\x99\x17\x13\xa9\x2f\xea\x30\x02\x1a\x20\x56\x91\xcf\xdd\xee\xd0\x55\xa4\x5a\x4f\x55\x05\x08\x39\x39\x21\x99\x39\xb9\x04\xed\x13\x25\x68\x8f\x1a\x6b\x52\x50\x7f\x92\x98\xfd\xea\x8d\xa7\xae\x15\xd4\x84\x59\xb2\x70\x73\x1b\x95\x7b\x33\xcf\x55\x9a\xa1\x6c\xe7\x5a\x1d\x5d\x94\x3c\xa2\x9e\x18\xa3\x7b\xf5\xaa\x96\x87\x9a\xc5\x04\x0d\xa0\x1f\xbe\x52\xb7\xbf\xdf\xbd\xbf\xbe\x98\xfc\xfe\xfe\xd7\xbf\xfe\x87\x1d\x2e\xc0\xfb\x96\xa3\x84\x07\xcd\x0f\xd0\x5b\x07\xbe\x0b\xdd\x75\x62\x02\x09\xaa\x6b\xe8\x1a\x8d\x25\x69\x1c\x80\x28\xa3\xa4\x26\x74\x54\x45\x45\xd5\x34\x74\x9d\xdd\x3b\xa3\x38\xf3\x84\xb4\x1d\x94\x3d\x6d\x07\x05\xec\xd5\xad\xcb\x9c\x99\x0a\xd2\x44\xe5\x9f\x13\xbf\xe5\x70\xd5\xb7\x4c\x6a\x14\x17\x81\x6e\x86\x11\x8e\x05\x68\x86\x17\x2d\x90\xdc\x7d\x47\x77\xdf\xf1\xe8\xf7\x1d\x8d\xc6\x38\xa8\xff\x97\x6e\x11\x66\xa4\x41\x6f\x5a\xef\x83\x82\xda\x44\xeb\xe5\xde\x1c\xa1\x88\x30\x1f\xc4\x82\x4f\x42\xba\x26\xf6\x8e\x04\x95\x4a\xad\xe1\x19\x66\x3e\x67\xc4\xef\x92\x8a\x45\xfd\xe1\x68\x80\x10\xfa\x7f\xf6\xbe\xaf\xb9\x6d\x1c\xd9\xf7\x5d\x9f\x02\xa5\x97\x3d\xa7\x4a\x56\x48\x49\xfe\x97\xb7\x4c\x92\xdd\xf1\x6e\x26\xe3\x8d\x33\xbb\x75\xa6\x72\x4b\x05\x91\xa0\x84\x63\x8a\xe0\x90\x94\x1d\xdd\x53\xfe\xee\xb7\x1a\x04\x48\x10\x24\x28\x50\xa4\x6c\x9f\xb9\x59\x4f\xd5\x2a\x12\xd9\x68\xfc\xd0\x68\x34\x1a\x8d\x6e\x9b\x9a\x3d\x1a\xb7\x02\x00\x74\xf3\xe1\xd5\x17\xf7\x39\xac\x6a\x64\x67\x72\x11\xf0\xc7\xbd\xaa\xe0\x48\x62\x7f\xfa\x2a\x38\x3f\x54\xd5\x60\xaa\xea\x4d\x39\xc7\x8f\xdc\xfb\x7e\x28\x09\x0c\xa5\xba\x2a\x7b\x5b\xe4\x37\x36\xa0\x8d\x70\xfe\xca\x96\xf1\x4b\xc2\x1e\xb8\xa6\x22\xc5\x20\xd7\x34\x99\xa0\x3c\xe1\x66\xb5\xbc\xbf\x3d\x45\x3f\x8b\x4a\x43\x34\x45\xf7\x24\x86\x73\x28\xb4\x25\x5b\x96\xec\x11\x8b\xc2\xfd\xb4\x4f\xe8\xe9\xff\x1f\xfa\xee\xf0\x44\xfc\x77\xdb\x98\xbe\xf8\x2c\xac\x4a\xf4\xfe\x54\x71\x56\x3f\x34\xf9\x0f\x4d\x7e\x02\x4d\x0e\xc9\x36\x3a\x9d\x5b\xc0\x0b\x42\xd2\x06\x53\xdd\x77\x24\xf2\xd3\x3c\x59\x83\xaa\x80\x55\xfa\xda\x88\xe6\x6f\x60\x04\xf7\xd1\xab\x4a\x5b\xd5\xd4\x42\x61\xf0\x2c\x16\xeb\x84\xa4\x29\xf7\x93\xac\xe0\xe4\x22\x0c\xd9\x63\x9e\x6f\x8f\x66\x69\xf9\xe0\x00\x17\x06\x7e\x58\xa9\xb9\x95\xfa\x55\x1f\x4e\xc8\x43\xbf\x7b\x5d\x2e\x15\x4d\x77\x0f\xaa\x98\x7e\xa8\xec\x1f\x2a\xbb\xa7\xca\x1e\x09\xe4\xc6\x65\xfb\x05\xaf\x82\x77\x75\x6a\x8e\xdf\xdd\xde\x7c\x65\xf7\x24\x02\x39\x2e\xbf\x56\xd4\x40\x2d\xdb\x84\x0e\xbb\x42\x01\xe5\x3f\xad\x20\x78\x03\x6f\x89\x8f\xde\xdd\xde\xa0\x0c\x7e\x9c\x22\xde\x8a\xf4\x3d\xd3\x94\x9b\xba\xa5\x4f\x1a\xca\x9e\x80\xaf\x52\xbf\x23\x19\x27\xb0\x96\x64\xd5\x5d\x03\xfc\x09\x17\xbe\xbf\xc4\xf5\xb1\xd4\x55\x58\x49\x0f\xfe\xc6\xe4\x3b\x86\x58\x59\xe0\x9d\xdf\x12\x71\x9d\x33\xc7\xfd\xea\xba\x6f\x9d\xc5\xdb\xc5\xfc\xf7\xb1\x51\x08\xc9\xf7\x98\x26\x24\xed\xdd\xa4\x6b\xdf\x24\x55\x0b\x90\x74\x6d\xca\x0d\xe6\xf8\xdc\xbb\x24\xd7\xab\x99\xbf\x08\x2e\x1c\x73\x33\x62\x21\x3a\xb6\xa1\x75\x82\x03\x1c\x61\x33\xfd\x84\x3c\xb0\xfb\x61\x06\xcb\xb5\x44\x2e\xf5\x58\x4c\x52\x73\x73\x38\x49\x70\x35\x02\xa0\x2e\xda\x38\xda\xc3\x29\x0a\xf6\xb7\x34\x82\x03\x14\xec\x4f\x8a\xea\x3c\x2c\x9a\xc8\x0a\xd3\x13\x14\xe3\x3d\xc4\x58\xd4\x36\x19\xbc\x6e\x69\x8d\x87\x7a\xa7\x2b\x3f\x3f\x19\x21\x50\x4d\x09\x81\x2b\xae\xf8\x72\x10\xfa\x3f\xed\x4a\x62\xa2\xcf\xfb\xca\xde\x43\x65\xb4\xbb\x02\x50\x49\xf1\xa2\x46\x69\x39\xff\x53\xcb\x59\xcd\x95\x45\xd7\x51\x33\xa2\xdc\xaa\x8d\x25\xdb\x35\x4d\xfc\x64\x0b\xa1\xe7\x91\x34\xbd\x65\x21\xf5\xf4\x9e\x74\x00\xaf\x42\x04\x25\x24\x4e\x48\x0a\x7c\x16\x65\xa1\x20\x26\x2b\x4d\x51\x2c\x1e\xb1\x04\x92\x44\x99\xe6\x6b\xd1\x38\x1b\x12\xc9\xb2\x0f\xfb\xde\x50\xee\xfb\x03\xb9\x57\x61\x54\xe1\xdb\x5b\x82\x87\xc1\xd4\x7f\x46\xe8\xbe\xec\x42\x6d\xe3\x5f\x82\xa5\xc0\xd4\xd0\xed\xff\x19\x59\xaa\x95\xce\xcb\x8a\xf1\xdd\x8c\x66\x21\xe9\xf4\xfa\x48\xef\x95\x3e\xf0\x5f\x76\x1a\xc5\xae\xc3\x0e\x04\xd4\x41\x4f\xe0\xdf\x2c\x38\x6a\xf0\x45\xd3\xea\x77\xed\xdd\x2b\xa9\x22\x34\xe6\x89\xb3\x3b\xbd\xde\x86\xce\xce\xa7\xd9\xc7\x28\x4b\xf6\x3d\xcc\x33\x95\x46\x65\x62\xc0\x0f\xc2\x46\x2b\x36\xec\x53\x74\xc7\x2d\xb4\x14\xb6\xb9\xfc\xa2\x6e\x7e\xf9\x08\x0e\x71\xe1\x50\x26\x21\x3e\xf6\x32\xe2\x5b\x62\xc9\xef\x33\x1c\x44\xc3\xb8\xde\x8d\x77\x29\x49\xde\xc2\x1d\x09\x33\xe0\x2b\x11\x98\x50\xfd\x36\x60\xc9\x23\x4e\x7c\xe2\x2f\x83\x5e\x0c\xb8\xd7\xb3\xa9\x7b\x71\x35\x75\xa7\xe7\x66\x16\x36\x38\xdd\x1c\x6c\xc3\xf8\xf6\x96\x64\x1b\xd6\xc7\xcc\xbb\xfd\xf5\xee\xab\x99\x39\xee\x43\x38\x9e\xb8\xe9\xa6\x9d\xb9\xbd\x84\x3c\x2c\xfb\x21\xc2\xe5\xce\xfc\x7a\x4d\xe4\xe1\xbf\x31\xf6\x7d\x0a\x32\x8f\xc3\x5b\x93\x38\x1e\x54\xe2\x2d\x6a\xbc\x95\xfd\xea\x3c\xd6\xff\x55\xed\x5c\x42\xb6\x2c\x23\x4b\xec\xfb\xbd\xe4\x72\x76\x39\x75\xa6\xce\xd4\x7d\x7b\xee\xce\xe6\x0b\x33\x96\x29\xf9\xc3\xdc\x4c\x73\xb2\x04\xe5\x9a\x3b\x8d\xb2\x8b\x85\x99\x0d\x77\x66\x6e\x57\xaf\xba\x69\xd7\xb4\xa6\xbc\x7e\xfe\xfa\xf5\x56\x94\xa7\x44\x1e\x84\x1a\x8a\x98\x26\xe9\x65\x32\xb3\x36\x73\xcc\xbc\x65\x74\x4b\x06\xda\x87\x38\x6f\x1d\xf8\x6f\xea\xce\xb4\xbd\xc8\x48\x97\x84\x82\x85\x5c\xab\x7f\x62\xeb\x9e\x3a\x3d\xa7\x80\x36\x2c\xf4\x85\x32\x47\x21\x5b\x23\x61\xf8\x69\x67\x4e\x76\xea\xfa\x59\x8d\xc6\xca\xda\x66\x39\x79\x68\x94\x61\xaf\x65\x0f\xb9\x62\x2c\x24\x38\x3a\x20\x55\x01\x0e\x53\x82\x68\x20\xb1\x42\x8f\x24\x21\x68\xcb\xfc\x3c\x06\x8f\x41\xbc\xea\x96\x41\x38\x9c\x48\xc9\x41\x00\x59\xf3\x1c\xe3\xd3\x28\xa1\xd9\x7e\x99\xbb\x9c\x8e\x17\x2d\x60\x68\x8f\xdc\x19\x7a\xc4\x69\xc1\x90\xbd\x5c\x65\x1b\x19\x0f\xa7\xb2\xd0\x45\xae\x0a\x0a\x45\x10\xf8\x2e\x85\xa4\xc8\x0c\xe1\x5d\xb6\x81\x1c\x13\x1e\xe4\xdd\xc8\x18\x98\x0d\x96\x46\x40\x8c\xd3\xf4\x91\x25\x3d\x4c\x4e\x30\x03\xac\x5c\x14\x96\x48\xfd\x84\x43\x1c\x79\xe4\xaf\x2c\x21\x1e\xce\xc3\xee\x55\xca\xf6\x80\xd5\x09\x29\x2e\xb0\x0d\x7b\x44\x21\x8b\xd6\x48\x16\x2c\x41\xab\xfc\x71\x14\x62\x38\x44\xc6\x59\x25\x63\x6c\x2a\xa3\x61\x12\x9c\x11\x4b\x68\x05\x41\x33\x2e\x76\x6a\x56\xb2\xa0\x33\x6a\x1e\x11\xe1\x05\x21\xfe\x12\x94\xe9\x32\x24\x41\xd6\x99\x89\x03\xcb\x8c\xce\xa3\x6c\x11\x41\x8b\x60\x9e\xa6\xc4\x63\x70\xa4\x23\x98\x45\x34\x45\x24\x62\xbb\xf5\x06\xae\xb2\x98\x27\xd9\xfc\xc2\x71\x1c\x63\xc7\x20\x87\xea\x73\x74\x07\x6f\xd9\x2e\xcf\x50\x07\x2d\x42\x77\x56\xfb\x8c\x74\xee\x8c\xeb\x5c\xce\x2f\x17\xee\xd5\x6c\xd1\xd2\x25\xc2\xef\xcd\x1c\xe3\x7d\xd3\x98\x86\xbc\x44\x05\x87\xc9\x2e\x4a\x11\x44\x2b\xb7\xc9\xf1\x04\x91\x6d\x9c\xed\xd1\xe3\x86\x44\xf0\x54\x42\xb8\x6a\x8b\x58\xf1\xd8\xc1\x85\x76\xc6\x17\xda\x59\xbe\xd0\xb6\x39\xfc\x44\x3a\x98\xce\x03\xa7\x75\xd2\x93\x03\x53\xe4\x97\x09\xc4\xf4\x06\x09\x5b\x61\xd0\x87\x2c\x32\xb3\xdd\x66\x14\xc5\x24\xca\x96\x31\x49\x96\x3e\xde\xf7\xe5\x13\x3f\x90\x04\xaf\x49\x89\x77\x4c\x12\x04\x74\x2d\x9a\x5f\xd3\xd5\x49\x9a\xff\x1b\xfd\x09\x90\xe3\x7b\x86\x80\x24\x32\x29\xb1\x0d\x4b\x1b\xb6\x4b\x4e\xc2\x13\x10\x56\x4a\x9e\x0b\x05\x52\x61\xa9\x6d\xa9\x20\x11\x09\xa8\x47\x71\xb2\xff\xfa\x5d\x14\x22\x57\xb9\xec\xb0\x56\xd4\x29\x89\x1b\x6e\xf9\xd5\xbc\x55\xf9\x7b\x91\x0c\x00\x8e\x40\x73\xf3\xd7\x72\x41\xb0\x33\x40\xcc\xc3\x91\xe1\x8c\x74\x7a\x7d\xa4\x7f\x52\xa0\xdb\xd1\xd0\xbf\x89\x02\xd6\x63\x7d\x55\x48\x08\x4b\x97\x5f\xda\xcf\xaf\x49\xac\xe0\x57\xdb\x95\x32\xc1\x91\xd7\x67\x23\xfc\x6d\xe7\x38\x73\x6f\x17\xdd\x47\xec\x31\xe2\xff\x68\x59\x1e\x39\x67\xcb\x68\xb7\x5d\x91\xa4\x47\x9b\x3e\x79\x38\xe3\xa4\xcc\x2d\x79\x6c\xbb\xa5\x59\x8f\x36\x0e\xf5\x6b\xa4\x7f\x2a\xda\x1f\xbf\xdf\xe0\x68\x4d\x6e\x85\x79\xd7\xcf\xf4\x6c\xa4\x55\x33\x42\x3d\xfe\x14\xd8\x9e\x48\x5a\x95\x96\xe3\x1f\x91\xc7\x65\x7f\x43\x94\x85\xfe\xf2\xf5\x99\xb3\x80\x5d\x44\xc2\xa2\x68\x93\x4a\xb6\xdb\x08\x28\x54\xc4\x74\x8b\x67\x31\x47\x3d\x22\x61\xae\x95\x62\x96\x64\x68\x0b\x17\x86\x3c\x5b\xa5\xc4\x2d\x9b\x25\x84\x4b\xd2\x07\xe2\x77\x56\xf2\x07\x0c\xab\x52\x96\x5d\xc7\x6d\xb1\xec\x72\x2e\xd2\xa6\xc3\xff\x01\x39\x68\x33\xc4\xc0\xec\x2f\x60\xe8\x7b\x16\xea\x5e\x9f\x39\x17\x67\xce\x85\xcd\x59\x68\xde\x70\x36\x78\xc7\x35\x09\x4a\xd8\x2e\xaf\x75\x10\xf3\x25\x56\x3a\x6b\x84\x23\x02\x24\x69\x9b\x47\x1e\x83\xb9\xbb\xa5\x61\x48\x85\x09\x6f\xee\xe8\xb9\x63\xec\x14\x5c\x3d\x5d\x16\x22\xb9\x0c\x59\x9a\x2d\x53\xb2\xde\x56\x42\x1e\x4e\xd3\x51\xd9\x0c\xa2\x91\xb0\x72\x70\x8a\x80\x01\x48\x84\xfe\x8f\xf7\xb7\x28\xa5\x60\xc3\x03\x87\xe0\xbd\x4a\xb2\x09\x4a\x37\x58\x94\x9f\xc5\x61\xa8\xce\xaa\xe2\x9a\x1f\x3c\x6d\x06\xc2\xb5\xc5\x01\xee\xf2\xe1\x28\xdd\xd2\xe7\x43\xa1\x6c\x12\x32\x71\x9e\x0c\x82\xb9\x11\x82\x18\x7b\xf7\x24\x7b\x16\x15\xe3\x5e\x1d\xe4\xe2\xc4\x2a\x66\x66\x9e\x12\x31\x21\x09\xf7\x2c\x2f\x41\xb8\xd6\xcf\xab\x64\xf4\xc6\xd3\x13\x62\x60\x64\x42\x18\x0c\xe9\x32\xc0\x34\x24\xfe\x89\x67\x80\x6c\x0d\x3d\x6e\xa8\xb7\x41\x79\x9b\x08\xf2\x68\x50\x88\x7e\x02\x1b\x15\x92\xc0\xc3\x4e\x04\x12\x5f\x00\x42\x70\x02\x17\xee\x7b\x75\xed\xb4\xf2\x35\x37\x8b\xd7\xe9\x57\x90\x74\xcb\x58\xb6\x21\x3e\x6a\x58\x4a\x94\xf5\x23\xb5\x5f\x40\x16\xe7\x6d\xdd\x79\xc0\xc9\x33\xaf\x89\x0f\x38\xa1\xfc\xa4\xf5\xf8\x2e\x55\x7a\xd4\x66\x16\xe6\x1b\xde\x5f\xb9\xb8\xf6\x31\x0c\x2b\x74\x84\x5d\x98\x41\x74\x71\x88\x63\x2a\xf7\xd5\x88\xf1\x17\x52\x3b\x93\xd0\x8f\xd2\xae\x8a\x49\xe3\xea\xc3\xe7\x3b\x70\x4b\xef\xea\x87\x40\x65\xa6\xef\x31\xde\x65\xcc\x0c\x24\xff\x79\x52\xa4\xba\x99\xa0\x14\xb2\xf1\x6d\x27\xe8\xdb\xd8\x9d\xf2\xbf\xc9\xd5\x94\xff\x7d\x1b\x9b\xf5\xde\x3d\x0d\xc3\x65\xfa\x48\x33\x6f\xd3\xf7\x60\x02\x48\xa1\x9c\x94\x80\x13\x25\x04\xd0\xf0\xb8\x0e\x81\xbd\xde\x2e\x12\x21\xaa\x79\x00\x65\xb6\x49\xb8\xc7\xf3\x5f\xb7\x9f\xcd\xfd\x84\xf0\x6f\xe5\xb7\xa7\x91\xfe\xa9\x26\x33\x94\x45\xf9\xd5\x71\xb1\x11\xeb\x2d\x3c\x3a\xc1\xda\xce\x8e\xdb\x08\x08\x4b\x69\xea\x10\x07\x9a\xbf\xb0\x94\xe2\x57\xfd\x19\x0e\x86\xc3\xf0\xd7\xa0\x21\x78\xae\xfa\xd8\xe1\x03\xab\xea\x34\xa8\x1d\x5a\xa9\xb8\x6a\x41\xf1\x4d\xb8\xe8\xb3\xc6\x28\x5e\xd2\x27\xbf\xec\x1e\x0a\x5a\x6f\xb2\x5a\xf2\xdc\x2c\x31\x63\xe7\xbb\x63\xf7\x3f\xd7\xcc\x79\x99\xfa\xbb\x1f\xdf\x39\x9d\x82\xeb\x09\x37\x15\xbd\x0d\xc1\x31\xb8\x08\xb0\x97\xd1\x87\x22\xa1\x94\x4c\x59\x54\xad\x43\x9a\xe6\x62\x06\x27\x7e\xdb\x78\x98\x7e\xcf\xcd\xfd\x96\xed\xf6\xef\x79\xd9\x83\x01\x47\x6c\x66\xe6\xbc\x52\xae\xbc\x1f\xeb\x82\x14\xaf\x59\x6e\x2a\xe1\xcd\x62\x12\x3d\xc4\x51\x5e\xc6\xfb\x91\x26\x64\xbd\xc3\x89\xff\x6d\xcc\x43\x8e\xbe\x8d\x23\xc6\xe2\x6f\xe3\x16\xed\x2e\xde\x6f\x01\x45\x3e\x31\x6a\x9a\xa5\x4a\xdf\xd5\xcb\x32\xaa\x9e\x28\xe6\x8c\x9e\xc0\xbe\x32\xc8\x23\x3d\xf8\xb6\x49\x9f\xf6\x73\x84\xd6\xe8\x48\xf7\x0c\x4e\x32\x8a\xc3\xf2\xe4\xae\xd4\x9f\xc8\x27\x19\xa6\xa1\xad\x93\x46\xed\x69\xc7\xa1\xd7\xa4\xf0\x04\x1a\xc1\xba\x05\xe8\x1f\x4b\x71\x78\xba\x65\xe0\x56\xb4\xd0\x79\x01\x28\xfb\xa0\x37\x39\xe6\x8a\xc2\xad\x3e\x5f\x53\x24\x63\xe7\xfb\xa5\xeb\x79\x2b\x9f\x90\xcb\xe0\x02\x07\xe4\xea\x1c\x9f\xaf\xbc\x4b\xd7\xb9\x98\xcf\xe6\xe7\xee\xd5\xb9\x7b\xe5\xf9\xb3\xf9\xea\x5a\xb7\x49\x95\x99\xed\x93\x80\x46\xb4\x31\x78\x14\xfe\x1b\x87\x2c\xb7\x2e\x96\x2c\xa1\x6b\xa8\x0f\xdb\xc4\x2f\xfc\x8d\x71\x1a\x35\x31\x2d\x64\x69\x07\x81\x0c\xc0\xf4\xfb\x77\x3a\x4a\x3a\x4e\xda\x10\x36\x29\x22\xa9\x29\xc6\x23\x13\x99\xc6\xa3\xc0\x7e\x82\xb6\xf0\x82\x95\x33\x9f\x2d\xce\x7c\x1c\x5c\x9c\x2d\xb0\x7f\x75\xb6\x58\x5c\xad\xce\xc8\x85\x1b\x10\x07\xbb\xc1\xb5\x7b\x35\x36\xb3\x70\x20\xfe\xe9\x60\xf3\x62\xc6\xdb\x87\x7f\x94\x2a\xe2\x36\x61\x2b\x32\x84\xae\x91\x84\xa4\xb2\x49\xd8\x0a\xec\x50\x59\xeb\x1b\x02\x6c\xc1\xcf\xb3\x0e\x09\xca\x70\xb2\x26\xb6\x71\x46\xff\x0d\x9e\xa2\x53\xef\xbc\xe4\x61\x20\xb8\x1f\x23\x6f\xaf\xec\xbc\x8e\xdb\x69\xa9\x4f\x8d\x05\xd1\x67\xee\x82\x35\xe3\x6d\x4e\x68\x96\xb6\x88\xa5\x38\xb2\x32\x72\xed\xb3\xdd\x2a\x24\x07\xd8\xe6\x09\x1c\x60\x7f\xcb\x5d\xa2\x31\x08\x51\x3a\xc9\x63\xa9\x1c\xd8\xb3\xb9\x66\xc6\x9d\x69\x4b\xd8\x1e\x17\xb1\x1e\x53\x4a\x6c\xea\xde\x2e\x16\x55\xeb\x6d\xa4\x7f\x6a\x9a\x54\xff\xdc\xe1\x90\x66\xfb\x21\xa6\x55\x49\x4a\x4c\x2c\x65\xd1\xfe\x23\xff\x4d\x9f\x6b\x96\x13\x0b\xde\x7a\x5e\xaf\x5f\x3e\xef\x9f\x27\x64\xb0\xae\x98\x54\xbe\xd4\x81\xac\x71\x99\x6f\x93\xe3\x5d\x76\xe2\x19\xfb\x81\x3d\x46\x21\xc3\x3e\x84\x20\xe4\x77\xc5\x57\x70\x59\x1c\xe2\x10\xf2\x49\x3b\x41\x0e\x44\x1e\x8a\x7d\x7b\xcc\x3d\x84\x79\xaa\xeb\x7c\xec\xcc\x03\xe3\x3a\xb3\xc5\xa8\xa9\xb3\x4f\x23\xad\xcb\x8a\xa8\xc1\x99\x20\x4d\x33\xea\xf5\xf7\xff\xe8\xd4\x4a\xd9\xad\x59\x9e\x69\xf1\xdc\xab\x3b\x21\xac\x62\x38\xa9\x33\x71\x62\xef\xbd\x36\x88\xea\x83\x63\x71\x1c\x72\x3a\xdb\x55\x3f\x28\xd6\x5e\x6f\xb7\x5f\x35\xa9\x00\xdf\x65\xd5\x21\x25\xd8\x57\x46\x7f\x6c\xc4\xda\xdf\x25\xb8\xd1\x0a\x3d\x84\xb4\xc6\x85\x22\x74\x92\xa4\x12\x1a\x68\x1e\x87\x0b\xf3\x02\x29\x34\xf0\x09\x47\xa1\x61\x21\xe8\x35\x14\x0d\xcb\xc7\x96\xe0\x74\x27\x4e\xdb\xe4\x52\x22\x7d\x85\xe0\x3e\xc9\x76\x70\xf0\x66\x1e\x9f\x52\x3f\x9d\x6c\x56\x76\xd5\x9c\xe6\xc1\x6c\x9d\xd5\x4a\x4f\x4e\x31\xb5\xb5\x5e\xfc\x16\x9f\xa4\x0f\x70\x4f\x35\x5d\xa6\xf1\x31\x1d\x28\xdb\x38\xe7\xfe\x9f\x51\x93\x94\x3d\x8d\xb4\x76\x0b\xad\xff\x40\xb3\x7d\xaf\xb8\xb7\xbb\x3c\x8c\xb2\x4e\xef\x3d\x0b\x43\x21\xb6\x0d\xd7\x5e\xc5\x1e\xae\x58\x56\xe0\x3d\x11\x0b\xf7\x2a\x2f\xc1\x1a\xbb\x59\x9d\xda\x4f\xb6\xf0\x73\x6f\xb9\xbc\xa3\x2c\xbc\xe6\x47\x8e\x40\x23\xad\x7a\x6c\x15\x7f\xaa\x96\xb8\xc1\x12\xe9\xa3\x53\x23\x94\xf3\x0b\xa2\x8b\xcf\x20\x70\x44\x7f\x44\xeb\x4e\xee\x32\xc7\x21\xe2\x8d\xee\xf9\xb9\xda\x24\xe7\x56\xa4\x1b\x17\x89\x1a\xc0\xd0\x62\x5b\xd8\x6b\xfa\x83\x25\x64\xb0\x8a\xdb\xea\x91\x29\xe1\x47\xda\x82\xd6\xb4\x05\xba\x28\xf7\x4a\x5c\xd0\x4c\x4c\x24\x25\x49\x65\x3e\xe7\x72\x2e\x20\xcc\xaf\x73\xf0\x68\x5d\x58\x9b\xf2\x64\x26\x3f\xf2\x95\xfc\xc8\x57\xf2\x23\x5f\xc9\x20\xf9\x4a\x54\x42\x63\xae\xd0\xbb\xc2\xaf\x01\x22\xd2\x0d\x65\x0c\xb2\xdc\xf3\x33\x40\x9c\xa2\x15\xc1\x09\x94\x10\x06\xfa\x13\x44\xdb\x32\xe1\x8f\x8d\x5d\x1b\x6f\xb3\xfb\xa5\x2e\xb0\xcb\x6b\xcf\x25\xd3\xe9\x74\xdc\x49\x9b\x89\x8c\x5e\x43\xac\xf1\x55\x52\xa6\x25\x5e\x24\xbb\xb3\x54\x5c\x39\x86\x3d\x07\xa2\x58\xb3\xc5\x88\xa4\xa2\x54\x88\x9a\xec\x2d\x9d\xa0\x35\x89\x20\x11\x01\xf1\x2d\x97\xee\xd5\x25\x71\xcf\xdd\x8b\xd9\x15\x26\xfe\x0c\x5f\xe0\x55\x70\xe9\x9e\x5f\x5d\x39\xd7\x5e\xb0\x08\xe6\x2d\xb7\xd4\xf3\x6a\x0a\x83\xcc\xb6\x72\x82\x9d\x81\x79\x4a\x26\xf2\x86\xd4\x44\x5c\xef\x80\x25\x56\xfd\x7c\x96\xc7\x89\x4d\x44\xe9\xc1\x04\x57\x26\x27\x27\xb2\x4b\x27\x28\xc2\xf9\x93\xbb\x84\x3c\xf7\x34\xad\xf1\x3a\x36\x9d\xd0\x08\x6e\x6d\xe7\xf4\x2e\x09\xcd\x98\x5b\x09\x12\xbf\x11\xce\x12\x04\xff\x7f\x87\x7e\xfb\xf2\xa9\x2a\x42\x3c\x73\x38\x24\xa4\x84\x6b\x3e\x6d\xa1\x3f\x9b\x2c\x8b\xd3\xb7\x6f\xde\x88\xaf\xa6\x1e\xdb\xbe\x29\x8a\x71\xbe\xc9\x0b\x70\x1c\x39\x8d\x07\xb0\x49\x34\x5a\x35\x93\x44\xcc\xe0\x9a\x41\x22\x66\xd5\x6b\x37\x4c\x7a\x59\x09\x17\x2b\x7c\xb9\xba\x72\x9d\xb3\x6b\x1f\xfb\x67\xae\xeb\xbb\x67\x57\xce\x6a\x71\xe6\x38\x9e\xb3\x08\xfc\xc5\xdc\xf1\xda\xce\xe5\x86\xd0\x66\xed\x4a\xec\xd8\x15\xe5\x87\x36\xfb\xf3\x6b\xb3\x53\x69\x20\x7e\xf7\xd6\xdb\x7f\xfc\x0e\x4e\xd8\x75\x9f\xc3\xdf\x3a\x25\x1e\x6f\xc5\xf3\x1a\x81\x80\x60\x51\x8f\xc8\x13\x0f\x5a\x6a\x99\xfc\xbe\xb3\x19\x2e\xcb\x83\x47\xe3\x58\x48\x76\x0e\x0e\x88\x25\xa2\x8d\x35\x8b\x55\xda\xf6\x90\x36\x91\x52\x13\x31\x01\xbc\x07\x8b\x37\xf7\x80\x56\xfa\x05\x8d\xd8\x59\xa8\x63\x4b\xd4\x3e\xe2\x04\x34\x62\xda\x3f\xb3\x42\x03\x25\x25\xb5\x42\x9c\x30\x58\x47\x89\x5f\x06\xf9\x11\xf1\x02\x0f\x1e\x03\x48\x23\xf2\x3d\x83\xac\x15\x6c\x8b\x33\xea\x29\x1a\xcb\x52\x62\x81\x20\xf1\x87\xbe\xad\x5d\xb0\x79\xf0\xb6\xf6\x96\x45\xd9\x26\xec\xdd\x70\x09\x55\xd1\x34\x8d\x10\x46\x9c\xbc\xb9\x75\x40\x6f\x59\x62\x76\xba\xd3\x90\xbb\xa2\x0d\x55\x68\x34\x22\x9d\x8e\x43\x08\x4e\x42\x0a\x1e\xce\xb2\xeb\x4d\x62\x50\x49\x4d\x90\xaf\xd9\x7f\xe1\x2b\x76\xf1\x9a\x19\x9d\x5d\x94\xd3\xf1\x07\x18\x1d\x4d\x7c\xf3\xab\x24\x60\xc6\xc2\xa1\xb0\x68\x06\xed\x49\x4b\x0a\xb4\x47\x42\xee\x4f\x27\x28\x40\xbd\xd2\xf6\x48\x1f\x95\x82\x9b\xf1\xc7\x2d\xa6\x61\xbf\x48\x47\x95\x84\xaa\x25\x09\x7c\x0f\x0b\x90\x8c\x85\xb5\x9d\xc6\xf0\x9e\x19\x9b\x56\xfd\x66\x11\x20\xca\xd9\x1a\x8f\x74\x63\x41\x81\x44\x4b\xfa\x6c\x89\x8a\xb9\x43\xe2\xc2\xc8\xc1\x2e\x99\x2d\x0f\x9e\x24\x40\x5e\x3c\x31\x74\xbd\xde\x0f\x61\x10\x74\xef\x8a\x3e\xc0\x35\x42\x95\x61\x16\xbf\x4a\xf6\x10\x7c\x0d\xd1\xdc\x32\xce\xc0\x72\xd8\xf1\x3a\x21\x5c\xdb\x2f\x69\xf7\x49\x6a\x73\x1d\xab\x6c\x20\x63\x19\x0e\x7b\xb4\x51\x3f\x96\xab\x34\xe4\x6d\x30\x8d\x8e\xe9\xc5\x81\x93\xcb\x92\x81\x2b\xc7\x71\xdc\x93\x07\xed\xf6\xbd\x4e\x20\x6a\x45\xd7\xb9\x68\x5d\x5e\x6e\xf3\xb7\xb4\x35\xa5\x46\x99\x2b\xe1\x17\xee\x20\xf8\xc4\x70\xb6\x4b\x1a\xba\x68\xcd\xc7\x25\x99\xfb\x1d\x1c\x90\x7f\x25\xa4\x47\x60\x90\x78\x5b\x9d\xbe\x60\x78\x95\x19\x23\x51\x40\x48\x6a\x37\x5f\xa5\x01\xcc\x8d\x64\x33\x00\x52\xd4\x8d\x20\xe6\xd2\xda\x83\x80\xba\x8d\xed\x41\xa6\xcd\x74\x6a\x23\x32\xd2\x3f\x15\x64\xc7\x7f\xa5\x24\xf4\x3f\xd6\x12\xbc\x74\x18\xaf\x82\x00\x4a\xb3\x64\xe7\x81\xac\xc1\xe5\x98\x38\x61\xfe\xce\xcb\x83\x45\x44\x21\x4a\x96\xd8\x0d\x1b\x24\x65\x34\x77\x50\x88\xab\x11\x24\xb9\x04\x75\x21\x30\xd2\x3f\x95\x00\xfd\x4c\x70\x98\x6d\xde\x6f\x88\x77\x7f\xbc\x50\x57\x89\x88\x20\x37\x38\x02\xdc\xf0\x1f\x3c\xa0\x6e\xb9\x06\xf1\xd4\x2d\x4b\x48\x58\xd3\x51\x69\x55\x32\xe7\x18\xd1\x8b\x13\x06\xb9\x8f\xcd\xe8\x49\xf9\x32\xaa\x0b\xd7\x59\x2c\xae\x8d\xf4\x77\x71\xdf\x44\x99\xe7\x9b\xf3\xf9\x76\x3e\x9f\x9e\x2f\x9c\xc5\xf5\xdc\xbd\x74\xd3\xb1\xb1\xb5\x07\x92\xa4\xad\x53\xee\x60\x73\x90\x0f\xf5\xa2\xd2\x40\x8b\xac\xdc\xdc\x1e\x2f\x22\x37\xb7\xd5\xdd\xe8\xcd\x2d\x24\x44\xc1\x90\xe9\xca\x52\x32\x68\xdc\xb5\x9f\x1a\x0b\xf1\x6e\x15\x52\x0f\xdd\xdc\x22\xb8\xee\x0f\x52\x60\xc6\xa5\xc8\x15\x6b\x8d\x8d\xb0\xb0\x95\xdc\x57\xd2\x13\xdd\x03\x34\x33\x51\x7d\x01\x29\x36\x45\x6a\x4a\x2c\xd1\x4f\xdb\xa9\x57\xbe\x79\x10\xe9\x8e\xa8\x0c\x74\x31\xb6\x91\x5c\xf5\xc4\x0e\x72\x06\x44\xe4\xb1\xd8\xf1\x74\x2d\x91\x02\x99\x8a\xe2\x4d\x82\x53\x32\x38\x06\xdc\xdb\x96\x0d\x07\x42\x85\x5e\x1d\x05\x99\x5b\xb0\x40\x22\x21\x5b\x02\x0e\x43\x1a\xad\x2d\xc1\xb0\xb0\x5f\x95\x1f\x9f\x26\xcf\x03\xe4\xc7\xef\x90\xb4\x65\x30\x1c\x2b\xe4\x60\x69\x97\xc1\x9b\xb0\x95\x2f\xc0\x23\xfc\x29\x44\x22\x3f\x66\xd4\xda\x1f\xb6\xda\x45\x7e\xd8\x02\x80\xdd\x15\xf7\x9c\x43\xb4\xc2\xde\xfd\x2e\x46\x39\x4d\x71\x80\x25\xd9\x03\x27\x3c\x41\x34\x4a\x33\x82\x7d\xd8\xf1\x63\x14\x87\x98\x46\xe8\x9e\xb4\xb8\xcb\x84\x88\x2c\x3b\x8c\x55\x3b\xa7\x42\x26\x4b\xbe\x14\xca\x46\x2e\x06\x6b\xfd\xb6\x20\x04\x61\x6e\x2c\x01\xbf\xcc\x3d\xd9\x43\x72\x11\x01\x1a\xcf\x10\xea\x25\x7b\x7e\xdd\x19\x10\x3c\x56\xf6\x86\xd2\xec\x55\x7a\xaa\x52\x2f\x7a\x20\xb1\xb4\x14\x39\x58\x51\xbb\xe2\x58\xee\x7a\x21\xe1\xd7\x01\x94\xff\x41\xf6\x50\xbc\x8f\x20\xa8\xf7\x05\xd8\x96\x88\x56\x24\x74\x52\xb9\x58\x5e\x48\x04\xdd\x56\x26\x52\xd7\x01\xf8\x99\xef\x55\xfa\x03\x5f\xd0\x11\xd6\x6a\xc1\x9f\x38\xba\xcb\x7d\xe2\x6a\x78\xbe\x98\x72\xc5\xed\xbd\x9c\x82\xe5\xa8\x60\x08\x6f\xed\xad\x08\x1e\x37\x04\xf2\xc1\xa2\x9f\xc5\xed\xfe\x54\x2a\xf9\x70\x2f\xef\xf4\xd3\x48\x24\x7b\xe7\x3b\xb2\xb6\xb9\x9f\x77\xed\x08\x1f\x85\xc6\x54\x61\x79\x48\xb0\x38\x4c\xd9\x86\xa6\x82\xcf\xaa\xd3\x58\xe2\xbc\xc9\x53\xda\x8a\x97\xcc\x7c\x4a\xc7\x6a\x67\x93\x5d\x63\x33\xa4\x01\x01\xdb\xbc\xe1\xf4\x43\x84\xbb\xab\x3c\x1f\xe6\x67\x79\x42\x7f\x76\x03\x43\xc7\xf8\xb8\x03\x42\xfa\xf2\x26\x1a\x0f\x08\x97\xac\xcd\x2e\xf2\x13\xe2\x67\x1b\x71\x8f\x35\x26\x09\x94\x73\x36\xdb\xd1\xb3\x36\x27\xdd\x2b\x73\x92\xf5\x1e\x47\xee\xd3\x44\x22\x49\x75\x71\xc5\x4b\x52\x87\x4b\x9c\x31\xde\x37\x8d\xad\x99\xb5\x81\x24\x4c\xf0\x84\x43\x88\x25\xdc\x17\xc2\x43\xa3\x8c\x95\xd3\xe1\xe0\x4c\x4c\xed\xdc\x4c\x96\xc2\x2e\x9a\xcb\x0f\x6f\x2b\x8d\x5a\xaf\x02\x50\x3c\x6d\xa8\x95\x40\xd0\xe2\x57\x29\x94\xd5\x00\x7b\x3c\xb5\xb5\xcc\x8b\xdc\x49\xe7\x1f\x72\xab\x35\x46\x98\x18\x03\x3b\x5a\x9d\x1f\xd5\xbe\x68\x1e\x10\x15\x52\x3b\x78\x6f\xb6\x8a\x49\xdc\x13\xde\x9b\xad\x8d\x79\xad\x59\x05\x96\x08\x1f\x61\xbf\x2a\x3f\x3e\x4d\x5e\xa5\xdd\x34\x45\x37\x9a\x55\x0f\x5b\x12\xfe\xaa\x28\xae\x91\x1b\xb3\x29\xc2\x50\x87\x3f\x0c\xab\x9e\x6b\x95\x89\x22\x83\x6f\x5f\x70\x52\x92\x2d\xcb\x24\x32\xbd\x6c\x98\x5f\x45\x54\xeb\x14\x7d\xc8\x09\xaa\xf9\x6d\x7e\xfd\x87\xb5\x1b\x5e\x82\xf4\x85\x04\xfd\x75\x40\x4e\x44\x35\xbf\x77\x11\xfd\x63\x47\x4a\x01\x4d\x48\x40\x20\xc8\xc7\xb6\xca\x44\x6f\xb3\xaa\x9c\x1a\x11\xfa\x08\x56\x1f\xd9\x6d\xa5\x43\x07\x09\xc1\x1b\x7c\x5d\x1c\x35\x0c\xbf\xf1\x18\xb7\x3d\x8b\x4f\x09\x2d\x58\xa2\x24\x11\x1a\x60\x88\xb1\xaa\x10\x54\x07\x2d\xdb\x54\x46\xac\x3c\x94\x00\xaf\x48\x82\x68\x04\x77\xc7\x95\x42\xe3\x56\x23\xd9\xc5\x2d\xd6\x3e\xa4\xef\xb1\xb7\x21\x79\xf9\x87\x72\x1c\xd1\xad\x58\x11\x2b\x03\xa1\x12\x1a\x73\xd9\x4b\x70\xb8\x1c\x22\xbc\x9f\x57\x30\x7d\x8b\x24\xcd\x22\xa4\x1f\xee\xd7\xf3\x78\xff\x84\x9b\xe6\x2c\x22\x66\x86\x06\xb1\x04\xf8\xa9\x55\x91\x67\x6c\xb5\x2f\x80\x98\x20\xa1\x6a\x50\xf5\x36\xcb\x48\xff\x64\x12\xb6\x7c\xcc\x87\xdb\xa4\x37\x51\x55\xc5\xae\x22\x69\xa2\xf2\x16\xec\x1e\x23\x42\x7c\x51\xcd\x81\x8f\x74\x22\x24\x17\xac\x09\x16\x88\x98\x40\x29\xaf\x76\xc2\x28\x49\x10\xbf\xaf\x1e\xfe\x22\xc2\xa3\x21\xd1\x21\xdc\x1c\x28\xe6\x0d\x95\x1d\x82\x56\x40\xfb\xc8\x8b\x2f\x28\xdd\x42\xbe\x41\x28\x24\x9f\x40\x45\xa9\x36\xf1\xb0\xc9\xe3\xd3\x71\x6c\x7f\x8b\x42\xe6\xdd\x0f\xa6\x46\x2a\xe4\xea\xde\xd3\x02\x8e\x1d\x7f\xce\xde\x67\x3a\xc4\x72\x0b\xfb\x54\x76\xf2\xbc\x1b\x77\xa2\x16\x50\xd1\xd5\x34\xc3\x7b\x58\xf9\xa0\xc3\x50\xc8\x8b\x25\x13\xe3\x8f\x60\x14\x87\x48\xfc\x83\x7c\x87\x2a\xf0\x34\x0b\xf7\x20\x49\x62\x7f\x6a\x5e\x9d\xa0\x9a\x90\xed\xe0\x47\x0f\x8c\x7a\x7d\xe6\x70\x41\x40\x9d\xb1\x34\xff\xb6\x08\x94\x91\xbb\x92\x1f\x81\x32\xcf\x1d\x28\x83\xd3\x0d\x08\xd1\xc1\xb9\x62\xa4\x3f\x3e\x9f\x5d\x05\x97\xf8\x72\xbe\x72\x48\x70\xb9\xba\xf6\xdd\xcb\xd5\xd5\xca\x0d\xbc\xf3\x15\xbe\x5a\xad\x5c\xec\x2f\x82\xab\x95\xbb\x9a\x07\x0e\xb9\x20\xde\x95\x83\x67\xc1\xdc\x5f\x78\x8e\xef\xfa\xe7\xe4\x1c\x5f\x8c\x8d\xcc\x49\xa9\x78\x61\xff\x44\x19\x1b\xb2\x3c\xc6\xa3\x53\x72\x63\x3b\xe9\x60\x2f\x2c\xd4\x24\x25\xa9\x5c\xf1\x2a\x0d\xdb\x4f\xc0\x66\x62\xc2\xeb\x2a\x13\x17\x08\x2d\x63\x5f\xaa\xbb\x7c\xc1\x8c\xc7\x09\xf6\xd7\x62\xb3\x51\x79\xf7\xa9\x03\xaa\x32\x11\xe2\x10\xa0\xd6\x68\x69\x98\xca\xb4\x8e\xb6\x90\x16\xcf\x3f\x0b\xa2\x92\xfb\xe3\xe1\x14\x59\x17\x8f\x5f\x1a\x14\x0a\xd5\xc0\x04\x99\xd0\xb1\x6b\x84\x42\x9e\xde\xd1\x80\x9d\x61\x76\x6a\x3c\xbd\xdb\x65\x2c\x62\x5b\x06\x75\xae\xe0\x5e\xcb\x16\x35\x5f\xf2\x28\x27\xf5\xc5\xcc\xbd\x34\xc7\xa3\x78\x8d\x59\x79\xda\xd5\x97\xc6\xd2\x67\x28\x47\xf1\xbe\x3d\x91\xee\xbf\x68\x18\xd1\xea\x25\x20\x8d\x0f\x16\x65\x34\x6a\x8d\xec\xb2\x62\xe6\x7d\x41\xc7\xcc\xcc\xc7\xdf\xda\xf8\x90\x59\x36\xfb\x43\x22\x48\x99\x19\xf9\xd4\x52\x62\xba\x77\x34\x8b\x5d\x18\xcb\x74\x36\x9d\x4f\x5b\x4a\x1d\xd3\xb4\x37\x1f\x51\x46\x92\x88\x64\xe8\x4e\x64\x2f\x96\xdb\x38\x9e\x15\xa5\x85\xb7\xaf\x24\xa4\x18\x7d\xa2\x24\xdb\x3d\xe0\x09\x7a\xf7\x93\x99\xcb\xbc\x5c\x4b\xff\x04\xcb\xbf\x81\x17\x00\x50\x46\xff\xf1\xe1\xe3\xed\x97\x8f\xef\xdf\x7d\xfd\xf8\xe1\x3f\x5b\x78\x4c\x48\x9a\xaf\x34\xb8\xc5\x2f\x0e\x7b\xe6\x81\xb9\x03\x67\xe4\x12\x8e\x56\x48\x32\x41\x0a\x13\x13\x44\x32\x6f\x7a\x0c\xc7\x23\xfd\x53\xd1\x87\xf1\x27\xb6\xfe\x44\x1e\x48\xd8\x23\xaa\x55\x25\xa1\xda\xd9\xeb\x90\xad\x30\x6c\x13\xd6\x28\x84\xdf\xf9\xa1\x2a\xdc\xba\x65\x0f\x24\x49\xa8\x0f\x47\x4b\x2c\x41\xe5\xfa\x60\xa9\x69\xcb\x17\xcc\x98\xd7\x98\x36\x30\x9e\x73\xc6\x4f\xb4\x4a\xb2\xe8\x3f\xa0\x3e\x0e\x5c\x3e\x82\x5a\xf0\xe9\x04\x91\xe9\x7a\x8a\xbe\xc9\x2c\xb9\x6f\x62\x1a\xad\x63\x16\xad\xbf\x8d\xff\x73\x22\x3b\x03\xdb\x7a\xf0\xa1\xc8\x4e\x03\x59\xde\xbd\x6c\x43\xb6\xf2\x8a\x15\x4d\x50\xba\x5b\x35\x77\xf8\xa8\x9a\xec\x42\xb4\x2a\x3f\x3f\x19\xe5\xa3\x46\x46\xef\x10\x80\x92\x25\xb8\x5a\xc7\x56\x95\x1f\x8d\xfa\x98\xa3\x67\x1e\x05\x2b\xc9\xff\x9b\x26\x26\xd3\xb1\xb1\x03\x63\x1e\x1a\x3a\x6a\xe2\xec\x69\xa4\xf1\x57\x4a\xb6\xd8\xe6\x57\xd8\x34\x0b\x4a\x83\x90\x54\xc8\x98\x4a\xec\x15\xfc\xbf\x1e\x31\xce\x58\x31\xd5\xe4\x31\x3c\xe7\x50\x54\xeb\xce\x5d\xa6\xf2\x89\x97\x96\xc5\x78\x06\x8b\x91\x9e\xbd\xfe\xb5\x0a\xe9\x04\x41\xc9\x63\xb4\x8b\x44\xdd\xa8\xa2\x54\xc2\x74\x6c\xec\x72\x27\xe9\xfd\xe5\x97\xcf\xef\x62\xfa\x0f\xb2\xef\x27\xbd\x3a\x99\x9a\xf4\x6e\x71\x04\x8a\xee\x97\x5f\x3e\xff\x25\xe5\x89\x9b\xee\x89\x6d\xb0\x11\x8e\xe9\x12\x22\xcc\x0e\x21\x6b\xdb\x63\x16\x91\xfd\xb1\xdd\x84\x77\xc5\x16\xa8\x2c\xad\xec\xed\xf3\x15\x15\xb4\xaf\xb8\xf3\x6b\xd7\xb1\xbe\xf7\x83\x87\xbe\x5b\xfd\xf9\xdd\xd7\x3c\x03\xe0\xf1\x8b\xb4\x4a\x82\x3b\x9f\xc1\x0f\x96\xfb\x71\x20\x69\x60\x5e\x1f\xf6\xf3\xbb\xaf\x50\xb3\x12\x22\xcb\x21\xf3\xcc\xce\x83\x70\x79\x38\xad\xac\x27\x22\x68\x81\x6f\x80\x92\xba\xc3\x39\x90\x7f\x4d\xfc\xe2\xb4\xa8\x42\xd3\x1e\x3a\x95\x84\x90\x31\x06\x5f\x15\x73\x49\xd4\x8d\xb0\x43\x27\xa4\xeb\x4d\x06\x17\xaf\x97\x11\xc9\x1e\x59\x72\xdf\xd9\x97\x5f\x6a\x94\x00\x87\x69\xa5\x50\x91\xfa\xe4\x18\xb2\xb3\x2c\x07\x4a\x23\x60\x64\x61\x3e\x75\xcd\x69\x38\x63\xbc\x5f\x5a\xcf\x05\x63\x13\xe3\x8f\xbf\x7d\xe9\x38\xdc\xbd\xfc\x2b\x15\x1a\xfd\x07\xfc\x94\xfe\x55\xb3\x6f\x55\xf8\xc8\xf6\xcf\xe1\xbe\x9c\x8d\x5f\x56\x06\xdd\xa9\x19\x07\x10\xc1\x97\x6e\x7f\x80\x29\xf0\xd3\xd7\xf7\x66\x90\xc5\x19\xdd\x52\xba\x04\x5e\x76\xc8\x25\x37\xfd\x93\xbd\xbc\x7d\xf3\xc6\x63\x34\x5a\xe3\x2c\x4f\xf6\x22\x0e\x6e\xde\x2c\xae\x17\xd7\x5e\xe0\xe0\xb3\xc0\xf3\x56\x67\x0b\xcf\x9b\x9d\x5d\xcf\x17\xb3\xb3\x4b\x1c\xb8\x57\xd7\x8e\xe7\x5d\x5c\xb4\x78\x3d\xe2\x04\x2a\x36\xbd\xa8\x4c\x70\x0e\x4e\x2d\x15\x22\xd4\xea\x45\x7b\x2a\x79\x38\x75\x5f\x2d\x6d\x06\x33\xf5\x98\x44\x7e\x07\xab\xe2\x36\x97\xf1\x9b\xc8\xe3\x7a\xf6\x78\xb3\xac\x4e\x48\xf1\x46\x63\x59\x20\x55\x4c\x29\xb4\x22\xd9\x23\x21\x51\x19\x5f\x0a\x96\x6d\xd7\xe3\x4b\x2f\xdb\xf5\x3a\x54\xbc\x3e\x70\xa8\x28\x78\x6b\x3d\x57\x1c\x4e\x11\xb5\x1c\x9d\x0d\x94\xa7\xad\x56\xdc\x63\x7a\xed\x3a\xce\xfc\xbc\x25\x5d\x9b\x4f\x93\x3c\x25\x58\xd7\x86\x75\xe9\xc8\x87\x96\x27\x77\xa4\x42\x44\x78\xf9\x8b\x84\x78\x60\x82\x56\xaa\xf6\x4d\xd0\xfb\x1c\x7a\xfe\xb8\x92\x00\xa2\xa5\x77\xf2\x0d\x73\x57\xec\x8c\x79\x73\x0b\x92\x3b\x88\xf5\x01\x57\x83\xb7\xc1\xc9\x5a\x9f\x69\xd5\x16\x45\x42\x8b\xa5\xf1\xb2\x73\xeb\xd9\x52\x43\xda\x8d\x96\xa6\x62\x9e\xc0\xa5\xc7\x6c\x38\x70\xc4\xfe\x5a\xc2\xdc\x69\x9f\x3e\x9a\xf5\xbb\x58\x91\x3b\x0e\x91\x12\xd7\x61\xa4\x7c\x4f\x23\x7f\x00\xb1\x5b\x16\x32\x47\xcc\x6d\xb1\x20\x20\xd1\x11\x47\xfd\xda\x6c\x95\x26\xb7\x2c\xce\xce\xab\x57\xe7\x37\x37\x3c\xcc\xe3\xdc\xe0\x5b\x39\x8f\x4f\x3c\xe0\x05\x00\x2f\x2e\x7a\xc2\x7f\xf7\xcc\xb5\xe5\xec\x17\xef\x7e\x17\x09\x9a\x89\x89\x5d\xa3\x5c\xb7\xe5\x98\xdb\xba\x86\x8b\xe7\xcd\x88\x0d\x79\x1a\x5f\xed\x82\x36\x2d\x55\x2c\xad\x70\xfd\x44\xfc\x35\x49\x7a\x23\x5a\x90\x11\x58\x16\x36\x8f\x04\x35\xe4\x0f\x20\x51\xb2\xe2\x15\x16\xb8\xa8\x74\xe4\x23\x1c\x17\x0f\x03\x6d\x41\x6a\x00\x7c\x25\x2d\x01\xb2\xd0\xe7\xe5\x35\x0d\xc5\x8c\xe0\x87\x58\xf5\x6c\x53\x74\x1d\x95\xf7\x9b\x68\xf6\x23\x88\xee\x99\x83\xe8\xe4\xf0\xf4\xd3\xaf\x43\x28\xfa\x01\xc2\xf9\x9c\xef\xa7\x0b\xe8\xfb\x93\x9b\x62\x62\x42\x2e\x87\x48\x8a\xe5\x7c\x6f\x4a\x8b\xd5\x74\x6f\xb2\xde\xc8\x10\xc9\xc5\xb6\x34\x1d\xec\xde\x23\xd8\x5c\x35\xad\x85\x7d\xd8\x3c\x65\x4c\x66\x4f\x91\x97\x34\x21\xdc\xd6\x08\x8b\xdb\xae\x08\x5e\x8f\xc5\x25\x77\x87\x2f\xb0\xf3\x7d\x19\x63\xef\x65\x23\x66\x2b\x0b\xea\x6d\xc2\x56\x21\xd9\x0e\xb4\x3c\x97\xd4\x14\xb7\x90\x62\xf8\xc0\xbd\x7a\xd8\x5f\x08\x3f\xd1\x03\x49\x8a\xbc\xea\x1d\x0c\xa2\xfd\x92\x76\x9f\x6d\x25\x4e\x73\xe3\x50\x24\x04\xa7\xbd\x32\x54\x89\xa9\x89\xe4\xd2\x82\x7c\x46\x20\x73\x40\x86\xb6\x38\xf3\x36\x85\xbd\x52\x2c\x3d\x46\x56\x5e\xeb\x2e\x24\x37\xe9\xbe\x10\xb8\x01\x3b\x90\xd0\x14\xc4\xc0\xe7\x02\x0a\x2e\x2f\x3f\x0d\xdb\x52\xcd\x76\x3e\x52\x5e\xea\x5f\x1f\x16\x17\x8d\xe1\xdc\xbd\x0e\x2c\xe5\x3c\xc0\x3d\x12\x41\xd9\x08\xbf\x6b\xae\x74\x2c\xe4\xa0\x8f\x14\xbb\xb3\x83\xea\x1d\x26\xf6\x0b\x6c\x1d\x14\x95\x52\x21\xf2\xd4\xc6\xeb\x71\x4b\xa8\x3d\x1c\x3c\xed\x61\x8f\xa3\x6b\xb8\x79\x36\x6a\xea\xcb\xd3\x48\x6b\x4e\x0a\xf7\x2f\x24\xdb\x30\xbf\xf7\x1c\x29\xc8\x88\x2d\x8f\x9c\x12\x5b\xfe\xbd\x3c\xda\xb5\x9c\x0d\xfc\x2c\xa9\xa3\x0d\xc4\x63\x55\xcc\xcb\x17\x54\xf3\xe9\x48\x51\xf4\xec\x0b\xce\xda\x2d\x2b\x01\x95\x46\x5a\xd3\x7e\xdd\x06\x85\x9f\x95\xe7\xb7\xba\xd3\x7e\xe3\xa2\x52\x52\xa3\x48\x63\x1a\x3e\xec\x33\x5a\x0e\x14\x38\xbe\x13\xc4\x44\x9b\x56\xc3\xb4\xa5\x11\xdd\xee\xb6\xe6\xbe\x5b\x9e\x81\x19\x91\x4d\x77\xeb\x35\x49\x5b\x3d\xc9\xdd\xb4\x43\x2b\x63\x07\x58\x53\x07\xce\x6a\x10\xa5\xe0\xa8\x7c\x74\x1e\x3f\x41\x44\x9b\x55\x01\x8f\x91\x89\x3c\x7b\x0f\x0d\x94\x0f\x80\x84\x0a\x75\x58\x0e\x29\xaf\x12\x93\xfc\x56\x9a\x71\xb4\xa0\x09\x59\xf0\x78\xc8\x46\x46\xfa\xa7\x0a\xd2\x6c\x97\xc9\x0c\xa5\x6a\xa3\x9d\x80\x2e\x69\x54\xa6\x08\xde\xab\xf7\xca\x8f\x48\xf9\x9e\x6d\x8e\x0d\x1e\xd0\x78\x3c\x6d\xbe\x82\x6a\x01\xc2\x51\xc3\xe8\x9a\xd3\xce\x2b\x5d\x6c\x49\x5c\x50\x62\xdc\x33\x88\xa8\x4e\xa8\x61\xc4\x20\x02\xef\x59\x32\xf4\x4f\x4c\x50\x1c\x4d\xa4\x48\x4b\xd0\x39\xa1\xf1\x48\x1f\x40\x05\xfd\xd2\x3d\xa0\x52\xec\x80\x7a\x41\x40\x43\x9b\x2b\x23\xb9\x9b\xc8\x07\xcf\x12\xec\x43\xe1\x13\x06\x3d\xd1\xc5\x7f\x78\x64\x2a\xb6\xca\x0c\x9a\x3b\x2b\xef\x1c\x5f\x91\xc5\xdc\x9b\xe3\xf3\x20\x70\x56\xf8\x7c\x75\x15\xb8\xfe\x82\x5c\x06\x4e\x10\xf8\x97\x9e\xe7\x12\x77\xb5\x72\x56\x0b\xff\xfc\x6a\x75\x89\xe7\x2b\xff\xda\xbf\xc2\xd7\xc4\x25\xc1\xd5\xca\x3c\xd2\x03\x6e\xa2\x2b\x74\x8b\x3d\xdb\xeb\x74\x17\x9e\xce\x8f\x36\xd2\x3f\x15\x0d\x8f\xd5\x8b\x91\x6a\xb3\x9d\x66\x80\xa4\x20\x96\x62\x51\xaa\x0b\xc9\x2b\x9e\x1d\x4d\x5c\x99\xc7\x71\x79\x54\x46\xab\xe6\x8c\x72\xd2\x55\x26\x92\x44\x16\x33\xb4\x48\x7e\x37\x41\x50\x2b\x52\x3c\x4d\xd3\x32\x99\x24\x4f\x23\xc8\x03\xed\x3b\x9b\x52\x62\xb4\x2a\x3f\x3f\x19\xc7\x4e\x5d\x3c\xe0\xef\x48\xc7\x5c\xb9\xc6\xd4\x9a\x1b\x43\xa7\xd2\x74\x19\x33\x48\x9f\xd0\x17\xd9\x77\x9c\xd8\xad\xa4\x65\x0b\x4e\xeb\xbe\x62\x8b\x93\x7b\x92\x29\x94\x2b\xdb\x16\x55\x92\xf5\xae\x51\xbf\xb3\xca\xd0\xfa\x03\x09\xd7\x0a\x31\x11\xf9\x98\x52\x92\x50\x1c\x8a\x4b\xb1\xb0\x6e\x4a\xd1\x56\xde\x95\x2f\xb5\x54\x41\x3d\x37\xb2\x2d\xc4\x70\x99\xef\x05\x4f\x57\xc2\x49\xdf\x8a\x56\x61\xed\x58\xbd\xa9\x42\x6c\x6c\xee\x9b\x00\xf3\x88\x85\x46\x6b\xb0\x18\x96\xc7\x0d\x43\x10\x3b\x91\x14\x4a\x66\x08\x23\xaf\xc5\xb1\xfd\xc7\x0e\x87\x8d\x31\xcd\x43\x0d\xcc\x3f\xf3\x06\x7e\x21\x59\x42\xbd\xb4\xef\xc8\x08\x6a\x32\x1e\x44\x62\x64\xec\x9e\x78\x00\x92\xad\xd1\x88\x0a\x2a\x27\xea\xa9\xb8\xb0\xfb\xa1\x68\xaa\x6f\x67\xf3\xb1\xc1\x90\xe9\x56\xf6\x14\x29\x1d\x39\xd8\x69\x21\x82\x7d\x04\x13\x48\xa8\x6a\xa1\x10\xd4\x5c\x48\x5b\x84\x93\xc5\x24\x7a\x88\xdb\xb8\x8c\x09\xf1\x97\x99\x7e\x89\x64\xd8\x21\x81\x36\xbe\x92\x34\xfb\xc2\xfd\xb5\x7d\x07\xe4\x13\x06\x76\x21\x4b\x0f\xdc\xe5\x01\xda\x88\x7f\x51\x7a\x83\x4d\x42\x39\xd2\x3f\xd5\x0d\x14\x21\xda\x15\x30\xba\x1b\x29\x72\x82\xe4\x86\x8a\x98\xdd\x90\x78\x01\x66\x1f\x82\x25\x40\xb0\x67\x69\xab\x6c\x59\x44\x33\x06\xcb\xfc\x52\x14\x2e\x35\x0a\x94\x74\x50\x1a\x47\xbc\x8b\xbe\x34\x12\x31\x2a\xac\x56\xff\x4e\xab\x77\xa7\xda\x80\xc0\xc7\xee\x06\xfa\x71\x63\xdc\x77\x23\xdc\x4c\x4d\x8c\x39\x0e\xc3\xc2\x30\xad\x49\x80\xe5\xa8\x1f\x04\xb9\xd1\x7e\x3a\xce\x24\xd2\x7a\xa3\x42\x5a\x42\x69\x0f\x30\x49\xf6\x37\x59\xaf\xd3\xc2\x06\x52\x02\x5a\xdd\xde\x9f\x20\x16\x85\x7b\x14\x40\x4d\xa3\x54\x5e\x65\xca\x93\xef\xfd\x01\x6f\xf3\xf4\xd2\x62\xcf\x6c\x89\xbc\xcc\x95\xa2\x7d\x7f\x50\xd1\xe5\x42\xab\x26\x71\x51\xde\xee\x66\x92\x1d\x6d\x67\x69\xcd\x80\x23\x7f\x09\x8e\xc1\x35\x5d\x9d\x4e\xc5\xd7\x7c\xff\x9d\xb5\x3a\x3f\x71\x00\xe5\x8d\xd1\xdf\xe8\x4f\xf0\x21\x4b\x70\x10\x50\x6f\x82\xfe\x2f\x49\x58\xbe\x41\x12\x5f\xc1\xf9\x1f\x9c\x95\xe6\xf1\xaf\x2d\x41\xde\x65\xef\xb7\x34\xda\x65\xe4\x7f\x09\x00\x39\xb3\xca\x8a\x5f\xc1\x00\xd2\xbd\xdb\x03\xf0\x27\x36\x8e\x5b\x07\xa4\xd5\xe2\xad\x36\xd0\x69\xad\x69\x47\xe9\x78\x4b\xed\x91\x26\x64\xbd\xc3\x89\xff\xc3\x56\xb3\xb7\xd5\x48\x59\x3a\xaa\x02\xc9\x91\xeb\x8c\xbe\x86\xa3\x18\x52\x08\xb0\x80\xaf\x23\x94\xf8\x9d\xb3\x8f\x35\xaf\xc5\xa7\x5d\xbf\x95\xa5\xb7\x42\xe1\xc9\x28\x57\xbc\xc2\xb3\xb7\x4b\xd2\xee\x17\x62\x34\x34\x73\x22\x72\x58\x81\x2e\x87\x70\x82\xd8\x96\x66\xe0\xe6\x62\x11\x8f\x4c\x0b\x31\x14\x65\x86\x3b\x30\xe6\x09\x41\xf6\x7f\xdf\xfc\x1e\x7d\x09\x3d\x7a\x73\x41\xf6\x7f\x7f\xf4\xb6\xd7\x33\xfc\xef\x2f\xa1\x17\x5e\xc7\xbf\xbf\xbf\xb9\xb8\xf9\xef\x9f\x16\xbf\xdc\xdd\xa4\x37\xd1\xe7\xd0\x8b\x7e\x8f\xff\x6b\xf6\xaf\xc0\xff\x39\x7c\xfc\xfd\xee\xe6\xe2\x26\xf2\x63\x6f\xfb\xaf\xc8\xff\xf7\x5f\xf7\xbf\xbf\xff\xfb\x75\xf0\xcf\x8a\x6c\xa9\x8d\x8e\x79\x70\xdc\xf2\xc0\x48\x75\x0d\xfe\x28\x04\x25\x8f\xe7\x91\x49\x74\x40\x8e\xf6\xe6\x3e\xcf\x16\x15\xaf\xf2\x48\x1f\xba\x72\x0a\xd4\xb5\x9b\xca\xba\xbd\xf8\xd7\xe8\xe8\x36\x96\x66\xb9\xe6\xa9\xda\xc5\x5b\xe8\xd7\x04\x7b\xe1\x4b\xec\x61\x8c\x4b\x42\x8f\xed\x47\x0b\xda\x5f\xc4\x99\x50\xbf\x63\x4e\x8d\x8a\x7a\x90\x53\xe4\xad\x86\x43\xa7\x23\x0e\xce\xba\x1d\x5a\x19\xe5\x6f\xfc\xee\xa7\xf7\xee\x6c\x6e\x80\xc5\xe2\x30\xb2\xca\xc7\x48\x77\x15\xab\x88\x42\x28\xd7\x4d\x9a\xee\x48\x9f\x3a\xa6\x3a\x19\x44\x81\x22\x20\xcb\xab\x31\xc1\x57\x76\x00\xf2\xdb\x80\xa9\x19\xb9\x6e\x9a\xda\xc8\xff\x21\x3e\xaa\x21\xcf\x8d\x3f\xd6\x87\xb3\xf6\xc8\xd3\xa8\xed\xdf\x4f\xa3\xa6\xcf\x4f\x6d\x43\xd4\x2f\xcd\x48\x9d\x50\x9e\xad\x9e\x1f\xa0\x57\x46\xcc\x6e\xac\xaa\xe4\xff\x67\x64\x89\xcd\xd3\xa4\xdf\x51\xf3\x48\xff\xd4\x08\xd5\x5d\x9e\x5b\xa6\x42\xf9\x28\xa8\x04\x21\x99\xac\x26\xd8\x85\x47\x40\xc5\xdf\xe8\xea\xe8\x19\xe9\x9f\xca\x8e\x0a\xe7\xaa\x76\x26\x73\x5c\x5f\x1b\x69\xa9\x4a\x11\x16\xcc\xfc\x28\x09\xae\xea\x64\x09\x0b\x73\x89\x91\xb6\x75\x9a\x61\x6b\x1c\xfc\xc1\xe7\x76\x1d\x38\x15\x3a\x2b\x18\xab\x3e\x6a\xb5\xad\xce\x28\x56\x48\x89\x35\x3c\x8d\x89\x07\x51\xd3\x05\x60\xdd\xce\x47\xa5\x0f\x64\xc9\x12\xba\xa6\x51\xf7\xe8\xbd\x03\xde\x90\xc3\xf8\xf4\x5b\x6e\xab\x44\x2a\xab\xed\x2e\x82\x3c\x45\x05\x2e\x4a\xbe\x28\x4b\x6c\x3c\x16\x45\xf9\x45\xf8\x25\x64\x68\xa0\x69\x46\xbd\xf4\x38\x78\xee\x8a\xf7\x75\x80\xac\x0f\x1e\x85\x28\x9a\x97\xf5\x8b\x15\xbe\x5c\x5d\xb9\xce\xd9\xb5\x8f\xfd\x33\xd7\xf5\xdd\xb3\x2b\x67\xb5\x38\x73\x1c\xcf\x59\x04\xfe\x62\xee\x78\x2d\xf7\x0e\x64\x44\x62\xad\x7d\x0d\x70\xf1\x5c\x5e\x50\x30\x0f\xdf\x97\x08\xc3\xd5\x7e\x3e\x5d\x89\x3f\x45\x1f\x1f\xc0\x29\x27\x7f\x82\xb2\x1c\x58\x16\xa6\x91\x59\x9e\x71\x18\xb2\x47\xd8\x2e\xe4\x24\xa7\xe6\xbe\x55\x59\x82\x41\x03\xe5\xf8\x16\xb9\xee\x6c\x5e\x7d\x09\x7e\x4b\x58\xc6\x3c\x06\x7a\x7f\xbc\xf3\x63\xb5\xcb\xaa\x40\xea\x00\x18\x82\x61\x07\xdb\x72\xe7\x48\xdc\x26\xf5\xab\xe3\x9d\xf7\xdb\x12\x55\xce\x32\x94\xb9\x08\x28\xce\x8a\xac\x71\x95\x9a\x85\xf2\x51\x88\x40\x85\xa7\x7d\x78\xfc\x97\xff\xba\x6b\x49\xef\x2b\x77\x05\x1d\x25\x5d\xee\x4d\x5b\x05\xfc\x04\x9e\x2a\x69\x44\x0f\xe1\x9f\x9a\x9d\x30\x47\xcb\x97\x5c\x1f\x99\x5b\x10\x94\xfa\x80\x22\x47\x1b\x48\x4d\xd1\x2d\x4b\x53\xba\x0a\x89\x5a\xa5\xea\x9b\x3c\x2c\xfc\x36\x9e\xa0\x6f\xa5\x3f\xea\xdb\x98\x5f\x90\xfd\x36\x8e\x18\x8b\xbf\x8d\x5b\xfa\xd1\x74\xd8\x38\xd2\x3f\xd5\xb4\x3c\x5c\x53\x6f\x77\xe2\xe8\x0b\xb4\xde\xb7\x06\x42\xaa\xba\xc7\x85\x5a\xd1\x14\x7f\x2a\xdd\x11\x90\x89\xb9\xa2\xf9\x9b\x56\x7f\xab\x49\x2c\x97\x2c\x8b\x6e\x2b\xcb\x62\xaf\x05\x4e\x59\x5f\xe5\xc2\x2f\xc6\xfa\x75\x64\x7c\xbf\xcb\x33\xbe\x7f\x6e\xdc\x91\xdb\x5c\x00\x3d\x26\xdb\xbb\x22\x95\xef\xb6\x50\x5a\xc8\xc7\xdb\xb1\xb9\x85\x63\xf3\xb8\x9f\x36\x3f\xbb\x42\xfd\xf3\xa7\x41\xb3\x9d\x3f\x73\xc2\xf2\x7e\x99\xbc\x85\x9c\x57\xc3\x81\x54\x0e\x3a\x4f\x99\x0a\xa9\x32\x8a\xb6\x28\xa1\x56\xdf\x66\x58\xce\x1c\xbe\xec\x2e\xd7\x2b\x33\x40\x72\xfa\x18\x41\xce\x49\x98\x8e\xab\xda\xc8\x8c\xf4\x4f\x75\x08\xa5\x89\xa1\xd2\xed\x8e\x9e\xa0\xa2\xea\x58\x09\x16\xe7\x1e\x4a\xb1\xd6\x6c\x0f\x94\xb1\x35\x81\x08\x7a\x51\xe3\x3d\x2b\xca\x3c\x73\xa3\x23\xb7\x41\x2c\x61\x96\x34\xcd\xf8\x1c\x94\xc3\xdf\xee\x3e\x98\xc7\x40\x5e\xab\x5e\x36\xdf\x59\xea\xe8\x64\xd4\x7e\xd7\x40\x4d\xb7\x8c\x65\x1b\x7e\xb6\x20\xce\xff\x38\x1c\x34\x2a\x90\x33\xf7\xc2\x99\xce\xcd\x31\x7f\x3c\x4b\xaa\xf1\xe0\x57\x17\xa4\x76\x26\xe5\x48\xf1\x41\xe2\xf1\x2b\x70\x46\x7b\xd0\x5e\x2c\x58\xb0\x15\xe7\xae\x5c\x88\x83\xd2\xc3\x86\xeb\x21\x18\x06\x19\xca\x1a\x3a\x36\x23\xe8\xb4\x44\x6d\x5a\x20\x37\x30\xe3\x25\xa0\x56\xbc\x6b\xf9\x3b\x0e\x6b\xa0\x3b\x50\xa6\xc2\x0b\xd8\x5b\x11\xa9\xc4\x6a\xf9\xbf\xf9\x76\x13\x61\xa9\x99\x2c\x35\xcb\xc1\xd0\xe5\x81\x37\x7d\x9a\x27\x4d\x23\xd2\x69\xeb\x27\x5c\x65\xdc\xd6\xcd\x77\xdf\x3e\x2c\x66\x5b\x1a\x15\x75\xd6\xc5\x86\x08\xfc\x6c\x8f\x34\x0c\xd1\x8a\x20\x0c\xbb\x80\x8c\xc9\xc4\x38\xc6\xe3\x58\xb5\xed\xb1\xd8\x96\xd7\xd1\xd1\x58\x12\x84\x8a\x6d\xfc\xff\xc2\xad\xff\x33\x05\x4e\x37\x19\x26\xbd\xa4\xa1\x6a\xe2\x94\x39\x16\xa4\xb1\x23\xe0\x45\xd9\x06\x67\x28\xdd\xb0\x5d\xe8\x83\x38\x14\x95\x22\xe5\x18\xf1\x5c\x93\xe0\x18\xfb\x16\x71\x07\x45\xbe\x57\x54\xf4\x03\x5f\xec\x57\xfb\xe2\xa6\xc3\x54\x6e\xbf\xa7\x5c\x1d\x9e\x15\xcf\x71\xa7\x03\xcd\xfe\xc2\xbd\xb9\x01\x5d\xef\x12\xe2\x4f\xff\x8c\xfe\x00\xc1\x5c\x1f\x96\x5f\x7e\xb7\x6e\x71\xd8\xa7\x8e\x90\xda\x4c\xde\xd5\x96\xc3\xbf\x46\xcf\xa7\x0a\xd8\x31\x2b\x41\x49\x0a\xc4\xf9\x31\x3f\x3e\x50\x0e\x4f\x70\xe4\x23\x9c\x65\xff\x8f\xbd\xa3\x6b\x71\x5c\xb7\xbe\xfb\x57\x88\x3c\xb5\xe0\xcc\x3a\xce\x64\xe7\xe3\xed\x32\xe5\xd2\x42\x29\x0b\x3b\xdb\x42\xa1\x04\x25\x56\x32\xee\xfa\x23\x58\xf6\xcc\xa6\x90\xff\x5e\x8e\x2c\xc9\xb2\x62\xc9\x72\xec\xcc\xce\x85\xdd\xbd\x5c\x9c\xb5\x7c\x74\x74\xa4\x23\x1d\x9d\x4f\x50\x80\x91\x08\x35\x0a\x5c\xb4\x1d\x50\x3f\x41\x7c\xdf\x2f\xc9\x28\x6f\x15\x5a\x82\xb6\x48\xa2\x34\x0c\x88\xa7\x3f\xa9\xf4\x64\x29\x40\x9e\xf8\x98\x5e\xe3\xf2\xf8\xf5\x5c\x27\x35\x84\xa8\x06\x78\xaa\xc4\x2f\x28\xf8\xca\xcb\xa8\xc2\x6b\xe6\xb0\xc6\xbe\x75\x3c\x6e\xed\xe6\x6a\x41\x06\xa3\x70\x13\x67\xe5\x32\x74\xc9\x93\xbb\xae\xca\xed\x50\x96\x54\x64\x28\x5c\x92\x79\x19\xa7\x96\x13\xd1\x64\xb1\xd5\xfb\x30\x02\x80\x9c\x9a\xe3\x83\x57\x07\x24\x83\x19\xb6\xb6\xc6\x70\xa8\x00\xa0\xae\x1e\xc6\x9c\x35\xe8\x61\x51\xab\x2c\x82\x7e\x2d\xd2\xf8\x99\x47\x39\x36\x37\xdd\x22\x08\xcd\xe5\x1f\x6a\x24\xa8\x55\x61\x74\x55\x04\x44\x6c\x2d\x18\x9f\xe8\xf5\xa4\x92\xa7\xba\x1b\xd8\x4c\x74\xeb\xd4\x60\x81\xe4\x10\x1e\xa0\x84\x53\x5a\x65\x3c\x2f\x0f\xe2\x83\x40\x8d\x05\xcd\x47\xf8\x15\xc7\x09\x93\x49\x41\x05\x83\xb7\x3c\xea\x86\x2d\x14\x50\xd7\x26\xc7\x99\x99\x28\x22\x61\xe0\x75\xd5\x6d\xbf\xf2\x80\x8f\xc8\x03\x3e\x28\x45\x77\x54\x15\xd8\xde\xc9\x65\x4c\xa6\xad\x4c\xd1\x0d\xdc\x7a\x45\xf6\x0c\x23\xda\x8b\x30\xf8\x43\x27\x62\xec\x45\x60\x5c\x8e\xba\x6b\xaa\x88\xfd\x4e\xe1\x73\x3c\xb3\x7f\xfb\xea\xd0\xcd\x4f\x9f\x54\x7e\x25\x18\x4b\xdc\x2e\xa9\x7f\x5a\x6b\xe6\x53\x0e\x4f\xa5\x8d\xb1\xcb\xfc\x3b\xc9\xe8\x60\xb6\xb6\xa5\x9e\xf0\xf4\xa7\x33\x11\xa6\xdf\xce\xe8\x2a\xcb\xb4\x0c\x8d\x2c\x14\x94\x50\x29\xcd\x30\x3d\x86\x94\x74\xd8\x51\x86\x29\xfa\x2f\x75\x96\x89\xbb\x3d\x8f\x8c\xc6\x50\xcb\x37\xfd\x4a\x07\x29\xdb\xb5\xbe\x3b\x19\x27\xee\x60\x95\x71\x0d\xd3\xa6\x11\xf1\x59\x96\xb1\x2b\xa5\x7f\x3d\x88\x83\x6c\xdc\x36\x85\x40\x9d\x04\xf6\x7f\xa3\x11\xf8\x87\xf4\xd2\x66\x5d\x32\xbd\x27\x20\x62\xe9\x7b\x42\x1f\x71\x18\x3e\x03\xd7\x3b\x60\xd6\x6a\x0d\x98\x4d\xd2\xa9\xf4\xb9\xb7\x10\xdc\xd3\x97\xc0\x19\x17\x31\x59\xf0\xb7\xfd\xbe\x20\x50\xbd\x28\x9a\x86\xa1\x0c\x40\x25\x6f\x61\xf9\xaa\x91\x05\x41\x6a\x04\xc7\x69\xde\x74\x18\x8f\x19\xe4\x66\x17\x6e\xe9\x12\x86\x4f\x9e\xfe\xd4\x4d\xb7\xd1\xb7\x28\x01\xc5\x74\x95\x52\x09\x25\xa5\x6a\x47\x9a\x6c\xdd\xd2\x01\x29\x6f\x4f\xfe\xd9\xf7\x6b\x21\x1d\xd3\x11\x90\x68\x95\xae\x27\xbe\xe7\x39\xf4\x35\xc9\x75\xce\xda\xcf\x64\xf2\xac\xb5\x17\xd7\xc3\xd5\x79\xfd\x96\x65\x22\x1c\xb7\xc7\x2c\x60\x05\x8c\xbe\x7c\x85\xed\x06\xcc\x36\xac\x1d\x7a\x91\xf9\x74\x20\xed\x15\x75\x5b\xc1\xee\xf2\xb7\x91\x80\x43\xe4\xbd\x41\x04\x4c\x47\x55\xd3\x6a\xc1\x38\x67\x7e\xf1\xf2\x63\x25\x00\xdb\x90\x8c\xec\xe2\x6d\x8c\x8b\xe3\x4f\x96\x9d\x85\xba\xc4\x55\xbf\x76\x5d\x6c\x76\x84\x58\x50\x18\x4b\xf5\x8f\x72\x09\xfd\x48\x37\x27\xe0\x8f\x9f\xa1\x26\x29\x8b\x78\xbf\x1f\x5d\x7e\xe9\x0d\x4c\x74\x4a\xa1\xa5\x86\xdf\x1f\x51\x8a\xb3\x0a\x27\x3e\xa4\x17\x23\x14\xbc\xff\x7c\x5e\x10\x1a\x8a\x1b\x83\x9c\x1f\x55\x09\xb1\x0c\x4b\x36\x31\x8f\xe1\xc7\x1a\x12\xda\x8d\x20\x5d\xf0\x23\x0c\xb6\xc1\x5d\x80\x1f\x36\xe4\xf3\x6a\xb9\x5a\xe1\x68\x13\x85\x1b\x7c\x7b\xf7\x40\x82\x87\x15\x09\xc9\x3d\x89\xee\xc8\xe7\x87\x70\xf5\xf0\x79\x75\x7b\x7f\xb7\xbc\x7d\xb8\xbf\x25\x78\x73\xb7\x8a\x96\xbb\x68\x87\x57\x2d\x0a\x3b\x6d\xb5\xbf\xe7\x05\xd9\xe2\xf1\x07\x96\x06\x4b\x31\xa4\x62\x38\x9c\x40\xbc\x25\x11\xc2\x55\x99\xa7\xb8\x64\xa1\x16\xe2\xab\xeb\x6e\xc2\x1a\xb2\x0a\x2a\x7c\xf6\x77\x1c\x95\x08\x11\x5c\x80\xd3\x2b\x35\x4f\xf1\x70\xc6\x30\x76\xdf\x8c\x1f\x45\xe0\x3b\x65\x5e\x15\x61\x10\x06\xf3\x45\x38\x0f\x16\xcf\x8b\xf0\x31\x08\x1e\x83\xe0\xdf\xb3\x0f\xbf\xab\x4d\xc8\xd1\x1c\x94\x7e\x86\x5b\x06\x20\x99\xbc\x85\x9f\x13\x3f\x4c\xa2\xfc\xe8\x02\x26\xef\x68\xca\xcc\xff\xa1\x54\x20\x02\xeb\x5f\x5a\x90\x5f\x5a\x10\x17\x2d\x88\x58\x2f\xcc\x67\xeb\x38\xc5\xf9\x22\x21\x29\xa7\x0b\x73\x96\x11\x22\x94\xdc\xc2\x99\xef\x8d\xd8\xd7\xe5\xa1\x83\x93\xc4\x35\x4e\x7c\x84\x48\xae\xa1\xcf\xef\x69\xb4\x2c\x30\xd4\xfd\xaf\x4b\x0e\xc5\x14\x29\x3d\xc8\x3c\xd9\x71\x46\x4b\x82\x23\x41\xf1\x88\xec\x30\x4b\xfe\x91\x91\x2b\xef\xd6\xa0\xf2\x5a\x5f\x4b\xfc\xb3\x9c\x56\x29\xfe\xb1\xbe\x24\xbb\xb0\x46\xe3\x97\x78\xff\xc2\xae\xc5\xb2\xf0\x0f\xda\x11\x22\xc5\xba\x08\x64\x3c\x51\x0c\xaa\x3e\x4c\xda\x27\x70\x8a\x99\x5b\x96\x8f\x92\xfc\x0d\x6d\x70\x82\xb3\xad\x7a\xd2\xa0\x78\x9f\xe5\x05\xe4\xa1\x2d\xcd\x23\x5f\x69\xd4\x36\x5f\x3c\xd2\x38\xeb\x2d\xc9\xed\x36\x6e\x5e\xd5\x00\x55\x99\x5c\xeb\x0c\x2e\x7a\xcb\x8b\x12\x82\x0a\xcb\x32\xb1\x4e\xd7\x42\xc3\xd9\x86\xb6\x94\x80\x07\x2e\x8e\x6e\x7e\xc8\x33\x39\x3d\xea\xb2\x7f\xc3\x71\x19\x67\x7b\xe6\x59\xa7\x8f\xea\x11\x45\x38\x4e\x8e\x3e\x7a\x23\xe4\x7b\x72\x84\x49\xed\xcc\x03\xdc\x0c\x6f\xc6\x3e\x68\x2d\x3f\xdb\x96\x25\x32\xfa\x4c\x21\x03\x74\xc1\x6a\xc2\x8d\xdb\xd9\x7d\xa8\xe3\xa6\xc4\x5b\x9b\x27\x60\xca\xf3\xbe\x27\xbd\xd1\xc9\xeb\x7a\xb6\x10\xb5\x81\x33\x92\xa2\x12\x10\x27\x27\x4f\x18\x7d\xa8\xa0\x82\x0b\xa6\xe0\x2d\x69\x2f\x93\x8e\xa0\x22\x30\x93\x21\x98\xab\x45\xad\xf2\x70\x9c\x82\x28\x7f\xcb\x92\x1c\x47\x83\x39\x57\xd7\x4c\x6a\xaf\xb5\xc1\x8a\x6e\x44\x36\x6c\x18\x5c\x9c\xa1\x4d\x5c\x8a\xa4\x98\x60\x7d\x37\x2f\x7c\xb1\x19\x29\xef\xf5\x0d\xbf\xb4\xc7\x85\x4c\x32\x8c\x22\xaf\xb2\x08\xb6\xdc\x03\x4f\xc6\x96\xa1\x34\x4e\x92\xb8\xd7\x79\xe0\xd6\xe2\xeb\xff\x81\xd4\x25\x93\x18\x9a\xa5\x47\xea\x24\xde\x6a\xe6\x7e\xc6\xf9\x2b\x40\x76\xb2\xf7\x95\x0d\xaa\xc3\x3b\x30\x5a\x75\x18\xc3\x66\x61\x07\x9b\x79\xfa\x93\x1c\x15\x1c\x0a\x59\x14\x67\xfb\xbf\xc7\x69\x3c\xca\xc6\xa5\xc3\xe1\x3b\xa1\xdc\xed\x28\x6f\x80\x12\xd6\xc2\x47\x69\x4c\xa9\xfc\x0d\xdb\x64\x46\x51\x95\xb1\x5f\xe0\x5b\xee\xb4\xf5\x41\x84\x54\x84\x87\x6f\x19\xe7\xe4\x52\xfe\x18\x67\x1f\x7a\x4b\xf3\xac\x7c\x19\xd1\xdf\x52\xf4\xa2\xfc\xb1\x76\xc8\xf9\x6c\x44\x97\x83\x04\xaa\x03\xa4\x35\x2d\x5f\x62\x7a\xe1\x48\xb5\x65\xc1\x25\x5c\x98\x7c\x28\x7a\x1a\x53\xc4\xc0\xa2\x3f\x7d\x7b\x7e\xfa\xb3\x8f\x0a\x26\x62\x65\xc9\xb1\xff\xd8\x50\xfe\xf6\x61\x9f\x5f\xb2\x24\xac\x88\x03\xc4\x51\x38\x3b\x72\xe3\x33\x29\x52\xca\xed\x6c\xad\x11\xb8\x33\xa2\x0a\x82\x9b\x94\x5a\xb5\x2a\x36\x47\x04\x81\x45\x14\x91\x2c\x3a\xe4\xb1\x7b\xf9\x77\x56\x1c\x3b\x92\x36\x62\x33\x81\x45\x02\x38\x23\x79\x76\x38\xa1\xad\x32\x7b\x7e\x47\x3f\x42\x32\xba\x76\x3f\xaf\xa4\xb0\xf3\x57\xef\x11\x12\xdc\x04\x37\xe1\x5d\xeb\xd0\xe8\x9f\xe2\x51\x92\x7c\x0b\x86\x32\xc9\x05\x29\xab\x22\xfb\x35\xc9\xef\x33\xc9\xad\x8e\xb8\x3a\xf0\xdd\x97\xd3\x3f\xa1\xe2\x25\x73\xb4\x65\xa9\xf8\x06\x1c\xe0\x97\xe7\xd3\x3b\x83\x05\xff\xcd\x70\x14\xb1\xa2\x0a\x38\xf9\x62\x82\xdc\x7b\x31\xb4\x5c\x0d\xfb\x2e\x87\xbf\x43\x06\x71\x46\x03\x95\x74\x6d\xf2\xe9\xbf\x2e\x0b\x03\x31\x4f\x1d\x23\x9c\x28\xe7\xed\x3a\x83\xff\x22\x9b\x97\x3c\xff\x7e\xb9\xe8\xd5\x00\x50\x75\x90\xf5\x3f\x42\xa2\xe9\xba\x90\x2d\xde\x40\x35\x3e\x70\xf1\x45\xe4\x15\xae\xd5\x37\xe8\x6b\xbc\xe7\x19\x55\xb6\x05\x04\xfd\xd7\x3e\xfa\xcd\x2e\x92\x67\x4d\x04\xa1\xdb\xca\x99\xc8\xa9\x1d\x8c\x4b\x01\x33\x2e\xf5\x8b\xe4\x3f\x31\xb7\x56\x99\x1f\x3a\x33\x87\xd9\x56\xb8\x3e\x79\x50\x51\x2a\xdf\x29\x21\x6d\x73\x70\x6f\x23\xbe\x50\xf9\xf9\x8a\xce\x4f\x7d\x9e\xd7\xa5\x24\x40\x1c\xd9\xc7\xa0\xc8\x05\x80\xbe\x88\xce\x65\x40\x2a\xea\xa3\x0c\xd7\x2d\xa1\x62\x98\xef\x39\x31\x9a\x4e\xbd\xd6\xeb\x93\x91\x96\x7a\xb8\xca\xec\x0c\x57\x0d\x81\xe6\xb6\xca\xb1\x6d\xf7\xf4\x1f\xcf\xd0\xeb\xac\x2a\xfa\x73\x3f\x1a\xd1\x9c\xbd\x94\xe5\x81\x3e\x7e\xfa\xc4\xff\xe9\x66\x9b\xa7\x9f\x20\xd5\x01\x29\xe2\x2a\xfd\x54\xb3\xc6\x50\xd6\x25\x49\x0c\xb1\xd1\xbf\xd5\x11\x86\xe3\x39\xf9\x0c\x9e\xc2\xd8\x18\xc1\xad\x09\xac\x72\xf5\x5b\x50\xde\x47\xf5\x07\x9c\xd9\xb7\x43\x38\x56\xb8\xb5\xad\x53\x6a\x26\xea\x65\xd7\x5a\xb7\xb8\x0b\x72\x96\x41\x76\xd8\x7c\x56\x19\xf9\x71\xa8\xcd\xe7\xd2\x9f\x94\x47\x34\xae\x82\x25\xe2\x31\xa6\xe8\x5b\x26\xa3\x93\x66\x46\x5c\xea\xef\x7a\xf2\xf0\xba\xdd\x1f\xfe\xfa\xfc\xfc\x45\x89\xac\xe4\x05\xcf\x99\xc0\xd6\x24\xd4\x66\x86\xa2\x2c\x6f\x10\x7f\xc3\x54\x04\xf3\x5b\xae\xf8\xab\xc0\x5c\xdc\x1e\x74\x5a\xd7\xdc\x7b\x3d\xfd\xc9\xc8\x0d\x93\xb1\x41\x7b\xfd\xf3\xb5\xce\x76\x4d\xdc\x5a\xf1\xc0\x09\xfc\xd8\xbb\x19\x14\x12\x3c\x74\x0b\xbf\x4c\x7b\x6d\x64\x6e\x95\xbe\x2a\x85\xaf\x14\x31\x36\xe4\x70\xfd\x48\xcc\x39\xea\xa0\x5f\x6c\x6e\x49\x78\xbf\xc1\xf3\x70\x87\x17\x70\xd0\x87\xf3\xfb\xfb\xe5\x6e\x1e\x04\x8b\xcf\xd1\x72\xbb\xc5\xb7\x36\xe1\x1e\x72\xe1\xaf\xf9\x62\x99\x94\xfc\xab\xc7\xc0\x46\x7e\x75\x75\x5f\xa0\x68\xd5\xd8\xea\x6f\x7f\x11\x3b\x91\x0a\xd7\x47\x14\xa7\x3c\x62\x33\x49\x04\x03\x51\x5d\x70\x84\xcf\xd8\xe1\x78\x35\x6d\xee\x65\xb1\x4b\xda\x18\xf3\x8c\x39\x63\x70\x5d\xa3\x2f\x76\x0b\x10\x94\x3a\x85\x10\x15\x7d\xde\xc0\x88\x20\x13\xf7\x86\xe2\xa7\xc0\x3f\x17\x88\xbc\x2e\xb6\x3f\x79\x5a\xe7\xfa\x7e\x38\x81\x29\xd0\x02\x91\xe5\x5b\x04\x35\x1d\x73\x03\xda\x82\xfa\x8b\x13\x11\x32\xcf\x34\x5b\xac\x8f\xc0\x5e\x43\x4b\xb4\x8b\x0b\xea\xea\x30\xd7\x40\x32\xd3\xf1\x7a\x5b\xee\xc5\x06\x43\x0e\x67\x3a\xc2\x77\x10\x5c\x30\x9e\x23\x25\x45\xf3\x77\xa5\xe3\xa5\xf4\xab\x73\xc6\x7c\xc1\xc7\x33\xeb\x89\x3b\xe5\x9e\x54\x18\xaa\x17\x3d\xbb\xd2\x8a\xac\x34\x83\x44\x5f\x5c\x62\x33\xf9\x86\x29\x39\x4e\x5a\xbb\x86\xed\xdb\xe0\x4d\xdd\x2a\xf9\xab\xe6\xac\xf4\xee\xb9\x02\x9b\x37\x02\xf6\x04\xfc\xe4\x9d\xa5\x3d\x25\x2d\xc2\x37\x7f\x65\xe8\xa8\x01\x2a\xcf\xbe\xb4\x5a\x85\xe1\xd9\xeb\x93\x67\xfb\x7d\xf2\xba\x9e\x4f\x9e\x86\xca\xac\xa3\x6c\xef\x65\x0b\x41\x05\xe1\x92\x71\x5e\xe6\x3d\x76\x5a\x14\x0e\xa7\xac\x67\xa0\xf4\x8c\xe6\x55\xb1\x25\x83\xbe\x3f\x27\x98\x27\xfe\x7f\xf2\x4e\xde\xff\x07\x00\x76\x43\xb0\x27\xee\x46\x03\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/index.html"].(os.FileInfo),
-		fs["/swagger.json"].(os.FileInfo),
+		fs["/openapi.json"].(os.FileInfo),
 	}
 
 	return fs
@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/mysteriumnetwork/node/core/audit"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type auditLog interface {
+	Query(filter audit.Filter) ([]audit.Entry, error)
+	Verify() error
+}
+
+type auditEndpoint struct {
+	log auditLog
+}
+
+// NewAuditEndpoint creates and returns audit log endpoint
+func NewAuditEndpoint(log auditLog) *auditEndpoint {
+	return &auditEndpoint{
+		log: log,
+	}
+}
+
+// swagger:operation GET /audit Audit auditLog
+// ---
+// summary: Returns audit log
+// description: Returns privileged API operations, latest first, along with the result of log integrity check
+// responses:
+//   200:
+//     description: Audit log entries
+//     schema:
+//       "$ref": "#/definitions/AuditLogDTO"
+//   422:
+//     description: Parameters validation error
+//     schema:
+//       "$ref": "#/definitions/ValidationErrorDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *auditEndpoint) List(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	query := contract.AuditLogQuery{}
+	if errs := query.Bind(req); errs.HasErrors() {
+		utils.SendValidationErrorMessage(resp, errs)
+		return
+	}
+
+	entries, err := e.log.Query(query.ToFilter())
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.NewAuditLogDTO(entries, e.log.Verify()), resp)
+}
+
+// AddRoutesForAudit attaches audit log endpoints to router
+func AddRoutesForAudit(router *httprouter.Router, log auditLog) {
+	e := NewAuditEndpoint(log)
+	router.GET("/audit", e.List)
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/core/audit"
+)
+
+type mockAuditLog struct {
+	entries      []audit.Entry
+	verifyErr    error
+	calledFilter audit.Filter
+}
+
+func (m *mockAuditLog) Query(filter audit.Filter) ([]audit.Entry, error) {
+	m.calledFilter = filter
+	return m.entries, nil
+}
+
+func (m *mockAuditLog) Verify() error {
+	return m.verifyErr
+}
+
+func Test_AuditLog(t *testing.T) {
+	router := httprouter.New()
+	log := &mockAuditLog{entries: []audit.Entry{{
+		Seq:        2,
+		Time:       time.Date(2020, 11, 1, 10, 0, 0, 0, time.UTC),
+		Actor:      "token:abc",
+		RemoteAddr: "127.0.0.1:5000",
+		Method:     http.MethodPost,
+		Path:       "/transactor/settle/sync",
+		Body:       json.RawMessage(`{"hermes_id":"0x1"}`),
+		Status:     http.StatusOK,
+		PrevHash:   "aa",
+		Hash:       "bb",
+	}}}
+	AddRoutesForAudit(router, log)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		assert.NoError(t, err)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := serve("/audit?actor=token:abc&path=/transactor&from=2020-11-01T00:00:00Z&limit=5")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "token:abc", log.calledFilter.Actor)
+	assert.Equal(t, "/transactor", log.calledFilter.PathPrefix)
+	assert.Equal(t, 5, log.calledFilter.Limit)
+	assert.Equal(t, time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC), *log.calledFilter.From)
+	assert.Nil(t, log.calledFilter.To)
+	assert.JSONEq(t, `{
+		"entries": [{
+			"seq": 2,
+			"time": "2020-11-01T10:00:00Z",
+			"actor": "token:abc",
+			"remote_addr": "127.0.0.1:5000",
+			"method": "POST",
+			"path": "/transactor/settle/sync",
+			"body": {"hermes_id": "0x1"},
+			"status": 200,
+			"prev_hash": "aa",
+			"hash": "bb"
+		}],
+		"intact": true
+	}`, resp.Body.String())
+
+	log.verifyErr = errors.New("entry 1 was modified")
+	resp = serve("/audit")
+	assert.Equal(t, 100, log.calledFilter.Limit)
+	assert.Contains(t, resp.Body.String(), `"intact":false,"integrity_error":"entry 1 was modified"`)
+
+	resp = serve("/audit?from=yesterday&limit=-1")
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+}
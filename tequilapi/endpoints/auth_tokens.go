@@ -0,0 +1,188 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/mysteriumnetwork/node/core/auth"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+	"github.com/mysteriumnetwork/node/tequilapi/validation"
+)
+
+type apiTokenManager interface {
+	Create(name string, scopes []auth.Scope, expiresAt *time.Time) (auth.APIToken, string, error)
+	List() ([]auth.APIToken, error)
+	Revoke(id string) error
+}
+
+type apiTokensEndpoint struct {
+	tokens apiTokenManager
+}
+
+// swagger:operation GET /auth/tokens Authentication listAPITokens
+// ---
+// summary: Lists API tokens
+// description: Lists named API tokens including expired and revoked ones. Token secrets are never returned.
+// responses:
+//   200:
+//     description: API tokens
+//     schema:
+//       "$ref": "#/definitions/APITokenListResponse"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *apiTokensEndpoint) List(resp http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+	tokens, err := e.tokens.List()
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	res := contract.APITokenListResponse{Tokens: make([]contract.APITokenDTO, len(tokens))}
+	for i, token := range tokens {
+		res.Tokens[i] = contract.NewAPITokenDTO(token)
+	}
+	utils.WriteAsJSON(res, resp)
+}
+
+// swagger:operation POST /auth/tokens Authentication createAPIToken
+// ---
+// summary: Creates API token
+// description: Creates named API token limited to the given scopes. Returned secret should be used as a bearer token and can not be retrieved later.
+// parameters:
+//   - in: body
+//     name: body
+//     schema:
+//       $ref: "#/definitions/CreateAPITokenRequest"
+// responses:
+//   201:
+//     description: API token created
+//     schema:
+//       "$ref": "#/definitions/CreateAPITokenResponse"
+//   400:
+//     description: Body parsing error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   422:
+//     description: Parameters validation error
+//     schema:
+//       "$ref": "#/definitions/ValidationErrorDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *apiTokensEndpoint) Create(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	var cr contract.CreateAPITokenRequest
+	if err := json.NewDecoder(req.Body).Decode(&cr); err != nil {
+		utils.SendError(resp, fmt.Errorf("failed to parse API token request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	scopes, errorMap := validateCreateAPITokenRequest(cr, time.Now())
+	if errorMap.HasErrors() {
+		utils.SendValidationErrorMessage(resp, errorMap)
+		return
+	}
+
+	token, secret, err := e.tokens.Create(cr.Name, scopes, cr.ExpiresAt)
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.CreateAPITokenResponse{
+		APITokenDTO: contract.NewAPITokenDTO(token),
+		Token:       secret,
+	}, resp, http.StatusCreated)
+}
+
+// swagger:operation DELETE /auth/tokens/{id} Authentication revokeAPIToken
+// ---
+// summary: Revokes API token
+// description: Revokes named API token, requests using it are rejected afterwards
+// parameters:
+// - name: id
+//   in: path
+//   description: API token ID
+//   type: string
+//   required: true
+// responses:
+//   202:
+//     description: API token revoked
+//   404:
+//     description: API token not found
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *apiTokensEndpoint) Revoke(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	err := e.tokens.Revoke(params.ByName("id"))
+	if err == auth.ErrTokenNotFound {
+		utils.SendError(resp, err, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+	resp.WriteHeader(http.StatusAccepted)
+}
+
+func validateCreateAPITokenRequest(cr contract.CreateAPITokenRequest, now time.Time) ([]auth.Scope, *validation.FieldErrorMap) {
+	errorMap := validation.NewErrorMap()
+	if strings.TrimSpace(cr.Name) == "" {
+		errorMap.ForField("name").Required()
+	}
+	if len(cr.Scopes) == 0 {
+		errorMap.ForField("scopes").Required()
+	}
+
+	scopes := make([]auth.Scope, 0, len(cr.Scopes))
+	for _, name := range cr.Scopes {
+		scope, err := auth.ParseScope(name)
+		if err != nil {
+			errorMap.ForField("scopes").Invalid(err.Error())
+			continue
+		}
+		scopes = append(scopes, scope)
+	}
+
+	if cr.ExpiresAt != nil && !cr.ExpiresAt.After(now) {
+		errorMap.ForField("expires_at").Invalid("expiry must be in the future")
+	}
+	return scopes, errorMap
+}
+
+// AddRoutesForAPITokens registers /auth/tokens endpoints in Tequilapi
+func AddRoutesForAPITokens(router *httprouter.Router, tokens apiTokenManager) {
+	e := &apiTokensEndpoint{tokens: tokens}
+	router.GET("/auth/tokens", e.List)
+	router.POST("/auth/tokens", e.Create)
+	router.DELETE("/auth/tokens/:id", e.Revoke)
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/core/auth"
+)
+
+type mockAPITokenManager struct {
+	tokens  []auth.APIToken
+	created auth.APIToken
+	revoked string
+}
+
+func (m *mockAPITokenManager) Create(name string, scopes []auth.Scope, expiresAt *time.Time) (auth.APIToken, string, error) {
+	m.created = auth.APIToken{
+		ID:        "abc",
+		Name:      name,
+		Scopes:    scopes,
+		CreatedAt: time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+		ExpiresAt: expiresAt,
+	}
+	return m.created, "mtk_abc_secret", nil
+}
+
+func (m *mockAPITokenManager) List() ([]auth.APIToken, error) {
+	return m.tokens, nil
+}
+
+func (m *mockAPITokenManager) Revoke(id string) error {
+	if id != "abc" {
+		return auth.ErrTokenNotFound
+	}
+	m.revoked = id
+	return nil
+}
+
+func serveAPITokens(manager apiTokenManager, method, path, body string) *httptest.ResponseRecorder {
+	router := httprouter.New()
+	AddRoutesForAPITokens(router, manager)
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	return resp
+}
+
+func Test_APITokens_Create(t *testing.T) {
+	manager := &mockAPITokenManager{}
+
+	resp := serveAPITokens(manager, http.MethodPost, "/auth/tokens",
+		`{"name": "grafana", "scopes": ["read", "Connection"], "expires_at": "2120-01-01T00:00:00Z"}`)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, []auth.Scope{auth.ScopeRead, auth.ScopeConnection}, manager.created.Scopes)
+	assert.JSONEq(t,
+		`{
+			"id": "abc",
+			"name": "grafana",
+			"scopes": ["read", "connection"],
+			"created_at": "2020-10-01T12:00:00Z",
+			"expires_at": "2120-01-01T00:00:00Z",
+			"token": "mtk_abc_secret"
+		}`,
+		resp.Body.String(),
+	)
+}
+
+func Test_APITokens_CreateValidatesRequest(t *testing.T) {
+	manager := &mockAPITokenManager{}
+
+	resp := serveAPITokens(manager, http.MethodPost, "/auth/tokens",
+		`{"name": "", "scopes": ["root"], "expires_at": "2000-01-01T00:00:00Z"}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"name"`)
+	assert.Contains(t, resp.Body.String(), `"scopes"`)
+	assert.Contains(t, resp.Body.String(), `"expires_at"`)
+	assert.Empty(t, manager.created.ID)
+}
+
+func Test_APITokens_ListAndRevoke(t *testing.T) {
+	revokedAt := time.Date(2020, 10, 2, 12, 0, 0, 0, time.UTC)
+	manager := &mockAPITokenManager{tokens: []auth.APIToken{{
+		ID:        "abc",
+		Name:      "old",
+		Scopes:    []auth.Scope{auth.ScopePayments},
+		Hash:      "hash",
+		CreatedAt: time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+		RevokedAt: &revokedAt,
+	}}}
+
+	resp := serveAPITokens(manager, http.MethodGet, "/auth/tokens", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t,
+		`{"tokens": [{
+			"id": "abc",
+			"name": "old",
+			"scopes": ["payments"],
+			"created_at": "2020-10-01T12:00:00Z",
+			"revoked_at": "2020-10-02T12:00:00Z"
+		}]}`,
+		resp.Body.String(),
+	)
+
+	resp = serveAPITokens(manager, http.MethodDelete, "/auth/tokens/abc", "")
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Equal(t, "abc", manager.revoked)
+
+	resp = serveAPITokens(manager, http.MethodDelete, "/auth/tokens/missing", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/mysteriumnetwork/node/config"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
 	"github.com/mysteriumnetwork/node/tequilapi/utils"
 	"github.com/rs/zerolog/log"
 )
@@ -37,12 +38,6 @@ type configProvider interface {
 	SaveUserConfig() error
 }
 
-// swagger:model configPayload
-type configPayload struct {
-	// example: {"data":{"access-policy":{"list":"mysterium"},"openvpn":{"port":5522}}}
-	Data map[string]interface{} `json:"data"`
-}
-
 type configAPI struct {
 	config configProvider
 }
@@ -66,7 +61,7 @@ func newConfigAPI(config configProvider) *configAPI {
 //     schema:
 //       "$ref": "#/definitions/ErrorMessageDTO"
 func (api *configAPI) GetConfig(writer http.ResponseWriter, httpReq *http.Request, params httprouter.Params) {
-	res := configPayload{Data: api.config.GetConfig()}
+	res := contract.ConfigPayload{Data: api.config.GetConfig()}
 	utils.WriteAsJSON(res, writer)
 }
 
@@ -85,7 +80,7 @@ func (api *configAPI) GetConfig(writer http.ResponseWriter, httpReq *http.Reques
 //     schema:
 //       "$ref": "#/definitions/ErrorMessageDTO"
 func (api *configAPI) GetDefaultConfig(writer http.ResponseWriter, httpReq *http.Request, params httprouter.Params) {
-	res := configPayload{Data: api.config.GetDefaultConfig()}
+	res := contract.ConfigPayload{Data: api.config.GetDefaultConfig()}
 	utils.WriteAsJSON(res, writer)
 }
 
@@ -104,7 +99,7 @@ func (api *configAPI) GetDefaultConfig(writer http.ResponseWriter, httpReq *http
 //     schema:
 //       "$ref": "#/definitions/ErrorMessageDTO"
 func (api *configAPI) GetUserConfig(writer http.ResponseWriter, httpReq *http.Request, params httprouter.Params) {
-	res := configPayload{Data: api.config.GetUserConfig()}
+	res := contract.ConfigPayload{Data: api.config.GetUserConfig()}
 	utils.WriteAsJSON(res, writer)
 }
 
@@ -129,7 +124,7 @@ func (api *configAPI) GetUserConfig(writer http.ResponseWriter, httpReq *http.Re
 //     schema:
 //       "$ref": "#/definitions/ErrorMessageDTO"
 func (api *configAPI) SetUserConfig(writer http.ResponseWriter, httpReq *http.Request, params httprouter.Params) {
-	var req configPayload
+	var req contract.ConfigPayload
 	err := json.NewDecoder(httpReq.Body).Decode(&req)
 	if err != nil {
 		utils.SendError(writer, err, http.StatusBadRequest)
@@ -34,6 +34,7 @@ import (
 	"github.com/mysteriumnetwork/node/market"
 	"github.com/mysteriumnetwork/node/tequilapi/contract"
 	"github.com/mysteriumnetwork/node/tequilapi/utils"
+	"github.com/mysteriumnetwork/node/tequilapi/validation"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 )
@@ -53,6 +54,10 @@ type ProposalGetter interface {
 	GetProposal(id market.ProposalID) (*market.ServiceProposal, error)
 }
 
+type hermesSelector interface {
+	SelectHermes(chainID int64, consumerID identity.Identity, proposal market.ServiceProposal) (common.Address, error)
+}
+
 type identityRegistry interface {
 	GetRegistrationStatus(int64, identity.Identity) (registry.RegistrationStatus, error)
 }
@@ -65,18 +70,18 @@ type ConnectionEndpoint struct {
 	//TODO connection should use concrete proposal from connection params and avoid going to marketplace
 	proposalRepository proposal.Repository
 	identityRegistry   identityRegistry
-	addressProvider    addressProvider
+	hermesSelector     hermesSelector
 }
 
 // NewConnectionEndpoint creates and returns connection endpoint
-func NewConnectionEndpoint(manager connection.Manager, stateProvider stateProvider, proposalRepository proposal.Repository, identityRegistry identityRegistry, publisher eventbus.Publisher, addressProvider addressProvider) *ConnectionEndpoint {
+func NewConnectionEndpoint(manager connection.Manager, stateProvider stateProvider, proposalRepository proposal.Repository, identityRegistry identityRegistry, publisher eventbus.Publisher, hermesSelector hermesSelector) *ConnectionEndpoint {
 	return &ConnectionEndpoint{
 		manager:            manager,
 		publisher:          publisher,
 		stateProvider:      stateProvider,
 		proposalRepository: proposalRepository,
 		identityRegistry:   identityRegistry,
-		addressProvider:    addressProvider,
+		hermesSelector:     hermesSelector,
 	}
 }
 
@@ -137,13 +142,7 @@ func (ce *ConnectionEndpoint) Status(resp http.ResponseWriter, _ *http.Request,
 //     schema:
 //       "$ref": "#/definitions/ErrorMessageDTO"
 func (ce *ConnectionEndpoint) Create(resp http.ResponseWriter, req *http.Request, params httprouter.Params) {
-	hermes, err := ce.addressProvider.GetActiveHermes(config.GetInt64(config.FlagChainID))
-	if err != nil {
-		utils.SendError(resp, err, http.StatusInternalServerError)
-		return
-	}
-
-	cr, err := toConnectionRequest(req, hermes.Hex())
+	cr, err := toConnectionRequest(req)
 	if err != nil {
 		utils.SendError(resp, err, http.StatusBadRequest)
 		ce.publisher.Publish(quality.AppTopicConnectionEvents, (&contract.ConnectionCreateRequest{}).Event(quality.StagePraseRequest, err.Error()))
@@ -206,6 +205,21 @@ func (ce *ConnectionEndpoint) Create(resp http.ResponseWriter, req *http.Request
 		return
 	}
 
+	if cr.HermesID == "" {
+		hermes, err := ce.hermesSelector.SelectHermes(config.GetInt64(config.FlagChainID), consumerID, *proposal)
+		if err != nil {
+			ce.publisher.Publish(quality.AppTopicConnectionEvents, cr.Event(quality.StageGetProposal, err.Error()))
+			utils.SendError(resp, err, http.StatusInternalServerError)
+			return
+		}
+		cr.HermesID = hermes.Hex()
+	} else if !proposal.AcceptsHermes(cr.HermesID) {
+		errorMap := validation.NewErrorMap()
+		errorMap.ForField("hermes_id").Invalid("hermes is not accepted by provider")
+		utils.SendValidationErrorMessage(resp, errorMap)
+		return
+	}
+
 	err = ce.manager.Connect(consumerID, common.HexToAddress(cr.HermesID), *proposal, getConnectOptions(cr))
 
 	if err != nil {
@@ -275,28 +289,27 @@ func (ce *ConnectionEndpoint) Kill(resp http.ResponseWriter, req *http.Request,
 //       "$ref": "#/definitions/ErrorMessageDTO"
 func (ce *ConnectionEndpoint) GetStatistics(writer http.ResponseWriter, request *http.Request, params httprouter.Params) {
 	connection := ce.stateProvider.GetState().Connection
-	response := contract.NewConnectionStatisticsDTO(connection.Session, connection.Statistics, connection.Throughput, connection.Invoice)
+	response := contract.NewConnectionStatisticsDTO(connection.Session, connection.Statistics, connection.Throughput, connection.Invoice, connection.ChannelStats, connection.Quality)
 
 	utils.WriteAsJSON(response, writer)
 }
 
 // AddRoutesForConnection adds connections routes to given router
 func AddRoutesForConnection(router *httprouter.Router, manager connection.Manager,
-	stateProvider stateProvider, proposalRepository proposal.Repository, identityRegistry identityRegistry, publisher eventbus.Publisher, addressProvider addressProvider) {
-	connectionEndpoint := NewConnectionEndpoint(manager, stateProvider, proposalRepository, identityRegistry, publisher, addressProvider)
+	stateProvider stateProvider, proposalRepository proposal.Repository, identityRegistry identityRegistry, publisher eventbus.Publisher, hermesSelector hermesSelector) {
+	connectionEndpoint := NewConnectionEndpoint(manager, stateProvider, proposalRepository, identityRegistry, publisher, hermesSelector)
 	router.GET("/connection", connectionEndpoint.Status)
 	router.PUT("/connection", connectionEndpoint.Create)
 	router.DELETE("/connection", connectionEndpoint.Kill)
 	router.GET("/connection/statistics", connectionEndpoint.GetStatistics)
 }
 
-func toConnectionRequest(req *http.Request, defaultHermes string) (*contract.ConnectionCreateRequest, error) {
+func toConnectionRequest(req *http.Request) (*contract.ConnectionCreateRequest, error) {
 	var connectionRequest = contract.ConnectionCreateRequest{
 		ConnectOptions: contract.ConnectOptions{
 			DisableKillSwitch: false,
 			DNS:               connection.DNSOptionAuto,
 		},
-		HermesID: defaultHermes,
 	}
 	err := json.NewDecoder(req.Body).Decode(&connectionRequest)
 	if err != nil {
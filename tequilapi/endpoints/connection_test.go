@@ -34,6 +34,7 @@ import (
 	"github.com/mysteriumnetwork/node/consumer/bandwidth"
 	"github.com/mysteriumnetwork/node/core/connection"
 	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
+	"github.com/mysteriumnetwork/node/core/speedtest"
 	"github.com/mysteriumnetwork/node/datasize"
 	"github.com/mysteriumnetwork/node/eventbus"
 	"github.com/mysteriumnetwork/node/identity"
@@ -47,6 +48,8 @@ type mockConnectionManager struct {
 	onDisconnectReturn   error
 	onCheckChannelReturn error
 	onStatusReturn       connectionstate.Status
+	onSpeedTestReturn    speedtest.Result
+	onSpeedTestErr       error
 	disconnectCount      int
 	requestedConsumerID  identity.Identity
 	requestedProvider    identity.Identity
@@ -79,6 +82,10 @@ func (cm *mockConnectionManager) Reconnect() {
 	return
 }
 
+func (cm *mockConnectionManager) SpeedTest(context.Context) (speedtest.Result, error) {
+	return cm.onSpeedTestReturn, cm.onSpeedTestErr
+}
+
 func mockRepositoryWithProposal(providerID, serviceType string) *mockProposalRepository {
 	sampleProposal := market.ServiceProposal{
 		ID:                1,
@@ -103,7 +110,7 @@ func TestAddRoutesForConnectionAddsRoutes(t *testing.T) {
 	fakeState.stateToReturn.Connection.Statistics = connectionstate.Statistics{BytesSent: 1, BytesReceived: 2}
 
 	mockedProposalProvider := mockRepositoryWithProposal("node1", "noop")
-	AddRoutesForConnection(router, fakeManager, fakeState, mockedProposalProvider, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	AddRoutesForConnection(router, fakeManager, fakeState, mockedProposalProvider, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 
 	tests := []struct {
 		method         string
@@ -162,7 +169,7 @@ func TestStateIsReturnedFromStore(t *testing.T) {
 		},
 	}
 
-	connEndpoint := NewConnectionEndpoint(manager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(manager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(http.MethodGet, "/irrelevant", nil)
 	resp := httptest.NewRecorder()
 
@@ -182,7 +189,7 @@ func TestStateIsReturnedFromStore(t *testing.T) {
 func TestPutReturns400ErrorIfRequestBodyIsNotJSON(t *testing.T) {
 	fakeManager := mockConnectionManager{}
 
-	connEndpoint := NewConnectionEndpoint(&fakeManager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(&fakeManager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(http.MethodPut, "/irrelevant", strings.NewReader("a"))
 	resp := httptest.NewRecorder()
 
@@ -201,7 +208,7 @@ func TestPutReturns400ErrorIfRequestBodyIsNotJSON(t *testing.T) {
 func TestPutReturns422ErrorIfRequestBodyIsMissingFieldValues(t *testing.T) {
 	fakeManager := mockConnectionManager{}
 
-	connEndpoint := NewConnectionEndpoint(&fakeManager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(&fakeManager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(http.MethodPut, "/irrelevant", strings.NewReader("{}"))
 	resp := httptest.NewRecorder()
 
@@ -230,7 +237,7 @@ func TestPutWithValidBodyCreatesConnection(t *testing.T) {
 	fakeState.stateToReturn.Connection.Session = state
 
 	proposalProvider := mockRepositoryWithProposal("required-node", "openvpn")
-	connEndpoint := NewConnectionEndpoint(&fakeManager, fakeState, proposalProvider, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(&fakeManager, fakeState, proposalProvider, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(
 		http.MethodPut,
 		"/irrelevant",
@@ -267,7 +274,7 @@ func TestPutUnregisteredIdentityReturnsError(t *testing.T) {
 	mir := *mockIdentityRegistryInstance
 	mir.RegistrationStatus = registry.Unregistered
 
-	connEndpoint := NewConnectionEndpoint(&fakeManager, &mockStateProvider{}, proposalProvider, &mir, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(&fakeManager, &mockStateProvider{}, proposalProvider, &mir, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(
 		http.MethodPut,
 		"/irrelevant",
@@ -296,7 +303,7 @@ func TestPutFailedRegistrationCheckReturnsError(t *testing.T) {
 	mir := *mockIdentityRegistryInstance
 	mir.RegistrationCheckError = errors.New("explosions everywhere")
 
-	connEndpoint := NewConnectionEndpoint(&fakeManager, &mockStateProvider{}, proposalProvider, &mir, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(&fakeManager, &mockStateProvider{}, proposalProvider, &mir, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(
 		http.MethodPut,
 		"/irrelevant",
@@ -322,7 +329,7 @@ func TestPutWithServiceTypeOverridesDefault(t *testing.T) {
 	fakeManager := mockConnectionManager{}
 
 	mystAPI := mockRepositoryWithProposal("required-node", "noop")
-	connEndpoint := NewConnectionEndpoint(&fakeManager, &mockStateProvider{}, mystAPI, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(&fakeManager, &mockStateProvider{}, mystAPI, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(
 		http.MethodPut,
 		"/irrelevant",
@@ -348,7 +355,7 @@ func TestPutWithServiceTypeOverridesDefault(t *testing.T) {
 func TestDeleteCallsDisconnect(t *testing.T) {
 	fakeManager := mockConnectionManager{}
 
-	connEndpoint := NewConnectionEndpoint(&fakeManager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(&fakeManager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(http.MethodDelete, "/irrelevant", nil)
 	resp := httptest.NewRecorder()
 
@@ -366,7 +373,7 @@ func TestGetStatisticsEndpointReturnsStatistics(t *testing.T) {
 	fakeState.stateToReturn.Connection.Invoice = crypto.Invoice{AgreementTotal: big.NewInt(10001)}
 
 	manager := mockConnectionManager{}
-	connEndpoint := NewConnectionEndpoint(&manager, fakeState, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connEndpoint := NewConnectionEndpoint(&manager, fakeState, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 
 	resp := httptest.NewRecorder()
 	connEndpoint.GetStatistics(resp, nil, nil)
@@ -389,7 +396,7 @@ func TestEndpointReturnsConflictStatusIfConnectionAlreadyExists(t *testing.T) {
 	manager.onConnectReturn = connection.ErrAlreadyExists
 
 	mystAPI := mockRepositoryWithProposal("required-node", "openvpn")
-	connectionEndpoint := NewConnectionEndpoint(&manager, nil, mystAPI, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connectionEndpoint := NewConnectionEndpoint(&manager, nil, mystAPI, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 
 	req := httptest.NewRequest(
 		http.MethodPut,
@@ -418,7 +425,7 @@ func TestDisconnectReturnsConflictStatusIfConnectionDoesNotExist(t *testing.T) {
 	manager := mockConnectionManager{}
 	manager.onDisconnectReturn = connection.ErrNoConnection
 
-	connectionEndpoint := NewConnectionEndpoint(&manager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connectionEndpoint := NewConnectionEndpoint(&manager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 
 	req := httptest.NewRequest(
 		http.MethodDelete,
@@ -444,7 +451,7 @@ func TestConnectReturnsConnectCancelledStatusWhenErrConnectionCancelledIsEncount
 	manager.onConnectReturn = connection.ErrConnectionCancelled
 
 	mockProposalProvider := mockRepositoryWithProposal("required-node", "openvpn")
-	connectionEndpoint := NewConnectionEndpoint(&manager, nil, mockProposalProvider, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connectionEndpoint := NewConnectionEndpoint(&manager, nil, mockProposalProvider, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(
 		http.MethodPut,
 		"/irrelevant",
@@ -472,7 +479,7 @@ func TestConnectReturnsErrorIfNoProposals(t *testing.T) {
 	manager := mockConnectionManager{}
 	manager.onConnectReturn = connection.ErrConnectionCancelled
 
-	connectionEndpoint := NewConnectionEndpoint(&manager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockAddressProvider{})
+	connectionEndpoint := NewConnectionEndpoint(&manager, nil, &mockProposalRepository{}, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
 	req := httptest.NewRequest(
 		http.MethodPut,
 		"/irrelevant",
@@ -497,3 +504,47 @@ func TestConnectReturnsErrorIfNoProposals(t *testing.T) {
 }
 
 var mockIdentityRegistryInstance = &registry.FakeRegistry{RegistrationStatus: registry.Registered}
+
+type mockHermesSelector struct {
+	hermesToReturn common.Address
+}
+
+func (m *mockHermesSelector) SelectHermes(chainID int64, consumerID identity.Identity, proposal market.ServiceProposal) (common.Address, error) {
+	return m.hermesToReturn, nil
+}
+
+func TestPutWithoutHermesSelectsHermes(t *testing.T) {
+	fakeManager := mockConnectionManager{}
+	selector := &mockHermesSelector{hermesToReturn: common.HexToAddress("0x3")}
+	connEndpoint := NewConnectionEndpoint(&fakeManager, &mockStateProvider{}, mockRepositoryWithProposal("required-node", "openvpn"), mockIdentityRegistryInstance, eventbus.New(), selector)
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/irrelevant",
+		strings.NewReader(`{"consumer_id": "my-identity", "provider_id": "required-node"}`),
+	)
+	resp := httptest.NewRecorder()
+
+	connEndpoint.Create(resp, req, httprouter.Params{})
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, common.HexToAddress("0x3"), fakeManager.requestedHermesID)
+}
+
+func TestPutWithNotAcceptedHermesReturnsError(t *testing.T) {
+	fakeManager := mockConnectionManager{}
+	proposalProvider := mockRepositoryWithProposal("required-node", "openvpn")
+	proposalProvider.proposals[0].SetAcceptedHermes([]string{"0x0000000000000000000000000000000000000003"})
+	connEndpoint := NewConnectionEndpoint(&fakeManager, &mockStateProvider{}, proposalProvider, mockIdentityRegistryInstance, eventbus.New(), &mockHermesSelector{})
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/irrelevant",
+		strings.NewReader(`{"consumer_id": "my-identity", "provider_id": "required-node", "hermes_id": "0x0000000000000000000000000000000000000004"}`),
+	)
+	resp := httptest.NewRecorder()
+
+	connEndpoint.Create(resp, req, httprouter.Params{})
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	assert.Contains(t, resp.Body.String(), "hermes is not accepted by provider")
+	assert.Equal(t, common.Address{}, fakeManager.requestedHermesID)
+}
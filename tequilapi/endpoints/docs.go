@@ -32,7 +32,14 @@ func NewDocsEndpoint() *DocsEndpoint {
 // DocsEndpoint serves API documentation.
 type DocsEndpoint struct{}
 
-// Index redirects root route to swagger docs.
+// Index redirects root route to API docs.
+// swagger:operation GET / Docs docsIndex
+// ---
+// summary: Redirects to API documentation
+// description: Redirects to the page rendering API documentation served under /docs/
+// responses:
+//   301:
+//     description: Redirect to /docs/
 func (se *DocsEndpoint) Index(resp http.ResponseWriter, request *http.Request, _ httprouter.Params) {
 	http.Redirect(resp, request, "/docs/", http.StatusMovedPermanently)
 }
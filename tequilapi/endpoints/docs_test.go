@@ -54,7 +54,7 @@ func Test_Docs(t *testing.T) {
 	router.ServeHTTP(resp, req)
 	// then
 	assert.Equal(t, 200, resp.Code)
-	assert.Contains(t, resp.Body.String(), `<redoc spec-url="./swagger.json"></redoc>`)
+	assert.Contains(t, resp.Body.String(), `<redoc spec-url="./openapi.json"></redoc>`)
 
 	// when
 	req, _ = http.NewRequest("GET", "/docs/index.html", nil)
@@ -65,10 +65,10 @@ func Test_Docs(t *testing.T) {
 	assert.Equal(t, "./", resp.Header().Get("Location"))
 
 	// when
-	req, _ = http.NewRequest("GET", "/docs/swagger.json", nil)
+	req, _ = http.NewRequest("GET", "/docs/openapi.json", nil)
 	resp = httptest.NewRecorder()
 	router.ServeHTTP(resp, req)
 	// then
 	assert.Equal(t, 200, resp.Code)
-	assert.Contains(t, resp.Body.String(), `"host": "127.0.0.1:4050"`)
+	assert.Contains(t, resp.Body.String(), `"openapi": "3.0.3"`)
 }
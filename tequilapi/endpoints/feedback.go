@@ -36,27 +36,6 @@ func newFeedbackAPI(reporter *feedback.Reporter) *feedbackAPI {
 	return &feedbackAPI{reporter: reporter}
 }
 
-// ReportIssueRequest params for issue report
-// swagger:model
-type ReportIssueRequest struct {
-	Email       string `json:"email"`
-	Description string `json:"description"`
-}
-
-// ReportIssueSuccess successful issue report
-// swagger:model
-type ReportIssueSuccess struct {
-	IssueID string `json:"issue_id"`
-}
-
-// ReportIssueError issue report error
-// swagger:model
-type ReportIssueError struct {
-	Errors []struct {
-		Message string `json:"message"`
-	} `json:"errors"`
-}
-
 // ReportIssue reports user issue
 // swagger:operation POST /feedback/issue Feedback reportIssue
 // ---
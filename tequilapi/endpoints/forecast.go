@@ -0,0 +1,108 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/mysteriumnetwork/node/core/forecast"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type forecaster interface {
+	ForecastBalance(id identity.Identity) (forecast.BalanceForecast, error)
+	ForecastEarnings(id identity.Identity) (forecast.EarningsForecast, error)
+}
+
+type forecastEndpoint struct {
+	forecaster forecaster
+}
+
+// NewForecastEndpoint creates and returns balance and earnings forecast endpoint
+func NewForecastEndpoint(forecaster forecaster) *forecastEndpoint {
+	return &forecastEndpoint{
+		forecaster: forecaster,
+	}
+}
+
+// swagger:operation GET /identities/{id}/forecast/balance Identity forecastBalance
+// ---
+// summary: Returns consumer balance forecast
+// description: Returns how long consumer balance lasts based on session history of the identity
+// parameters:
+// - name: id
+//   in: path
+//   description: hex address of identity
+//   type: string
+//   required: true
+// responses:
+//   200:
+//     description: Balance forecast
+//     schema:
+//       "$ref": "#/definitions/BalanceForecastDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *forecastEndpoint) Balance(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	result, err := e.forecaster.ForecastBalance(identity.FromAddress(params.ByName("id")))
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+	utils.WriteAsJSON(contract.NewBalanceForecastDTO(result), resp)
+}
+
+// swagger:operation GET /identities/{id}/forecast/earnings Identity forecastEarnings
+// ---
+// summary: Returns provider earnings forecast
+// description: Returns projected earnings and the next automatic settlement of the identity
+// parameters:
+// - name: id
+//   in: path
+//   description: hex address of identity
+//   type: string
+//   required: true
+// responses:
+//   200:
+//     description: Earnings forecast
+//     schema:
+//       "$ref": "#/definitions/EarningsForecastDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *forecastEndpoint) Earnings(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	result, err := e.forecaster.ForecastEarnings(identity.FromAddress(params.ByName("id")))
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+	utils.WriteAsJSON(contract.NewEarningsForecastDTO(result), resp)
+}
+
+// AddRoutesForForecast attaches balance and earnings forecast endpoints to router
+func AddRoutesForForecast(router *httprouter.Router, forecaster forecaster) {
+	e := NewForecastEndpoint(forecaster)
+	router.GET("/identities/:id/forecast/balance", e.Balance)
+	router.GET("/identities/:id/forecast/earnings", e.Earnings)
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/core/forecast"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+type mockForecaster struct {
+	balance  forecast.BalanceForecast
+	earnings forecast.EarningsForecast
+	err      error
+	id       identity.Identity
+}
+
+func (m *mockForecaster) ForecastBalance(id identity.Identity) (forecast.BalanceForecast, error) {
+	m.id = id
+	return m.balance, m.err
+}
+
+func (m *mockForecaster) ForecastEarnings(id identity.Identity) (forecast.EarningsForecast, error) {
+	m.id = id
+	return m.earnings, m.err
+}
+
+func Test_ForecastBalance(t *testing.T) {
+	depletedAt := time.Date(2020, 12, 1, 12, 0, 0, 0, time.UTC)
+	forecaster := &mockForecaster{balance: forecast.BalanceForecast{
+		Balance:           big.NewInt(200),
+		Sessions:          2,
+		SpentPerDay:       big.NewInt(20),
+		SpentPerHour:      big.NewInt(50),
+		SpentPerGiB:       big.NewInt(40),
+		ConnectedTimeLeft: 4 * time.Hour,
+		DataLeft:          1024,
+		DepletedAt:        &depletedAt,
+	}}
+	router := httprouter.New()
+	AddRoutesForForecast(router, forecaster)
+
+	req, err := http.NewRequest(http.MethodGet, "/identities/0x000000000000000000000000000000000000000a/forecast/balance", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "0x000000000000000000000000000000000000000a", forecaster.id.Address)
+	assert.JSONEq(t,
+		`{
+			"balance": 200,
+			"sessions": 2,
+			"spent_per_day": 20,
+			"spent_per_hour": 50,
+			"spent_per_gib": 40,
+			"connected_time_left": 14400,
+			"data_left": 1024,
+			"depleted_at": "2020-12-01T12:00:00Z"
+		}`,
+		resp.Body.String(),
+	)
+}
+
+func Test_ForecastEarnings(t *testing.T) {
+	hermesID := common.HexToAddress("0x1")
+	forecaster := &mockForecaster{earnings: forecast.EarningsForecast{
+		EarnedPerDay: big.NewInt(20),
+		Weekly:       big.NewInt(140),
+		Monthly:      big.NewInt(600),
+		Unsettled:    big.NewInt(50),
+		NextSettlement: &forecast.Settlement{
+			HermesID: hermesID,
+			Trigger:  pingpong.SettlementTriggerThreshold,
+			Amount:   big.NewInt(90),
+			At:       time.Date(2020, 12, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}}
+	router := httprouter.New()
+	AddRoutesForForecast(router, forecaster)
+
+	req, err := http.NewRequest(http.MethodGet, "/identities/0x000000000000000000000000000000000000000a/forecast/earnings", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t,
+		`{
+			"earned_per_day": 20,
+			"weekly": 140,
+			"monthly": 600,
+			"unsettled": 50,
+			"next_settlement": {
+				"hermes_id": "`+hermesID.Hex()+`",
+				"trigger": "threshold",
+				"amount": 90,
+				"at": "2020-12-01T12:00:00Z"
+			}
+		}`,
+		resp.Body.String(),
+	)
+}
+
+func Test_ForecastEarnings_Error(t *testing.T) {
+	router := httprouter.New()
+	AddRoutesForForecast(router, &mockForecaster{err: errors.New("storage failure")})
+
+	req, err := http.NewRequest(http.MethodGet, "/identities/0x000000000000000000000000000000000000000a/forecast/earnings", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/julienschmidt/httprouter"
@@ -55,6 +56,8 @@ type providerChannel interface {
 
 type identityMover interface {
 	Import(blob []byte, currPass, newPass string) (identity.Identity, error)
+	Export(address, currPass, newPass string) ([]byte, error)
+	Bundle(address, currPass, passphrase string) ([]byte, error)
 }
 
 type identitiesAPI struct {
@@ -199,7 +202,8 @@ func (endpoint *identitiesAPI) Create(resp http.ResponseWriter, httpReq *http.Re
 // swagger:operation PUT /identities/{id}/unlock Identity unlockIdentity
 // ---
 // summary: Unlocks identity
-// description: Uses passphrase to decrypt identity stored in keystore
+// description: Uses passphrase to decrypt identity stored in keystore.
+//   Identities held by an external signer are only checked to be present in it, passphrase is ignored and timeout is not supported.
 // parameters:
 // - in: path
 //   name: id
@@ -215,7 +219,7 @@ func (endpoint *identitiesAPI) Create(resp http.ResponseWriter, httpReq *http.Re
 //   202:
 //     description: Identity unlocked
 //   400:
-//     description: Body parsing error
+//     description: Body parsing error or timeout is not supported by keystore
 //     schema:
 //       "$ref": "#/definitions/ErrorMessageDTO"
 //   403:
@@ -246,8 +250,17 @@ func (endpoint *identitiesAPI) Unlock(resp http.ResponseWriter, httpReq *http.Re
 		return
 	}
 
+	var timeout time.Duration
+	if req.Timeout != nil {
+		timeout = time.Duration(*req.Timeout) * time.Second
+	}
+
 	chainID := config.GetInt64(config.FlagChainID)
-	err = endpoint.idm.Unlock(chainID, id.Address, *req.Passphrase)
+	err = endpoint.idm.UnlockTimed(chainID, id.Address, *req.Passphrase, timeout)
+	if err == identity.ErrLockUnsupported {
+		utils.SendError(resp, err, http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		utils.SendError(resp, err, http.StatusForbidden)
 		return
@@ -255,6 +268,51 @@ func (endpoint *identitiesAPI) Unlock(resp http.ResponseWriter, httpReq *http.Re
 	resp.WriteHeader(http.StatusAccepted)
 }
 
+// swagger:operation PUT /identities/{id}/lock Identity lockIdentity
+// ---
+// summary: Locks identity
+// description: Removes decrypted identity key from memory and stops services provided by the identity.
+//   Not supported for identities held by an external signer, which keeps keys unlocked on its own.
+// parameters:
+// - in: path
+//   name: id
+//   description: Identity stored in keystore
+//   type: string
+//   required: true
+// responses:
+//   202:
+//     description: Identity locked
+//   400:
+//     description: Locking is not supported by keystore
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   404:
+//     description: Identity not found
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (endpoint *identitiesAPI) Lock(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	id, err := endpoint.idm.GetIdentity(params.ByName("id"))
+	if err != nil {
+		utils.SendError(resp, err, http.StatusNotFound)
+		return
+	}
+
+	err = endpoint.idm.Lock(id.Address)
+	if err == identity.ErrLockUnsupported {
+		utils.SendError(resp, err, http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+	resp.WriteHeader(http.StatusAccepted)
+}
+
 // swagger:operation GET /identities/{id} Identity getIdentity
 // ---
 // summary: Get identity
@@ -365,7 +423,7 @@ func (endpoint *identitiesAPI) RegistrationStatus(resp http.ResponseWriter, _ *h
 	utils.WriteAsJSON(registrationDataDTO, resp)
 }
 
-// swagger:operation GET /identities/{id}/beneficiary Identity beneficiary address
+// swagger:operation GET /identities/{id}/beneficiary Identity beneficiaryAddress
 // ---
 // summary: Provide identity beneficiary address
 // description: Provides beneficiary address for given identity
@@ -398,7 +456,7 @@ func (endpoint *identitiesAPI) Beneficiary(resp http.ResponseWriter, _ *http.Req
 	utils.WriteAsJSON(registrationDataDTO, resp)
 }
 
-// swagger:operation GET /identities/{id}/referral Referral
+// swagger:operation GET /identities/{id}/referral Referral getReferralToken
 // ---
 // summary: Gets referral token
 // description: Gets a referral token for the given identity if a campaign exists
@@ -427,7 +485,7 @@ func (endpoint *identitiesAPI) GetReferralToken(resp http.ResponseWriter, reques
 	}, resp)
 }
 
-// swagger:operation GET /identities/{id}/referral-available Referral availability check
+// swagger:operation GET /identities/{id}/referral-available Referral referralTokenAvailable
 // ---
 // summary: Checks if the user can obtain a referral token
 // description: Verifies user's eligibility and the presence of an applicable public campaign
@@ -453,6 +511,60 @@ func (endpoint *identitiesAPI) ReferralTokenAvailable(resp http.ResponseWriter,
 	}
 }
 
+// swagger:operation POST /identities/{id}/export Identities exportIdentity
+// ---
+// summary: Exports a given identity.
+// description: Exports identity key, or a backup bundle also holding identity registration, beneficiary, Hermes promises and settlement history. Both can be imported back using identity import endpoint.
+// parameters:
+// - in: path
+//   name: id
+//   description: hex address of identity
+//   type: string
+//   required: true
+// - in: body
+//   name: body
+//   description: Passphrases and export type
+//   schema:
+//     $ref: "#/definitions/IdentityExportRequestDTO"
+// responses:
+//   200:
+//     description: Exported identity
+//     schema:
+//       "$ref": "#/definitions/IdentityExportResponseDTO"
+//   400:
+//     description: Bad Request error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (endpoint *identitiesAPI) Export(resp http.ResponseWriter, request *http.Request, params httprouter.Params) {
+	var req contract.IdentityExportRequest
+	if err := json.NewDecoder(request.Body).Decode(&req); err != nil {
+		utils.SendError(resp, err, http.StatusBadRequest)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.SendError(resp, err, http.StatusBadRequest)
+		return
+	}
+
+	var data []byte
+	var err error
+	if req.Bundle {
+		data, err = endpoint.mover.Bundle(params.ByName("id"), req.CurrentPassphrase, req.Passphrase)
+	} else {
+		data, err = endpoint.mover.Export(params.ByName("id"), req.CurrentPassphrase, req.Passphrase)
+	}
+	if err != nil {
+		utils.SendError(resp, fmt.Errorf("failed to export identity: %w", err), http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.IdentityExportResponse{Data: data}, resp)
+}
+
 // swagger:operation POST /identities-import Identities importIdentity
 // ---
 // summary: Imports a given identity.
@@ -540,10 +652,12 @@ func AddRoutesForIdentities(
 	router.GET("/identities/:id", idmEnd.Get)
 	router.GET("/identities/:id/status", idmEnd.Get)
 	router.PUT("/identities/:id/unlock", idmEnd.Unlock)
+	router.PUT("/identities/:id/lock", idmEnd.Lock)
 	router.GET("/identities/:id/registration", idmEnd.RegistrationStatus)
 	router.GET("/identities/:id/beneficiary", idmEnd.Beneficiary)
 	router.GET("/identities/:id/referral", idmEnd.GetReferralToken)
 	router.GET("/identities/:id/referral-available", idmEnd.ReferralTokenAvailable)
+	router.POST("/identities/:id/export", idmEnd.Export)
 
 	router.POST("/identities-import", idmEnd.Import)
 }
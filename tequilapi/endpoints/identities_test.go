@@ -21,7 +21,9 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/julienschmidt/httprouter"
@@ -105,6 +107,41 @@ func TestUnlockIdentitySuccess(t *testing.T) {
 	assert.Equal(t, int64(0), mockIdm.LastUnlockChainID)
 }
 
+func TestUnlockIdentityWithTimeout(t *testing.T) {
+	mockIdm := identity.NewIdentityManagerFake(existingIdentities, newIdentity)
+	resp := httptest.NewRecorder()
+	req, err := http.NewRequest(
+		http.MethodPut,
+		identityUrl,
+		bytes.NewBufferString(`{"passphrase": "mypassphrase", "timeout": 60}`),
+	)
+	params := httprouter.Params{{Key: "id", Value: "0x000000000000000000000000000000000000000a"}}
+	assert.Nil(t, err)
+
+	endpoint := &identitiesAPI{idm: mockIdm}
+	endpoint.Unlock(resp, req, params)
+
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Equal(t, "0x000000000000000000000000000000000000000a", mockIdm.LastUnlockAddress)
+	assert.Equal(t, time.Minute, mockIdm.LastUnlockTimeout)
+}
+
+func TestLockIdentity(t *testing.T) {
+	mockIdm := identity.NewIdentityManagerFake(existingIdentities, newIdentity)
+	endpoint := &identitiesAPI{idm: mockIdm}
+
+	resp := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPut, "/identities/0x000000000000000000000000000000000000000a/lock", nil)
+	assert.Nil(t, err)
+	endpoint.Lock(resp, req, httprouter.Params{{Key: "id", Value: "0x000000000000000000000000000000000000000a"}})
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Equal(t, "0x000000000000000000000000000000000000000a", mockIdm.LastLockAddress)
+
+	resp = httptest.NewRecorder()
+	endpoint.Lock(resp, req, httprouter.Params{{Key: "id", Value: "0x000000000000000000000000000000000000000b"}})
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
 func TestUnlockIdentityWithInvalidJSON(t *testing.T) {
 	mockIdm := identity.NewIdentityManagerFake(existingIdentities, newIdentity)
 	resp := httptest.NewRecorder()
@@ -295,3 +332,49 @@ func (ma *mockAddressProvider) GetActiveHermes(chainID int64) (common.Address, e
 func (ma *mockAddressProvider) GetRegistryAddress(chainID int64) (common.Address, error) {
 	return ma.registryToReturn, nil
 }
+
+type mockIdentityMover struct {
+	exportedWith []string
+}
+
+func (m *mockIdentityMover) Import(blob []byte, currPass, newPass string) (identity.Identity, error) {
+	return identity.FromAddress("0x1"), nil
+}
+
+func (m *mockIdentityMover) Export(address, currPass, newPass string) ([]byte, error) {
+	m.exportedWith = []string{"key", address, currPass, newPass}
+	return []byte("key"), nil
+}
+
+func (m *mockIdentityMover) Bundle(address, currPass, passphrase string) ([]byte, error) {
+	m.exportedWith = []string{"bundle", address, currPass, passphrase}
+	return []byte("bundle"), nil
+}
+
+func Test_IdentityExport(t *testing.T) {
+	mover := &mockIdentityMover{}
+	endpoint := &identitiesAPI{mover: mover}
+	router := httprouter.New()
+	router.POST("/identities/:id/export", endpoint.Export)
+
+	serve := func(body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, "/identities/0x1/export", strings.NewReader(body))
+		assert.NoError(t, err)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := serve(`{}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	resp = serve(`{"passphrase": "new", "current_passphrase": "old"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []string{"key", "0x1", "old", "new"}, mover.exportedWith)
+	assert.JSONEq(t, `{"data":"a2V5"}`, resp.Body.String())
+
+	resp = serve(`{"passphrase": "new", "bundle": true}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []string{"bundle", "0x1", "", "new"}, mover.exportedWith)
+	assert.JSONEq(t, `{"data":"YnVuZGxl"}`, resp.Body.String())
+}
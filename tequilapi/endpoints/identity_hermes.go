@@ -0,0 +1,183 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package endpoints
+
+import (
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/julienschmidt/httprouter"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/config"
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type hermesChannelLister interface {
+	List(chainID int64) []pingpong.HermesChannel
+}
+
+type hermesStatusProvider interface {
+	GetHermesStatus(chainID int64, registryAddress, hermesID common.Address) (pingpong.HermesStatus, error)
+}
+
+type registryAddressProvider interface {
+	GetRegistryAddress(chainID int64) (common.Address, error)
+}
+
+type consumerTotalsGetter interface {
+	Get(chainID int64, id identity.Identity, hermesID common.Address) (*big.Int, error)
+}
+
+type identityHermesEndpoint struct {
+	channels        hermesChannelLister
+	statusProvider  hermesStatusProvider
+	addressProvider registryAddressProvider
+	consumerTotals  consumerTotalsGetter
+	knownHermes     []string
+}
+
+// NewIdentityHermesEndpoint creates and returns identity per Hermes accounting endpoint
+func NewIdentityHermesEndpoint(
+	channels hermesChannelLister,
+	statusProvider hermesStatusProvider,
+	addressProvider registryAddressProvider,
+	consumerTotals consumerTotalsGetter,
+	knownHermes []string,
+) *identityHermesEndpoint {
+	return &identityHermesEndpoint{
+		channels:        channels,
+		statusProvider:  statusProvider,
+		addressProvider: addressProvider,
+		consumerTotals:  consumerTotals,
+		knownHermes:     knownHermes,
+	}
+}
+
+// swagger:operation GET /identities/{id}/hermes Identity identityHermes
+// ---
+// summary: Returns identity accounting per Hermes
+// description: Returns balances, channels and promised totals of the identity with every known Hermes
+// parameters:
+// - name: id
+//   in: path
+//   description: hex address of identity
+//   type: string
+//   required: true
+// responses:
+//   200:
+//     description: Identity accounting per Hermes
+//     schema:
+//       "$ref": "#/definitions/IdentityHermesListDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *identityHermesEndpoint) List(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	chainID := config.GetInt64(config.FlagChainID)
+	id := identity.FromAddress(params.ByName("id"))
+
+	registry, err := e.addressProvider.GetRegistryAddress(chainID)
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	hermesIDs := make([]common.Address, 0, len(e.knownHermes))
+	channels := make(map[common.Address]pingpong.HermesChannel)
+	for _, hex := range e.knownHermes {
+		hermesIDs = appendHermes(hermesIDs, common.HexToAddress(hex))
+	}
+	for _, ch := range e.channels.List(chainID) {
+		if !strings.EqualFold(ch.Identity.Address, id.Address) {
+			continue
+		}
+		hermesIDs = appendHermes(hermesIDs, ch.HermesID)
+		channels[ch.HermesID] = ch
+	}
+
+	result := contract.IdentityHermesListDTO{Hermes: make([]contract.IdentityHermesDTO, 0, len(hermesIDs))}
+	for _, hermesID := range hermesIDs {
+		dto := contract.IdentityHermesDTO{
+			HermesID:          hermesID.Hex(),
+			Earnings:          new(big.Int),
+			EarningsUnsettled: new(big.Int),
+			Stake:             new(big.Int),
+			Settled:           new(big.Int),
+			Promised:          new(big.Int),
+		}
+
+		status, err := e.statusProvider.GetHermesStatus(chainID, registry, hermesID)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Could not get hermes %s status", hermesID.Hex())
+		} else {
+			dto.Active = status.IsActive
+			dto.Fee = status.Fee
+		}
+
+		if ch, ok := channels[hermesID]; ok {
+			dto.ChannelID = ch.ChannelID
+			dto.Earnings = ch.LifetimeBalance()
+			dto.EarningsUnsettled = ch.UnsettledBalance()
+			if ch.Channel.Stake != nil {
+				dto.Stake = ch.Channel.Stake
+			}
+			if ch.Channel.Settled != nil {
+				dto.Settled = ch.Channel.Settled
+			}
+		}
+
+		promised, err := e.consumerTotals.Get(chainID, id, hermesID)
+		if err == nil {
+			dto.Promised = promised
+		} else if err != pingpong.ErrNotFound {
+			utils.SendError(resp, err, http.StatusInternalServerError)
+			return
+		}
+
+		result.Hermes = append(result.Hermes, dto)
+	}
+
+	utils.WriteAsJSON(result, resp)
+}
+
+func appendHermes(hermesIDs []common.Address, hermesID common.Address) []common.Address {
+	for _, known := range hermesIDs {
+		if known == hermesID {
+			return hermesIDs
+		}
+	}
+	return append(hermesIDs, hermesID)
+}
+
+// AddRoutesForIdentityHermes attaches identity per Hermes accounting endpoints to router
+func AddRoutesForIdentityHermes(
+	router *httprouter.Router,
+	channels hermesChannelLister,
+	statusProvider hermesStatusProvider,
+	addressProvider registryAddressProvider,
+	consumerTotals consumerTotalsGetter,
+	knownHermes []string,
+) {
+	e := NewIdentityHermesEndpoint(channels, statusProvider, addressProvider, consumerTotals, knownHermes)
+	router.GET("/identities/:id/hermes", e.List)
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package endpoints
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/julienschmidt/httprouter"
+	"github.com/mysteriumnetwork/payments/client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+type mockHermesChannelLister struct {
+	channels []pingpong.HermesChannel
+}
+
+func (m *mockHermesChannelLister) List(chainID int64) []pingpong.HermesChannel {
+	return m.channels
+}
+
+type mockHermesStatusProvider struct {
+	statuses map[common.Address]pingpong.HermesStatus
+}
+
+func (m *mockHermesStatusProvider) GetHermesStatus(chainID int64, registryAddress, hermesID common.Address) (pingpong.HermesStatus, error) {
+	status, ok := m.statuses[hermesID]
+	if !ok {
+		return pingpong.HermesStatus{}, errors.New("unknown hermes")
+	}
+	return status, nil
+}
+
+type mockRegistryAddressProvider struct{}
+
+func (m *mockRegistryAddressProvider) GetRegistryAddress(chainID int64) (common.Address, error) {
+	return common.Address{}, nil
+}
+
+type mockConsumerTotalsGetter struct {
+	totals map[common.Address]*big.Int
+}
+
+func (m *mockConsumerTotalsGetter) Get(chainID int64, id identity.Identity, hermesID common.Address) (*big.Int, error) {
+	total, ok := m.totals[hermesID]
+	if !ok {
+		return nil, pingpong.ErrNotFound
+	}
+	return total, nil
+}
+
+func Test_IdentityHermes(t *testing.T) {
+	hermes1 := common.HexToAddress("0x1")
+	hermes2 := common.HexToAddress("0x2")
+	hermes3 := common.HexToAddress("0x3")
+	id := identity.FromAddress("0x000000000000000000000000000000000000000a")
+
+	router := httprouter.New()
+	AddRoutesForIdentityHermes(
+		router,
+		&mockHermesChannelLister{channels: []pingpong.HermesChannel{
+			{ChannelID: "ch2", Identity: id, HermesID: hermes2, Channel: client.ProviderChannel{Stake: big.NewInt(5), Settled: big.NewInt(3)}},
+			{ChannelID: "other", Identity: identity.FromAddress("0xb"), HermesID: hermes3},
+		}},
+		&mockHermesStatusProvider{statuses: map[common.Address]pingpong.HermesStatus{
+			hermes1: {IsActive: true, Fee: 2000},
+			hermes2: {IsActive: true, Fee: 1000},
+		}},
+		&mockRegistryAddressProvider{},
+		&mockConsumerTotalsGetter{totals: map[common.Address]*big.Int{hermes1: big.NewInt(7)}},
+		[]string{hermes1.Hex()},
+	)
+
+	req, err := http.NewRequest(http.MethodGet, "/identities/"+id.Address+"/hermes", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t,
+		`{
+			"hermes": [
+				{
+					"hermes_id": "`+hermes1.Hex()+`",
+					"active": true,
+					"fee": 2000,
+					"earnings": 0,
+					"earnings_unsettled": 0,
+					"stake": 0,
+					"settled": 0,
+					"promised": 7
+				},
+				{
+					"hermes_id": "`+hermes2.Hex()+`",
+					"active": true,
+					"fee": 1000,
+					"channel_id": "ch2",
+					"earnings": 0,
+					"earnings_unsettled": 0,
+					"stake": 5,
+					"settled": 3,
+					"promised": 0
+				}
+			]
+		}`,
+		resp.Body.String(),
+	)
+}
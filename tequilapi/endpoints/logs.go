@@ -0,0 +1,186 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/logconfig"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type logControl interface {
+	LogLevel() zerolog.Level
+	SetLogLevel(level zerolog.Level)
+	ComponentLogLevels() map[string]zerolog.Level
+	SetComponentLogLevel(component string, level zerolog.Level)
+	ResetComponentLogLevel(component string)
+	SubscribeLogs(filter logconfig.LogFilter) *logconfig.LogSubscription
+}
+
+type logsEndpoint struct {
+	control logControl
+}
+
+// NewLogsEndpoint creates and returns logs endpoint
+func NewLogsEndpoint(control logControl) *logsEndpoint {
+	return &logsEndpoint{
+		control: control,
+	}
+}
+
+// swagger:operation GET /logs/levels Logs getLogLevels
+// ---
+// summary: Returns log levels
+// description: Returns global log level and its overrides for components
+// responses:
+//   200:
+//     description: Log levels
+//     schema:
+//       "$ref": "#/definitions/LogLevelsDTO"
+func (e *logsEndpoint) Levels(resp http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+	utils.WriteAsJSON(contract.NewLogLevelsDTO(e.control.LogLevel(), e.control.ComponentLogLevels()), resp)
+}
+
+// swagger:operation PUT /logs/levels Logs setLogLevels
+// ---
+// summary: Changes log levels
+// description: Changes global log level and overrides it for the given components (package paths), until the node is restarted
+// parameters:
+//   - in: body
+//     name: body
+//     schema:
+//       $ref: "#/definitions/LogLevelsRequest"
+// responses:
+//   200:
+//     description: Log levels after the change
+//     schema:
+//       "$ref": "#/definitions/LogLevelsDTO"
+//   400:
+//     description: Body parsing error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   422:
+//     description: Parameters validation error
+//     schema:
+//       "$ref": "#/definitions/ValidationErrorDTO"
+func (e *logsEndpoint) SetLevels(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	var lr contract.LogLevelsRequest
+	if err := json.NewDecoder(req.Body).Decode(&lr); err != nil {
+		utils.SendError(resp, fmt.Errorf("failed to parse log levels request: %w", err), http.StatusBadRequest)
+		return
+	}
+	if errorMap := lr.Validate(); errorMap.HasErrors() {
+		utils.SendValidationErrorMessage(resp, errorMap)
+		return
+	}
+
+	if lr.Level != "" {
+		level, _ := contract.ParseLogLevel(lr.Level)
+		e.control.SetLogLevel(level)
+	}
+	for component, levelName := range lr.Components {
+		component = strings.Trim(strings.TrimSpace(component), "/")
+		if levelName == "" {
+			e.control.ResetComponentLogLevel(component)
+			continue
+		}
+		level, _ := contract.ParseLogLevel(levelName)
+		e.control.SetComponentLogLevel(component, level)
+	}
+	log.Info().Msgf("Log levels changed: %s %v", e.control.LogLevel(), e.control.ComponentLogLevels())
+
+	utils.WriteAsJSON(contract.NewLogLevelsDTO(e.control.LogLevel(), e.control.ComponentLogLevels()), resp)
+}
+
+// swagger:operation GET /logs/stream Logs logStream
+// ---
+// summary: Streams logs
+// description: Streams log events as they are written, one JSON object per line, until the client disconnects. Only events passing the current log levels are streamed.
+// produces:
+// - application/x-ndjson
+// responses:
+//   200:
+//     description: Stream of log events
+//   422:
+//     description: Parameters validation error
+//     schema:
+//       "$ref": "#/definitions/ValidationErrorDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *logsEndpoint) Stream(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	query := contract.LogStreamQuery{}
+	if errs := query.Bind(req); errs.HasErrors() {
+		utils.SendValidationErrorMessage(resp, errs)
+		return
+	}
+
+	flusher, ok := resp.(http.Flusher)
+	if !ok {
+		utils.SendErrorMessage(resp, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	filter := logconfig.LogFilter{Level: query.MinLevel()}
+	if query.Component != nil {
+		filter.Component = strings.Trim(*query.Component, "/")
+	}
+	sub := e.control.SubscribeLogs(filter)
+	defer sub.Close()
+
+	resp.Header().Set("Content-Type", "application/x-ndjson")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var reported uint64
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case event := <-sub.Events():
+			if dropped := sub.Dropped(); dropped > reported {
+				if _, err := fmt.Fprintf(resp, `{"level":"warn","message":"%d log events dropped, client is too slow"}`+"\n", dropped-reported); err != nil {
+					return
+				}
+				reported = dropped
+			}
+			if _, err := resp.Write(event); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// AddRoutesForLogs attaches log level and streaming endpoints to router
+func AddRoutesForLogs(router *httprouter.Router, control logControl) {
+	e := NewLogsEndpoint(control)
+	router.GET("/logs/levels", e.Levels)
+	router.PUT("/logs/levels", e.SetLevels)
+	router.GET("/logs/stream", e.Stream)
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package endpoints
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/logconfig"
+)
+
+type mockLogControl struct {
+	logconfig.LogControl
+	level      zerolog.Level
+	components map[string]zerolog.Level
+}
+
+func (m *mockLogControl) LogLevel() zerolog.Level {
+	return m.level
+}
+
+func (m *mockLogControl) SetLogLevel(level zerolog.Level) {
+	m.level = level
+}
+
+func (m *mockLogControl) ComponentLogLevels() map[string]zerolog.Level {
+	return m.components
+}
+
+func (m *mockLogControl) SetComponentLogLevel(component string, level zerolog.Level) {
+	m.components[component] = level
+}
+
+func (m *mockLogControl) ResetComponentLogLevel(component string) {
+	delete(m.components, component)
+}
+
+func Test_LogLevels(t *testing.T) {
+	router := httprouter.New()
+	control := &mockLogControl{level: zerolog.InfoLevel, components: map[string]zerolog.Level{"p2p": zerolog.ErrorLevel}}
+	AddRoutesForLogs(router, control)
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, "/logs/levels", strings.NewReader(body))
+		assert.NoError(t, err)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := serve(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"level":"info","components":{"p2p":"error"}}`, resp.Body.String())
+
+	resp = serve(http.MethodPut, `{"level":"warn","components":{"/session/pingpong":"trace","p2p":""}}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"level":"warn","components":{"session/pingpong":"trace"}}`, resp.Body.String())
+
+	resp = serve(http.MethodPut, `{"level":"loud","components":{"p2p":"quiet"}}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"level"`)
+	assert.Contains(t, resp.Body.String(), `"components"`)
+	assert.Equal(t, zerolog.WarnLevel, control.level)
+
+	resp = serve(http.MethodPut, `{`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func Test_LogStream(t *testing.T) {
+	// given
+	original := log.Logger
+	defer func() { log.Logger = original }()
+	logconfig.Bootstrap()
+
+	router := httprouter.New()
+	AddRoutesForLogs(router, logconfig.LogControl{})
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/logs/stream?level=loud")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/logs/stream?level=warn")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+	// when
+	log.Info().Msg("streamed info")
+	log.Warn().Msg("streamed warning")
+
+	// then
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	assert.NoError(t, err)
+	assert.Contains(t, line, `"level":"warn"`)
+	assert.Contains(t, line, `"message":"streamed warning"`)
+}
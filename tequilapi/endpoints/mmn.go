@@ -50,7 +50,7 @@ func newMMNAPI(config mmnProvider, client *mmn.MMN) *mmnAPI {
 }
 
 // GetNodeReport returns node report from MMN
-// swagger:operation GET /mmn/node-report MMN getNodeReport
+// swagger:operation GET /mmn/report MMN getNodeReport
 // ---
 // summary: Returns node report from MMN
 // description: Returns node report from MMN
@@ -73,7 +73,7 @@ func (api *mmnAPI) GetNodeReport(writer http.ResponseWriter, httpReq *http.Reque
 }
 
 // GetApiKey returns MMN's API key
-// swagger:operation GET /mmn/report MMN getApiKey
+// swagger:operation GET /mmn/api-key MMN getApiKey
 // ---
 // summary: returns MMN's API key
 // description: returns MMN's API key
@@ -113,7 +113,6 @@ func (api *mmnAPI) GetApiKey(writer http.ResponseWriter, httpReq *http.Request,
 //     description: Internal server error
 //     schema:
 //       "$ref": "#/definitions/ErrorMessageDTO"
-
 func (api *mmnAPI) SetApiKey(writer http.ResponseWriter, httpReq *http.Request, params httprouter.Params) {
 	var req contract.MMNApiKeyRequest
 	err := json.NewDecoder(httpReq.Body).Decode(&req)
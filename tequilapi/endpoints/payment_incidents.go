@@ -0,0 +1,167 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"encoding/csv"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type paymentIncidentStorage interface {
+	List(filter pingpong.PaymentIncidentFilter) ([]pingpong.PaymentIncident, error)
+	Unblock(offender identity.Identity) error
+}
+
+type paymentIncidentsEndpoint struct {
+	storage paymentIncidentStorage
+}
+
+// NewPaymentIncidentsEndpoint creates and returns payment incidents endpoint
+func NewPaymentIncidentsEndpoint(storage paymentIncidentStorage) *paymentIncidentsEndpoint {
+	return &paymentIncidentsEndpoint{
+		storage: storage,
+	}
+}
+
+// swagger:operation GET /payments/incidents Payments paymentIncidents
+// ---
+// summary: Returns payment incidents
+// description: Returns overcharges, underpayments and invalid promises recorded by both consumer and provider, latest first
+// responses:
+//   200:
+//     description: Payment incidents
+//     schema:
+//       "$ref": "#/definitions/PaymentIncidentListDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *paymentIncidentsEndpoint) List(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	query := contract.PaymentIncidentQuery{}
+	query.Bind(req)
+
+	incidents, err := e.storage.List(query.ToFilter())
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.NewPaymentIncidentListDTO(incidents), resp)
+}
+
+// swagger:operation GET /payments/incidents/export Payments exportPaymentIncidents
+// ---
+// summary: Exports payment incidents
+// description: Returns payment incidents as a CSV file
+// produces:
+// - text/csv
+// responses:
+//   200:
+//     description: Payment incidents in CSV format
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *paymentIncidentsEndpoint) Export(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	query := contract.PaymentIncidentQuery{}
+	query.Bind(req)
+
+	incidents, err := e.storage.List(query.ToFilter())
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "text/csv")
+	resp.Header().Set("Content-Disposition", `attachment; filename="payment-incidents.csv"`)
+
+	w := csv.NewWriter(resp)
+	records := [][]string{{
+		"id", "created_at", "kind", "direction", "session_id", "offender", "provider_id", "consumer_id",
+		"hermes_id", "expected", "actual", "error", "invoice_hashlock", "promise_amount", "message_signature",
+	}}
+	for _, incident := range incidents {
+		dto := contract.NewPaymentIncidentDTO(incident)
+		var hashlock, promised, signature string
+		if dto.Invoice != nil {
+			hashlock = dto.Invoice.Hashlock
+		}
+		if dto.ExchangeMessage != nil {
+			promised = bigString(dto.ExchangeMessage.Promise.Amount)
+			signature = dto.ExchangeMessage.Signature
+		}
+		records = append(records, []string{
+			strconv.Itoa(dto.ID), dto.CreatedAt, dto.Kind, dto.Direction, dto.SessionID, dto.Offender, dto.ProviderID, dto.ConsumerID,
+			dto.HermesID, bigString(dto.Expected), bigString(dto.Actual), dto.Error, hashlock, promised, signature,
+		})
+	}
+	if err := w.WriteAll(records); err != nil {
+		log.Error().Err(err).Msg("Failed to write payment incidents")
+	}
+}
+
+// swagger:operation PUT /payments/incidents/offenders/{id}/unblock Payments unblockPaymentIncidentOffender
+// ---
+// summary: Unblocks payment incident offender
+// description: Forgives payment incidents caused by the given peer so far, so it is no longer blocked because of them. Incidents stay in history.
+// parameters:
+// - name: id
+//   in: path
+//   description: Offender identity address
+//   type: string
+//   required: true
+// responses:
+//   202:
+//     description: Offender unblocked
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *paymentIncidentsEndpoint) Unblock(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	if err := e.storage.Unblock(identity.FromAddress(params.ByName("id"))); err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp.WriteHeader(http.StatusAccepted)
+}
+
+func bigString(v *big.Int) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
+
+// AddRoutesForPaymentIncidents attaches payment incidents endpoints to router
+func AddRoutesForPaymentIncidents(router *httprouter.Router, storage paymentIncidentStorage) {
+	e := NewPaymentIncidentsEndpoint(storage)
+	router.GET("/payments/incidents", e.List)
+	router.GET("/payments/incidents/export", e.Export)
+	router.PUT("/payments/incidents/offenders/:id/unblock", e.Unblock)
+}
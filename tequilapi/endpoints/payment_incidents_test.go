@@ -0,0 +1,110 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+type mockPaymentIncidentStorage struct {
+	incidents    []pingpong.PaymentIncident
+	calledFilter pingpong.PaymentIncidentFilter
+	unblocked    []identity.Identity
+}
+
+func (m *mockPaymentIncidentStorage) List(filter pingpong.PaymentIncidentFilter) ([]pingpong.PaymentIncident, error) {
+	m.calledFilter = filter
+	return m.incidents, nil
+}
+
+func (m *mockPaymentIncidentStorage) Unblock(offender identity.Identity) error {
+	m.unblocked = append(m.unblocked, offender)
+	return nil
+}
+
+func Test_PaymentIncidents(t *testing.T) {
+	router := httprouter.New()
+	storage := &mockPaymentIncidentStorage{incidents: []pingpong.PaymentIncident{{
+		ID:         1,
+		Time:       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Kind:       pingpong.IncidentProviderOvercharge,
+		Direction:  "Consumed",
+		SessionID:  "s1",
+		Offender:   "0x2",
+		ProviderID: identity.FromAddress("0x2"),
+		ConsumerID: identity.FromAddress("0x1"),
+		Error:      "provider is overcharging",
+		Expected:   big.NewInt(10),
+		Actual:     big.NewInt(20),
+	}}}
+	AddRoutesForPaymentIncidents(router, storage)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		assert.NoError(t, err)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := serve("/payments/incidents?offender=0x2&kind=provider_overcharge")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "0x2", storage.calledFilter.Offender.Address)
+	assert.Equal(t, pingpong.IncidentProviderOvercharge, *storage.calledFilter.Kind)
+	assert.Nil(t, storage.calledFilter.SessionID)
+	assert.JSONEq(t, `{"incidents": [{
+		"id": 1,
+		"kind": "provider_overcharge",
+		"direction": "Consumed",
+		"session_id": "s1",
+		"offender": "0x2",
+		"provider_id": "0x2",
+		"consumer_id": "0x1",
+		"hermes_id": "0x0000000000000000000000000000000000000000",
+		"error": "provider is overcharging",
+		"expected": 10,
+		"actual": 20,
+		"created_at": "2020-01-01T00:00:00Z"
+	}]}`, resp.Body.String())
+
+	resp = serve("/payments/incidents/export?session_id=s1")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "s1", *storage.calledFilter.SessionID)
+	assert.Equal(t, "text/csv", resp.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Equal(t, "1,2020-01-01T00:00:00Z,provider_overcharge,Consumed,s1,0x2,0x2,0x1,0x0000000000000000000000000000000000000000,10,20,provider is overcharging,,,", lines[1])
+
+	req, err := http.NewRequest(http.MethodPut, "/payments/incidents/offenders/0x2/unblock", nil)
+	assert.NoError(t, err)
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Equal(t, []identity.Identity{identity.FromAddress("0x2")}, storage.unblocked)
+}
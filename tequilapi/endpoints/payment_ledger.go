@@ -0,0 +1,117 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type paymentLedgerStorage interface {
+	List(filter pingpong.ConsumerLedgerFilter) ([]pingpong.ConsumerLedgerEntry, error)
+}
+
+type paymentLedgerVerifier interface {
+	Verify(entries []pingpong.ConsumerLedgerEntry) pingpong.LedgerReport
+	VerifyTotals(entries []pingpong.ConsumerLedgerEntry) pingpong.LedgerReport
+}
+
+type paymentLedgerEndpoint struct {
+	storage  paymentLedgerStorage
+	verifier paymentLedgerVerifier
+}
+
+// NewPaymentLedgerEndpoint creates and returns payment ledger endpoint
+func NewPaymentLedgerEndpoint(storage paymentLedgerStorage, verifier paymentLedgerVerifier) *paymentLedgerEndpoint {
+	return &paymentLedgerEndpoint{
+		storage:  storage,
+		verifier: verifier,
+	}
+}
+
+// swagger:operation GET /payments/ledger Payments paymentLedger
+// ---
+// summary: Returns consumer payment ledger
+// description: Returns every invoice received by consumer together with exchange message signed to pay it
+// responses:
+//   200:
+//     description: Payment ledger
+//     schema:
+//       "$ref": "#/definitions/PaymentLedgerDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *paymentLedgerEndpoint) List(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	query := contract.PaymentLedgerQuery{}
+	query.Bind(req)
+
+	entries, err := e.storage.List(query.ToFilter())
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.NewPaymentLedgerDTO(entries), resp)
+}
+
+// swagger:operation GET /payments/ledger/verify Payments verifyPaymentLedger
+// ---
+// summary: Verifies consumer payment ledger
+// description: Checks signatures of recorded exchange messages and that promised amounts match invoices.
+//   When only consumer_id is given the latest promise is also compared with consumer grand total.
+// responses:
+//   200:
+//     description: Verification report
+//     schema:
+//       "$ref": "#/definitions/PaymentLedgerReportDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *paymentLedgerEndpoint) Verify(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	query := contract.PaymentLedgerQuery{}
+	query.Bind(req)
+
+	entries, err := e.storage.List(query.ToFilter())
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	var report pingpong.LedgerReport
+	if query.ConsumerID != nil && query.SessionID == nil {
+		report = e.verifier.VerifyTotals(entries)
+	} else {
+		report = e.verifier.Verify(entries)
+	}
+
+	utils.WriteAsJSON(contract.NewPaymentLedgerReportDTO(report), resp)
+}
+
+// AddRoutesForPaymentLedger attaches payment ledger endpoints to router
+func AddRoutesForPaymentLedger(router *httprouter.Router, storage paymentLedgerStorage, verifier paymentLedgerVerifier) {
+	e := NewPaymentLedgerEndpoint(storage, verifier)
+	router.GET("/payments/ledger", e.List)
+	router.GET("/payments/ledger/verify", e.Verify)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+type mockPaymentLedgerStorage struct {
+	entries      []pingpong.ConsumerLedgerEntry
+	calledFilter pingpong.ConsumerLedgerFilter
+}
+
+func (m *mockPaymentLedgerStorage) List(filter pingpong.ConsumerLedgerFilter) ([]pingpong.ConsumerLedgerEntry, error) {
+	m.calledFilter = filter
+	return m.entries, nil
+}
+
+type mockPaymentLedgerVerifier struct {
+	totalsVerified bool
+}
+
+func (m *mockPaymentLedgerVerifier) Verify(entries []pingpong.ConsumerLedgerEntry) pingpong.LedgerReport {
+	m.totalsVerified = false
+	return pingpong.LedgerReport{Entries: len(entries), Invoiced: big.NewInt(10), Promised: big.NewInt(10)}
+}
+
+func (m *mockPaymentLedgerVerifier) VerifyTotals(entries []pingpong.ConsumerLedgerEntry) pingpong.LedgerReport {
+	m.totalsVerified = true
+	return pingpong.LedgerReport{
+		Entries:  len(entries),
+		Invoiced: big.NewInt(10),
+		Promised: big.NewInt(10),
+		Problems: []pingpong.LedgerProblem{{EntryID: 1, SessionID: "s1", Reason: "bad"}},
+	}
+}
+
+func Test_PaymentLedger(t *testing.T) {
+	router := httprouter.New()
+	storage := &mockPaymentLedgerStorage{entries: []pingpong.ConsumerLedgerEntry{{ID: 1, SessionID: "s1", Promised: big.NewInt(10)}}}
+	verifier := &mockPaymentLedgerVerifier{}
+	AddRoutesForPaymentLedger(router, storage, verifier)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		assert.NoError(t, err)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := serve("/payments/ledger?session_id=s1")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "s1", *storage.calledFilter.SessionID)
+	assert.Nil(t, storage.calledFilter.ConsumerID)
+	assert.Contains(t, resp.Body.String(), `"session_id":"s1"`)
+	assert.Contains(t, resp.Body.String(), `"promised":10`)
+
+	resp = serve("/payments/ledger/verify?session_id=s1&consumer_id=0x1")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.False(t, verifier.totalsVerified)
+	assert.JSONEq(t, `{"entries": 1, "invoiced": 10, "promised": 10, "valid": true, "problems": []}`, resp.Body.String())
+
+	resp = serve("/payments/ledger/verify?consumer_id=0x1")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, verifier.totalsVerified)
+	assert.Equal(t, "0x1", storage.calledFilter.ConsumerID.Address)
+	assert.JSONEq(t, `{"entries": 1, "invoiced": 10, "promised": 10, "valid": false, "problems": [{"entry_id": 1, "session_id": "s1", "reason": "bad"}]}`, resp.Body.String())
+}
@@ -25,37 +25,11 @@ import (
 
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/market/mysterium"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
 	"github.com/mysteriumnetwork/node/tequilapi/utils"
 	"github.com/mysteriumnetwork/node/tequilapi/validation"
 )
 
-// swagger:model PayoutInfoDTO
-type payoutInfo struct {
-	// in Ethereum address format
-	// required: true
-	// example: 0x000000000000000000000000000000000000000a
-	EthAddress string `json:"eth_address"`
-}
-
-// swagger:model ReferralInfoDTO
-type referralInfo struct {
-	// required: true
-	// example: ABC123
-	ReferralCode string `json:"referral_code"`
-}
-
-// swagger:model EmailInfoDTO
-type emailInfo struct {
-	// required: true
-	Email string `json:"email"`
-}
-
-type payoutInfoResponse struct {
-	EthAddress   string `json:"eth_address"`
-	ReferralCode string `json:"referral_code"`
-	Email        string `json:"email"`
-}
-
 // PayoutInfoRegistry allows to register payout info
 type PayoutInfoRegistry interface {
 	GetPayoutInfo(id identity.Identity, signer identity.Signer) (*mysterium.PayoutInfoResponse, error)
@@ -75,6 +49,25 @@ func NewPayoutEndpoint(idm identity.Manager, signerFactory identity.SignerFactor
 	return &payoutEndpoint{idm, signerFactory, payoutInfoRegistry}
 }
 
+// swagger:operation GET /identities/{id}/payout Identity getPayoutInfo
+// ---
+// summary: Returns payout info
+// description: Returns payout address, referral code and email registered for identity
+// parameters:
+// - name: id
+//   in: path
+//   description: Identity stored in keystore
+//   type: string
+//   required: true
+// responses:
+//   200:
+//     description: Payout info
+//     schema:
+//       "$ref": "#/definitions/PayoutInfoResponse"
+//   404:
+//     description: Payout info not found
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
 func (endpoint *payoutEndpoint) GetPayoutInfo(resp http.ResponseWriter, request *http.Request, params httprouter.Params) {
 	id := identity.FromAddress(params.ByName("id"))
 	payoutInfo, err := endpoint.payoutInfoRegistry.GetPayoutInfo(id, endpoint.signerFactory(id))
@@ -83,7 +76,7 @@ func (endpoint *payoutEndpoint) GetPayoutInfo(resp http.ResponseWriter, request
 		return
 	}
 
-	response := &payoutInfoResponse{
+	response := &contract.PayoutInfoResponse{
 		EthAddress:   payoutInfo.EthAddress,
 		ReferralCode: payoutInfo.ReferralCode,
 		Email:        payoutInfo.Email,
@@ -245,25 +238,25 @@ func (endpoint *payoutEndpoint) UpdateEmail(resp http.ResponseWriter, request *h
 	resp.WriteHeader(http.StatusOK)
 }
 
-func toPayoutInfoRequest(req *http.Request) (*payoutInfo, error) {
-	var payoutReq = &payoutInfo{}
+func toPayoutInfoRequest(req *http.Request) (*contract.PayoutInfoDTO, error) {
+	var payoutReq = &contract.PayoutInfoDTO{}
 	err := json.NewDecoder(req.Body).Decode(&payoutReq)
 	return payoutReq, err
 }
 
-func toEmailRequest(req *http.Request) (*emailInfo, error) {
-	var referralReq = &emailInfo{}
+func toEmailRequest(req *http.Request) (*contract.EmailInfoDTO, error) {
+	var referralReq = &contract.EmailInfoDTO{}
 	err := json.NewDecoder(req.Body).Decode(&referralReq)
 	return referralReq, err
 }
 
-func toReferralInfoRequest(req *http.Request) (*referralInfo, error) {
-	var referralReq = &referralInfo{}
+func toReferralInfoRequest(req *http.Request) (*contract.ReferralInfoDTO, error) {
+	var referralReq = &contract.ReferralInfoDTO{}
 	err := json.NewDecoder(req.Body).Decode(&referralReq)
 	return referralReq, err
 }
 
-func validatePayoutInfoRequest(req *payoutInfo) (errors *validation.FieldErrorMap) {
+func validatePayoutInfoRequest(req *contract.PayoutInfoDTO) (errors *validation.FieldErrorMap) {
 	errors = validation.NewErrorMap()
 	if req.EthAddress == "" {
 		errors.ForField("eth_address").AddError("required", "Field is required")
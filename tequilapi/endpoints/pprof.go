@@ -30,6 +30,21 @@ func AddRoutesForPProf(router *httprouter.Router) {
 	router.GET("/debug/pprof/:profile", pprofHandler)
 }
 
+// swagger:operation GET /debug/pprof/{profile} Debug pprof
+// ---
+// summary: Returns runtime profiling data
+// description: Serves runtime profiling data in the format expected by the pprof visualization tool, index of profiles is served when profile is not given
+// parameters:
+// - name: profile
+//   in: path
+//   description: Name of the profile, e.g. heap, goroutine, profile or trace
+//   type: string
+// produces:
+// - text/plain
+// - application/octet-stream
+// responses:
+//   200:
+//     description: Profiling data
 func pprofHandler(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
 	switch params.ByName("profile") {
 	case "cmdline":
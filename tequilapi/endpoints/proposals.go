@@ -20,12 +20,15 @@ package endpoints
 import (
 	"math/big"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 
 	"github.com/mysteriumnetwork/node/core/discovery/proposal"
 	"github.com/mysteriumnetwork/node/core/quality"
+	"github.com/mysteriumnetwork/node/core/speedtest"
 	"github.com/mysteriumnetwork/node/tequilapi/contract"
 	"github.com/mysteriumnetwork/node/tequilapi/utils"
 )
@@ -35,16 +38,23 @@ type QualityFinder interface {
 	ProposalsQuality() []quality.ProposalQuality
 }
 
+// SpeedTestFinder allows to fetch latest local speed test results
+type SpeedTestFinder interface {
+	Latest() (map[string]speedtest.Result, error)
+}
+
 type proposalsEndpoint struct {
 	proposalRepository proposal.Repository
 	qualityProvider    QualityFinder
+	speedTestProvider  SpeedTestFinder
 }
 
 // NewProposalsEndpoint creates and returns proposal creation endpoint
-func NewProposalsEndpoint(proposalRepository proposal.Repository, qualityProvider QualityFinder) *proposalsEndpoint {
+func NewProposalsEndpoint(proposalRepository proposal.Repository, qualityProvider QualityFinder, speedTestProvider SpeedTestFinder) *proposalsEndpoint {
 	return &proposalsEndpoint{
 		proposalRepository: proposalRepository,
 		qualityProvider:    qualityProvider,
+		speedTestProvider:  speedTestProvider,
 	}
 }
 
@@ -81,6 +91,10 @@ func NewProposalsEndpoint(proposalRepository proposal.Repository, qualityProvide
 //     name: location_country
 //     description: If given will filter proposals by node location country.
 //     type: string
+//   - in: query
+//     name: sort_by
+//     description: If set to "speed", proposals are ordered by locally measured download speed, untested proposals go last. Speed test results are included in response.
+//     type: string
 // responses:
 //   200:
 //     description: List of proposals
@@ -143,10 +157,23 @@ func (pe *proposalsEndpoint) List(resp http.ResponseWriter, req *http.Request, p
 		addProposalQuality(proposalsRes.Proposals, metrics)
 	}
 
+	sortBy := req.URL.Query().Get("sort_by")
+	if fetchQuality == "true" || sortBy == "speed" {
+		results, err := pe.speedTestProvider.Latest()
+		if err != nil {
+			utils.SendError(resp, err, http.StatusInternalServerError)
+			return
+		}
+		addProposalSpeedTest(proposalsRes.Proposals, results)
+	}
+	if sortBy == "speed" {
+		sortProposalsBySpeed(proposalsRes.Proposals)
+	}
+
 	utils.WriteAsJSON(proposalsRes, resp)
 }
 
-// swagger:operation GET /proposals/quality Proposal quality metrics
+// swagger:operation GET /proposals/quality Proposal proposalsQuality
 // ---
 // summary: Returns proposals quality metrics
 // description: Returns list of proposals  quality metrics
@@ -160,6 +187,88 @@ func (pe *proposalsEndpoint) Quality(resp http.ResponseWriter, req *http.Request
 	utils.WriteAsJSON(contract.NewProposalQualityResponse(quality), resp)
 }
 
+// swagger:operation GET /proposals/query Proposal queryProposals
+// ---
+// summary: Queries proposals
+// description: Returns a page of proposals matching filter expression, optionally sorted by quality, normalized price, latency or country. Items carry quality metrics and local speed test results, so they do not need to be joined by the client.
+// responses:
+//   200:
+//     description: Page of proposals
+//     schema:
+//       "$ref": "#/definitions/ProposalQueryResponse"
+//   422:
+//     description: Parameters validation error
+//     schema:
+//       "$ref": "#/definitions/ValidationErrorDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (pe *proposalsEndpoint) Query(resp http.ResponseWriter, req *http.Request, params httprouter.Params) {
+	query := contract.NewProposalQuery()
+	if errors := query.Bind(req); errors.HasErrors() {
+		utils.SendValidationErrorMessage(resp, errors)
+		return
+	}
+
+	proposals, err := pe.proposalRepository.Proposals(query.ToFilter())
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	items := make([]contract.ProposalQueryItemDTO, len(proposals))
+	for i, p := range proposals {
+		items[i] = contract.NewProposalQueryItemDTO(p)
+	}
+
+	if query.SortBy == contract.ProposalSortQuality || query.Includes("quality") {
+		metrics := qualityMetricsByProposal(pe.qualityProvider.ProposalsQuality())
+		for i, item := range items {
+			if m, ok := metrics[item.ProviderID+item.ServiceType]; ok {
+				items[i].Quality = &m
+			}
+		}
+	}
+	if query.SortBy == contract.ProposalSortLatency || query.Includes("speed_test") {
+		results, err := pe.speedTestProvider.Latest()
+		if err != nil {
+			utils.SendError(resp, err, http.StatusInternalServerError)
+			return
+		}
+		for i, item := range items {
+			if r, ok := results[item.ProviderID+item.ServiceType]; ok {
+				dto := contract.NewSpeedTestResultDTO(r)
+				items[i].SpeedTest = &dto
+			}
+		}
+	}
+	sortProposalQueryItems(items, query.SortBy, query.Descending())
+
+	var start int
+	if after := query.After(); after != nil {
+		start = sort.Search(len(items), func(i int) bool {
+			return proposalQueryItemLess(*after, items[i], query.SortBy, query.Descending())
+		})
+	}
+	page := items[start:]
+	if len(page) > query.Limit {
+		page = page[:query.Limit]
+	}
+
+	res := contract.ProposalQueryResponse{
+		Items:      make([]contract.ProposalQueryItemDTO, len(page)),
+		TotalItems: len(items),
+	}
+	for i, item := range page {
+		res.Items[i] = query.Project(item)
+	}
+	if start+len(page) < len(items) {
+		res.NextCursor = contract.NewProposalQueryCursor(query.SortBy, page[len(page)-1])
+	}
+	utils.WriteAsJSON(res, resp)
+}
+
 func parsePriceBound(req *http.Request, key string) (*big.Int, error) {
 	bound := req.URL.Query().Get(key)
 	if bound == "" {
@@ -173,26 +282,132 @@ func parsePriceBound(req *http.Request, key string) (*big.Int, error) {
 }
 
 // AddRoutesForProposals attaches proposals endpoints to router
-func AddRoutesForProposals(router *httprouter.Router, proposalRepository proposal.Repository, qualityProvider QualityFinder) {
-	pe := NewProposalsEndpoint(proposalRepository, qualityProvider)
+func AddRoutesForProposals(router *httprouter.Router, proposalRepository proposal.Repository, qualityProvider QualityFinder, speedTestProvider SpeedTestFinder) {
+	pe := NewProposalsEndpoint(proposalRepository, qualityProvider, speedTestProvider)
 	router.GET("/proposals", pe.List)
 	router.GET("/proposals/quality", pe.Quality)
+	router.GET("/proposals/query", pe.Query)
 }
 
 // addProposalQuality adds quality metrics to proposals.
 func addProposalQuality(proposals []contract.ProposalDTO, metrics []quality.ProposalQuality) {
-	// Convert metrics slice to map for fast lookup.
-	metricsMap := map[string]quality.ProposalQuality{}
+	metricsMap := qualityMetricsByProposal(metrics)
+	for i, p := range proposals {
+		if mc, ok := metricsMap[p.ProviderID+p.ServiceType]; ok {
+			proposals[i].Quality = &mc
+		}
+	}
+}
+
+// qualityMetricsByProposal converts metrics slice to map for fast lookup by provider ID and service type.
+func qualityMetricsByProposal(metrics []quality.ProposalQuality) map[string]contract.QualityMetricsDTO {
+	metricsMap := make(map[string]contract.QualityMetricsDTO, len(metrics))
 	for _, m := range metrics {
-		metricsMap[m.ProposalID.ProviderID+m.ProposalID.ServiceType] = m
+		metricsMap[m.ProposalID.ProviderID+m.ProposalID.ServiceType] = contract.QualityMetricsDTO{
+			Quality:          m.Quality,
+			MonitoringFailed: m.MonitoringFailed,
+		}
 	}
+	return metricsMap
+}
 
+// addProposalSpeedTest adds latest local speed test results to proposals.
+func addProposalSpeedTest(proposals []contract.ProposalDTO, results map[string]speedtest.Result) {
 	for i, p := range proposals {
-		if mc, ok := metricsMap[p.ProviderID+p.ServiceType]; ok {
-			proposals[i].Quality = &contract.QualityMetricsDTO{
-				Quality:          mc.Quality,
-				MonitoringFailed: mc.MonitoringFailed,
-			}
+		if r, ok := results[p.ProviderID+p.ServiceType]; ok {
+			dto := contract.NewSpeedTestResultDTO(r)
+			proposals[i].SpeedTest = &dto
+		}
+	}
+}
+
+// sortProposalsBySpeed orders proposals by measured download speed, keeping untested proposals in original order at the end.
+func sortProposalsBySpeed(proposals []contract.ProposalDTO) {
+	sort.SliceStable(proposals, func(i, j int) bool {
+		if proposals[j].SpeedTest == nil {
+			return proposals[i].SpeedTest != nil
+		}
+		if proposals[i].SpeedTest == nil {
+			return false
+		}
+		return proposals[i].SpeedTest.Download > proposals[j].SpeedTest.Download
+	})
+}
+
+// sortProposalQueryItems orders proposals by the given key, putting proposals without the value at the end.
+// Ties are ordered by provider ID and service type, so pages stay consistent between requests.
+func sortProposalQueryItems(items []contract.ProposalQueryItemDTO, sortBy string, descending bool) {
+	sort.Slice(items, func(i, j int) bool {
+		return proposalQueryItemLess(items[i], items[j], sortBy, descending)
+	})
+}
+
+// proposalQueryItemLess tells whether proposal a goes before proposal b in query results.
+func proposalQueryItemLess(a, b contract.ProposalQueryItemDTO, sortBy string, descending bool) bool {
+	va, vb := proposalSortValue(a, sortBy), proposalSortValue(b, sortBy)
+	if va == nil || vb == nil {
+		if va != nil || vb != nil {
+			return va != nil
+		}
+	} else if cmp := compareProposalSortValues(va, vb); cmp != 0 {
+		if descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	}
+	if a.ProviderID != b.ProviderID {
+		return a.ProviderID < b.ProviderID
+	}
+	return a.ServiceType < b.ServiceType
+}
+
+// proposalSortValue returns value of the sort key, nil when proposal lacks it.
+func proposalSortValue(item contract.ProposalQueryItemDTO, sortBy string) interface{} {
+	switch sortBy {
+	case contract.ProposalSortQuality:
+		if item.Quality != nil {
+			return item.Quality.Quality
+		}
+	case contract.ProposalSortPriceGiB:
+		if item.PricePerGiB != nil && item.PricePerGiB.Amount != nil {
+			return item.PricePerGiB.Amount
+		}
+	case contract.ProposalSortPriceMinute:
+		if item.PricePerMinute != nil && item.PricePerMinute.Amount != nil {
+			return item.PricePerMinute.Amount
+		}
+	case contract.ProposalSortLatency:
+		if item.SpeedTest != nil {
+			return item.SpeedTest.Latency
+		}
+	case contract.ProposalSortCountry:
+		if item.Location != nil && item.Location.Country != "" {
+			return item.Location.Country
+		}
+	}
+	return nil
+}
+
+func compareProposalSortValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case float64:
+		switch b := b.(float64); {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	case int64:
+		switch b := b.(int64); {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
 		}
+	case string:
+		return strings.Compare(a, b.(string))
+	case *big.Int:
+		return a.Cmp(b.(*big.Int))
 	}
+	return 0
 }
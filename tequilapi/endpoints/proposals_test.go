@@ -18,19 +18,23 @@
 package endpoints
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/mysteriumnetwork/node/core/discovery/proposal"
 	"github.com/mysteriumnetwork/node/core/quality"
+	"github.com/mysteriumnetwork/node/core/speedtest"
 	"github.com/mysteriumnetwork/node/market"
 	"github.com/mysteriumnetwork/node/mocks"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
 )
 
 type TestServiceDefinition struct{}
@@ -84,7 +88,7 @@ func TestProposalsEndpointListByNodeId(t *testing.T) {
 	req.URL.RawQuery = query.Encode()
 
 	resp := httptest.NewRecorder()
-	handlerFunc := NewProposalsEndpoint(repository, &mockQualityProvider{}).List
+	handlerFunc := NewProposalsEndpoint(repository, &mockQualityProvider{}, &mockSpeedTestProvider{}).List
 	handlerFunc(resp, req, nil)
 
 	assert.JSONEq(
@@ -152,7 +156,7 @@ func TestProposalsEndpointAcceptsAccessPolicyParams(t *testing.T) {
 	req.URL.RawQuery = query.Encode()
 
 	resp := httptest.NewRecorder()
-	handlerFunc := NewProposalsEndpoint(repository, &mockQualityProvider{}).List
+	handlerFunc := NewProposalsEndpoint(repository, &mockQualityProvider{}, &mockSpeedTestProvider{}).List
 	handlerFunc(resp, req, nil)
 
 	assert.JSONEq(
@@ -209,7 +213,7 @@ func TestProposalsEndpointList(t *testing.T) {
 	assert.Nil(t, err)
 
 	resp := httptest.NewRecorder()
-	handlerFunc := NewProposalsEndpoint(repository, &mockQualityProvider{}).List
+	handlerFunc := NewProposalsEndpoint(repository, &mockQualityProvider{}, &mockSpeedTestProvider{}).List
 	handlerFunc(resp, req, nil)
 
 	assert.JSONEq(
@@ -281,7 +285,7 @@ func TestProposalsEndpointListFetchQuality(t *testing.T) {
 
 	resp := httptest.NewRecorder()
 
-	handlerFunc := NewProposalsEndpoint(repository, &mockQualityProvider{}).List
+	handlerFunc := NewProposalsEndpoint(repository, &mockQualityProvider{}, &mockSpeedTestProvider{}).List
 	handlerFunc(resp, req, nil)
 
 	assert.JSONEq(
@@ -344,6 +348,243 @@ func TestProposalsEndpointListFetchQuality(t *testing.T) {
 	)
 }
 
+func TestProposalsEndpointListSortBySpeed(t *testing.T) {
+	repository := &mockProposalRepository{
+		proposals: serviceProposals,
+	}
+	speedTests := &mockSpeedTestProvider{
+		results: map[string]speedtest.Result{
+			"other_providertestprotocol": {
+				ProviderID:  "other_provider",
+				ServiceType: "testprotocol",
+				Download:    1000,
+				Upload:      500,
+				Latency:     20 * time.Millisecond,
+				TestedAt:    time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	req, err := http.NewRequest(http.MethodGet, "/irrelevant?sort_by=speed", nil)
+	assert.Nil(t, err)
+
+	resp := httptest.NewRecorder()
+	NewProposalsEndpoint(repository, &mockQualityProvider{}, speedTests).List(resp, req, nil)
+
+	var res contract.ListProposalsResponse
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &res))
+	assert.Len(t, res.Proposals, 2)
+	assert.Equal(t, "other_provider", res.Proposals[0].ProviderID)
+	assert.Equal(t, &contract.SpeedTestResultDTO{
+		ProviderID:  "other_provider",
+		ServiceType: "testprotocol",
+		Upload:      500,
+		Download:    1000,
+		Latency:     20,
+		TestedAt:    "2020-10-01T12:00:00Z",
+	}, res.Proposals[0].SpeedTest)
+	assert.Equal(t, "0xProviderId", res.Proposals[1].ProviderID)
+	assert.Nil(t, res.Proposals[1].SpeedTest)
+}
+
+func TestProposalsEndpointQuery(t *testing.T) {
+	repository := &mockProposalRepository{
+		proposals: serviceProposals,
+	}
+	req, err := http.NewRequest(http.MethodGet, "/irrelevant", nil)
+	assert.Nil(t, err)
+	query := req.URL.Query()
+	query.Set("filter", "and(eq(service_type, testprotocol), not(eq(provider_id, unknown)))")
+	query.Set("sort_by", "quality")
+	query.Set("fields", "provider_id,quality,price_per_gib,price_per_minute")
+	req.URL.RawQuery = query.Encode()
+
+	resp := httptest.NewRecorder()
+	NewProposalsEndpoint(repository, &mockQualityProvider{}, &mockSpeedTestProvider{}).Query(resp, req, nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(
+		t,
+		`{
+			"items": [
+				{
+					"provider_id": "0xProviderId",
+					"quality": {"quality": 2, "monitoring_failed": false},
+					"price_per_gib": {"amount": 7000000, "currency": "MYST"},
+					"price_per_minute": {"amount": 50000, "currency": "MYST"}
+				},
+				{
+					"provider_id": "other_provider",
+					"price_per_gib": {"amount": 7000000, "currency": "MYST"},
+					"price_per_minute": {"amount": 50000, "currency": "MYST"}
+				}
+			],
+			"total_items": 2
+		}`,
+		resp.Body.String(),
+	)
+	assert.True(t, repository.recordedFilter.ExcludeUnsupported)
+	assert.True(t, repository.recordedFilter.Condition(serviceProposals[0]))
+	assert.False(t, repository.recordedFilter.Condition(market.ServiceProposal{ProviderID: "unknown", ServiceType: "testprotocol"}))
+}
+
+func TestProposalsEndpointQuerySortByLatency(t *testing.T) {
+	repository := &mockProposalRepository{
+		proposals: serviceProposals,
+	}
+	speedTests := &mockSpeedTestProvider{
+		results: map[string]speedtest.Result{
+			"other_providertestprotocol": {Latency: 20 * time.Millisecond},
+		},
+	}
+	req, err := http.NewRequest(http.MethodGet, "/irrelevant?sort_by=latency&fields=provider_id", nil)
+	assert.Nil(t, err)
+
+	resp := httptest.NewRecorder()
+	NewProposalsEndpoint(repository, &mockQualityProvider{}, speedTests).Query(resp, req, nil)
+
+	assert.JSONEq(t, `{"items": [{"provider_id": "other_provider"}, {"provider_id": "0xProviderId"}], "total_items": 2}`, resp.Body.String())
+}
+
+func TestProposalsEndpointQueryPagination(t *testing.T) {
+	endpoint := NewProposalsEndpoint(&mockProposalRepository{proposals: serviceProposals}, &mockQualityProvider{}, &mockSpeedTestProvider{})
+
+	req, err := http.NewRequest(http.MethodGet, "/irrelevant?limit=1&fields=provider_id", nil)
+	assert.Nil(t, err)
+	resp := httptest.NewRecorder()
+	endpoint.Query(resp, req, nil)
+
+	var res contract.ProposalQueryResponse
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &res))
+	assert.Equal(t, []contract.ProposalQueryItemDTO{{ProviderID: "0xProviderId"}}, res.Items)
+	assert.Equal(t, 2, res.TotalItems)
+	assert.NotEmpty(t, res.NextCursor)
+
+	req, err = http.NewRequest(http.MethodGet, "/irrelevant?limit=20&fields=provider_id&cursor="+res.NextCursor, nil)
+	assert.Nil(t, err)
+	resp = httptest.NewRecorder()
+	endpoint.Query(resp, req, nil)
+
+	res = contract.ProposalQueryResponse{}
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &res))
+	assert.Equal(t, []contract.ProposalQueryItemDTO{{ProviderID: "other_provider"}}, res.Items)
+	assert.Empty(t, res.NextCursor)
+}
+
+func TestProposalsEndpointQueryPaginationWithEqualSortKeys(t *testing.T) {
+	proposal := func(providerID, serviceType string) market.ServiceProposal {
+		p := serviceProposals[0]
+		p.ProviderID, p.ServiceType = providerID, serviceType
+		return p
+	}
+	repository := &mockProposalRepository{
+		proposals: []market.ServiceProposal{
+			proposal("0x3", "wireguard"),
+			proposal("0x1", "wireguard"),
+			proposal("0x2", "openvpn"),
+			proposal("0x1", "openvpn"),
+			proposal("0x2", "wireguard"),
+		},
+	}
+	endpoint := NewProposalsEndpoint(repository, &mockQualityProvider{}, &mockSpeedTestProvider{})
+
+	var seen []contract.ProposalQueryItemDTO
+	cursor := ""
+	for {
+		req, err := http.NewRequest(http.MethodGet, "/irrelevant?sort_by=price_gib&limit=2&fields=provider_id,service_type&cursor="+cursor, nil)
+		assert.Nil(t, err)
+		resp := httptest.NewRecorder()
+		endpoint.Query(resp, req, nil)
+
+		var res contract.ProposalQueryResponse
+		assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &res))
+		seen = append(seen, res.Items...)
+		if res.NextCursor == "" {
+			break
+		}
+		cursor = res.NextCursor
+		// repository does not guarantee the order between requests
+		for i, j := 0, len(repository.proposals)-1; i < j; i, j = i+1, j-1 {
+			repository.proposals[i], repository.proposals[j] = repository.proposals[j], repository.proposals[i]
+		}
+	}
+
+	assert.Equal(t, []contract.ProposalQueryItemDTO{
+		{ProviderID: "0x1", ServiceType: "openvpn"},
+		{ProviderID: "0x1", ServiceType: "wireguard"},
+		{ProviderID: "0x2", ServiceType: "openvpn"},
+		{ProviderID: "0x2", ServiceType: "wireguard"},
+		{ProviderID: "0x3", ServiceType: "wireguard"},
+	}, seen)
+}
+
+func TestProposalsEndpointQueryPaginationResumesAfterLastItem(t *testing.T) {
+	proposal := func(providerID string) market.ServiceProposal {
+		p := serviceProposals[0]
+		p.ProviderID = providerID
+		return p
+	}
+	repository := &mockProposalRepository{
+		proposals: []market.ServiceProposal{proposal("0x1"), proposal("0x2"), proposal("0x3"), proposal("0x4")},
+	}
+	endpoint := NewProposalsEndpoint(repository, &mockQualityProvider{}, &mockSpeedTestProvider{})
+
+	req, err := http.NewRequest(http.MethodGet, "/irrelevant?sort_by=country&limit=2&fields=provider_id", nil)
+	assert.Nil(t, err)
+	resp := httptest.NewRecorder()
+	endpoint.Query(resp, req, nil)
+
+	var res contract.ProposalQueryResponse
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &res))
+	assert.Equal(t, []contract.ProposalQueryItemDTO{{ProviderID: "0x1"}, {ProviderID: "0x2"}}, res.Items)
+
+	// proposal of the first page disappears before the next page is requested
+	repository.proposals = repository.proposals[1:]
+
+	req, err = http.NewRequest(http.MethodGet, "/irrelevant?sort_by=country&limit=2&fields=provider_id&cursor="+res.NextCursor, nil)
+	assert.Nil(t, err)
+	resp = httptest.NewRecorder()
+	endpoint.Query(resp, req, nil)
+
+	res = contract.ProposalQueryResponse{}
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &res))
+	assert.Equal(t, []contract.ProposalQueryItemDTO{{ProviderID: "0x3"}, {ProviderID: "0x4"}}, res.Items)
+	assert.Equal(t, 3, res.TotalItems)
+	assert.Empty(t, res.NextCursor)
+}
+
+func TestProposalsEndpointQueryRejectsCursorOfOtherSortKey(t *testing.T) {
+	cursor := contract.NewProposalQueryCursor(contract.ProposalSortQuality, contract.ProposalQueryItemDTO{ProviderID: "0x1"})
+	req, err := http.NewRequest(http.MethodGet, "/irrelevant?sort_by=country&cursor="+cursor, nil)
+	assert.Nil(t, err)
+
+	resp := httptest.NewRecorder()
+	NewProposalsEndpoint(&mockProposalRepository{}, &mockQualityProvider{}, &mockSpeedTestProvider{}).Query(resp, req, nil)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"cursor"`)
+}
+
+func TestProposalsEndpointQueryValidatesParams(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "/irrelevant?filter=eq(ip,1)&sort_by=speed&order=up&fields=id&limit=0&cursor=x", nil)
+	assert.Nil(t, err)
+
+	resp := httptest.NewRecorder()
+	NewProposalsEndpoint(&mockProposalRepository{}, &mockQualityProvider{}, &mockSpeedTestProvider{}).Query(resp, req, nil)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	for _, field := range []string{"filter", "sort_by", "order", "fields", "limit", "cursor"} {
+		assert.Contains(t, resp.Body.String(), `"`+field+`"`)
+	}
+}
+
+type mockSpeedTestProvider struct {
+	results map[string]speedtest.Result
+}
+
+func (m *mockSpeedTestProvider) Latest() (map[string]speedtest.Result, error) {
+	return m.results, nil
+}
+
 type mockQualityProvider struct{}
 
 func (m *mockQualityProvider) ProposalsQuality() []quality.ProposalQuality {
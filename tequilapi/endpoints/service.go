@@ -19,9 +19,11 @@ package endpoints
 
 import (
 	"encoding/json"
+	"math/big"
 	"net/http"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/mysteriumnetwork/node/core/pricing"
 	"github.com/mysteriumnetwork/node/core/service"
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/market"
@@ -37,6 +39,12 @@ import (
 type ServiceEndpoint struct {
 	serviceManager ServiceManager
 	optionsParser  map[string]services.ServiceOptionsParser
+	pricer         servicePricer
+}
+
+type servicePricer interface {
+	ToMyst(providerID identity.Identity, serviceType string, perGiB, perMinute *big.Int) (*big.Int, *big.Int, error)
+	Price(providerID identity.Identity, serviceType string) (pricing.Price, bool)
 }
 
 var (
@@ -47,10 +55,11 @@ var (
 )
 
 // NewServiceEndpoint creates and returns service endpoint
-func NewServiceEndpoint(serviceManager ServiceManager, optionsParser map[string]services.ServiceOptionsParser) *ServiceEndpoint {
+func NewServiceEndpoint(serviceManager ServiceManager, optionsParser map[string]services.ServiceOptionsParser, pricer servicePricer) *ServiceEndpoint {
 	return &ServiceEndpoint{
 		serviceManager: serviceManager,
 		optionsParser:  optionsParser,
+		pricer:         pricer,
 	}
 }
 
@@ -67,12 +76,12 @@ func NewServiceEndpoint(serviceManager ServiceManager, optionsParser map[string]
 func (se *ServiceEndpoint) ServiceList(resp http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
 	instances := se.serviceManager.List()
 
-	statusResponse := toServiceListResponse(instances)
+	statusResponse := se.toServiceListResponse(instances)
 	utils.WriteAsJSON(statusResponse, resp)
 }
 
 // ServiceGet provides info for requested service on the node.
-// swagger:operation GET /services/:id Service serviceGet
+// swagger:operation GET /services/{id} Service serviceGet
 // ---
 // summary: Information about service
 // description: ServiceGet provides info for requested service on the node.
@@ -94,7 +103,7 @@ func (se *ServiceEndpoint) ServiceGet(resp http.ResponseWriter, _ *http.Request,
 		return
 	}
 
-	statusResponse := toServiceInfoResponse(id, instance)
+	statusResponse := se.toServiceInfoResponse(id, instance)
 	utils.WriteAsJSON(statusResponse, resp)
 }
 
@@ -130,6 +139,10 @@ func (se *ServiceEndpoint) ServiceGet(resp http.ResponseWriter, _ *http.Request,
 //     description: Internal server error
 //     schema:
 //       "$ref": "#/definitions/ErrorMessageDTO"
+//   503:
+//     description: Prices set in fiat currency can't be converted to MYST
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
 func (se *ServiceEndpoint) ServiceStart(resp http.ResponseWriter, req *http.Request, params httprouter.Params) {
 	sr, err := se.toServiceRequest(req)
 	if err != nil {
@@ -149,16 +162,26 @@ func (se *ServiceEndpoint) ServiceStart(resp http.ResponseWriter, req *http.Requ
 	}
 
 	log.Info().Msgf("Service start options: %+v", sr)
+	providerID := identity.FromAddress(sr.ProviderID)
+	priceGB, priceMinute, err := se.pricer.ToMyst(providerID, sr.Type, sr.PaymentMethod.PriceGB, sr.PaymentMethod.PriceMinute)
+	if err != nil {
+		utils.SendError(resp, err, http.StatusServiceUnavailable)
+		return
+	}
+
 	id, err := se.serviceManager.Start(
-		identity.FromAddress(sr.ProviderID),
+		providerID,
 		sr.Type,
 		sr.AccessPolicies.IDs,
 		sr.Options,
-		pingpong.NewPaymentMethod(sr.PaymentMethod.PriceGB, sr.PaymentMethod.PriceMinute),
+		pingpong.NewPaymentMethod(priceGB, priceMinute),
 	)
 	if err == service.ErrorLocation {
 		utils.SendError(resp, err, http.StatusBadRequest)
 		return
+	} else if err == service.ErrIdentityLocked {
+		utils.SendError(resp, err, http.StatusForbidden)
+		return
 	} else if err != nil {
 		utils.SendError(resp, err, http.StatusInternalServerError)
 		return
@@ -167,12 +190,12 @@ func (se *ServiceEndpoint) ServiceStart(resp http.ResponseWriter, req *http.Requ
 	instance := se.serviceManager.Service(id)
 
 	resp.WriteHeader(http.StatusCreated)
-	statusResponse := toServiceInfoResponse(id, instance)
+	statusResponse := se.toServiceInfoResponse(id, instance)
 	utils.WriteAsJSON(statusResponse, resp)
 }
 
 // ServiceStop stops service on the node.
-// swagger:operation DELETE /services/:id Service serviceStop
+// swagger:operation DELETE /services/{id} Service serviceStop
 // ---
 // summary: Stops service
 // description: Initiates service stop
@@ -214,8 +237,8 @@ func (se *ServiceEndpoint) isAlreadyRunning(sr contract.ServiceStartRequest) boo
 }
 
 // AddRoutesForService adds service routes to given router
-func AddRoutesForService(router *httprouter.Router, serviceManager ServiceManager, optionsParser map[string]services.ServiceOptionsParser) {
-	serviceEndpoint := NewServiceEndpoint(serviceManager, optionsParser)
+func AddRoutesForService(router *httprouter.Router, serviceManager ServiceManager, optionsParser map[string]services.ServiceOptionsParser, pricer servicePricer) {
+	serviceEndpoint := NewServiceEndpoint(serviceManager, optionsParser, pricer)
 
 	router.GET("/services", serviceEndpoint.ServiceList)
 	router.POST("/services", serviceEndpoint.ServiceStart)
@@ -286,21 +309,25 @@ func (se *ServiceEndpoint) toServiceOptions(serviceType string, value *json.RawM
 	return options
 }
 
-func toServiceInfoResponse(id service.ID, instance *service.Instance) contract.ServiceInfoDTO {
-	return contract.ServiceInfoDTO{
+func (se *ServiceEndpoint) toServiceInfoResponse(id service.ID, instance *service.Instance) contract.ServiceInfoDTO {
+	res := contract.ServiceInfoDTO{
 		ID:         string(id),
 		ProviderID: instance.ProviderID.Address,
 		Type:       instance.Type,
 		Options:    instance.Options,
 		Status:     string(instance.State()),
-		Proposal:   contract.NewProposalDTO(instance.Proposal),
+		Proposal:   contract.NewProposalDTO(instance.CopyProposal()),
 	}
+	if price, ok := se.pricer.Price(instance.ProviderID, instance.Type); ok {
+		res.Price = contract.NewServicePriceDTO(price)
+	}
+	return res
 }
 
-func toServiceListResponse(instances map[service.ID]*service.Instance) contract.ServiceListResponse {
+func (se *ServiceEndpoint) toServiceListResponse(instances map[service.ID]*service.Instance) contract.ServiceListResponse {
 	res := make([]contract.ServiceInfoDTO, 0)
 	for id, instance := range instances {
-		res = append(res, toServiceInfoResponse(id, instance))
+		res = append(res, se.toServiceInfoResponse(id, instance))
 	}
 	return res
 }
@@ -21,18 +21,22 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/mysteriumnetwork/node/core/pricing"
 	"github.com/mysteriumnetwork/node/core/service"
 	"github.com/mysteriumnetwork/node/core/service/servicestate"
 	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/market"
 	"github.com/mysteriumnetwork/node/mocks"
 	"github.com/mysteriumnetwork/node/services"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -90,9 +94,12 @@ type fancyServiceOptions struct {
 	Foo string `json:"foo"`
 }
 
-type mockServiceManager struct{}
+type mockServiceManager struct {
+	paymentMethod market.PaymentMethod
+}
 
-func (sm *mockServiceManager) Start(providerID identity.Identity, serviceType string, policyIDs []string, options service.Options, _ market.PaymentMethod) (service.ID, error) {
+func (sm *mockServiceManager) Start(providerID identity.Identity, serviceType string, policyIDs []string, options service.Options, pm market.PaymentMethod) (service.ID, error) {
+	sm.paymentMethod = pm
 	if serviceType == serviceTypeWithAccessPolicy {
 		return mockAccessPolicyServiceID, nil
 	}
@@ -115,6 +122,28 @@ func (sm *mockServiceManager) List() map[service.ID]*service.Instance {
 }
 func (sm *mockServiceManager) Kill() error { return nil }
 
+type mockServicePricer struct {
+	price *pricing.Price
+	err   error
+}
+
+func (m *mockServicePricer) ToMyst(_ identity.Identity, _ string, perGiB, perMinute *big.Int) (*big.Int, *big.Int, error) {
+	if m.err != nil {
+		return nil, nil, m.err
+	}
+	if m.price == nil {
+		return perGiB, perMinute, nil
+	}
+	return m.price.MystPerGiB, m.price.MystPerMinute, nil
+}
+
+func (m *mockServicePricer) Price(_ identity.Identity, _ string) (pricing.Price, bool) {
+	if m.price == nil {
+		return pricing.Price{}, false
+	}
+	return *m.price, true
+}
+
 var fakeOptionsParser = map[string]services.ServiceOptionsParser{
 	"testprotocol": func(opts *json.RawMessage) (service.Options, error) {
 		return nil, nil
@@ -129,7 +158,7 @@ var fakeOptionsParser = map[string]services.ServiceOptionsParser{
 
 func Test_AddRoutesForServiceAddsRoutes(t *testing.T) {
 	router := httprouter.New()
-	AddRoutesForService(router, &mockServiceManager{}, fakeOptionsParser)
+	AddRoutesForService(router, &mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	tests := []struct {
 		method         string
@@ -262,7 +291,7 @@ func Test_AddRoutesForServiceAddsRoutes(t *testing.T) {
 }
 
 func Test_ServiceStartInvalidType(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(
 		http.MethodGet,
@@ -291,7 +320,7 @@ func Test_ServiceStartInvalidType(t *testing.T) {
 }
 
 func Test_ServiceStart_InvalidType(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(
 		http.MethodGet,
@@ -320,7 +349,7 @@ func Test_ServiceStart_InvalidType(t *testing.T) {
 }
 
 func Test_ServiceStart_InvalidOptions(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(
 		http.MethodGet,
@@ -349,7 +378,7 @@ func Test_ServiceStart_InvalidOptions(t *testing.T) {
 }
 
 func Test_ServiceStartAlreadyRunning(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(
 		http.MethodGet,
@@ -373,7 +402,7 @@ func Test_ServiceStartAlreadyRunning(t *testing.T) {
 }
 
 func Test_ServiceStatus_NotFoundIsReturnedWhenNotStarted(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(http.MethodGet, "/irrelevant", nil)
 	resp := httptest.NewRecorder()
@@ -384,7 +413,7 @@ func Test_ServiceStatus_NotFoundIsReturnedWhenNotStarted(t *testing.T) {
 }
 
 func Test_ServiceGetReturnsServiceInfo(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(http.MethodGet, "/irrelevant", nil)
 	resp := httptest.NewRecorder()
@@ -429,7 +458,7 @@ func Test_ServiceGetReturnsServiceInfo(t *testing.T) {
 	)
 }
 func Test_ServiceCreate_Returns400ErrorIfRequestBodyIsNotJSON(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(http.MethodPut, "/irrelevant", strings.NewReader("a"))
 	resp := httptest.NewRecorder()
@@ -447,7 +476,7 @@ func Test_ServiceCreate_Returns400ErrorIfRequestBodyIsNotJSON(t *testing.T) {
 }
 
 func Test_ServiceCreate_Returns422ErrorIfRequestBodyIsMissingFieldValues(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(http.MethodPut, "/irrelevant", strings.NewReader("{}"))
 	resp := httptest.NewRecorder()
@@ -468,7 +497,7 @@ func Test_ServiceCreate_Returns422ErrorIfRequestBodyIsMissingFieldValues(t *test
 }
 
 func Test_ServiceStart_WithAccessPolicy(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(
 		http.MethodGet,
@@ -538,7 +567,7 @@ func Test_ServiceStart_WithAccessPolicy(t *testing.T) {
 }
 
 func Test_ServiceStart_ReturnsBadRequest_WithUnknownParams(t *testing.T) {
-	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser)
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{})
 
 	req := httptest.NewRequest(
 		http.MethodGet,
@@ -562,3 +591,58 @@ func Test_ServiceStart_ReturnsBadRequest_WithUnknownParams(t *testing.T) {
 		resp.Body.String(),
 	)
 }
+
+func Test_ServiceStart_ConvertsFiatPrices(t *testing.T) {
+	manager := &mockServiceManager{}
+	pricer := &mockServicePricer{price: &pricing.Price{
+		Currency:      "USD",
+		Rate:          0.5,
+		PerGiB:        big.NewInt(1e17),
+		PerMinute:     big.NewInt(1e12),
+		MystPerGiB:    big.NewInt(2e17),
+		MystPerMinute: big.NewInt(2e12),
+	}}
+	serviceEndpoint := NewServiceEndpoint(manager, fakeOptionsParser, pricer)
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/irrelevant",
+		strings.NewReader(`{
+			"type": "mockAccessPolicyService",
+			"provider_id": "0xproviderid",
+			"payment_method": {"price_gb": 100000000000000000, "price_minute": 1000000000000}
+		}`),
+	)
+	resp := httptest.NewRecorder()
+
+	serviceEndpoint.ServiceStart(resp, req, httprouter.Params{})
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, pingpong.NewPaymentMethod(big.NewInt(2e17), big.NewInt(2e12)), manager.paymentMethod)
+
+	var info contract.ServiceInfoDTO
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &info))
+	assert.Equal(t, &contract.ServicePriceDTO{
+		Currency:      "USD",
+		ExchangeRate:  0.5,
+		PerGiB:        0.1,
+		PerMinute:     0.000001,
+		MystPerGiB:    big.NewInt(2e17),
+		MystPerMinute: big.NewInt(2e12),
+	}, info.Price)
+}
+
+func Test_ServiceStart_ReturnsServiceUnavailable_WhenPricesCantBeConverted(t *testing.T) {
+	serviceEndpoint := NewServiceEndpoint(&mockServiceManager{}, fakeOptionsParser, &mockServicePricer{err: errors.New("rate unavailable")})
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/irrelevant",
+		strings.NewReader(`{"type": "mockAccessPolicyService", "provider_id": "0xproviderid"}`),
+	)
+	resp := httptest.NewRecorder()
+
+	serviceEndpoint.ServiceStart(resp, req, httprouter.Params{})
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+}
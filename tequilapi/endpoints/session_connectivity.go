@@ -19,26 +19,13 @@ package endpoints
 
 import (
 	"net/http"
-	"time"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/mysteriumnetwork/node/session/connectivity"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
 	"github.com/mysteriumnetwork/node/tequilapi/utils"
 )
 
-// swagger:model ConnectivityStatus
-type sessionConnectivityStatusCollection struct {
-	Entries []*sessionConnectivityStatus `json:"entries"`
-}
-
-type sessionConnectivityStatus struct {
-	PeerAddress  string    `json:"peer_address"`
-	SessionID    string    `json:"session_id"`
-	Code         uint32    `json:"code"`
-	Message      string    `json:"message"`
-	CreatedAtUTC time.Time `json:"created_at_utc"`
-}
-
 type sessionConnectivityEndpoint struct {
 	statusStorage connectivity.StatusStorage
 }
@@ -53,12 +40,12 @@ type sessionConnectivityEndpoint struct {
 //     schema:
 //       "$ref": "#/definitions/ConnectivityStatus"
 func (e *sessionConnectivityEndpoint) List(resp http.ResponseWriter, req *http.Request, params httprouter.Params) {
-	r := sessionConnectivityStatusCollection{
-		Entries: []*sessionConnectivityStatus{},
+	r := contract.SessionConnectivityStatusCollection{
+		Entries: []*contract.SessionConnectivityStatus{},
 	}
 
 	for _, entry := range e.statusStorage.GetAllStatusEntries() {
-		r.Entries = append(r.Entries, &sessionConnectivityStatus{
+		r.Entries = append(r.Entries, &contract.SessionConnectivityStatus{
 			PeerAddress:  entry.PeerID.Address,
 			SessionID:    entry.SessionID,
 			Code:         uint32(entry.StatusCode),
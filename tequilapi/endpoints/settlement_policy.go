@@ -0,0 +1,175 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type settlementPolicyStorage interface {
+	Get(id identity.Identity) (pingpong.SettlementPolicy, error)
+	Store(id identity.Identity, policy pingpong.SettlementPolicy) error
+	Delete(id identity.Identity) error
+}
+
+type settlementPolicyEndpoint struct {
+	storage settlementPolicyStorage
+}
+
+// NewSettlementPolicyEndpoint creates and returns settlement policy endpoint
+func NewSettlementPolicyEndpoint(storage settlementPolicyStorage) *settlementPolicyEndpoint {
+	return &settlementPolicyEndpoint{
+		storage: storage,
+	}
+}
+
+// swagger:operation GET /identities/{id}/settlement-policy Identity getSettlementPolicy
+// ---
+// summary: Returns settlement policy
+// description: Returns automatic settlement policy of the given identity
+// parameters:
+// - name: id
+//   in: path
+//   description: Identity address
+//   type: string
+//   required: true
+// responses:
+//   200:
+//     description: Settlement policy
+//     schema:
+//       "$ref": "#/definitions/SettlementPolicyDTO"
+//   404:
+//     description: Settlement policy is not set
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *settlementPolicyEndpoint) Get(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	policy, err := e.storage.Get(identity.FromAddress(params.ByName("id")))
+	if errors.Is(err, pingpong.ErrNotFound) {
+		utils.SendError(resp, errors.New("settlement policy is not set"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.NewSettlementPolicyDTO(policy), resp)
+}
+
+// swagger:operation PUT /identities/{id}/settlement-policy Identity setSettlementPolicy
+// ---
+// summary: Sets settlement policy
+// description: Sets automatic settlement policy of the given identity
+// parameters:
+// - name: id
+//   in: path
+//   description: Identity address
+//   type: string
+//   required: true
+// - in: body
+//   name: body
+//   description: Settlement policy
+//   schema:
+//     $ref: "#/definitions/SettlementPolicyDTO"
+// responses:
+//   200:
+//     description: Settlement policy stored
+//     schema:
+//       "$ref": "#/definitions/SettlementPolicyDTO"
+//   400:
+//     description: Bad request
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *settlementPolicyEndpoint) Set(resp http.ResponseWriter, req *http.Request, params httprouter.Params) {
+	id := identity.FromAddress(params.ByName("id"))
+
+	var dto contract.SettlementPolicyDTO
+	if err := json.NewDecoder(req.Body).Decode(&dto); err != nil {
+		utils.SendError(resp, fmt.Errorf("failed to parse settlement policy: %w", err), http.StatusBadRequest)
+		return
+	}
+	if dto.Beneficiary != "" && !common.IsHexAddress(dto.Beneficiary) {
+		utils.SendError(resp, errors.New("invalid beneficiary address"), http.StatusBadRequest)
+		return
+	}
+
+	policy := dto.ToPolicy()
+	if err := policy.Validate(); err != nil {
+		utils.SendError(resp, err, http.StatusBadRequest)
+		return
+	}
+	if err := e.storage.Store(id, policy); err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	e.Get(resp, req, params)
+}
+
+// swagger:operation DELETE /identities/{id}/settlement-policy Identity deleteSettlementPolicy
+// ---
+// summary: Removes settlement policy
+// description: Removes settlement policy, falling back to threshold based settlement
+// parameters:
+// - name: id
+//   in: path
+//   description: Identity address
+//   type: string
+//   required: true
+// responses:
+//   202:
+//     description: Settlement policy removed
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *settlementPolicyEndpoint) Delete(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	if err := e.storage.Delete(identity.FromAddress(params.ByName("id"))); err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp.WriteHeader(http.StatusAccepted)
+}
+
+// AddRoutesForSettlementPolicy attaches settlement policy endpoints to router
+func AddRoutesForSettlementPolicy(router *httprouter.Router, storage settlementPolicyStorage) {
+	e := NewSettlementPolicyEndpoint(storage)
+	router.GET("/identities/:id/settlement-policy", e.Get)
+	router.PUT("/identities/:id/settlement-policy", e.Set)
+	router.DELETE("/identities/:id/settlement-policy", e.Delete)
+}
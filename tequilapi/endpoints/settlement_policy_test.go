@@ -0,0 +1,84 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+type mockSettlementPolicyStorage struct {
+	policies map[string]pingpong.SettlementPolicy
+}
+
+func (m *mockSettlementPolicyStorage) Get(id identity.Identity) (pingpong.SettlementPolicy, error) {
+	policy, ok := m.policies[id.Address]
+	if !ok {
+		return policy, pingpong.ErrNotFound
+	}
+	return policy, nil
+}
+
+func (m *mockSettlementPolicyStorage) Store(id identity.Identity, policy pingpong.SettlementPolicy) error {
+	m.policies[id.Address] = policy
+	return nil
+}
+
+func (m *mockSettlementPolicyStorage) Delete(id identity.Identity) error {
+	delete(m.policies, id.Address)
+	return nil
+}
+
+func Test_SettlementPolicy(t *testing.T) {
+	router := httprouter.New()
+	storage := &mockSettlementPolicyStorage{policies: map[string]pingpong.SettlementPolicy{}}
+	AddRoutesForSettlementPolicy(router, storage)
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, "/identities/0x1/settlement-policy", bytes.NewBufferString(body))
+		assert.NoError(t, err)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := serve(http.MethodGet, "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	resp = serve(http.MethodPut, `{"schedule": "hourly"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	resp = serve(http.MethodPut, `{"schedule": "weekly", "beneficiary": "not an address"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	resp = serve(http.MethodPut, `{"schedule": "weekly", "min_amount": 1000, "max_fee": 10, "beneficiary": "0xbe180c8CA53F280C7BE8669596fF7939d933AA10"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"schedule": "weekly", "min_amount": 1000, "max_fee": 10, "beneficiary": "0xbe180c8CA53F280C7BE8669596fF7939d933AA10"}`, resp.Body.String())
+
+	resp = serve(http.MethodDelete, "")
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Empty(t, storage.policies)
+}
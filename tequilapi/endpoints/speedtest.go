@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/mysteriumnetwork/node/core/connection"
+	"github.com/mysteriumnetwork/node/core/speedtest"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type speedTestStorage interface {
+	List(providerID string) ([]speedtest.Result, error)
+}
+
+type speedTestEndpoint struct {
+	manager connection.Manager
+	storage speedTestStorage
+}
+
+// NewSpeedTestEndpoint creates and returns speed test endpoint
+func NewSpeedTestEndpoint(manager connection.Manager, storage speedTestStorage) *speedTestEndpoint {
+	return &speedTestEndpoint{
+		manager: manager,
+		storage: storage,
+	}
+}
+
+// swagger:operation POST /connection/speed-test Connection connectionSpeedTest
+// ---
+// summary: Runs speed test
+// description: Measures latency, upload and download throughput to the provider of current connection over the p2p channel. Traffic does not go through the VPN tunnel and no direct connection baseline is measured.
+// responses:
+//   200:
+//     description: Speed test result
+//     schema:
+//       "$ref": "#/definitions/SpeedTestResultDTO"
+//   409:
+//     description: No connection exists
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *speedTestEndpoint) Run(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	result, err := e.manager.SpeedTest(req.Context())
+	if err != nil {
+		switch err {
+		case connection.ErrNoConnection:
+			utils.SendError(resp, err, http.StatusConflict)
+		default:
+			utils.SendError(resp, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	utils.WriteAsJSON(contract.NewSpeedTestResultDTO(result), resp)
+}
+
+// swagger:operation GET /speed-tests SpeedTest speedTestList
+// ---
+// summary: Returns speed test results
+// description: Returns stored speed test results, newest first
+// parameters:
+//   - in: query
+//     name: provider_id
+//     description: If given will filter results by provider id
+//     type: string
+// responses:
+//   200:
+//     description: List of speed test results
+//     schema:
+//       "$ref": "#/definitions/SpeedTestListResponse"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *speedTestEndpoint) List(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	results, err := e.storage.List(req.URL.Query().Get("provider_id"))
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.NewSpeedTestListResponse(results), resp)
+}
+
+// AddRoutesForSpeedTest attaches speed test endpoints to router
+func AddRoutesForSpeedTest(router *httprouter.Router, manager connection.Manager, storage speedTestStorage) {
+	e := NewSpeedTestEndpoint(manager, storage)
+	router.POST("/connection/speed-test", e.Run)
+	router.GET("/speed-tests", e.List)
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/core/connection"
+	"github.com/mysteriumnetwork/node/core/speedtest"
+)
+
+type mockSpeedTestStorage struct {
+	results []speedtest.Result
+}
+
+func (m *mockSpeedTestStorage) List(providerID string) ([]speedtest.Result, error) {
+	var res []speedtest.Result
+	for _, r := range m.results {
+		if providerID == "" || r.ProviderID == providerID {
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+func Test_SpeedTestRun(t *testing.T) {
+	testedAt := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		result       speedtest.Result
+		err          error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "returns measured result",
+			result: speedtest.Result{
+				ProviderID:  "0x1",
+				ServiceType: "wireguard",
+				SessionID:   "session",
+				Upload:      2000,
+				Download:    5000,
+				Latency:     45 * time.Millisecond,
+				TestedAt:    testedAt,
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{
+				"provider_id": "0x1",
+				"service_type": "wireguard",
+				"session_id": "session",
+				"upload": 2000,
+				"download": 5000,
+				"latency": 45,
+				"tested_at": "2020-01-01T10:00:00Z"
+			}`,
+		},
+		{
+			name:         "returns conflict when not connected",
+			err:          connection.ErrNoConnection,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"message": "no connection exists"}`,
+		},
+		{
+			name:         "returns internal error when speed test fails",
+			err:          errors.New("speed test failed"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"message": "speed test failed"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			router := httprouter.New()
+			manager := &mockConnectionManager{onSpeedTestReturn: tc.result, onSpeedTestErr: tc.err}
+			AddRoutesForSpeedTest(router, manager, &mockSpeedTestStorage{})
+
+			req, err := http.NewRequest(http.MethodPost, "/connection/speed-test", nil)
+			assert.NoError(t, err)
+			resp := httptest.NewRecorder()
+			router.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.expectedCode, resp.Code)
+			assert.JSONEq(t, tc.expectedBody, resp.Body.String())
+		})
+	}
+}
+
+func Test_SpeedTestList(t *testing.T) {
+	router := httprouter.New()
+	storage := &mockSpeedTestStorage{results: []speedtest.Result{
+		{ProviderID: "0x1", ServiceType: "wireguard", SessionID: "s1", Upload: 1, Download: 2, Latency: time.Millisecond},
+		{ProviderID: "0x2", ServiceType: "openvpn", SessionID: "s2", Upload: 3, Download: 4, Latency: 2 * time.Millisecond},
+	}}
+	AddRoutesForSpeedTest(router, &mockConnectionManager{}, storage)
+
+	req, err := http.NewRequest(http.MethodGet, "/speed-tests?provider_id=0x2", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"results": [{
+		"provider_id": "0x2",
+		"service_type": "openvpn",
+		"session_id": "s2",
+		"upload": 3,
+		"download": 4,
+		"latency": 2,
+		"tested_at": "0001-01-01T00:00:00Z"
+	}]}`, resp.Body.String())
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type spendingLimitStorage interface {
+	Get(id identity.Identity) (pingpong.SpendingLimits, error)
+	Store(id identity.Identity, limits pingpong.SpendingLimits) error
+	Delete(id identity.Identity) error
+	Spent(id identity.Identity, period pingpong.SpendingPeriod, at time.Time) (*big.Int, error)
+}
+
+type spendingLimitsEndpoint struct {
+	storage spendingLimitStorage
+}
+
+// NewSpendingLimitsEndpoint creates and returns spending limits endpoint
+func NewSpendingLimitsEndpoint(storage spendingLimitStorage) *spendingLimitsEndpoint {
+	return &spendingLimitsEndpoint{
+		storage: storage,
+	}
+}
+
+// swagger:operation GET /identities/{id}/spending-limits Identity getSpendingLimits
+// ---
+// summary: Returns spending limits
+// description: Returns consumer spending limits of the given identity together with amounts spent today and this month
+// parameters:
+// - name: id
+//   in: path
+//   description: Identity address
+//   type: string
+//   required: true
+// responses:
+//   200:
+//     description: Spending limits
+//     schema:
+//       "$ref": "#/definitions/SpendingLimitsDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *spendingLimitsEndpoint) Get(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	id := identity.FromAddress(params.ByName("id"))
+
+	limits, err := e.storage.Get(id)
+	if err != nil && !errors.Is(err, pingpong.ErrNotFound) {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	spentToday, err := e.storage.Spent(id, pingpong.SpendingPeriodDay, now)
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+	spentThisMonth, err := e.storage.Spent(id, pingpong.SpendingPeriodMonth, now)
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.NewSpendingLimitsDTO(limits, spentToday, spentThisMonth), resp)
+}
+
+// swagger:operation PUT /identities/{id}/spending-limits Identity setSpendingLimits
+// ---
+// summary: Sets spending limits
+// description: Sets consumer spending limits of the given identity. Connection is disconnected once any limit is reached.
+// parameters:
+// - name: id
+//   in: path
+//   description: Identity address
+//   type: string
+//   required: true
+// - in: body
+//   name: body
+//   description: Spending limits
+//   schema:
+//     $ref: "#/definitions/SpendingLimitsDTO"
+// responses:
+//   200:
+//     description: Spending limits stored
+//     schema:
+//       "$ref": "#/definitions/SpendingLimitsDTO"
+//   400:
+//     description: Bad request
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *spendingLimitsEndpoint) Set(resp http.ResponseWriter, req *http.Request, params httprouter.Params) {
+	var dto contract.SpendingLimitsDTO
+	if err := json.NewDecoder(req.Body).Decode(&dto); err != nil {
+		utils.SendError(resp, fmt.Errorf("failed to parse spending limits: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	limits := dto.ToLimits()
+	if err := limits.Validate(); err != nil {
+		utils.SendError(resp, err, http.StatusBadRequest)
+		return
+	}
+	if err := e.storage.Store(identity.FromAddress(params.ByName("id")), limits); err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	e.Get(resp, req, params)
+}
+
+// swagger:operation DELETE /identities/{id}/spending-limits Identity deleteSpendingLimits
+// ---
+// summary: Removes spending limits
+// description: Removes consumer spending limits of the given identity
+// parameters:
+// - name: id
+//   in: path
+//   description: Identity address
+//   type: string
+//   required: true
+// responses:
+//   202:
+//     description: Spending limits removed
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *spendingLimitsEndpoint) Delete(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	if err := e.storage.Delete(identity.FromAddress(params.ByName("id"))); err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp.WriteHeader(http.StatusAccepted)
+}
+
+// AddRoutesForSpendingLimits attaches spending limits endpoints to router
+func AddRoutesForSpendingLimits(router *httprouter.Router, storage spendingLimitStorage) {
+	e := NewSpendingLimitsEndpoint(storage)
+	router.GET("/identities/:id/spending-limits", e.Get)
+	router.PUT("/identities/:id/spending-limits", e.Set)
+	router.DELETE("/identities/:id/spending-limits", e.Delete)
+}
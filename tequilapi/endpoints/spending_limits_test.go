@@ -0,0 +1,100 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"bytes"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/identity"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+)
+
+type mockSpendingLimitStorage struct {
+	limits map[string]pingpong.SpendingLimits
+	spent  map[pingpong.SpendingPeriod]*big.Int
+}
+
+func (m *mockSpendingLimitStorage) Get(id identity.Identity) (pingpong.SpendingLimits, error) {
+	limits, ok := m.limits[id.Address]
+	if !ok {
+		return limits, pingpong.ErrNotFound
+	}
+	return limits, nil
+}
+
+func (m *mockSpendingLimitStorage) Store(id identity.Identity, limits pingpong.SpendingLimits) error {
+	m.limits[id.Address] = limits
+	return nil
+}
+
+func (m *mockSpendingLimitStorage) Delete(id identity.Identity) error {
+	delete(m.limits, id.Address)
+	return nil
+}
+
+func (m *mockSpendingLimitStorage) Spent(_ identity.Identity, period pingpong.SpendingPeriod, _ time.Time) (*big.Int, error) {
+	return m.spent[period], nil
+}
+
+func Test_SpendingLimits(t *testing.T) {
+	router := httprouter.New()
+	storage := &mockSpendingLimitStorage{
+		limits: map[string]pingpong.SpendingLimits{},
+		spent: map[pingpong.SpendingPeriod]*big.Int{
+			pingpong.SpendingPeriodDay:   big.NewInt(5),
+			pingpong.SpendingPeriodMonth: big.NewInt(50),
+		},
+	}
+	AddRoutesForSpendingLimits(router, storage)
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, "/identities/0x1/spending-limits", bytes.NewBufferString(body))
+		assert.NoError(t, err)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := serve(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"spent_today": 5, "spent_this_month": 50}`, resp.Body.String())
+
+	resp = serve(http.MethodPut, `{"per_day": "not a number"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	resp = serve(http.MethodPut, `{"per_day": -1}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Empty(t, storage.limits)
+
+	resp = serve(http.MethodPut, `{"per_session": 10, "per_day": 100, "spent_today": 1000}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"per_session": 10, "per_day": 100, "spent_today": 5, "spent_this_month": 50}`, resp.Body.String())
+	assert.Equal(t, pingpong.SpendingLimits{PerSession: big.NewInt(10), PerDay: big.NewInt(100)}, storage.limits["0x1"])
+
+	resp = serve(http.MethodDelete, "")
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Empty(t, storage.limits)
+}
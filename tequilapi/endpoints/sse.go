@@ -21,7 +21,6 @@ import (
 	"net/http"
 
 	"github.com/julienschmidt/httprouter"
-	"github.com/mysteriumnetwork/node/eventbus"
 )
 
 // SSEHandler represents the sse handler
@@ -30,11 +29,7 @@ type SSEHandler interface {
 }
 
 // AddRoutesForSSE adds route for sse
-func AddRoutesForSSE(router *httprouter.Router, stateProvider stateProvider, bus eventbus.EventBus) error {
-	sseHandler := NewSSEHandler(stateProvider)
-	if err := sseHandler.Subscribe(bus); err != nil {
-		return err
-	}
+func AddRoutesForSSE(router *httprouter.Router, sseHandler SSEHandler) {
+	router.GET("/events", sseHandler.Sub)
 	router.GET("/events/state", sseHandler.Sub)
-	return nil
 }
@@ -22,18 +22,27 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 
-	"github.com/mysteriumnetwork/node/session/pingpong"
-
 	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
 	"github.com/mysteriumnetwork/node/consumer/session"
+	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
 	nodeEvent "github.com/mysteriumnetwork/node/core/node/event"
 	stateEvent "github.com/mysteriumnetwork/node/core/state/event"
 	"github.com/mysteriumnetwork/node/eventbus"
+	"github.com/mysteriumnetwork/node/identity/registry"
+	natEvent "github.com/mysteriumnetwork/node/nat/event"
+	sessionEvent "github.com/mysteriumnetwork/node/session/event"
+	"github.com/mysteriumnetwork/node/session/pingpong"
+	pingpongEvent "github.com/mysteriumnetwork/node/session/pingpong/event"
 	"github.com/mysteriumnetwork/node/tequilapi/contract"
-	"github.com/pkg/errors"
-	"github.com/rs/zerolog/log"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+	"github.com/mysteriumnetwork/node/trace"
 )
 
 // EventType represents all the event types we're subscribing to
@@ -52,14 +61,72 @@ const (
 	ServiceStatusEvent EventType = "service-status"
 	// StateChangeEvent represents the state change
 	StateChangeEvent EventType = "state-change"
+	// SpendingLimitEvent represents consumer spending limit alert
+	SpendingLimitEvent EventType = "spending-limit"
+	// ConnectionStateEvent represents consumer connection state change
+	ConnectionStateEvent EventType = "connection-state"
+	// SessionEvent represents provider session lifecycle change
+	SessionEvent EventType = "session"
+	// InvoicePaidEvent represents invoice paid by consumer
+	InvoicePaidEvent EventType = "invoice-paid"
+	// PromiseEvent represents promise received by provider from hermes
+	PromiseEvent EventType = "promise"
+	// SettlementEvent represents completed settlement
+	SettlementEvent EventType = "settlement"
+	// RegistrationEvent represents identity registration status change
+	RegistrationEvent EventType = "registration"
+	// TraceEvent represents completed connection trace stage
+	TraceEvent EventType = "trace"
 )
 
+// EventTypes lists event types clients can subscribe to.
+var EventTypes = []EventType{
+	StateChangeEvent,
+	SpendingLimitEvent,
+	ConnectionStateEvent,
+	SessionEvent,
+	InvoicePaidEvent,
+	PromiseEvent,
+	SettlementEvent,
+	RegistrationEvent,
+	NATEvent,
+	TraceEvent,
+}
+
+const (
+	// sseReplaySize is the number of most recent events kept for clients resuming with Last-Event-ID.
+	sseReplaySize = 256
+	// sseClientBufferSize is the number of events queued for a client before it is considered too slow and disconnected.
+	sseClientBufferSize = 64
+)
+
+// EventMessage is a serialized event delivered to subscribers.
+type EventMessage struct {
+	// ID is zero for messages which can not be resumed from, e.g. initial state.
+	ID   uint64
+	Type EventType
+	Data string
+}
+
+type sseClient struct {
+	messages chan EventMessage
+	topics   map[EventType]struct{}
+}
+
+func (c *sseClient) wants(eventType EventType) bool {
+	if len(c.topics) == 0 {
+		return true
+	}
+	_, ok := c.topics[eventType]
+	return ok
+}
+
 // Handler represents an sse handler
 type Handler struct {
-	clients       map[chan string]struct{}
-	newClients    chan chan string
-	deadClients   chan chan string
-	messages      chan string
+	lock          sync.Mutex
+	clients       map[*sseClient]struct{}
+	replay        []EventMessage
+	lastID        uint64
 	stopOnce      sync.Once
 	stopChan      chan struct{}
 	stateProvider stateProvider
@@ -72,10 +139,8 @@ type stateProvider interface {
 // NewSSEHandler returns a new instance of handler
 func NewSSEHandler(stateProvider stateProvider) *Handler {
 	return &Handler{
-		clients:       make(map[chan string]struct{}),
-		newClients:    make(chan (chan string)),
-		deadClients:   make(chan (chan string)),
-		messages:      make(chan string, 20),
+		clients:       make(map[*sseClient]struct{}),
+		replay:        make([]EventMessage, 0, sseReplaySize),
 		stopChan:      make(chan struct{}),
 		stateProvider: stateProvider,
 	}
@@ -83,15 +148,47 @@ func NewSSEHandler(stateProvider stateProvider) *Handler {
 
 // Subscribe subscribes to the event bus.
 func (h *Handler) Subscribe(bus eventbus.Subscriber) error {
-	err := bus.Subscribe(nodeEvent.AppTopicNode, h.ConsumeNodeEvent)
-	if err != nil {
-		return err
+	subscriptions := map[string]interface{}{
+		nodeEvent.AppTopicNode:                   h.ConsumeNodeEvent,
+		stateEvent.AppTopicState:                 h.ConsumeStateEvent,
+		pingpongEvent.AppTopicSpendingLimit:      h.ConsumeSpendingLimitEvent,
+		connectionstate.AppTopicConnectionState:  h.ConsumeConnectionStateEvent,
+		sessionEvent.AppTopicSession:             h.ConsumeSessionEvent,
+		pingpongEvent.AppTopicInvoicePaid:        h.ConsumeInvoicePaidEvent,
+		pingpongEvent.AppTopicHermesPromise:      h.ConsumePromiseEvent,
+		pingpongEvent.AppTopicSettlementComplete: h.ConsumeSettlementEvent,
+		registry.AppTopicIdentityRegistration:    h.ConsumeRegistrationEvent,
+		natEvent.AppTopicTraversal:               h.ConsumeNATEvent,
+		trace.AppTopicTraceEvent:                 h.ConsumeTraceEvent,
 	}
-	err = bus.Subscribe(stateEvent.AppTopicState, h.ConsumeStateEvent)
-	return err
+	for topic, fn := range subscriptions {
+		if err := bus.Subscribe(topic, fn); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Sub subscribes a user to sse
+// swagger:operation GET /events Events subscribeEvents
+// ---
+// summary: Subscribes to node events
+// description: Streams node events as server-sent events. Every event carries an ID which can be passed back via Last-Event-ID header or last_event_id query parameter to resume the stream after reconnecting, as long as missed events are still kept in the bounded replay buffer. Otherwise the stream starts with the current state snapshot.
+// parameters:
+//   - in: query
+//     name: topics
+//     description: Comma separated event types to receive, all types are sent if omitted. Known types are state-change, spending-limit, connection-state, session, invoice-paid, promise, settlement, registration, nat and trace.
+//     type: string
+//   - in: query
+//     name: last_event_id
+//     description: ID of the last received event to resume from
+//     type: integer
+// responses:
+//   200:
+//     description: Stream of events
+//   400:
+//     description: Unknown topic requested
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
 func (h *Handler) Sub(resp http.ResponseWriter, req *http.Request, params httprouter.Params) {
 	f, ok := resp.(http.Flusher)
 	if !ok {
@@ -104,83 +201,208 @@ func (h *Handler) Sub(resp http.ResponseWriter, req *http.Request, params httpro
 		return
 	}
 
+	topics, err := parseEventTopics(req)
+	if err != nil {
+		utils.SendError(resp, err, http.StatusBadRequest)
+		return
+	}
+	lastEventID, resuming := parseLastEventID(req)
+	sub, err := h.subscribe(topics, lastEventID, resuming)
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+	defer sub.Close()
+
 	resp.Header().Set("Content-Type", "text/event-stream")
 	resp.Header().Set("Cache-Control", "no-cache,no-transform")
 	resp.Header().Set("Connection", "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	f.Flush()
 
-	messageChan := make(chan string, 1)
-	err := h.sendInitialState(messageChan)
-	if err != nil {
-		resp.WriteHeader(http.StatusBadRequest)
-		resp.Header().Set("Content-type", "application/json; charset=utf-8")
-		writeErr := json.NewEncoder(resp).Encode(err)
-		if writeErr != nil {
-			http.Error(resp, "Http response write error", http.StatusInternalServerError)
+	for _, msg := range sub.Backlog {
+		if err := writeSSEMessage(resp, msg); err != nil {
+			log.Error().Err(err).Msg("")
+			return
 		}
 	}
-
-	h.newClients <- messageChan
-
-	go func() {
-		<-req.Context().Done()
-		h.deadClients <- messageChan
-	}()
+	f.Flush()
 
 	for {
 		select {
-		case msg, open := <-messageChan:
+		case msg, open := <-sub.Messages():
 			if !open {
 				return
 			}
 
-			_, err := fmt.Fprintf(resp, "data: %s\n\n", msg)
-			if err != nil {
+			if err := writeSSEMessage(resp, msg); err != nil {
 				log.Error().Err(err).Msg("")
 				return
 			}
 
 			f.Flush()
-		case <-h.stopChan:
+		case <-req.Context().Done():
+			return
+		case <-sub.Done():
 			return
 		}
 	}
 }
 
-func (h *Handler) sendInitialState(messageChan chan string) error {
-	res, err := json.Marshal(Event{
-		Type:    StateChangeEvent,
-		Payload: mapState(h.stateProvider.GetState()),
-	})
+// EventSubscription receives events outside of HTTP, e.g. by gRPC clients.
+type EventSubscription struct {
+	handler *Handler
+	client  *sseClient
+
+	// Backlog holds events to deliver before live ones: missed events when resuming or the current state otherwise.
+	Backlog []EventMessage
+}
+
+// Messages returns live events, the channel is closed when subscriber is not keeping up.
+func (s *EventSubscription) Messages() <-chan EventMessage {
+	return s.client.messages
+}
+
+// Done is closed when the node stops.
+func (s *EventSubscription) Done() <-chan struct{} {
+	return s.handler.stopChan
+}
+
+// Close unsubscribes from events.
+func (s *EventSubscription) Close() {
+	s.handler.unregister(s.client)
+}
+
+// Listen subscribes to given event types, all types are received when none given.
+// Events after non zero lastEventID are replayed if still buffered, otherwise the current state is sent first.
+func (h *Handler) Listen(names []string, lastEventID uint64) (*EventSubscription, error) {
+	topics, err := toEventTopics(names)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return h.subscribe(topics, lastEventID, lastEventID > 0)
+}
 
-	messageChan <- string(res)
-	return nil
+func (h *Handler) subscribe(topics map[EventType]struct{}, lastEventID uint64, resuming bool) (*EventSubscription, error) {
+	client := &sseClient{
+		messages: make(chan EventMessage, sseClientBufferSize),
+		topics:   topics,
+	}
+	replay, resumed := h.register(client, lastEventID, resuming)
+	sub := &EventSubscription{handler: h, client: client, Backlog: replay}
+
+	if !resumed && client.wants(StateChangeEvent) {
+		initial, err := h.initialState()
+		if err != nil {
+			sub.Close()
+			return nil, errors.Wrap(err, "could not marshal initial state")
+		}
+		sub.Backlog = append([]EventMessage{initial}, replay...)
+	}
+	return sub, nil
 }
 
-func (h *Handler) serve() {
-	defer func() {
-		for k := range h.clients {
-			close(k)
+func writeSSEMessage(resp http.ResponseWriter, msg EventMessage) error {
+	if msg.ID > 0 {
+		if _, err := fmt.Fprintf(resp, "id: %d\n", msg.ID); err != nil {
+			return err
 		}
-	}()
+	}
+	_, err := fmt.Fprintf(resp, "data: %s\n\n", msg.Data)
+	return err
+}
 
-	for {
-		select {
-		case <-h.stopChan:
-			return
-		case s := <-h.newClients:
-			h.clients[s] = struct{}{}
-		case s := <-h.deadClients:
-			delete(h.clients, s)
-			close(s)
-		case msg := <-h.messages:
-			for s := range h.clients {
-				s <- msg
-			}
+func parseEventTopics(req *http.Request) (map[EventType]struct{}, error) {
+	var names []string
+	for _, value := range req.URL.Query()["topics"] {
+		names = append(names, strings.Split(value, ",")...)
+	}
+	return toEventTopics(names)
+}
+
+func toEventTopics(names []string) (map[EventType]struct{}, error) {
+	topics := make(map[EventType]struct{})
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		eventType, ok := findEventType(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown topic %q", name)
+		}
+		topics[eventType] = struct{}{}
+	}
+	return topics, nil
+}
+
+func findEventType(name string) (EventType, bool) {
+	for _, eventType := range EventTypes {
+		if string(eventType) == name {
+			return eventType, true
 		}
 	}
+	return "", false
+}
+
+func parseLastEventID(req *http.Request) (uint64, bool) {
+	value := req.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = req.URL.Query().Get("last_event_id")
+	}
+	if value == "" {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// register adds the client and returns buffered events it missed since lastEventID.
+// Resuming fails if the missed events are no longer buffered or the ID is unknown, e.g. after node restart.
+func (h *Handler) register(client *sseClient, lastEventID uint64, resuming bool) ([]EventMessage, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.clients[client] = struct{}{}
+
+	if !resuming || lastEventID > h.lastID {
+		return nil, false
+	}
+	if len(h.replay) > 0 && lastEventID+1 < h.replay[0].ID {
+		return nil, false
+	}
+
+	var missed []EventMessage
+	for _, msg := range h.replay {
+		if msg.ID > lastEventID && client.wants(msg.Type) {
+			missed = append(missed, msg)
+		}
+	}
+	return missed, true
+}
+
+func (h *Handler) unregister(client *sseClient) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if _, ok := h.clients[client]; ok {
+		delete(h.clients, client)
+		close(client.messages)
+	}
+}
+
+func (h *Handler) initialState() (EventMessage, error) {
+	res, err := json.Marshal(Event{
+		Type:    StateChangeEvent,
+		Payload: mapState(h.stateProvider.GetState()),
+	})
+	if err != nil {
+		return EventMessage{}, err
+	}
+	return EventMessage{Type: StateChangeEvent, Data: string(res)}, nil
 }
 
 func (h *Handler) stop() {
@@ -193,18 +415,37 @@ func (h *Handler) send(e Event) {
 		log.Error().Err(err).Msg("Could not marshal SSE message")
 		return
 	}
-	h.messages <- string(marshaled)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.lastID++
+	msg := EventMessage{ID: h.lastID, Type: e.Type, Data: string(marshaled)}
+	if len(h.replay) == sseReplaySize {
+		copy(h.replay, h.replay[1:])
+		h.replay = h.replay[:sseReplaySize-1]
+	}
+	h.replay = append(h.replay, msg)
+
+	for client := range h.clients {
+		if !client.wants(e.Type) {
+			continue
+		}
+		select {
+		case client.messages <- msg:
+		default:
+			// Client is too slow, disconnect it so it could resume from the replay buffer.
+			log.Warn().Msg("SSE client is not keeping up, disconnecting")
+			delete(h.clients, client)
+			close(client.messages)
+		}
+	}
 }
 
 // ConsumeNodeEvent consumes the node state event
 func (h *Handler) ConsumeNodeEvent(e nodeEvent.Payload) {
-	if e.Status == nodeEvent.StatusStarted {
-		go h.serve()
-		return
-	}
 	if e.Status == nodeEvent.StatusStopped {
 		h.stop()
-		return
 	}
 }
 
@@ -260,7 +501,7 @@ func mapState(event stateEvent.State) stateRes {
 		Sessions:      sessionsRes,
 		SessionsStats: contract.NewSessionStatsDTO(sessionsStats),
 		Consumer: consumerStateRes{
-			Connection: contract.NewConnectionDTO(event.Connection.Session, event.Connection.Statistics, event.Connection.Throughput, event.Connection.Invoice),
+			Connection: contract.NewConnectionDTO(event.Connection.Session, event.Connection.Statistics, event.Connection.Throughput, event.Connection.Invoice, event.Connection.ChannelStats, event.Connection.Quality),
 		},
 		Identities: identitiesRes,
 		Channels:   channelsRes,
@@ -285,3 +526,75 @@ func (h *Handler) ConsumeStateEvent(event stateEvent.State) {
 		Payload: mapState(event),
 	})
 }
+
+// ConsumeSpendingLimitEvent consumes the consumer spending limit alert
+func (h *Handler) ConsumeSpendingLimitEvent(e pingpongEvent.AppEventSpendingLimit) {
+	h.send(Event{
+		Type:    SpendingLimitEvent,
+		Payload: contract.NewSpendingLimitAlertDTO(e),
+	})
+}
+
+// ConsumeConnectionStateEvent consumes the consumer connection state change event
+func (h *Handler) ConsumeConnectionStateEvent(e connectionstate.AppEventConnectionState) {
+	h.send(Event{
+		Type:    ConnectionStateEvent,
+		Payload: contract.NewConnectionStateEventDTO(e),
+	})
+}
+
+// ConsumeSessionEvent consumes the provider session lifecycle event
+func (h *Handler) ConsumeSessionEvent(e sessionEvent.AppEventSession) {
+	h.send(Event{
+		Type:    SessionEvent,
+		Payload: contract.NewSessionEventDTO(e),
+	})
+}
+
+// ConsumeInvoicePaidEvent consumes the invoice paid event
+func (h *Handler) ConsumeInvoicePaidEvent(e pingpongEvent.AppEventInvoicePaid) {
+	h.send(Event{
+		Type:    InvoicePaidEvent,
+		Payload: contract.NewInvoicePaidEventDTO(e),
+	})
+}
+
+// ConsumePromiseEvent consumes the hermes promise event
+func (h *Handler) ConsumePromiseEvent(e pingpongEvent.AppEventHermesPromise) {
+	h.send(Event{
+		Type:    PromiseEvent,
+		Payload: contract.NewPromiseEventDTO(e),
+	})
+}
+
+// ConsumeSettlementEvent consumes the settlement complete event
+func (h *Handler) ConsumeSettlementEvent(e pingpongEvent.AppEventSettlementComplete) {
+	h.send(Event{
+		Type:    SettlementEvent,
+		Payload: contract.NewSettlementEventDTO(e),
+	})
+}
+
+// ConsumeRegistrationEvent consumes the identity registration event
+func (h *Handler) ConsumeRegistrationEvent(e registry.AppEventIdentityRegistration) {
+	h.send(Event{
+		Type:    RegistrationEvent,
+		Payload: contract.NewRegistrationEventDTO(e),
+	})
+}
+
+// ConsumeNATEvent consumes the NAT traversal event
+func (h *Handler) ConsumeNATEvent(e natEvent.Event) {
+	h.send(Event{
+		Type:    NATEvent,
+		Payload: contract.NewNATEventDTO(e),
+	})
+}
+
+// ConsumeTraceEvent consumes the connection trace event
+func (h *Handler) ConsumeTraceEvent(e trace.Event) {
+	h.send(Event{
+		Type:    TraceEvent,
+		Payload: contract.NewTraceEventDTO(e),
+	})
+}
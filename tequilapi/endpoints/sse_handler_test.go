@@ -24,6 +24,7 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -33,8 +34,11 @@ import (
 	"github.com/mysteriumnetwork/node/core/connection/connectionstate"
 	nodeEvent "github.com/mysteriumnetwork/node/core/node/event"
 	stateEvent "github.com/mysteriumnetwork/node/core/state/event"
+	"github.com/mysteriumnetwork/node/identity"
 	"github.com/mysteriumnetwork/node/identity/registry"
+	pingpongEvent "github.com/mysteriumnetwork/node/session/pingpong/event"
 	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/trace"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,14 +53,14 @@ func (msp *mockStateProvider) GetState() stateEvent.State {
 func TestHandler_Stops(t *testing.T) {
 	h := NewSSEHandler(&mockStateProvider{})
 
-	wait := make(chan struct{})
-	go func() {
-		h.serve()
-		wait <- struct{}{}
-	}()
-
 	h.stop()
-	<-wait
+	h.stop()
+
+	select {
+	case <-h.stopChan:
+	default:
+		t.Fatal("handler not stopped")
+	}
 }
 
 func TestHandler_ConsumeNodeEvent_Stops(t *testing.T) {
@@ -65,7 +69,12 @@ func TestHandler_ConsumeNodeEvent_Stops(t *testing.T) {
 		Status: nodeEvent.StatusStopped,
 	}
 	h.ConsumeNodeEvent(me)
-	h.serve()
+
+	select {
+	case <-h.stopChan:
+	default:
+		t.Fatal("handler not stopped")
+	}
 }
 
 func TestHandler_ConsumeNodeEvent_Starts(t *testing.T) {
@@ -76,9 +85,9 @@ func TestHandler_ConsumeNodeEvent_Starts(t *testing.T) {
 
 	h.ConsumeNodeEvent(me)
 
-	// without starting, this would block forever
-	h.newClients <- make(chan string)
-	h.newClients <- make(chan string)
+	// sending events must not block
+	h.ConsumeStateEvent(stateEvent.State{})
+	h.ConsumeStateEvent(stateEvent.State{})
 
 	h.stop()
 }
@@ -86,7 +95,6 @@ func TestHandler_ConsumeNodeEvent_Starts(t *testing.T) {
 func TestHandler_SendsInitialAndFollowingStates(t *testing.T) {
 	msp := &mockStateProvider{}
 	h := NewSSEHandler(msp)
-	go h.serve()
 	defer h.stop()
 	laddr := net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0}
 	listener, err := net.ListenTCP("tcp4", &laddr)
@@ -120,7 +128,7 @@ func TestHandler_SendsInitialAndFollowingStates(t *testing.T) {
 				return
 			}
 			stringified := strings.Join(strings.Fields(strings.TrimSpace(string(line))), " ")
-			if len(stringified) > 0 {
+			if len(stringified) > 0 && !strings.HasPrefix(stringified, "id:") {
 				results <- stringified
 			}
 		}
@@ -258,3 +266,171 @@ func TestHandler_SendsInitialAndFollowingStates(t *testing.T) {
 
 	<-serveExit
 }
+
+type sseLine struct {
+	id   string
+	data string
+}
+
+func subscribeSSE(t *testing.T, h *Handler, query string, lastEventID string) (<-chan sseLine, func()) {
+	router := httprouter.New()
+	router.GET("/events", h.Sub)
+	server := httptest.NewServer(router)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/events"+query, nil)
+	assert.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	events := make(chan sseLine, 10)
+	go func() {
+		reader := bufio.NewReader(resp.Body)
+		var current sseLine
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimSpace(line)
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				current.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				current.data = strings.TrimPrefix(line, "data: ")
+			case line == "":
+				events <- current
+				current = sseLine{}
+			}
+		}
+	}()
+
+	return events, func() {
+		cancel()
+		server.Close()
+	}
+}
+
+func nextSSE(t *testing.T, events <-chan sseLine) sseLine {
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event received")
+		return sseLine{}
+	}
+}
+
+func TestHandler_FiltersTopics(t *testing.T) {
+	h := NewSSEHandler(&mockStateProvider{})
+	defer h.stop()
+
+	events, cancel := subscribeSSE(t, h, "?topics=registration,settlement", "")
+	defer cancel()
+
+	h.ConsumeStateEvent(stateEvent.State{})
+	h.ConsumeRegistrationEvent(registry.AppEventIdentityRegistration{
+		ID:      identity.FromAddress("0x1"),
+		Status:  registry.Registered,
+		ChainID: 5,
+	})
+
+	e := nextSSE(t, events)
+	assert.Equal(t, "2", e.id)
+	assert.JSONEq(t, `{"type": "registration", "payload": {"identity": "0x1", "status": "Registered", "chain_id": 5}}`, e.data)
+}
+
+func TestHandler_ResumesFromLastEventID(t *testing.T) {
+	h := NewSSEHandler(&mockStateProvider{})
+	defer h.stop()
+
+	for i := 0; i < 3; i++ {
+		h.ConsumeSettlementEvent(pingpongEvent.AppEventSettlementComplete{ChainID: int64(i)})
+	}
+
+	events, cancel := subscribeSSE(t, h, "?topics=settlement", "1")
+	defer cancel()
+
+	e := nextSSE(t, events)
+	assert.Equal(t, "2", e.id)
+	assert.Contains(t, e.data, `"chain_id":1`)
+	e = nextSSE(t, events)
+	assert.Equal(t, "3", e.id)
+	assert.Contains(t, e.data, `"chain_id":2`)
+
+	h.ConsumeSettlementEvent(pingpongEvent.AppEventSettlementComplete{ChainID: 3})
+	e = nextSSE(t, events)
+	assert.Equal(t, "4", e.id)
+}
+
+func TestHandler_SendsStateWhenResumeIsNotPossible(t *testing.T) {
+	h := NewSSEHandler(&mockStateProvider{})
+	defer h.stop()
+
+	for i := 0; i < sseReplaySize+2; i++ {
+		h.ConsumeTraceEvent(trace.Event{ID: "trace"})
+	}
+
+	events, cancel := subscribeSSE(t, h, "", "1")
+	defer cancel()
+
+	e := nextSSE(t, events)
+	assert.Empty(t, e.id)
+	assert.Contains(t, e.data, `"type":"state-change"`)
+}
+
+func TestHandler_RejectsUnknownTopic(t *testing.T) {
+	h := NewSSEHandler(&mockStateProvider{})
+	defer h.stop()
+
+	req := httptest.NewRequest(http.MethodGet, "/events?topics=state-change,unknown", nil)
+	resp := httptest.NewRecorder()
+	h.Sub(resp, req, nil)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Empty(t, h.clients)
+}
+
+func TestHandler_DisconnectsSlowClients(t *testing.T) {
+	h := NewSSEHandler(&mockStateProvider{})
+	sub, err := h.Listen([]string{"trace"}, 0)
+	assert.NoError(t, err)
+
+	for i := 0; i <= sseClientBufferSize; i++ {
+		h.ConsumeTraceEvent(trace.Event{ID: "trace"})
+	}
+
+	assert.Empty(t, h.clients)
+	assert.Len(t, h.replay, sseClientBufferSize+1)
+	assert.Len(t, sub.Messages(), sseClientBufferSize)
+}
+
+func TestHandler_Listen(t *testing.T) {
+	h := NewSSEHandler(&mockStateProvider{})
+	h.ConsumeRegistrationEvent(registry.AppEventIdentityRegistration{ID: identity.FromAddress("0x1"), Status: registry.Registered, ChainID: 1})
+	h.ConsumeTraceEvent(trace.Event{ID: "trace"})
+
+	sub, err := h.Listen([]string{"registration"}, 0)
+	assert.NoError(t, err)
+	defer sub.Close()
+	assert.Empty(t, sub.Backlog)
+
+	resumed, err := h.Listen(nil, 1)
+	assert.NoError(t, err)
+	defer resumed.Close()
+	assert.Len(t, resumed.Backlog, 1)
+	assert.Equal(t, uint64(2), resumed.Backlog[0].ID)
+	assert.Equal(t, TraceEvent, resumed.Backlog[0].Type)
+
+	h.ConsumeRegistrationEvent(registry.AppEventIdentityRegistration{ID: identity.FromAddress("0x1"), Status: registry.Registered, ChainID: 2})
+	msg := <-sub.Messages()
+	assert.Equal(t, uint64(3), msg.ID)
+	assert.Contains(t, msg.Data, `"chain_id":2`)
+
+	_, err = h.Listen([]string{"unknown"}, 0)
+	assert.Error(t, err)
+}
@@ -302,7 +302,7 @@ func (te *transactorEndpoint) RegisterIdentity(resp http.ResponseWriter, request
 	resp.WriteHeader(http.StatusAccepted)
 }
 
-// swagger:operation POST /identities/{id}/beneficiary
+// swagger:operation POST /identities/{id}/beneficiary Identity settleWithBeneficiary
 // ---
 // summary: Settle with Beneficiary
 // description: Change beneficiary and settle earnings to it. This is async method.
@@ -335,7 +335,7 @@ func (te *transactorEndpoint) SettleWithBeneficiaryAsync(resp http.ResponseWrite
 	resp.WriteHeader(http.StatusAccepted)
 }
 
-// swagger:operation GET /identities/{id}/beneficiary-status
+// swagger:operation GET /identities/{id}/beneficiary-status Identity beneficiaryTxStatus
 // ---
 // summary: Returns beneficiary transaction status
 // description: Returns the last beneficiary transaction status for given identity
@@ -370,7 +370,7 @@ func (te *transactorEndpoint) BeneficiaryTxStatus(resp http.ResponseWriter, _ *h
 	)
 }
 
-// swagger:operation GET /settle/history settlementList
+// swagger:operation GET /transactor/settle/history Transactor settlementList
 // ---
 // summary: Returns settlement history
 // description: Returns settlement history
@@ -411,7 +411,7 @@ func (te *transactorEndpoint) SettlementHistory(resp http.ResponseWriter, req *h
 	utils.WriteAsJSON(response, resp)
 }
 
-// swagger:operation POST /transactor/stake/decrease Decrease Stake
+// swagger:operation POST /transactor/stake/decrease Transactor decreaseStake
 // ---
 // summary: Decreases stake
 // description: Decreases stake on eth blockchain via the mysterium transactor.
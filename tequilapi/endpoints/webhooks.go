@@ -0,0 +1,305 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/mysteriumnetwork/node/core/webhook"
+	"github.com/mysteriumnetwork/node/tequilapi/contract"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+	"github.com/mysteriumnetwork/node/tequilapi/validation"
+)
+
+type webhookManager interface {
+	Create(url string, topics []webhook.Topic, secret string) (webhook.Webhook, error)
+	List() ([]webhook.Webhook, error)
+	Get(id string) (webhook.Webhook, error)
+	Delete(id string) error
+	Test(id string) (webhook.Delivery, error)
+	Deliveries(webhookID string) []webhook.Delivery
+}
+
+type webhooksEndpoint struct {
+	webhooks webhookManager
+}
+
+// swagger:operation GET /webhooks Webhooks listWebhooks
+// ---
+// summary: Lists webhooks
+// description: Lists webhooks notified about node events. Signing secrets are never returned.
+// responses:
+//   200:
+//     description: Webhooks
+//     schema:
+//       "$ref": "#/definitions/WebhookListResponse"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *webhooksEndpoint) List(resp http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+	webhooks, err := e.webhooks.List()
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	res := contract.WebhookListResponse{Webhooks: make([]contract.WebhookDTO, len(webhooks))}
+	for i, w := range webhooks {
+		res.Webhooks[i] = newWebhookDTO(w)
+	}
+	utils.WriteAsJSON(res, resp)
+}
+
+// swagger:operation POST /webhooks Webhooks createWebhook
+// ---
+// summary: Creates webhook
+// description: Creates webhook notified about events of the given topics. Notifications are signed with HMAC-SHA256 of the secret, returned secret can not be retrieved later.
+// parameters:
+//   - in: body
+//     name: body
+//     schema:
+//       $ref: "#/definitions/CreateWebhookRequest"
+// responses:
+//   201:
+//     description: Webhook created
+//     schema:
+//       "$ref": "#/definitions/CreateWebhookResponse"
+//   400:
+//     description: Body parsing error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   422:
+//     description: Parameters validation error
+//     schema:
+//       "$ref": "#/definitions/ValidationErrorDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *webhooksEndpoint) Create(resp http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	var cr contract.CreateWebhookRequest
+	if err := json.NewDecoder(req.Body).Decode(&cr); err != nil {
+		utils.SendError(resp, fmt.Errorf("failed to parse webhook request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	topics, errorMap := validateCreateWebhookRequest(cr)
+	if errorMap.HasErrors() {
+		utils.SendValidationErrorMessage(resp, errorMap)
+		return
+	}
+
+	w, err := e.webhooks.Create(cr.URL, topics, cr.Secret)
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteAsJSON(contract.CreateWebhookResponse{
+		WebhookDTO: newWebhookDTO(w),
+		Secret:     w.Secret,
+	}, resp, http.StatusCreated)
+}
+
+// swagger:operation DELETE /webhooks/{id} Webhooks deleteWebhook
+// ---
+// summary: Deletes webhook
+// description: Deletes webhook, pending deliveries to it are abandoned
+// parameters:
+// - name: id
+//   in: path
+//   description: Webhook ID
+//   type: string
+//   required: true
+// responses:
+//   202:
+//     description: Webhook deleted
+//   404:
+//     description: Webhook not found
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *webhooksEndpoint) Delete(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	err := e.webhooks.Delete(params.ByName("id"))
+	if err == webhook.ErrWebhookNotFound {
+		utils.SendError(resp, err, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+	resp.WriteHeader(http.StatusAccepted)
+}
+
+// swagger:operation POST /webhooks/{id}/test Webhooks testWebhook
+// ---
+// summary: Sends test notification
+// description: Sends a ping notification to webhook, delivery progress can be followed in its delivery history
+// parameters:
+// - name: id
+//   in: path
+//   description: Webhook ID
+//   type: string
+//   required: true
+// responses:
+//   202:
+//     description: Test notification queued
+//     schema:
+//       "$ref": "#/definitions/WebhookDeliveryDTO"
+//   404:
+//     description: Webhook not found
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *webhooksEndpoint) Test(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	delivery, err := e.webhooks.Test(params.ByName("id"))
+	if err == webhook.ErrWebhookNotFound {
+		utils.SendError(resp, err, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+	utils.WriteAsJSON(newWebhookDeliveryDTO(delivery), resp, http.StatusAccepted)
+}
+
+// swagger:operation GET /webhooks/{id}/deliveries Webhooks listWebhookDeliveries
+// ---
+// summary: Lists webhook deliveries
+// description: Lists most recent notification deliveries to webhook, latest first. History is kept in memory only.
+// parameters:
+// - name: id
+//   in: path
+//   description: Webhook ID
+//   type: string
+//   required: true
+// responses:
+//   200:
+//     description: Webhook deliveries
+//     schema:
+//       "$ref": "#/definitions/WebhookDeliveryListResponse"
+//   404:
+//     description: Webhook not found
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+//   500:
+//     description: Internal server error
+//     schema:
+//       "$ref": "#/definitions/ErrorMessageDTO"
+func (e *webhooksEndpoint) Deliveries(resp http.ResponseWriter, _ *http.Request, params httprouter.Params) {
+	w, err := e.webhooks.Get(params.ByName("id"))
+	if err == webhook.ErrWebhookNotFound {
+		utils.SendError(resp, err, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.SendError(resp, err, http.StatusInternalServerError)
+		return
+	}
+
+	deliveries := e.webhooks.Deliveries(w.ID)
+	res := contract.WebhookDeliveryListResponse{Deliveries: make([]contract.WebhookDeliveryDTO, len(deliveries))}
+	for i, d := range deliveries {
+		res.Deliveries[i] = newWebhookDeliveryDTO(d)
+	}
+	utils.WriteAsJSON(res, resp)
+}
+
+func validateCreateWebhookRequest(cr contract.CreateWebhookRequest) ([]webhook.Topic, *validation.FieldErrorMap) {
+	errorMap := validation.NewErrorMap()
+	if cr.URL == "" {
+		errorMap.ForField("url").Required()
+	} else if err := webhook.ValidateURL(cr.URL); err != nil {
+		errorMap.ForField("url").Invalid(err.Error())
+	}
+	if len(cr.Topics) == 0 {
+		errorMap.ForField("topics").Required()
+	}
+
+	topics := make([]webhook.Topic, 0, len(cr.Topics))
+	for _, name := range cr.Topics {
+		topic, err := webhook.ParseTopic(name)
+		if err != nil {
+			errorMap.ForField("topics").Invalid(err.Error())
+			continue
+		}
+		topics = append(topics, topic)
+	}
+	return topics, errorMap
+}
+
+func newWebhookDTO(w webhook.Webhook) contract.WebhookDTO {
+	dto := contract.WebhookDTO{
+		ID:        w.ID,
+		URL:       w.URL,
+		Topics:    make([]string, len(w.Topics)),
+		CreatedAt: w.CreatedAt.Format(time.RFC3339),
+	}
+	for i, topic := range w.Topics {
+		dto.Topics[i] = string(topic)
+	}
+	return dto
+}
+
+func newWebhookDeliveryDTO(d webhook.Delivery) contract.WebhookDeliveryDTO {
+	dto := contract.WebhookDeliveryDTO{
+		ID:             d.ID,
+		NotificationID: d.NotificationID,
+		Topic:          string(d.Topic),
+		Status:         string(d.Status),
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		Attempts:       make([]contract.WebhookDeliveryAttemptDTO, len(d.Attempts)),
+	}
+	if d.NextAttemptAt != nil {
+		dto.NextAttemptAt = d.NextAttemptAt.Format(time.RFC3339)
+	}
+	for i, a := range d.Attempts {
+		dto.Attempts[i] = contract.WebhookDeliveryAttemptDTO{
+			Time:       a.Time.Format(time.RFC3339),
+			StatusCode: a.StatusCode,
+			Error:      a.Error,
+			DurationMS: int64(a.Duration / time.Millisecond),
+		}
+	}
+	return dto
+}
+
+// AddRoutesForWebhooks registers /webhooks endpoints in Tequilapi
+func AddRoutesForWebhooks(router *httprouter.Router, webhooks webhookManager) {
+	e := &webhooksEndpoint{webhooks: webhooks}
+	router.GET("/webhooks", e.List)
+	router.POST("/webhooks", e.Create)
+	router.DELETE("/webhooks/:id", e.Delete)
+	router.POST("/webhooks/:id/test", e.Test)
+	router.GET("/webhooks/:id/deliveries", e.Deliveries)
+}
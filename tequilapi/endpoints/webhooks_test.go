@@ -0,0 +1,226 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package endpoints
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mysteriumnetwork/node/core/webhook"
+)
+
+type mockWebhookManager struct {
+	webhooks   []webhook.Webhook
+	deliveries []webhook.Delivery
+	created    webhook.Webhook
+	deleted    string
+}
+
+func (m *mockWebhookManager) Create(url string, topics []webhook.Topic, secret string) (webhook.Webhook, error) {
+	if secret == "" {
+		secret = "generated"
+	}
+	m.created = webhook.Webhook{
+		ID:        "abc",
+		URL:       url,
+		Secret:    secret,
+		Topics:    topics,
+		CreatedAt: time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+	return m.created, nil
+}
+
+func (m *mockWebhookManager) List() ([]webhook.Webhook, error) {
+	return m.webhooks, nil
+}
+
+func (m *mockWebhookManager) Get(id string) (webhook.Webhook, error) {
+	for _, w := range m.webhooks {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+	return webhook.Webhook{}, webhook.ErrWebhookNotFound
+}
+
+func (m *mockWebhookManager) Delete(id string) error {
+	if _, err := m.Get(id); err != nil {
+		return err
+	}
+	m.deleted = id
+	return nil
+}
+
+func (m *mockWebhookManager) Test(id string) (webhook.Delivery, error) {
+	if _, err := m.Get(id); err != nil {
+		return webhook.Delivery{}, err
+	}
+	return m.deliveries[0], nil
+}
+
+func (m *mockWebhookManager) Deliveries(webhookID string) []webhook.Delivery {
+	return m.deliveries
+}
+
+func serveWebhooks(manager webhookManager, method, path, body string) *httptest.ResponseRecorder {
+	router := httprouter.New()
+	AddRoutesForWebhooks(router, manager)
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	return resp
+}
+
+func Test_Webhooks_Create(t *testing.T) {
+	manager := &mockWebhookManager{}
+
+	resp := serveWebhooks(manager, http.MethodPost, "/webhooks",
+		`{"url": "https://example.com/hook", "topics": ["balance", "nat-failure"]}`)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, []webhook.Topic{webhook.TopicBalance, webhook.TopicNATFailure}, manager.created.Topics)
+	assert.JSONEq(t,
+		`{
+			"id": "abc",
+			"url": "https://example.com/hook",
+			"topics": ["balance", "nat-failure"],
+			"created_at": "2020-10-01T12:00:00Z",
+			"secret": "generated"
+		}`,
+		resp.Body.String(),
+	)
+}
+
+func Test_Webhooks_CreateValidatesRequest(t *testing.T) {
+	resp := serveWebhooks(&mockWebhookManager{}, http.MethodPost, "/webhooks",
+		`{"url": "ftp://example.com", "topics": ["weather"]}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"url"`)
+	assert.Contains(t, resp.Body.String(), `unknown topic \"weather\"`)
+
+	resp = serveWebhooks(&mockWebhookManager{}, http.MethodPost, "/webhooks", `{}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"url"`)
+	assert.Contains(t, resp.Body.String(), `"topics"`)
+}
+
+func Test_Webhooks_ListDoesNotExposeSecret(t *testing.T) {
+	manager := &mockWebhookManager{webhooks: []webhook.Webhook{{
+		ID:        "abc",
+		URL:       "https://example.com/hook",
+		Secret:    "s3cr3t",
+		Topics:    []webhook.Topic{webhook.TopicServiceStatus},
+		CreatedAt: time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+	}}}
+
+	resp := serveWebhooks(manager, http.MethodGet, "/webhooks", "")
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t,
+		`{"webhooks": [{
+			"id": "abc",
+			"url": "https://example.com/hook",
+			"topics": ["service-status"],
+			"created_at": "2020-10-01T12:00:00Z"
+		}]}`,
+		resp.Body.String(),
+	)
+}
+
+func Test_Webhooks_Delete(t *testing.T) {
+	manager := &mockWebhookManager{webhooks: []webhook.Webhook{{ID: "abc"}}}
+
+	resp := serveWebhooks(manager, http.MethodDelete, "/webhooks/abc", "")
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Equal(t, "abc", manager.deleted)
+
+	resp = serveWebhooks(manager, http.MethodDelete, "/webhooks/unknown", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func Test_Webhooks_Deliveries(t *testing.T) {
+	created := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	next := created.Add(10 * time.Second)
+	manager := &mockWebhookManager{
+		webhooks: []webhook.Webhook{{ID: "abc"}},
+		deliveries: []webhook.Delivery{{
+			ID:             "d1",
+			WebhookID:      "abc",
+			NotificationID: "n1",
+			Topic:          webhook.TopicSettlementFailed,
+			Status:         webhook.DeliveryPending,
+			Error:          "unexpected response status 503 Service Unavailable",
+			CreatedAt:      created,
+			NextAttemptAt:  &next,
+			Attempts: []webhook.Attempt{{
+				Time:       created,
+				StatusCode: 503,
+				Error:      "unexpected response status 503 Service Unavailable",
+				Duration:   120 * time.Millisecond,
+			}},
+		}},
+	}
+
+	resp := serveWebhooks(manager, http.MethodGet, "/webhooks/abc/deliveries", "")
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t,
+		`{"deliveries": [{
+			"id": "d1",
+			"notification_id": "n1",
+			"topic": "settlement-failed",
+			"status": "pending",
+			"error": "unexpected response status 503 Service Unavailable",
+			"created_at": "2020-10-01T12:00:00Z",
+			"next_attempt_at": "2020-10-01T12:00:10Z",
+			"attempts": [{
+				"time": "2020-10-01T12:00:00Z",
+				"status_code": 503,
+				"error": "unexpected response status 503 Service Unavailable",
+				"duration_ms": 120
+			}]
+		}]}`,
+		resp.Body.String(),
+	)
+
+	resp = serveWebhooks(manager, http.MethodGet, "/webhooks/unknown/deliveries", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func Test_Webhooks_Test(t *testing.T) {
+	manager := &mockWebhookManager{
+		webhooks:   []webhook.Webhook{{ID: "abc"}},
+		deliveries: []webhook.Delivery{{ID: "d1", Topic: webhook.TopicPing, Status: webhook.DeliveryPending}},
+	}
+
+	resp := serveWebhooks(manager, http.MethodPost, "/webhooks/abc/test", "")
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"topic":"ping"`)
+
+	resp = serveWebhooks(manager, http.MethodPost, "/webhooks/unknown/test", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tequilapi
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mysteriumnetwork/node/core/audit"
+	"github.com/mysteriumnetwork/node/core/auth"
+)
+
+// auditBodyLimit is the size of request body captured for audit, requests are passed on unchanged.
+const auditBodyLimit = 64 * 1024
+
+type auditRecorder interface {
+	Record(e audit.Entry) error
+}
+
+type actorResolver interface {
+	Actor(token string) string
+}
+
+type auditHandler struct {
+	originalHandler http.Handler
+	recorder        auditRecorder
+	actors          actorResolver
+}
+
+// AuditRequests middleware records state changing API requests to the audit log
+func AuditRequests(original http.Handler, recorder auditRecorder, actors actorResolver) http.Handler {
+	return &auditHandler{
+		originalHandler: original,
+		recorder:        recorder,
+		actors:          actors,
+	}
+}
+
+func (ah *auditHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if !audited(req) {
+		ah.originalHandler.ServeHTTP(resp, req)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(req.Body, auditBodyLimit))
+	if err != nil {
+		log.Warn().Err(err).Msg("Could not read request body for audit")
+	}
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+
+	recorder := &statusRecorder{ResponseWriter: resp, status: http.StatusOK}
+	ah.originalHandler.ServeHTTP(recorder, req)
+
+	actor := ah.actors.Actor(requestToken(req))
+	if actor == "" {
+		actor = "anonymous"
+	}
+	entry := audit.Entry{
+		Actor:        actor,
+		RemoteAddr:   req.RemoteAddr,
+		ForwardedFor: req.Header.Get("X-Forwarded-For"),
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		Query:        audit.RedactQuery(req.URL.Query()),
+		Body:         audit.RedactBody(body),
+		Status:       recorder.status,
+	}
+	if err := ah.recorder.Record(entry); err != nil {
+		log.Error().Err(err).Msgf("Could not audit %s %s", req.Method, req.URL.Path)
+	}
+}
+
+// audited selects state changing requests and privileged reads, e.g. identity export.
+func audited(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return auth.RequiredScope(req.Method, req.URL.Path) == auth.ScopeAdmin
+	default:
+		return true
+	}
+}
+
+func requestToken(req *http.Request) string {
+	if header := req.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+	}
+	if cookie, err := req.Cookie(auth.JWTCookieName); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (sr *statusRecorder) WriteHeader(status int) {
+	sr.status = status
+	sr.ResponseWriter.WriteHeader(status)
+}
+
+// Flush passes flushing to the original writer, so that streaming endpoints work when audited.
+func (sr *statusRecorder) Flush() {
+	if flusher, ok := sr.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tequilapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mysteriumnetwork/node/core/audit"
+)
+
+type mockAuditRecorder struct {
+	entries []audit.Entry
+}
+
+func (mar *mockAuditRecorder) Record(e audit.Entry) error {
+	mar.entries = append(mar.entries, e)
+	return nil
+}
+
+type mockActorResolver struct{}
+
+func (mockActorResolver) Actor(token string) string {
+	if token == "" {
+		return ""
+	}
+	return "user:" + token
+}
+
+func TestAuditRequests_RecordsChanges(t *testing.T) {
+	recorder := &mockAuditRecorder{}
+	var received string
+	handler := AuditRequests(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		received = string(body)
+		resp.WriteHeader(http.StatusAccepted)
+	}), recorder, mockActorResolver{})
+
+	body := `{"current_password":"old","new_password":"new","username":"myst"}`
+	req := httptest.NewRequest(http.MethodPut, "/auth/password?token=abc", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer myst")
+	req.Header.Set("X-Forwarded-For", "192.168.1.5")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, body, received)
+	require.Len(t, recorder.entries, 1)
+	entry := recorder.entries[0]
+	assert.Equal(t, "user:myst", entry.Actor)
+	assert.Equal(t, "192.168.1.5", entry.ForwardedFor)
+	assert.NotEmpty(t, entry.RemoteAddr)
+	assert.Equal(t, http.MethodPut, entry.Method)
+	assert.Equal(t, "/auth/password", entry.Path)
+	assert.Equal(t, http.StatusAccepted, entry.Status)
+	assert.Equal(t, []string{"[REDACTED]"}, entry.Query["token"])
+	assert.JSONEq(t, `{"current_password":"[REDACTED]","new_password":"[REDACTED]","username":"myst"}`, string(entry.Body))
+}
+
+func TestAuditRequests_SkipsReads(t *testing.T) {
+	recorder := &mockAuditRecorder{}
+	handler := AuditRequests(&mockedHTTPHandler{}, recorder, mockActorResolver{})
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/identities", nil))
+	assert.Empty(t, recorder.entries)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/identities/0x1/export", nil))
+	require.Len(t, recorder.entries, 1)
+	assert.Equal(t, "anonymous", recorder.entries[0].Actor)
+	assert.Equal(t, http.StatusOK, recorder.entries[0].Status)
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tequilapi
+
+import (
+	"net/http"
+
+	"github.com/mysteriumnetwork/node/core/auth"
+	"github.com/mysteriumnetwork/node/tequilapi/endpoints"
+	"github.com/mysteriumnetwork/node/tequilapi/utils"
+)
+
+type requestAuthorizer interface {
+	Authorize(token, method, path string) error
+}
+
+type authorizationHandler struct {
+	originalHandler http.Handler
+	authorizer      requestAuthorizer
+}
+
+// AuthorizeRequests middleware limits API requests to what their token allows, API tokens are checked against their scopes.
+// TLS listener is meant for remote clients, so requests over TLS must carry a token.
+// Tokenless requests are let through on plain TCP and Unix socket listeners only,
+// which are protected by the bind address and socket file permissions.
+func AuthorizeRequests(original http.Handler, authorizer requestAuthorizer) http.Handler {
+	return &authorizationHandler{
+		originalHandler: original,
+		authorizer:      authorizer,
+	}
+}
+
+func (ah *authorizationHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == endpoints.TequilapiAuthenticateEndpointPath || req.URL.Path == endpoints.TequilapiLoginEndpointPath {
+		ah.originalHandler.ServeHTTP(resp, req)
+		return
+	}
+
+	token := requestToken(req)
+	if token == "" && req.TLS == nil {
+		ah.originalHandler.ServeHTTP(resp, req)
+		return
+	}
+
+	switch err := ah.authorizer.Authorize(token, req.Method, req.URL.Path); err {
+	case nil:
+		ah.originalHandler.ServeHTTP(resp, req)
+	case auth.ErrForbidden:
+		utils.SendError(resp, err, http.StatusForbidden)
+	default:
+		utils.SendError(resp, auth.ErrUnauthorized, http.StatusUnauthorized)
+	}
+}
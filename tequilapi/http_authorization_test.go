@@ -0,0 +1,123 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tequilapi
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mysteriumnetwork/node/core/auth"
+	"github.com/mysteriumnetwork/node/core/storage/boltdb"
+)
+
+// mockRequestAuthorizer grants "read-token" read scope and "admin-token" full access.
+type mockRequestAuthorizer struct{}
+
+func (mockRequestAuthorizer) Authorize(token, method, path string) error {
+	switch token {
+	case "admin-token":
+		return nil
+	case "read-token":
+		if auth.RequiredScope(method, path) == auth.ScopeRead {
+			return nil
+		}
+		return auth.ErrForbidden
+	default:
+		return auth.ErrUnauthorized
+	}
+}
+
+func TestAuthorizeRequests(t *testing.T) {
+	handler := AuthorizeRequests(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.WriteHeader(http.StatusOK)
+	}), mockRequestAuthorizer{})
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		tls            bool
+		expectedStatus int
+	}{
+		{name: "read scope reads", method: http.MethodGet, path: "/identities", token: "read-token", tls: true, expectedStatus: http.StatusOK},
+		{name: "read scope can not connect", method: http.MethodPut, path: "/connection", token: "read-token", tls: true, expectedStatus: http.StatusForbidden},
+		{name: "read scope can not connect over plain listener", method: http.MethodPut, path: "/connection", token: "read-token", expectedStatus: http.StatusForbidden},
+		{name: "read scope can not export identity", method: http.MethodPost, path: "/identities/0x1/export", token: "read-token", expectedStatus: http.StatusForbidden},
+		{name: "admin connects", method: http.MethodPut, path: "/connection", token: "admin-token", tls: true, expectedStatus: http.StatusOK},
+		{name: "invalid token", method: http.MethodGet, path: "/identities", token: "invalid", expectedStatus: http.StatusUnauthorized},
+		{name: "TLS requires token", method: http.MethodGet, path: "/identities", tls: true, expectedStatus: http.StatusUnauthorized},
+		{name: "login does not require token", method: http.MethodPost, path: "/auth/login", tls: true, expectedStatus: http.StatusOK},
+		{name: "local request without token", method: http.MethodPut, path: "/connection", expectedStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			if tt.tls {
+				req.TLS = &tls.ConnectionState{}
+			}
+			resp := httptest.NewRecorder()
+
+			handler.ServeHTTP(resp, req)
+
+			assert.Equal(t, tt.expectedStatus, resp.Code)
+		})
+	}
+}
+
+func TestAuthorizeRequests_UnderScopedAPITokenIsForbidden(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tequilapiAuthorizationTest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	bolt, err := boltdb.NewStorage(dir)
+	require.NoError(t, err)
+	defer bolt.Close()
+
+	tokens := auth.NewTokenManager(bolt)
+	_, secret, err := tokens.Create("dashboard", []auth.Scope{auth.ScopeRead, auth.ScopeConnection}, nil)
+	require.NoError(t, err)
+
+	handler := AuthorizeRequests(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.WriteHeader(http.StatusOK)
+	}), auth.NewRequestAuthorizer(auth.NewJWTAuthenticator(auth.JWTEncryptionKey("key")), tokens))
+
+	serve := func(method, path string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+secret)
+		req.TLS = &tls.ConnectionState{}
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/services"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPut, "/connection"))
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/services"))
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/transactor/settle/sync"))
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/logs"))
+}
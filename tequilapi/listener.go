@@ -17,13 +17,143 @@
 
 package tequilapi
 
-import "net"
+import (
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
 
 // NewListener returns tequilapi listener.
 func NewListener(network, address string) (net.Listener, error) {
 	return net.Listen(network, address)
 }
 
+// NewUnixListener returns tequilapi listener on Unix domain socket.
+// Access to the socket is restricted by the given file mode, stale socket left by previous run is removed.
+func NewUnixListener(path string, mode os.FileMode) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, errors.Errorf("refusing to replace non-socket file %s", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, errors.Wrap(err, "could not remove stale socket")
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		listener.Close()
+		return nil, errors.Wrap(err, "could not set socket permissions")
+	}
+	return listener, nil
+}
+
+// NewMultiListener returns listener accepting connections from all of the given listeners.
+// Address of the first listener is reported as the address of the returned one.
+// Failed listener is dropped, Accept fails only after all of them have failed.
+func NewMultiListener(listeners ...net.Listener) net.Listener {
+	if len(listeners) == 1 {
+		return listeners[0]
+	}
+
+	ml := &multiListener{
+		listeners: listeners,
+		active:    int32(len(listeners)),
+		accepted:  make(chan acceptResult),
+		closed:    make(chan struct{}),
+	}
+	for _, l := range listeners {
+		go ml.acceptFrom(l)
+	}
+	return ml
+}
+
+type acceptResult struct {
+	conn net.Conn
+	err  error
+}
+
+type multiListener struct {
+	listeners []net.Listener
+	active    int32
+	accepted  chan acceptResult
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (ml *multiListener) acceptFrom(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); !ok || !ne.Temporary() {
+				ml.drop(l, err)
+				return
+			}
+		}
+
+		select {
+		case ml.accepted <- acceptResult{conn: conn, err: err}:
+		case <-ml.closed:
+			if conn != nil {
+				conn.Close()
+			}
+			return
+		}
+	}
+}
+
+// drop stops serving the failed listener, error is passed to Accept only when it was the last one.
+func (ml *multiListener) drop(l net.Listener, err error) {
+	select {
+	case <-ml.closed:
+		return
+	default:
+	}
+
+	log.Error().Err(err).Msgf("Tequilapi listener %s failed, not accepting connections on it anymore", l.Addr())
+	if atomic.AddInt32(&ml.active, -1) > 0 {
+		return
+	}
+
+	select {
+	case ml.accepted <- acceptResult{err: err}:
+	case <-ml.closed:
+	}
+}
+
+func (ml *multiListener) Accept() (net.Conn, error) {
+	select {
+	case res := <-ml.accepted:
+		return res.conn, res.err
+	case <-ml.closed:
+		return nil, errors.New("listener closed")
+	}
+}
+
+func (ml *multiListener) Close() error {
+	var err error
+	ml.closeOnce.Do(func() {
+		close(ml.closed)
+		for _, l := range ml.listeners {
+			if closeErr := l.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+	})
+	return err
+}
+
+func (ml *multiListener) Addr() net.Addr {
+	return ml.listeners[0].Addr()
+}
+
 // NewNoopListener returns noop tequilapi listener.
 func NewNoopListener() (net.Listener, error) {
 	return &noopListener{}, nil
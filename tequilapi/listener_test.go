@@ -0,0 +1,61 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tequilapi
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingListener struct {
+	net.Listener
+	err error
+}
+
+func (l *failingListener) Accept() (net.Conn, error) {
+	return nil, l.err
+}
+
+func TestMultiListener_DropsFailedListener(t *testing.T) {
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	failing := &failingListener{Listener: tcpListener, err: errors.New("socket removed")}
+
+	second, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ml := NewMultiListener(failing, second)
+	defer ml.Close()
+
+	client, err := net.Dial("tcp", second.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+
+	conn, err := ml.Accept()
+	require.NoError(t, err)
+	assert.Equal(t, client.LocalAddr().String(), conn.RemoteAddr().String())
+	conn.Close()
+
+	second.Close()
+	_, err = ml.Accept()
+	assert.Error(t, err)
+}
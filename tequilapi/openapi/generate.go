@@ -0,0 +1,640 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package openapi
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	tequilapiPackage = modulePath + "/tequilapi"
+	endpointsPackage = modulePath + "/tequilapi/endpoints"
+)
+
+// modelPackages hold swagger:model and swagger:parameters types referenced by operations.
+var modelPackages = []string{
+	modulePath + "/tequilapi/contract",
+	modulePath + "/tequilapi/utils",
+	endpointsPackage,
+	tequilapiPackage,
+}
+
+var httpMethods = map[string]bool{
+	"GET": true, "HEAD": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true, "OPTIONS": true,
+}
+
+// Route is an endpoint registered in the router of Tequilapi.
+type Route struct {
+	Method string
+	// Path is the path pattern of httprouter, e.g. /identities/:id.
+	Path string
+
+	handler string
+	file    *goFile
+	pkg     *goPackage
+}
+
+// String returns route as "METHOD /path".
+func (r Route) String() string {
+	return r.Method + " " + r.Path
+}
+
+// Generate generates OpenAPI document of Tequilapi from the sources of the module in the given directory.
+// Operations are documented by swagger:operation comments of route handlers, schemas are derived from
+// the types of the contract, so that the document follows the code.
+func Generate(root string) (*Document, error) {
+	g, err := newGenerator(root)
+	if err != nil {
+		return nil, err
+	}
+	return g.generate()
+}
+
+// Routes lists endpoints registered by Tequilapi, as found in the sources of the module in the given directory.
+func Routes(root string) ([]Route, error) {
+	g, err := newGenerator(root)
+	if err != nil {
+		return nil, err
+	}
+	return g.routes()
+}
+
+type generator struct {
+	src        *source
+	schemas    *schemas
+	operations []*operationDoc
+	parameters map[string][]*typeDecl
+}
+
+// operationDoc is operation documented by swagger:operation comment of a function.
+type operationDoc struct {
+	fn     *funcDecl
+	pkg    *goPackage
+	method string
+	path   string
+	tags   []string
+	id     string
+	spec   yamlOperation
+	used   bool
+}
+
+type yamlOperation struct {
+	Summary     string                  `yaml:"summary"`
+	Description string                  `yaml:"description"`
+	Produces    []string                `yaml:"produces"`
+	Parameters  []yamlParameter         `yaml:"parameters"`
+	Responses   map[string]yamlResponse `yaml:"responses"`
+}
+
+type yamlParameter struct {
+	Name        string      `yaml:"name"`
+	In          string      `yaml:"in"`
+	Description string      `yaml:"description"`
+	Type        string      `yaml:"type"`
+	Format      string      `yaml:"format"`
+	Required    bool        `yaml:"required"`
+	Schema      *yamlSchema `yaml:"schema"`
+}
+
+type yamlResponse struct {
+	Description string      `yaml:"description"`
+	Schema      *yamlSchema `yaml:"schema"`
+}
+
+type yamlSchema struct {
+	Ref                  string                 `yaml:"$ref"`
+	Type                 string                 `yaml:"type"`
+	Format               string                 `yaml:"format"`
+	Description          string                 `yaml:"description"`
+	Items                *yamlSchema            `yaml:"items"`
+	Properties           map[string]*yamlSchema `yaml:"properties"`
+	AdditionalProperties *yamlSchema            `yaml:"additionalProperties"`
+}
+
+func newGenerator(root string) (*generator, error) {
+	g := &generator{
+		src:        newSource(root),
+		parameters: make(map[string][]*typeDecl),
+	}
+	g.schemas = newSchemas(g.src)
+
+	for _, importPath := range modelPackages {
+		p, err := g.src.pkg(importPath)
+		if err != nil {
+			return nil, err
+		}
+		g.schemas.scanModels(p)
+		g.scanParameters(p)
+	}
+	for _, importPath := range []string{tequilapiPackage, endpointsPackage} {
+		p, err := g.src.pkg(importPath)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.scanOperations(p); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// scanParameters registers swagger:parameters structs by the IDs of operations they belong to.
+func (g *generator) scanParameters(p *goPackage) {
+	names := make([]string, 0, len(p.types))
+	for name := range p.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		decl := p.types[name]
+		for _, line := range strings.Split(decl.doc, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) == 0 || fields[0] != "swagger:parameters" {
+				continue
+			}
+			for _, operationID := range fields[1:] {
+				g.parameters[operationID] = append(g.parameters[operationID], decl)
+			}
+		}
+	}
+}
+
+// scanOperations parses swagger:operation comments of functions of the package.
+func (g *generator) scanOperations(p *goPackage) error {
+	for _, fn := range p.funcs {
+		lines := strings.Split(fn.doc, "\n")
+		for i, line := range lines {
+			fields := strings.Fields(line)
+			if len(fields) == 0 || fields[0] != "swagger:operation" {
+				continue
+			}
+			if len(fields) < 3 {
+				return fmt.Errorf("%s: %s: malformed swagger:operation annotation", fn.file.name, fn.name)
+			}
+
+			op := &operationDoc{fn: fn, pkg: p, method: strings.ToUpper(fields[1]), path: fields[2]}
+			if len(fields) > 3 {
+				op.tags = fields[3 : len(fields)-1]
+				op.id = fields[len(fields)-1]
+			}
+
+			body := lines[i+1:]
+			for j, line := range body {
+				if strings.TrimSpace(line) == "---" {
+					body = body[j+1:]
+					break
+				}
+			}
+			if err := yaml.Unmarshal([]byte(strings.Join(body, "\n")), &op.spec); err != nil {
+				return fmt.Errorf("%s: %s: invalid swagger:operation spec: %w", fn.file.name, fn.name, err)
+			}
+			g.operations = append(g.operations, op)
+			break
+		}
+	}
+	return nil
+}
+
+// routes finds route registrations of the router in Tequilapi packages.
+func (g *generator) routes() ([]Route, error) {
+	var routes []Route
+	for _, importPath := range []string{tequilapiPackage, endpointsPackage} {
+		p, err := g.src.pkg(importPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range p.files {
+			var inspectErr error
+			ast.Inspect(f.ast, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok || len(call.Args) != 2 {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || !httpMethods[sel.Sel.Name] {
+					return true
+				}
+				path, ok := stringValue(p, call.Args[0])
+				if !ok {
+					inspectErr = fmt.Errorf("%s: %s: could not resolve path of the route", f.name, g.src.fset.Position(call.Pos()))
+					return false
+				}
+				routes = append(routes, Route{
+					Method:  sel.Sel.Name,
+					Path:    path,
+					handler: handlerName(call.Args[1]),
+					file:    f,
+					pkg:     p,
+				})
+				return true
+			})
+			if inspectErr != nil {
+				return nil, inspectErr
+			}
+		}
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes, nil
+}
+
+// stringValue resolves string literal or constant of the package.
+func stringValue(p *goPackage, expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.STRING {
+			return "", false
+		}
+		value, err := strconv.Unquote(expr.Value)
+		return value, err == nil
+	case *ast.Ident:
+		value, ok := p.consts[expr.Name]
+		return value, ok
+	}
+	return "", false
+}
+
+// handlerName returns name of the function or method handling the route, empty for function literals.
+func handlerName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	case *ast.CallExpr:
+		return handlerName(expr.Fun)
+	}
+	return ""
+}
+
+// operationFor finds documentation of the route, looking for the handler in the file registering
+// the route first, then in the package. Routes of undocumented handlers fall back to operations
+// documented for the same method and a matching path.
+func (g *generator) operationFor(route Route) (*operationDoc, error) {
+	if route.handler != "" {
+		scopes := []func(op *operationDoc) bool{
+			func(op *operationDoc) bool { return op.fn.file == route.file },
+			func(op *operationDoc) bool { return op.pkg == route.pkg },
+			func(op *operationDoc) bool { return true },
+		}
+		for _, inScope := range scopes {
+			var candidates []*operationDoc
+			for _, op := range g.operations {
+				if op.fn.name == route.handler && inScope(op) {
+					candidates = append(candidates, op)
+				}
+			}
+			if len(candidates) > 1 {
+				candidates = filterOperations(candidates, func(op *operationDoc) bool { return op.method == route.Method })
+			}
+			if len(candidates) > 1 {
+				candidates = filterOperations(candidates, func(op *operationDoc) bool { return pathMatches(route.Path, op.path) })
+			}
+			if len(candidates) == 1 {
+				return candidates[0], nil
+			}
+			if len(candidates) > 1 {
+				return nil, fmt.Errorf("route %s is documented ambiguously", route)
+			}
+		}
+	}
+
+	candidates := filterOperations(g.operations, func(op *operationDoc) bool {
+		return op.method == route.Method && pathMatches(route.Path, op.path)
+	})
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+	return nil, fmt.Errorf("route %s is not documented", route)
+}
+
+func filterOperations(operations []*operationDoc, keep func(op *operationDoc) bool) []*operationDoc {
+	var filtered []*operationDoc
+	for _, op := range operations {
+		if keep(op) {
+			filtered = append(filtered, op)
+		}
+	}
+	return filtered
+}
+
+// pathMatches checks if documented path is matched by the route pattern.
+func pathMatches(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "*") {
+			return true
+		}
+		if i >= len(pathSegments) {
+			return false
+		}
+		if strings.HasPrefix(segment, ":") || segment == pathSegments[i] {
+			continue
+		}
+		if strings.HasPrefix(pathSegments[i], "{") {
+			continue
+		}
+		return false
+	}
+	return len(patternSegments) == len(pathSegments)
+}
+
+var routeParam = regexp.MustCompile(`[:*](\w+)`)
+
+// openAPIPath converts httprouter path pattern to OpenAPI path template.
+func openAPIPath(pattern string) string {
+	return routeParam.ReplaceAllString(pattern, "{$1}")
+}
+
+var pathParam = regexp.MustCompile(`{(\w+)}`)
+
+func (g *generator) generate() (*Document, error) {
+	routes, err := g.routes()
+	if err != nil {
+		return nil, err
+	}
+
+	info, servers, err := g.info()
+	if err != nil {
+		return nil, err
+	}
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Servers: servers,
+		Paths:   make(map[string]*PathItem),
+	}
+
+	var problems []string
+	operationIDs := make(map[string]int)
+	for _, route := range routes {
+		op, err := g.operationFor(route)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		path := openAPIPath(route.Path)
+		if route.handler == "" {
+			// Function literals dispatch to documented operations, which are listed under their own paths.
+			path = op.path
+		}
+		op.used = true
+
+		operation, err := g.operation(op, path)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("route %s: %v", route, err))
+			continue
+		}
+		if operation.OperationID != "" {
+			operationIDs[operation.OperationID]++
+			if count := operationIDs[operation.OperationID]; count > 1 {
+				operation.OperationID += strconv.Itoa(count)
+			}
+		}
+
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		method := strings.ToLower(route.Method)
+		if _, exists := (*item)[method]; exists {
+			problems = append(problems, fmt.Sprintf("route %s: operation %s %s is documented twice", route, route.Method, path))
+			continue
+		}
+		(*item)[method] = operation
+	}
+	for _, op := range g.operations {
+		if !op.used {
+			problems = append(problems, fmt.Sprintf("%s: %s documents %s %s, which is not routed", op.fn.file.name, op.fn.name, op.method, op.path))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("could not generate OpenAPI document:\n%s", strings.Join(problems, "\n"))
+	}
+
+	doc.Components.Schemas = g.schemas.components
+	return doc, nil
+}
+
+// info reads metadata of the API from swagger:meta package documentation.
+func (g *generator) info() (Info, []Server, error) {
+	p, err := g.src.pkg(tequilapiPackage)
+	if err != nil {
+		return Info{}, nil, err
+	}
+
+	var doc string
+	for _, f := range p.files {
+		if text := f.ast.Doc.Text(); strings.Contains(text, "swagger:meta") {
+			doc = text
+			break
+		}
+	}
+	if doc == "" {
+		return Info{}, nil, fmt.Errorf("package %s has no swagger:meta documentation", tequilapiPackage)
+	}
+
+	lines := strings.Split(doc, "\n")
+	info := Info{Title: strings.TrimSpace(strings.TrimPrefix(lines[0], "Package "+p.name()))}
+	var servers []Server
+	var description []string
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "Host:"):
+			servers = append(servers, Server{URL: "http://" + strings.TrimSpace(strings.TrimPrefix(trimmed, "Host:"))})
+		case strings.HasPrefix(trimmed, "Version:"):
+			info.Version = strings.TrimSpace(strings.TrimPrefix(trimmed, "Version:"))
+		case strings.HasPrefix(line, " "), strings.HasPrefix(trimmed, "swagger:"):
+			// Indented lines hold Swagger 2 metadata, irrelevant for OpenAPI 3.
+		default:
+			description = append(description, line)
+		}
+	}
+	info.Description = strings.TrimSpace(strings.Join(description, "\n"))
+	return info, servers, nil
+}
+
+// operation converts documented Swagger 2 operation to OpenAPI 3.
+func (g *generator) operation(op *operationDoc, path string) (*Operation, error) {
+	operation := &Operation{
+		OperationID: op.id,
+		Tags:        op.tags,
+		Summary:     op.spec.Summary,
+		Description: op.spec.Description,
+		Responses:   make(map[string]*Response),
+	}
+
+	declared := make(map[string]bool)
+	for _, param := range op.spec.Parameters {
+		if param.In == "body" {
+			schema, err := g.schema(param.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", param.Name, err)
+			}
+			operation.RequestBody = &RequestBody{
+				Description: param.Description,
+				Required:    param.Required,
+				Content:     map[string]*MediaType{"application/json": {Schema: schema}},
+			}
+			continue
+		}
+
+		schema := &Schema{Type: param.Type, Format: param.Format}
+		if param.Schema != nil {
+			var err error
+			if schema, err = g.schema(param.Schema); err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", param.Name, err)
+			}
+		}
+		if schema.Type == "" && schema.Ref == "" {
+			schema.Type = "string"
+		}
+		if param.In == "path" {
+			if !strings.Contains(path, "{"+param.Name+"}") {
+				// Handlers shared by several routes document parameters of all of them.
+				continue
+			}
+			declared[param.Name] = true
+		}
+		operation.Parameters = append(operation.Parameters, &Parameter{
+			Name:        param.Name,
+			In:          param.In,
+			Description: param.Description,
+			Required:    param.Required || param.In == "path",
+			Schema:      schema,
+		})
+	}
+	for _, match := range pathParam.FindAllStringSubmatch(path, -1) {
+		if !declared[match[1]] {
+			operation.Parameters = append(operation.Parameters, &Parameter{
+				Name:     match[1],
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+	}
+	for _, decl := range g.parameters[op.id] {
+		params, err := g.structParameters(decl)
+		if err != nil {
+			return nil, err
+		}
+		operation.Parameters = append(operation.Parameters, params...)
+	}
+
+	produces := op.spec.Produces
+	if len(produces) == 0 {
+		produces = []string{"application/json"}
+	}
+	for status, resp := range op.spec.Responses {
+		response := &Response{Description: resp.Description}
+		if resp.Schema != nil {
+			schema, err := g.schema(resp.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("response %s: %w", status, err)
+			}
+			response.Content = make(map[string]*MediaType)
+			for _, mediaType := range produces {
+				response.Content[mediaType] = &MediaType{Schema: schema}
+			}
+		}
+		operation.Responses[status] = response
+	}
+	if len(operation.Responses) == 0 {
+		return nil, fmt.Errorf("operation has no responses documented")
+	}
+	return operation, nil
+}
+
+// structParameters converts fields of swagger:parameters struct to operation parameters.
+func (g *generator) structParameters(decl *typeDecl) ([]*Parameter, error) {
+	st, ok := decl.spec.Type.(*ast.StructType)
+	if !ok {
+		return nil, fmt.Errorf("parameters %s are not a struct", decl.name)
+	}
+
+	var params []*Parameter
+	err := g.schemas.fields(decl.pkg, decl.file, st, func(name string, field *ast.Field, schema *Schema, annotations fieldAnnotations) error {
+		in := annotations.in
+		if in == "" {
+			in = "query"
+		}
+		description := annotations.description
+		annotations.description = ""
+		params = append(params, &Parameter{
+			Name:        name,
+			In:          in,
+			Description: description,
+			Required:    annotations.required || in == "path",
+			Schema:      annotations.apply(&Schema{Ref: schema.Ref, Type: schema.Type, Format: schema.Format, Items: schema.Items}),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parameters %s: %w", decl.name, err)
+	}
+	return params, nil
+}
+
+// schema converts Swagger 2 schema of the operation to OpenAPI 3 one.
+func (g *generator) schema(s *yamlSchema) (*Schema, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/definitions/")
+		if name == s.Ref {
+			return nil, fmt.Errorf("unsupported reference %q", s.Ref)
+		}
+		return g.schemas.model(name)
+	}
+
+	schema := &Schema{Type: s.Type, Format: s.Format, Description: s.Description}
+	var err error
+	if schema.Items, err = g.schema(s.Items); err != nil {
+		return nil, err
+	}
+	if schema.AdditionalProperties, err = g.schema(s.AdditionalProperties); err != nil {
+		return nil, err
+	}
+	if len(s.Properties) > 0 {
+		schema.Properties = make(map[string]*Schema, len(s.Properties))
+		for name, property := range s.Properties {
+			if schema.Properties[name], err = g.schema(property); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return schema, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package openapi
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const moduleRoot = "../.."
+
+func TestGenerate_DocumentsEveryRoute(t *testing.T) {
+	doc, err := Generate(moduleRoot)
+	require.NoError(t, err)
+
+	routes, err := Routes(moduleRoot)
+	require.NoError(t, err)
+	require.NotEmpty(t, routes)
+
+	for _, route := range routes {
+		if _, ok := doc.Operation(route.Method, openAPIPath(route.Path)); ok {
+			continue
+		}
+		// Routes handled by function literals are documented by the operations they dispatch to.
+		documented := false
+		for path, item := range doc.Paths {
+			if _, ok := (*item)[strings.ToLower(route.Method)]; ok && pathMatches(route.Path, path) {
+				documented = true
+			}
+		}
+		assert.True(t, documented, "route %s is not documented", route)
+	}
+}
+
+func TestGenerate_ExamplesMatchSchemas(t *testing.T) {
+	doc, err := Generate(moduleRoot)
+	require.NoError(t, err)
+
+	for _, err := range doc.ValidateExamples() {
+		assert.NoError(t, err)
+	}
+}
+
+func TestGenerate_PublishedDocumentIsUpToDate(t *testing.T) {
+	doc, err := Generate(moduleRoot)
+	require.NoError(t, err)
+	generated, err := doc.MarshalIndented()
+	require.NoError(t, err)
+
+	published, err := ioutil.ReadFile(filepath.Join(moduleRoot, "tequilapi", "docs", "openapi.json"))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(generated), string(published), "tequilapi/docs/openapi.json is outdated, regenerate it with `mage GenerateOpenAPI`")
+}
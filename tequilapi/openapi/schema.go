@@ -0,0 +1,504 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const validationPackage = modulePath + "/tequilapi/validation"
+
+// knownTypes are schemas of types outside the module, or having custom JSON representation.
+var knownTypes = map[string]Schema{
+	"time.Time":                                      {Type: "string", Format: "date-time"},
+	"time.Duration":                                  {Type: "integer", Format: "int64"},
+	"encoding/json.RawMessage":                       {},
+	"math/big.Int":                                   {Type: "integer"},
+	"math/big.Float":                                 {Type: "number"},
+	"github.com/go-openapi/strfmt.Date":              {Type: "string", Format: "date"},
+	"github.com/go-openapi/strfmt.DateTime":          {Type: "string", Format: "date-time"},
+	"github.com/ethereum/go-ethereum/common.Address": {Type: "string"},
+	"github.com/ethereum/go-ethereum/common.Hash":    {Type: "string"},
+	"github.com/rs/zerolog.Level":                    {Type: "integer"},
+}
+
+// schemas builds schemas of Go types, collecting struct types as reusable components.
+type schemas struct {
+	src        *source
+	components map[string]*Schema
+	names      map[*typeDecl]string
+	models     map[string]*typeDecl
+}
+
+func newSchemas(src *source) *schemas {
+	return &schemas{
+		src:        src,
+		components: make(map[string]*Schema),
+		names:      make(map[*typeDecl]string),
+		models:     make(map[string]*typeDecl),
+	}
+}
+
+// scanModels registers types annotated with swagger:model, so that they can be referenced by model name.
+func (b *schemas) scanModels(p *goPackage) {
+	for _, decl := range p.types {
+		if name, ok := modelName(decl); ok {
+			b.models[name] = decl
+		}
+	}
+}
+
+// model returns schema referencing the component of the model by its name.
+func (b *schemas) model(name string) (*Schema, error) {
+	decl, ok := b.models[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown model %q", name)
+	}
+	return b.named(decl)
+}
+
+// modelName returns name given to the type by swagger:model annotation.
+func modelName(decl *typeDecl) (string, bool) {
+	for _, line := range strings.Split(decl.doc, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != "swagger:model" {
+			continue
+		}
+		if len(fields) > 1 {
+			return fields[1], true
+		}
+		return decl.name, true
+	}
+	return "", false
+}
+
+// componentName picks unique name for the component schema of the type.
+func (b *schemas) componentName(decl *typeDecl) string {
+	if name, ok := b.names[decl]; ok {
+		return name
+	}
+	name, ok := modelName(decl)
+	if !ok {
+		name = decl.name
+	}
+	for existing, other := range b.names {
+		if other == name && existing != decl {
+			name = decl.pkg.name() + decl.name
+			break
+		}
+	}
+	b.names[decl] = name
+	return name
+}
+
+// forType returns schema of the type expression used in the given file.
+func (b *schemas) forType(p *goPackage, f *goFile, expr ast.Expr) (*Schema, error) {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return b.forType(p, f, expr.X)
+	case *ast.ParenExpr:
+		return b.forType(p, f, expr.X)
+	case *ast.ArrayType:
+		if ident, ok := expr.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := b.forType(p, f, expr.Elt)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case *ast.MapType:
+		values, err := b.forType(p, f, expr.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case *ast.InterfaceType:
+		return &Schema{}, nil
+	case *ast.StructType:
+		return b.structSchema(p, f, expr)
+	case *ast.Ident:
+		if schema, ok := builtinSchema(expr.Name); ok {
+			return schema, nil
+		}
+	case *ast.SelectorExpr:
+		if schema, ok := knownTypes[qualifiedName(f, expr)]; ok {
+			return &schema, nil
+		}
+	default:
+		return nil, fmt.Errorf("%s: unsupported type expression %T", f.name, expr)
+	}
+
+	decl, ok, err := b.src.lookupType(p, f, expr)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// Types of other modules are not parsed, their representation is unknown.
+		return &Schema{}, nil
+	}
+	return b.named(decl)
+}
+
+// named returns schema of the named type declared in the module.
+func (b *schemas) named(decl *typeDecl) (*Schema, error) {
+	if decl.pkg.path == validationPackage {
+		switch decl.name {
+		case "FieldErrorList":
+			fieldError, err := b.named(decl.pkg.types["FieldError"])
+			if err != nil {
+				return nil, err
+			}
+			return &Schema{Type: "array", Items: fieldError}, nil
+		case "FieldErrorMap":
+			list, err := b.named(decl.pkg.types["FieldErrorList"])
+			if err != nil {
+				return nil, err
+			}
+			return &Schema{Type: "object", AdditionalProperties: list}, nil
+		}
+	}
+	if decl.pkg.methods[decl.name]["MarshalJSON"] {
+		return &Schema{}, nil
+	}
+	if decl.pkg.methods[decl.name]["MarshalText"] {
+		return &Schema{Type: "string"}, nil
+	}
+
+	_, isStruct := decl.spec.Type.(*ast.StructType)
+	_, isModel := modelName(decl)
+	if !isStruct && !isModel {
+		return b.forType(decl.pkg, decl.file, decl.spec.Type)
+	}
+
+	name := b.componentName(decl)
+	if _, ok := b.components[name]; ok {
+		return schemaRef(name), nil
+	}
+	// Placeholder breaks cycles of self referencing types.
+	b.components[name] = &Schema{}
+	schema, err := b.forType(decl.pkg, decl.file, decl.spec.Type)
+	if err != nil {
+		return nil, err
+	}
+	if schema.Ref == "" && schema.Description == "" {
+		schema.Description = docDescription(decl.doc)
+	}
+	b.components[name] = schema
+	return schemaRef(name), nil
+}
+
+func builtinSchema(name string) (*Schema, bool) {
+	switch name {
+	case "string":
+		return &Schema{Type: "string"}, true
+	case "bool":
+		return &Schema{Type: "boolean"}, true
+	case "int", "int8", "int16", "uint", "uint8", "uint16", "byte", "rune":
+		return &Schema{Type: "integer"}, true
+	case "int32", "uint32":
+		return &Schema{Type: "integer", Format: "int32"}, true
+	case "int64", "uint64":
+		return &Schema{Type: "integer", Format: "int64"}, true
+	case "float32":
+		return &Schema{Type: "number", Format: "float"}, true
+	case "float64":
+		return &Schema{Type: "number", Format: "double"}, true
+	case "error":
+		return &Schema{Type: "string"}, true
+	}
+	return nil, false
+}
+
+// structSchema returns object schema of struct fields, as encoding/json represents them.
+func (b *schemas) structSchema(p *goPackage, f *goFile, st *ast.StructType) (*Schema, error) {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	err := b.fields(p, f, st, func(name string, field *ast.Field, fieldSchema *Schema, annotations fieldAnnotations) error {
+		if annotations.required {
+			schema.Required = append(schema.Required, name)
+		}
+		schema.Properties[name] = annotations.apply(fieldSchema)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// fieldVisitor is called for every field of the struct represented in JSON.
+type fieldVisitor func(name string, field *ast.Field, schema *Schema, annotations fieldAnnotations) error
+
+// fields visits JSON fields of the struct, flattening embedded structs.
+func (b *schemas) fields(p *goPackage, f *goFile, st *ast.StructType, visit fieldVisitor) error {
+	for _, field := range st.Fields.List {
+		tagName, tagged := jsonTagName(field)
+		if tagName == "-" {
+			continue
+		}
+
+		if len(field.Names) == 0 && !tagged {
+			embedded, ok, err := b.embeddedStruct(p, f, field.Type)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if err := b.fields(embedded.pkg, embedded.file, embedded.spec.Type.(*ast.StructType), visit); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		names := make([]string, 0, len(field.Names))
+		for _, ident := range field.Names {
+			if ident.IsExported() {
+				names = append(names, ident.Name)
+			}
+		}
+		if len(field.Names) == 0 {
+			names = append(names, embeddedName(field.Type))
+		}
+		for _, name := range names {
+			if tagName != "" {
+				name = tagName
+			}
+			fieldSchema, err := b.forType(p, f, field.Type)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", name, err)
+			}
+			annotations, err := parseFieldAnnotations(fieldDoc(field), fieldSchema, b.components)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", name, err)
+			}
+			if err := visit(name, field, fieldSchema, annotations); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// embeddedStruct resolves type of the embedded field, if it is a struct of the module.
+func (b *schemas) embeddedStruct(p *goPackage, f *goFile, expr ast.Expr) (*typeDecl, bool, error) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	decl, ok, err := b.src.lookupType(p, f, expr)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	if _, ok := decl.spec.Type.(*ast.StructType); !ok {
+		return nil, false, nil
+	}
+	return decl, true, nil
+}
+
+func embeddedName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedName(expr.X)
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
+
+// jsonTagName returns field name given by the json tag.
+func jsonTagName(field *ast.Field) (string, bool) {
+	if field.Tag == nil {
+		return "", false
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return "", false
+	}
+	value, ok := reflect.StructTag(tag).Lookup("json")
+	if !ok {
+		return "", false
+	}
+	name := strings.Split(value, ",")[0]
+	return name, name != ""
+}
+
+func fieldDoc(field *ast.Field) string {
+	doc := field.Doc.Text()
+	if comment := field.Comment.Text(); comment != "" {
+		doc += comment
+	}
+	return doc
+}
+
+// fieldAnnotations holds metadata given in doc comments of a field.
+type fieldAnnotations struct {
+	description string
+	in          string
+	required    bool
+	example     interface{}
+	defaults    interface{}
+}
+
+// annotationKeys are keys of go-swagger annotations, lines starting with them are not part of descriptions.
+var annotationKeys = []string{
+	"example", "required", "in", "default", "format", "minimum", "maximum", "enum", "pattern",
+	"min length", "max length", "min items", "max items", "unique", "read only", "collection format",
+}
+
+func annotationLine(line string) (key, value string, ok bool) {
+	line = strings.TrimSpace(line)
+	for _, key := range annotationKeys {
+		if len(line) > len(key) && strings.EqualFold(line[:len(key)], key) && line[len(key)] == ':' {
+			return key, strings.TrimSpace(line[len(key)+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+func parseFieldAnnotations(doc string, schema *Schema, components map[string]*Schema) (fieldAnnotations, error) {
+	var annotations fieldAnnotations
+	var description []string
+	for _, line := range strings.Split(doc, "\n") {
+		key, value, ok := annotationLine(line)
+		if !ok {
+			if !strings.HasPrefix(strings.TrimSpace(line), "swagger:") {
+				description = append(description, line)
+			}
+			continue
+		}
+		var err error
+		switch key {
+		case "example":
+			annotations.example = parseValue(value, resolve(schema, components))
+		case "default":
+			annotations.defaults = parseValue(value, resolve(schema, components))
+		case "required":
+			annotations.required, err = strconv.ParseBool(value)
+		case "in":
+			annotations.in = value
+		}
+		if err != nil {
+			return annotations, fmt.Errorf("invalid %s annotation %q: %w", key, value, err)
+		}
+	}
+	annotations.description = strings.TrimSpace(strings.Join(description, "\n"))
+	return annotations, nil
+}
+
+// apply sets annotated metadata to the schema of the field.
+func (a fieldAnnotations) apply(schema *Schema) *Schema {
+	if a.description == "" && a.example == nil && a.defaults == nil {
+		return schema
+	}
+	if schema.Ref != "" {
+		// Siblings of $ref are ignored in OpenAPI 3.0, so the reference gets wrapped.
+		schema = &Schema{AllOf: []*Schema{schema}}
+	}
+	schema.Description = a.description
+	schema.Example = a.example
+	schema.Default = a.defaults
+	return schema
+}
+
+// resolve returns schema referenced by the given one, if it is a reference.
+func resolve(schema *Schema, components map[string]*Schema) *Schema {
+	for i := 0; schema != nil && i < 10; i++ {
+		switch {
+		case schema.Ref != "":
+			schema = components[strings.TrimPrefix(schema.Ref, refPrefix)]
+		case len(schema.AllOf) == 1:
+			schema = schema.AllOf[0]
+		default:
+			return schema
+		}
+	}
+	return schema
+}
+
+// parseValue parses annotated value according to the type of the schema. Values not matching
+// the type are kept as given, to be reported by validation of examples.
+func parseValue(value string, schema *Schema) interface{} {
+	if schema == nil {
+		schema = &Schema{}
+	}
+	switch schema.Type {
+	case "string":
+		return value
+	case "integer", "number":
+		var number json.Number
+		if err := json.Unmarshal([]byte(value), &number); err != nil {
+			return value
+		}
+		return number
+	case "boolean":
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+		return value
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err == nil {
+		return parsed
+	}
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+		return value
+	}
+	return jsonCompatible(parsed)
+}
+
+// jsonCompatible converts values decoded from YAML to the ones encoding/json can encode.
+func jsonCompatible(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			converted[fmt.Sprint(k)] = jsonCompatible(v)
+		}
+		return converted
+	case []interface{}:
+		for i, v := range value {
+			value[i] = jsonCompatible(v)
+		}
+	}
+	return value
+}
+
+// docDescription returns doc comment without annotation lines.
+func docDescription(doc string) string {
+	var lines []string
+	for _, line := range strings.Split(doc, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "swagger:") {
+			continue
+		}
+		if _, _, ok := annotationLine(trimmed); ok {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package openapi
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// modulePath is the import path of the module, packages under it are parsed from sources.
+const modulePath = "github.com/mysteriumnetwork/node"
+
+// source holds parsed Go packages of the module, loaded on demand.
+type source struct {
+	root     string
+	fset     *token.FileSet
+	packages map[string]*goPackage
+}
+
+func newSource(root string) *source {
+	return &source{
+		root:     root,
+		fset:     token.NewFileSet(),
+		packages: make(map[string]*goPackage),
+	}
+}
+
+type goPackage struct {
+	path   string
+	files  []*goFile
+	types  map[string]*typeDecl
+	consts map[string]string
+	funcs  []*funcDecl
+	// methods lists method names by receiver type name.
+	methods map[string]map[string]bool
+}
+
+type goFile struct {
+	name    string
+	ast     *ast.File
+	imports map[string]string
+}
+
+type typeDecl struct {
+	pkg  *goPackage
+	file *goFile
+	name string
+	spec *ast.TypeSpec
+	doc  string
+}
+
+type funcDecl struct {
+	file *goFile
+	name string
+	doc  string
+}
+
+// name returns name of the package, as used in its import path.
+func (p *goPackage) name() string {
+	return p.path[strings.LastIndex(p.path, "/")+1:]
+}
+
+// pkg returns package of the module by its import path, parsing it if needed.
+func (s *source) pkg(importPath string) (*goPackage, error) {
+	if p, ok := s.packages[importPath]; ok {
+		return p, nil
+	}
+	if importPath != modulePath && !strings.HasPrefix(importPath, modulePath+"/") {
+		return nil, fmt.Errorf("package %s is not part of the module", importPath)
+	}
+
+	dir := filepath.Join(s.root, filepath.FromSlash(strings.TrimPrefix(importPath, modulePath)))
+	parsed, err := parser.ParseDir(s.fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse package %s: %w", importPath, err)
+	}
+
+	p := &goPackage{
+		path:    importPath,
+		types:   make(map[string]*typeDecl),
+		consts:  make(map[string]string),
+		methods: make(map[string]map[string]bool),
+	}
+	for _, astPkg := range parsed {
+		fileNames := make([]string, 0, len(astPkg.Files))
+		for name := range astPkg.Files {
+			fileNames = append(fileNames, name)
+		}
+		// Declarations are looked up in a stable order, the first one wins for platform specific duplicates.
+		sort.Strings(fileNames)
+		for _, name := range fileNames {
+			p.addFile(name, astPkg.Files[name])
+		}
+	}
+	s.packages[importPath] = p
+	return p, nil
+}
+
+func (p *goPackage) addFile(name string, file *ast.File) {
+	f := &goFile{name: filepath.Base(name), ast: file, imports: make(map[string]string)}
+	for _, imp := range file.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		alias := importPath[strings.LastIndex(importPath, "/")+1:]
+		if imp.Name != nil {
+			alias = imp.Name.Name
+		}
+		f.imports[alias] = importPath
+	}
+	p.files = append(p.files, f)
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					doc := spec.Doc
+					if doc == nil && len(decl.Specs) == 1 {
+						doc = decl.Doc
+					}
+					if _, ok := p.types[spec.Name.Name]; !ok {
+						p.types[spec.Name.Name] = &typeDecl{pkg: p, file: f, name: spec.Name.Name, spec: spec, doc: doc.Text()}
+					}
+				case *ast.ValueSpec:
+					if decl.Tok != token.CONST {
+						continue
+					}
+					for i, ident := range spec.Names {
+						if i >= len(spec.Values) {
+							continue
+						}
+						if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+							p.consts[ident.Name], _ = strconv.Unquote(lit.Value)
+						}
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) == 0 {
+				p.funcs = append(p.funcs, &funcDecl{file: f, name: decl.Name.Name, doc: decl.Doc.Text()})
+				continue
+			}
+			recv := decl.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if ident, ok := recv.(*ast.Ident); ok {
+				if p.methods[ident.Name] == nil {
+					p.methods[ident.Name] = make(map[string]bool)
+				}
+				p.methods[ident.Name][decl.Name.Name] = true
+			}
+			p.funcs = append(p.funcs, &funcDecl{file: f, name: decl.Name.Name, doc: decl.Doc.Text()})
+		}
+	}
+}
+
+// lookupType resolves named type in the context of the given file.
+func (s *source) lookupType(p *goPackage, f *goFile, expr ast.Expr) (*typeDecl, bool, error) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		decl, ok := p.types[expr.Name]
+		return decl, ok, nil
+	case *ast.SelectorExpr:
+		pkgIdent, ok := expr.X.(*ast.Ident)
+		if !ok {
+			return nil, false, nil
+		}
+		importPath, ok := f.imports[pkgIdent.Name]
+		if !ok || (importPath != modulePath && !strings.HasPrefix(importPath, modulePath+"/")) {
+			return nil, false, nil
+		}
+		imported, err := s.pkg(importPath)
+		if err != nil {
+			return nil, false, err
+		}
+		decl, ok := imported.types[expr.Sel.Name]
+		return decl, ok, nil
+	}
+	return nil, false, nil
+}
+
+// qualifiedName returns name of the type expression including package path, e.g. "time.Time".
+func qualifiedName(f *goFile, expr ast.Expr) string {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkgIdent, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return f.imports[pkgIdent.Name] + "." + sel.Sel.Name
+}
@@ -0,0 +1,242 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// MarshalIndented returns document encoded as indented JSON.
+func (d *Document) MarshalIndented() ([]byte, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// Operation returns operation of the given method on the path template, if documented.
+func (d *Document) Operation(method, path string) (*Operation, bool) {
+	item, ok := d.Paths[path]
+	if !ok {
+		return nil, false
+	}
+	op, ok := (*item)[strings.ToLower(method)]
+	return op, ok
+}
+
+// ValidateExamples checks that examples and defaults given in the document conform to their schemas,
+// and that all referenced schemas exist.
+func (d *Document) ValidateExamples() []error {
+	v := &exampleValidator{components: d.Components.Schemas}
+
+	for _, name := range sortedKeys(d.Components.Schemas) {
+		v.walk(refPrefix+name, d.Components.Schemas[name])
+	}
+	for _, path := range sortedPathKeys(d.Paths) {
+		item := d.Paths[path]
+		for _, method := range sortedOperationKeys(*item) {
+			op := (*item)[method]
+			location := fmt.Sprintf("%s %s", strings.ToUpper(method), path)
+			for _, param := range op.Parameters {
+				v.walk(location+" parameter "+param.Name, param.Schema)
+			}
+			if op.RequestBody != nil {
+				for mediaType, content := range op.RequestBody.Content {
+					v.walk(location+" request body "+mediaType, content.Schema)
+				}
+			}
+			for status, resp := range op.Responses {
+				for mediaType, content := range resp.Content {
+					v.walk(location+" response "+status+" "+mediaType, content.Schema)
+				}
+			}
+		}
+	}
+	return v.errs
+}
+
+type exampleValidator struct {
+	components map[string]*Schema
+	errs       []error
+}
+
+// walk validates examples of the schema and its subschemas.
+func (v *exampleValidator) walk(location string, schema *Schema) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != "" {
+		if _, ok := v.components[strings.TrimPrefix(schema.Ref, refPrefix)]; !ok {
+			v.errs = append(v.errs, fmt.Errorf("%s: unresolved reference %s", location, schema.Ref))
+		}
+		return
+	}
+
+	if schema.Example != nil {
+		if err := v.validate(schema.Example, schema); err != nil {
+			v.errs = append(v.errs, fmt.Errorf("%s: invalid example: %w", location, err))
+		}
+	}
+	if schema.Default != nil {
+		if err := v.validate(schema.Default, schema); err != nil {
+			v.errs = append(v.errs, fmt.Errorf("%s: invalid default: %w", location, err))
+		}
+	}
+
+	for i, sub := range schema.AllOf {
+		v.walk(fmt.Sprintf("%s.allOf[%d]", location, i), sub)
+	}
+	for _, name := range sortedKeys(schema.Properties) {
+		v.walk(location+"."+name, schema.Properties[name])
+	}
+	v.walk(location+"[]", schema.Items)
+	v.walk(location+".*", schema.AdditionalProperties)
+}
+
+// validate checks that the value conforms to the schema.
+func (v *exampleValidator) validate(value interface{}, schema *Schema) error {
+	if schema.Ref != "" {
+		component, ok := v.components[strings.TrimPrefix(schema.Ref, refPrefix)]
+		if !ok {
+			return fmt.Errorf("unresolved reference %s", schema.Ref)
+		}
+		return v.validate(value, component)
+	}
+	for _, sub := range schema.AllOf {
+		if err := v.validate(value, sub); err != nil {
+			return err
+		}
+	}
+	if value == nil {
+		if schema.Nullable || schema.Type == "" {
+			return nil
+		}
+		return fmt.Errorf("null is not of type %s", schema.Type)
+	}
+
+	switch schema.Type {
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%v is not a string", value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%v is not a boolean", value)
+		}
+	case "integer", "number":
+		number, ok := toFloat(value)
+		if !ok {
+			return fmt.Errorf("%v is not a number", value)
+		}
+		if schema.Type == "integer" && number != math.Trunc(number) {
+			return fmt.Errorf("%v is not an integer", value)
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%v is not an array", value)
+		}
+		if schema.Items == nil {
+			return nil
+		}
+		for i, item := range items {
+			if err := v.validate(item, schema.Items); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%v is not an object", value)
+		}
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				return fmt.Errorf("required property %s is missing", name)
+			}
+		}
+		for _, name := range sortedKeys(object) {
+			property, ok := schema.Properties[name]
+			if !ok {
+				property = schema.AdditionalProperties
+			}
+			if property == nil {
+				continue
+			}
+			if err := v.validate(object[name], property); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case json.Number:
+		number, err := value.Float64()
+		return number, err == nil
+	case float64:
+		return value, true
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case uint64:
+		return float64(value), true
+	}
+	return 0, false
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]*Schema:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]interface{}:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedPathKeys(paths map[string]*PathItem) []string {
+	keys := make([]string, 0, len(paths))
+	for key := range paths {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedOperationKeys(item PathItem) []string {
+	keys := make([]string, 0, len(item))
+	for key := range item {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
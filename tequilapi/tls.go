@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tequilapi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	selfSignedCertFile = "tequilapi.crt"
+	selfSignedKeyFile  = "tequilapi.key"
+	selfSignedValidity = 10 * 365 * 24 * time.Hour
+)
+
+// TLSOptions describes how tequilapi TLS should be set up.
+type TLSOptions struct {
+	// CertFile and KeyFile point to PEM encoded certificate and its private key.
+	CertFile string
+	KeyFile  string
+	// SelfSigned generates and persists certificate in Dir when CertFile and KeyFile are not given.
+	SelfSigned bool
+	Dir        string
+	Hosts      []string
+	// ClientCAFile enables mutual TLS, requiring clients to present a certificate signed by one of its CAs.
+	ClientCAFile string
+}
+
+// NewTLSConfig builds server TLS configuration and returns it along with the SHA-256 fingerprint of the served certificate.
+func NewTLSConfig(opts TLSOptions) (*tls.Config, string, error) {
+	certFile, keyFile := opts.CertFile, opts.KeyFile
+	if (certFile == "") != (keyFile == "") {
+		return nil, "", errors.New("both TLS certificate and key must be given")
+	}
+
+	if certFile == "" {
+		if !opts.SelfSigned {
+			return nil, "", errors.New("TLS certificate is not configured")
+		}
+		certFile = filepath.Join(opts.Dir, selfSignedCertFile)
+		keyFile = filepath.Join(opts.Dir, selfSignedKeyFile)
+		if err := ensureSelfSignedCert(certFile, keyFile, opts.Hosts); err != nil {
+			return nil, "", err
+		}
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "could not load TLS certificate")
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if opts.ClientCAFile != "" {
+		caPEM, err := ioutil.ReadFile(opts.ClientCAFile)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "could not read TLS client CA")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, "", errors.New("no certificates found in TLS client CA " + opts.ClientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, CertificateFingerprint(cert.Certificate[0]), nil
+}
+
+// CertificateFingerprint returns hex encoded SHA-256 digest of the DER encoded certificate.
+func CertificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// NormalizeFingerprint strips separators and letter case from the given fingerprint, e.g. "AB:CD" becomes "abcd".
+func NormalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.NewReplacer(":", "", " ", "").Replace(fingerprint))
+}
+
+func ensureSelfSignedCert(certFile, keyFile string, hosts []string) error {
+	_, certErr := os.Stat(certFile)
+	_, keyErr := os.Stat(keyFile)
+	if certErr == nil && keyErr == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(certFile), 0700); err != nil {
+		return errors.Wrap(err, "could not create TLS certificate directory")
+	}
+	certPEM, keyPEM, err := generateSelfSignedCert(hosts, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return errors.Wrap(err, "could not write TLS key")
+	}
+	if err := ioutil.WriteFile(certFile, certPEM, 0644); err != nil {
+		return errors.Wrap(err, "could not write TLS certificate")
+	}
+	return nil
+}
+
+func generateSelfSignedCert(hosts []string, now time.Time) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not generate TLS key")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not generate certificate serial")
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Mysterium Network"}, CommonName: "tequilapi"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, host := range append([]string{"localhost", "127.0.0.1", "::1"}, hosts...) {
+		if ip := net.ParseIP(host); ip != nil {
+			if !ip.IsUnspecified() {
+				template.IPAddresses = append(template.IPAddresses, ip)
+			}
+		} else if host != "" {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not create TLS certificate")
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not encode TLS key")
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
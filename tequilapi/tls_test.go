@@ -0,0 +1,151 @@
+/*
+ * Copyright (C) 2020 The "MysteriumNetwork/node" Authors.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tequilapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTLSConfig_GeneratesAndReusesSelfSignedCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tequilapi-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	opts := TLSOptions{SelfSigned: true, Dir: dir, Hosts: []string{"0.0.0.0", "node.local"}}
+	config, fingerprint, err := NewTLSConfig(opts)
+	require.NoError(t, err)
+	require.Len(t, config.Certificates, 1)
+	assert.Len(t, fingerprint, 64)
+
+	cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	require.NoError(t, err)
+	assert.Contains(t, cert.DNSNames, "node.local")
+	assert.Contains(t, cert.DNSNames, "localhost")
+	assert.Equal(t, tls.NoClientCert, config.ClientAuth)
+
+	info, err := os.Stat(filepath.Join(dir, selfSignedKeyFile))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	_, again, err := NewTLSConfig(opts)
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, again)
+}
+
+func TestNewTLSConfig_RequiresClientCertificateWhenCAIsGiven(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tequilapi-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	caPEM, _, err := generateSelfSignedCert(nil, time.Now())
+	require.NoError(t, err)
+	caFile := filepath.Join(dir, "ca.crt")
+	require.NoError(t, ioutil.WriteFile(caFile, caPEM, 0600))
+
+	config, _, err := NewTLSConfig(TLSOptions{SelfSigned: true, Dir: dir, ClientCAFile: caFile})
+	require.NoError(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, config.ClientAuth)
+	assert.NotNil(t, config.ClientCAs)
+}
+
+func TestNewTLSConfig_Errors(t *testing.T) {
+	_, _, err := NewTLSConfig(TLSOptions{CertFile: "cert.pem"})
+	assert.EqualError(t, err, "both TLS certificate and key must be given")
+
+	_, _, err = NewTLSConfig(TLSOptions{})
+	assert.EqualError(t, err, "TLS certificate is not configured")
+}
+
+func TestNormalizeFingerprint(t *testing.T) {
+	assert.Equal(t, "abcdef01", NormalizeFingerprint("AB:CD:EF:01"))
+	assert.Equal(t, "abcdef01", NormalizeFingerprint("ab cd ef 01"))
+}
+
+func TestServerOverTLSAndUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tequilapi-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config, fingerprint, err := NewTLSConfig(TLSOptions{SelfSigned: true, Dir: dir})
+	require.NoError(t, err)
+
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	socketPath := filepath.Join(dir, "tequilapi.sock")
+	socketListener, err := NewUnixListener(socketPath, 0600)
+	require.NoError(t, err)
+
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := NewServer(NewMultiListener(tls.NewListener(tcpListener, config), socketListener), handler, RegexpCorsPolicy{})
+	server.StartServing()
+	defer func() {
+		server.Stop()
+		server.Wait()
+	}()
+
+	httpsClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			assert.Equal(t, fingerprint, CertificateFingerprint(state.PeerCertificates[0].Raw))
+			return nil
+		},
+	}}}
+	resp, err := httpsClient.Get("https://" + tcpListener.Addr().String() + "/")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	socketClient := &http.Client{Transport: &http.Transport{
+		Dial: func(_, _ string) (net.Conn, error) {
+			return net.Dial("unix", socketPath)
+		},
+	}}
+	resp, err = socketClient.Get("http://unix/")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestNewUnixListener_RefusesToReplaceRegularFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tequilapi-sock")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "tequilapi.sock")
+	require.NoError(t, ioutil.WriteFile(path, []byte("data"), 0600))
+
+	_, err = NewUnixListener(path, 0600)
+	assert.Error(t, err)
+}
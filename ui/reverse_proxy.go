@@ -18,6 +18,7 @@
 package ui
 
 import (
+	"crypto/tls"
 	"errors"
 	"net"
 	"net/http"
@@ -32,7 +33,7 @@ import (
 	"github.com/mysteriumnetwork/node/tequilapi/endpoints"
 )
 
-func buildTransport() *http.Transport {
+func buildTransport(tlsConfig *tls.Config) *http.Transport {
 	return &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -41,13 +42,18 @@ func buildTransport() *http.Transport {
 		}).DialContext,
 		MaxIdleConnsPerHost: 5,
 		IdleConnTimeout:     15,
+		TLSClientConfig:     tlsConfig,
 	}
 }
 
-func buildReverseProxy(tequilapiAddress string, tequilapiPort int) *httputil.ReverseProxy {
+func buildReverseProxy(tequilapiAddress string, tequilapiPort int, tequilapiTLS *tls.Config) *httputil.ReverseProxy {
+	scheme := "http"
+	if tequilapiTLS != nil {
+		scheme = "https"
+	}
 	proxy := &httputil.ReverseProxy{
 		Director: func(req *http.Request) {
-			req.URL.Scheme = "http"
+			req.URL.Scheme = scheme
 			req.URL.Host = tequilapiAddress + ":" + strconv.Itoa(tequilapiPort)
 			req.URL.Path = strings.Replace(req.URL.Path, tequilapiUrlPrefix, "", 1)
 			req.URL.Path = strings.TrimRight(req.URL.Path, "/")
@@ -60,7 +66,7 @@ func buildReverseProxy(tequilapiAddress string, tequilapiPort int) *httputil.Rev
 			res.Header.Del("Access-Control-Allow-Methods")
 			return nil
 		},
-		Transport: buildTransport(),
+		Transport: buildTransport(tequilapiTLS),
 	}
 
 	proxy.FlushInterval = 10 * time.Millisecond
@@ -69,8 +75,8 @@ func buildReverseProxy(tequilapiAddress string, tequilapiPort int) *httputil.Rev
 }
 
 // ReverseTequilapiProxy proxies UIServer requests to the TequilAPI server
-func ReverseTequilapiProxy(tequilapiAddress string, tequilapiPort int, authenticator jwtAuthenticator) gin.HandlerFunc {
-	proxy := buildReverseProxy(tequilapiAddress, tequilapiPort)
+func ReverseTequilapiProxy(tequilapiAddress string, tequilapiPort int, tequilapiTLS *tls.Config, authorizer requestAuthorizer) gin.HandlerFunc {
+	proxy := buildReverseProxy(tequilapiAddress, tequilapiPort, tequilapiTLS)
 
 	return func(c *gin.Context) {
 		// skip non Tequilapi routes
@@ -86,7 +92,13 @@ func ReverseTequilapiProxy(tequilapiAddress string, tequilapiPort int, authentic
 				return
 			}
 
-			if _, err := authenticator.ValidateToken(authToken); err != nil {
+			path := strings.Replace(c.Request.URL.Path, tequilapiUrlPrefix, "", 1)
+			err = authorizer.Authorize(authToken, c.Request.Method, path)
+			if err == auth.ErrForbidden {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			if err != nil {
 				c.AbortWithStatus(http.StatusUnauthorized)
 				return
 			}
@@ -19,6 +19,7 @@ package ui
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"strings"
@@ -40,8 +41,8 @@ type Server struct {
 	discovery discovery.LANDiscovery
 }
 
-type jwtAuthenticator interface {
-	ValidateToken(token string) (bool, error)
+type requestAuthorizer interface {
+	Authorize(token, method, path string) error
 }
 
 var corsConfig = cors.Config{
@@ -72,11 +73,12 @@ var corsConfig = cors.Config{
 
 // NewServer creates a new instance of the server for the given port
 // you can chain addresses with ',' i.e. "192.168.0.1,127.0.0.1"
-func NewServer(bindAddress string, port int, tequilapiAddress string, tequilapiPort int, authenticator jwtAuthenticator, httpClient *requests.HTTPClient) *Server {
+// tequilapiTLS should be given when tequilapi is served over HTTPS
+func NewServer(bindAddress string, port int, tequilapiAddress string, tequilapiPort int, tequilapiTLS *tls.Config, authorizer requestAuthorizer, httpClient *requests.HTTPClient) *Server {
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.New()
 	r.Use(gin.Recovery())
-	r.NoRoute(ReverseTequilapiProxy(tequilapiAddress, tequilapiPort, authenticator))
+	r.NoRoute(ReverseTequilapiProxy(tequilapiAddress, tequilapiPort, tequilapiTLS, authorizer))
 	r.Use(cors.New(corsConfig))
 
 	r.StaticFS("/", godvpnweb.Assets)
@@ -27,16 +27,16 @@ import (
 	"golang.org/x/net/html"
 )
 
-type jwtAuth struct {
+type mockAuthorizer struct {
 }
 
-func (j *jwtAuth) ValidateToken(token string) (bool, error) {
-	return false, nil
+func (a *mockAuthorizer) Authorize(token, method, path string) error {
+	return nil
 }
 
 func Test_Server_ServesHTML(t *testing.T) {
 	// given
-	s := NewServer("localhost", 55555, "localhost", 55554, &jwtAuth{}, requests.NewHTTPClient("0.0.0.0", requests.DefaultTimeout))
+	s := NewServer("localhost", 55555, "localhost", 55554, nil, &mockAuthorizer{}, requests.NewHTTPClient("0.0.0.0", requests.DefaultTimeout))
 	s.discovery = &mockDiscovery{}
 	s.Serve()
 	time.Sleep(time.Millisecond * 100)